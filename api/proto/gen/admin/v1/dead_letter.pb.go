@@ -0,0 +1,1094 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: admin/v1/dead_letter.proto
+
+package adminv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeadLetter 一条死信：重试耗尽或遇到永久性错误而最终发送失败的通知
+type DeadLetter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知 ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 业务键
+	BizKey string `protobuf:"bytes,2,opt,name=biz_key,json=bizKey,proto3" json:"biz_key,omitempty"`
+	// 发送渠道
+	Channel v1.Channel `protobuf:"varint,3,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 接收者
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// 累计发送次数
+	Attempts int32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// 最后一次失败的错误类别：RETRYABLE、PERMANENT、THROTTLED
+	ErrorCategory string `protobuf:"bytes,6,opt,name=error_category,json=errorCategory,proto3" json:"error_category,omitempty"`
+	// 最后一次失败的原因
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// 最后一次尝试的供应商
+	Provider string `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 移入死信的时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 最后修改时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeadLetter) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetBizKey() string {
+	if x != nil {
+		return x.BizKey
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetChannel() v1.Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return v1.Channel(0)
+}
+
+func (x *DeadLetter) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// DeadLetterFilter 死信筛选条件，未设置的条件不生效
+type DeadLetterFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 发送渠道
+	Channel v1.Channel `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 错误类别
+	ErrorCategory string `protobuf:"bytes,2,opt,name=error_category,json=errorCategory,proto3" json:"error_category,omitempty"`
+	// 移入死信的时间下限（含）
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// 移入死信的时间上限（不含）
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterFilter) Reset() {
+	*x = DeadLetterFilter{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterFilter) ProtoMessage() {}
+
+func (x *DeadLetterFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterFilter.ProtoReflect.Descriptor instead.
+func (*DeadLetterFilter) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeadLetterFilter) GetChannel() v1.Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return v1.Channel(0)
+}
+
+func (x *DeadLetterFilter) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+func (x *DeadLetterFilter) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *DeadLetterFilter) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+// ListDeadLettersRequest 分页查询死信的请求，按通知 ID 升序返回
+type ListDeadLettersRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *DeadLetterFilter      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 每页条数，默认 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 上一页响应中的 next_page_token，为空时从头开始
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDeadLettersRequest) GetFilter() *DeadLetterFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDeadLettersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListDeadLettersResponse 分页查询死信的响应
+type ListDeadLettersResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetters []*DeadLetter          `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	// 下一页的分页令牌，为空表示没有更多数据
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetDeadLetterRequest 查看单条死信的请求
+type GetDeadLetterRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId uint64                 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDeadLetterRequest) Reset() {
+	*x = GetDeadLetterRequest{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterRequest) ProtoMessage() {}
+
+func (x *GetDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDeadLetterRequest) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+// GetDeadLetterResponse 查看单条死信的响应，包含通知的完整内容
+type GetDeadLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetter    *DeadLetter            `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+	Record        *v1.NotificationRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadLetterResponse) Reset() {
+	*x = GetDeadLetterResponse{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterResponse) ProtoMessage() {}
+
+func (x *GetDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDeadLetterResponse) GetDeadLetter() *DeadLetter {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
+}
+
+func (x *GetDeadLetterResponse) GetRecord() *v1.NotificationRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// TemplateParams 模板参数
+type TemplateParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        map[string]string      `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateParams) Reset() {
+	*x = TemplateParams{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateParams) ProtoMessage() {}
+
+func (x *TemplateParams) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateParams.ProtoReflect.Descriptor instead.
+func (*TemplateParams) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TemplateParams) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// UpdateDeadLetterRequest 修改死信通知内容的请求，仅修改设置了的字段，业务键与渠道不可修改
+type UpdateDeadLetterRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId uint64                 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 新的接收者
+	Recipient *string `protobuf:"bytes,2,opt,name=recipient,proto3,oneof" json:"recipient,omitempty"`
+	// 新的模板 ID
+	TemplateId *string `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	// 新的模板参数，整体替换原有参数
+	TemplateParams *TemplateParams `protobuf:"bytes,4,opt,name=template_params,json=templateParams,proto3" json:"template_params,omitempty"`
+	// 新的邮件内容，整体替换原有内容
+	Email         *v1.EmailContent `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeadLetterRequest) Reset() {
+	*x = UpdateDeadLetterRequest{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeadLetterRequest) ProtoMessage() {}
+
+func (x *UpdateDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateDeadLetterRequest) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *UpdateDeadLetterRequest) GetRecipient() string {
+	if x != nil && x.Recipient != nil {
+		return *x.Recipient
+	}
+	return ""
+}
+
+func (x *UpdateDeadLetterRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *UpdateDeadLetterRequest) GetTemplateParams() *TemplateParams {
+	if x != nil {
+		return x.TemplateParams
+	}
+	return nil
+}
+
+func (x *UpdateDeadLetterRequest) GetEmail() *v1.EmailContent {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+// UpdateDeadLetterResponse 修改死信通知内容的响应
+type UpdateDeadLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetter    *DeadLetter            `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+	Record        *v1.NotificationRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeadLetterResponse) Reset() {
+	*x = UpdateDeadLetterResponse{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeadLetterResponse) ProtoMessage() {}
+
+func (x *UpdateDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateDeadLetterResponse) GetDeadLetter() *DeadLetter {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
+}
+
+func (x *UpdateDeadLetterResponse) GetRecord() *v1.NotificationRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// NotificationIDs 一组通知 ID
+type NotificationIDs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []uint64               `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationIDs) Reset() {
+	*x = NotificationIDs{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationIDs) ProtoMessage() {}
+
+func (x *NotificationIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationIDs.ProtoReflect.Descriptor instead.
+func (*NotificationIDs) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NotificationIDs) GetValues() []uint64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// FilterSelector 按筛选条件选择死信
+type FilterSelector struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *DeadLetterFilter      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 最多重新入队的条数
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterSelector) Reset() {
+	*x = FilterSelector{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterSelector) ProtoMessage() {}
+
+func (x *FilterSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterSelector.ProtoReflect.Descriptor instead.
+func (*FilterSelector) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FilterSelector) GetFilter() *DeadLetterFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *FilterSelector) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// RequeueDeadLettersRequest 批量将死信重新入队的请求，按通知 ID 或筛选条件选择
+type RequeueDeadLettersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Selector:
+	//
+	//	*RequeueDeadLettersRequest_NotificationIds
+	//	*RequeueDeadLettersRequest_Filter
+	Selector      isRequeueDeadLettersRequest_Selector `protobuf_oneof:"selector"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadLettersRequest) Reset() {
+	*x = RequeueDeadLettersRequest{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadLettersRequest) ProtoMessage() {}
+
+func (x *RequeueDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequeueDeadLettersRequest) GetSelector() isRequeueDeadLettersRequest_Selector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *RequeueDeadLettersRequest) GetNotificationIds() *NotificationIDs {
+	if x != nil {
+		if x, ok := x.Selector.(*RequeueDeadLettersRequest_NotificationIds); ok {
+			return x.NotificationIds
+		}
+	}
+	return nil
+}
+
+func (x *RequeueDeadLettersRequest) GetFilter() *FilterSelector {
+	if x != nil {
+		if x, ok := x.Selector.(*RequeueDeadLettersRequest_Filter); ok {
+			return x.Filter
+		}
+	}
+	return nil
+}
+
+type isRequeueDeadLettersRequest_Selector interface {
+	isRequeueDeadLettersRequest_Selector()
+}
+
+type RequeueDeadLettersRequest_NotificationIds struct {
+	// 指定通知 ID
+	NotificationIds *NotificationIDs `protobuf:"bytes,1,opt,name=notification_ids,json=notificationIds,proto3,oneof"`
+}
+
+type RequeueDeadLettersRequest_Filter struct {
+	// 按筛选条件选择
+	Filter *FilterSelector `protobuf:"bytes,2,opt,name=filter,proto3,oneof"`
+}
+
+func (*RequeueDeadLettersRequest_NotificationIds) isRequeueDeadLettersRequest_Selector() {}
+
+func (*RequeueDeadLettersRequest_Filter) isRequeueDeadLettersRequest_Selector() {}
+
+// RequeueResult 单条死信的重新入队结果
+type RequeueResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId uint64                 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 是否已重新入队
+	Requeued bool `protobuf:"varint,2,opt,name=requeued,proto3" json:"requeued,omitempty"`
+	// 失败原因，仅在入队失败时有值
+	ErrorMessage  string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueResult) Reset() {
+	*x = RequeueResult{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueResult) ProtoMessage() {}
+
+func (x *RequeueResult) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueResult.ProtoReflect.Descriptor instead.
+func (*RequeueResult) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequeueResult) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *RequeueResult) GetRequeued() bool {
+	if x != nil {
+		return x.Requeued
+	}
+	return false
+}
+
+func (x *RequeueResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// RequeueDeadLettersResponse 批量重新入队的响应
+type RequeueDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*RequeueResult       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadLettersResponse) Reset() {
+	*x = RequeueDeadLettersResponse{}
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadLettersResponse) ProtoMessage() {}
+
+func (x *RequeueDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_dead_letter_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_dead_letter_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RequeueDeadLettersResponse) GetResults() []*RequeueResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_admin_v1_dead_letter_proto protoreflect.FileDescriptor
+
+const file_admin_v1_dead_letter_proto_rawDesc = "" +
+	"\n" +
+	"\x1aadmin/v1/dead_letter.proto\x12\badmin.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"notification/v1/notification.proto\x1a\x17validate/validate.proto\"\x94\x03\n" +
+	"\n" +
+	"DeadLetter\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x12\x17\n" +
+	"\abiz_key\x18\x02 \x01(\tR\x06bizKey\x122\n" +
+	"\achannel\x18\x03 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12%\n" +
+	"\x0eerror_category\x18\x06 \x01(\tR\rerrorCategory\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12\x1a\n" +
+	"\bprovider\x18\b \x01(\tR\bprovider\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x84\x02\n" +
+	"\x10DeadLetterFilter\x12<\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelB\b\xfaB\x05\x82\x01\x02\x10\x01R\achannel\x12.\n" +
+	"\x0eerror_category\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18\x10R\rerrorCategory\x12?\n" +
+	"\rcreated_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\"\x9d\x01\n" +
+	"\x16ListDeadLettersRequest\x122\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1a.admin.v1.DeadLetterFilterR\x06filter\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xf4\x03(\x00R\bpageSize\x12&\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18 R\tpageToken\"z\n" +
+	"\x17ListDeadLettersResponse\x127\n" +
+	"\fdead_letters\x18\x01 \x03(\v2\x14.admin.v1.DeadLetterR\vdeadLetters\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"H\n" +
+	"\x14GetDeadLetterRequest\x120\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x0enotificationId\"\x8b\x01\n" +
+	"\x15GetDeadLetterResponse\x125\n" +
+	"\vdead_letter\x18\x01 \x01(\v2\x14.admin.v1.DeadLetterR\n" +
+	"deadLetter\x12;\n" +
+	"\x06record\x18\x02 \x01(\v2#.notification.v1.NotificationRecordR\x06record\"\x89\x01\n" +
+	"\x0eTemplateParams\x12<\n" +
+	"\x06values\x18\x01 \x03(\v2$.admin.v1.TemplateParams.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbf\x02\n" +
+	"\x17UpdateDeadLetterRequest\x120\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x0enotificationId\x12-\n" +
+	"\trecipient\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02H\x00R\trecipient\x88\x01\x01\x12-\n" +
+	"\vtemplate_id\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@H\x01R\n" +
+	"templateId\x88\x01\x01\x12A\n" +
+	"\x0ftemplate_params\x18\x04 \x01(\v2\x18.admin.v1.TemplateParamsR\x0etemplateParams\x123\n" +
+	"\x05email\x18\x05 \x01(\v2\x1d.notification.v1.EmailContentR\x05emailB\f\n" +
+	"\n" +
+	"_recipientB\x0e\n" +
+	"\f_template_id\"\x8e\x01\n" +
+	"\x18UpdateDeadLetterResponse\x125\n" +
+	"\vdead_letter\x18\x01 \x01(\v2\x14.admin.v1.DeadLetterR\n" +
+	"deadLetter\x12;\n" +
+	"\x06record\x18\x02 \x01(\v2#.notification.v1.NotificationRecordR\x06record\">\n" +
+	"\x0fNotificationIDs\x12+\n" +
+	"\x06values\x18\x01 \x03(\x04B\x13\xfaB\x10\x92\x01\r\b\x01\x10\xf4\x03\x18\x01\"\x042\x02 \x00R\x06values\"p\n" +
+	"\x0eFilterSelector\x12<\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1a.admin.v1.DeadLetterFilterB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06filter\x12 \n" +
+	"\x05limit\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xf4\x03 \x00R\x05limit\"\xa8\x01\n" +
+	"\x19RequeueDeadLettersRequest\x12F\n" +
+	"\x10notification_ids\x18\x01 \x01(\v2\x19.admin.v1.NotificationIDsH\x00R\x0fnotificationIds\x122\n" +
+	"\x06filter\x18\x02 \x01(\v2\x18.admin.v1.FilterSelectorH\x00R\x06filterB\x0f\n" +
+	"\bselector\x12\x03\xf8B\x01\"y\n" +
+	"\rRequeueResult\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x12\x1a\n" +
+	"\brequeued\x18\x02 \x01(\bR\brequeued\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"O\n" +
+	"\x1aRequeueDeadLettersResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.admin.v1.RequeueResultR\aresults2\xf9\x02\n" +
+	"\x11DeadLetterService\x12V\n" +
+	"\x0fListDeadLetters\x12 .admin.v1.ListDeadLettersRequest\x1a!.admin.v1.ListDeadLettersResponse\x12P\n" +
+	"\rGetDeadLetter\x12\x1e.admin.v1.GetDeadLetterRequest\x1a\x1f.admin.v1.GetDeadLetterResponse\x12Y\n" +
+	"\x10UpdateDeadLetter\x12!.admin.v1.UpdateDeadLetterRequest\x1a\".admin.v1.UpdateDeadLetterResponse\x12_\n" +
+	"\x12RequeueDeadLetters\x12#.admin.v1.RequeueDeadLettersRequest\x1a$.admin.v1.RequeueDeadLettersResponseB\x9c\x01\n" +
+	"\fcom.admin.v1B\x0fDeadLetterProtoP\x01Z:github.com/dingdong-postman/api/proto/gen/admin/v1;adminv1\xa2\x02\x03AXX\xaa\x02\bAdmin.V1\xca\x02\bAdmin\\V1\xe2\x02\x14Admin\\V1\\GPBMetadata\xea\x02\tAdmin::V1b\x06proto3"
+
+var (
+	file_admin_v1_dead_letter_proto_rawDescOnce sync.Once
+	file_admin_v1_dead_letter_proto_rawDescData []byte
+)
+
+func file_admin_v1_dead_letter_proto_rawDescGZIP() []byte {
+	file_admin_v1_dead_letter_proto_rawDescOnce.Do(func() {
+		file_admin_v1_dead_letter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_dead_letter_proto_rawDesc), len(file_admin_v1_dead_letter_proto_rawDesc)))
+	})
+	return file_admin_v1_dead_letter_proto_rawDescData
+}
+
+var file_admin_v1_dead_letter_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_admin_v1_dead_letter_proto_goTypes = []any{
+	(*DeadLetter)(nil),                 // 0: admin.v1.DeadLetter
+	(*DeadLetterFilter)(nil),           // 1: admin.v1.DeadLetterFilter
+	(*ListDeadLettersRequest)(nil),     // 2: admin.v1.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),    // 3: admin.v1.ListDeadLettersResponse
+	(*GetDeadLetterRequest)(nil),       // 4: admin.v1.GetDeadLetterRequest
+	(*GetDeadLetterResponse)(nil),      // 5: admin.v1.GetDeadLetterResponse
+	(*TemplateParams)(nil),             // 6: admin.v1.TemplateParams
+	(*UpdateDeadLetterRequest)(nil),    // 7: admin.v1.UpdateDeadLetterRequest
+	(*UpdateDeadLetterResponse)(nil),   // 8: admin.v1.UpdateDeadLetterResponse
+	(*NotificationIDs)(nil),            // 9: admin.v1.NotificationIDs
+	(*FilterSelector)(nil),             // 10: admin.v1.FilterSelector
+	(*RequeueDeadLettersRequest)(nil),  // 11: admin.v1.RequeueDeadLettersRequest
+	(*RequeueResult)(nil),              // 12: admin.v1.RequeueResult
+	(*RequeueDeadLettersResponse)(nil), // 13: admin.v1.RequeueDeadLettersResponse
+	nil,                                // 14: admin.v1.TemplateParams.ValuesEntry
+	(v1.Channel)(0),                    // 15: notification.v1.Channel
+	(*timestamppb.Timestamp)(nil),      // 16: google.protobuf.Timestamp
+	(*v1.NotificationRecord)(nil),      // 17: notification.v1.NotificationRecord
+	(*v1.EmailContent)(nil),            // 18: notification.v1.EmailContent
+}
+var file_admin_v1_dead_letter_proto_depIdxs = []int32{
+	15, // 0: admin.v1.DeadLetter.channel:type_name -> notification.v1.Channel
+	16, // 1: admin.v1.DeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	16, // 2: admin.v1.DeadLetter.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 3: admin.v1.DeadLetterFilter.channel:type_name -> notification.v1.Channel
+	16, // 4: admin.v1.DeadLetterFilter.created_after:type_name -> google.protobuf.Timestamp
+	16, // 5: admin.v1.DeadLetterFilter.created_before:type_name -> google.protobuf.Timestamp
+	1,  // 6: admin.v1.ListDeadLettersRequest.filter:type_name -> admin.v1.DeadLetterFilter
+	0,  // 7: admin.v1.ListDeadLettersResponse.dead_letters:type_name -> admin.v1.DeadLetter
+	0,  // 8: admin.v1.GetDeadLetterResponse.dead_letter:type_name -> admin.v1.DeadLetter
+	17, // 9: admin.v1.GetDeadLetterResponse.record:type_name -> notification.v1.NotificationRecord
+	14, // 10: admin.v1.TemplateParams.values:type_name -> admin.v1.TemplateParams.ValuesEntry
+	6,  // 11: admin.v1.UpdateDeadLetterRequest.template_params:type_name -> admin.v1.TemplateParams
+	18, // 12: admin.v1.UpdateDeadLetterRequest.email:type_name -> notification.v1.EmailContent
+	0,  // 13: admin.v1.UpdateDeadLetterResponse.dead_letter:type_name -> admin.v1.DeadLetter
+	17, // 14: admin.v1.UpdateDeadLetterResponse.record:type_name -> notification.v1.NotificationRecord
+	1,  // 15: admin.v1.FilterSelector.filter:type_name -> admin.v1.DeadLetterFilter
+	9,  // 16: admin.v1.RequeueDeadLettersRequest.notification_ids:type_name -> admin.v1.NotificationIDs
+	10, // 17: admin.v1.RequeueDeadLettersRequest.filter:type_name -> admin.v1.FilterSelector
+	12, // 18: admin.v1.RequeueDeadLettersResponse.results:type_name -> admin.v1.RequeueResult
+	2,  // 19: admin.v1.DeadLetterService.ListDeadLetters:input_type -> admin.v1.ListDeadLettersRequest
+	4,  // 20: admin.v1.DeadLetterService.GetDeadLetter:input_type -> admin.v1.GetDeadLetterRequest
+	7,  // 21: admin.v1.DeadLetterService.UpdateDeadLetter:input_type -> admin.v1.UpdateDeadLetterRequest
+	11, // 22: admin.v1.DeadLetterService.RequeueDeadLetters:input_type -> admin.v1.RequeueDeadLettersRequest
+	3,  // 23: admin.v1.DeadLetterService.ListDeadLetters:output_type -> admin.v1.ListDeadLettersResponse
+	5,  // 24: admin.v1.DeadLetterService.GetDeadLetter:output_type -> admin.v1.GetDeadLetterResponse
+	8,  // 25: admin.v1.DeadLetterService.UpdateDeadLetter:output_type -> admin.v1.UpdateDeadLetterResponse
+	13, // 26: admin.v1.DeadLetterService.RequeueDeadLetters:output_type -> admin.v1.RequeueDeadLettersResponse
+	23, // [23:27] is the sub-list for method output_type
+	19, // [19:23] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_dead_letter_proto_init() }
+func file_admin_v1_dead_letter_proto_init() {
+	if File_admin_v1_dead_letter_proto != nil {
+		return
+	}
+	file_admin_v1_dead_letter_proto_msgTypes[7].OneofWrappers = []any{}
+	file_admin_v1_dead_letter_proto_msgTypes[11].OneofWrappers = []any{
+		(*RequeueDeadLettersRequest_NotificationIds)(nil),
+		(*RequeueDeadLettersRequest_Filter)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_dead_letter_proto_rawDesc), len(file_admin_v1_dead_letter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_dead_letter_proto_goTypes,
+		DependencyIndexes: file_admin_v1_dead_letter_proto_depIdxs,
+		MessageInfos:      file_admin_v1_dead_letter_proto_msgTypes,
+	}.Build()
+	File_admin_v1_dead_letter_proto = out.File
+	file_admin_v1_dead_letter_proto_goTypes = nil
+	file_admin_v1_dead_letter_proto_depIdxs = nil
+}
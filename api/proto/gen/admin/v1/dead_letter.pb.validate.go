@@ -0,0 +1,2157 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: admin/v1/dead_letter.proto
+
+package adminv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = notificationv1.Channel(0)
+)
+
+// Validate checks the field values on DeadLetter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeadLetter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeadLetterMultiError, or
+// nil if none found.
+func (m *DeadLetter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for BizKey
+
+	// no validation rules for Channel
+
+	// no validation rules for Recipient
+
+	// no validation rules for Attempts
+
+	// no validation rules for ErrorCategory
+
+	// no validation rules for LastError
+
+	// no validation rules for Provider
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeadLetterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeadLetterMultiError is an error wrapping multiple validation errors
+// returned by DeadLetter.ValidateAll() if the designated constraints aren't met.
+type DeadLetterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeadLetterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeadLetterMultiError) AllErrors() []error { return m }
+
+// DeadLetterValidationError is the validation error returned by
+// DeadLetter.Validate if the designated constraints aren't met.
+type DeadLetterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterValidationError) ErrorName() string { return "DeadLetterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterValidationError{}
+
+// Validate checks the field values on DeadLetterFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeadLetterFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetterFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeadLetterFilterMultiError, or nil if none found.
+func (m *DeadLetterFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetterFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := notificationv1.Channel_name[int32(m.GetChannel())]; !ok {
+		err := DeadLetterFilterValidationError{
+			field:  "Channel",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetErrorCategory()) > 16 {
+		err := DeadLetterFilterValidationError{
+			field:  "ErrorCategory",
+			reason: "value length must be at most 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeadLetterFilterValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeadLetterFilterValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterFilterValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeadLetterFilterValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeadLetterFilterValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterFilterValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeadLetterFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeadLetterFilterMultiError is an error wrapping multiple validation errors
+// returned by DeadLetterFilter.ValidateAll() if the designated constraints
+// aren't met.
+type DeadLetterFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeadLetterFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeadLetterFilterMultiError) AllErrors() []error { return m }
+
+// DeadLetterFilterValidationError is the validation error returned by
+// DeadLetterFilter.Validate if the designated constraints aren't met.
+type DeadLetterFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterFilterValidationError) ErrorName() string { return "DeadLetterFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetterFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterFilterValidationError{}
+
+// Validate checks the field values on ListDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLettersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeadLettersRequestMultiError, or nil if none found.
+func (m *ListDeadLettersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLettersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListDeadLettersRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListDeadLettersRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDeadLettersRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		err := ListDeadLettersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 32 {
+		err := ListDeadLettersRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListDeadLettersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLettersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeadLettersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeadLettersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLettersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLettersRequestMultiError) AllErrors() []error { return m }
+
+// ListDeadLettersRequestValidationError is the validation error returned by
+// ListDeadLettersRequest.Validate if the designated constraints aren't met.
+type ListDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersRequestValidationError) ErrorName() string {
+	return "ListDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersRequestValidationError{}
+
+// Validate checks the field values on ListDeadLettersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLettersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeadLettersResponseMultiError, or nil if none found.
+func (m *ListDeadLettersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLettersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeadLetters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("DeadLetters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("DeadLetters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeadLettersResponseValidationError{
+					field:  fmt.Sprintf("DeadLetters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListDeadLettersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLettersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeadLettersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeadLettersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLettersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLettersResponseMultiError) AllErrors() []error { return m }
+
+// ListDeadLettersResponseValidationError is the validation error returned by
+// ListDeadLettersResponse.Validate if the designated constraints aren't met.
+type ListDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersResponseValidationError) ErrorName() string {
+	return "ListDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersResponseValidationError{}
+
+// Validate checks the field values on GetDeadLetterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeadLetterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeadLetterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeadLetterRequestMultiError, or nil if none found.
+func (m *GetDeadLetterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeadLetterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNotificationId() <= 0 {
+		err := GetDeadLetterRequestValidationError{
+			field:  "NotificationId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDeadLetterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeadLetterRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDeadLetterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeadLetterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeadLetterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeadLetterRequestMultiError) AllErrors() []error { return m }
+
+// GetDeadLetterRequestValidationError is the validation error returned by
+// GetDeadLetterRequest.Validate if the designated constraints aren't met.
+type GetDeadLetterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeadLetterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeadLetterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeadLetterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeadLetterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeadLetterRequestValidationError) ErrorName() string {
+	return "GetDeadLetterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeadLetterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeadLetterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeadLetterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeadLetterRequestValidationError{}
+
+// Validate checks the field values on GetDeadLetterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeadLetterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeadLetterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeadLetterResponseMultiError, or nil if none found.
+func (m *GetDeadLetterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeadLetterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeadLetter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadLetter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeadLetterResponseValidationError{
+				field:  "DeadLetter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeadLetterResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeadLetterResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeadLetterResponseValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDeadLetterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeadLetterResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDeadLetterResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeadLetterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeadLetterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeadLetterResponseMultiError) AllErrors() []error { return m }
+
+// GetDeadLetterResponseValidationError is the validation error returned by
+// GetDeadLetterResponse.Validate if the designated constraints aren't met.
+type GetDeadLetterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeadLetterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeadLetterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeadLetterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeadLetterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeadLetterResponseValidationError) ErrorName() string {
+	return "GetDeadLetterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeadLetterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeadLetterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeadLetterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeadLetterResponseValidationError{}
+
+// Validate checks the field values on TemplateParams with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TemplateParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateParams with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TemplateParamsMultiError,
+// or nil if none found.
+func (m *TemplateParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Values
+
+	if len(errors) > 0 {
+		return TemplateParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateParamsMultiError is an error wrapping multiple validation errors
+// returned by TemplateParams.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateParamsMultiError) AllErrors() []error { return m }
+
+// TemplateParamsValidationError is the validation error returned by
+// TemplateParams.Validate if the designated constraints aren't met.
+type TemplateParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateParamsValidationError) ErrorName() string { return "TemplateParamsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateParamsValidationError{}
+
+// Validate checks the field values on UpdateDeadLetterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDeadLetterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDeadLetterRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDeadLetterRequestMultiError, or nil if none found.
+func (m *UpdateDeadLetterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDeadLetterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNotificationId() <= 0 {
+		err := UpdateDeadLetterRequestValidationError{
+			field:  "NotificationId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTemplateParams()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDeadLetterRequestValidationError{
+					field:  "TemplateParams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDeadLetterRequestValidationError{
+					field:  "TemplateParams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplateParams()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDeadLetterRequestValidationError{
+				field:  "TemplateParams",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEmail()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDeadLetterRequestValidationError{
+					field:  "Email",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDeadLetterRequestValidationError{
+					field:  "Email",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmail()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDeadLetterRequestValidationError{
+				field:  "Email",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Recipient != nil {
+
+		if l := utf8.RuneCountInString(m.GetRecipient()); l < 1 || l > 256 {
+			err := UpdateDeadLetterRequestValidationError{
+				field:  "Recipient",
+				reason: "value length must be between 1 and 256 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.TemplateId != nil {
+
+		if utf8.RuneCountInString(m.GetTemplateId()) > 64 {
+			err := UpdateDeadLetterRequestValidationError{
+				field:  "TemplateId",
+				reason: "value length must be at most 64 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateDeadLetterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDeadLetterRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateDeadLetterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDeadLetterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDeadLetterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDeadLetterRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDeadLetterRequestValidationError is the validation error returned by
+// UpdateDeadLetterRequest.Validate if the designated constraints aren't met.
+type UpdateDeadLetterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDeadLetterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDeadLetterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDeadLetterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDeadLetterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDeadLetterRequestValidationError) ErrorName() string {
+	return "UpdateDeadLetterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDeadLetterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDeadLetterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDeadLetterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDeadLetterRequestValidationError{}
+
+// Validate checks the field values on UpdateDeadLetterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDeadLetterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDeadLetterResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDeadLetterResponseMultiError, or nil if none found.
+func (m *UpdateDeadLetterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDeadLetterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeadLetter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDeadLetterResponseValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadLetter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDeadLetterResponseValidationError{
+				field:  "DeadLetter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDeadLetterResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDeadLetterResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDeadLetterResponseValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateDeadLetterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDeadLetterResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateDeadLetterResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDeadLetterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDeadLetterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDeadLetterResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDeadLetterResponseValidationError is the validation error returned by
+// UpdateDeadLetterResponse.Validate if the designated constraints aren't met.
+type UpdateDeadLetterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDeadLetterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDeadLetterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDeadLetterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDeadLetterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDeadLetterResponseValidationError) ErrorName() string {
+	return "UpdateDeadLetterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDeadLetterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDeadLetterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDeadLetterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDeadLetterResponseValidationError{}
+
+// Validate checks the field values on NotificationIDs with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NotificationIDs) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationIDs with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationIDsMultiError, or nil if none found.
+func (m *NotificationIDs) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationIDs) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetValues()); l < 1 || l > 500 {
+		err := NotificationIDsValidationError{
+			field:  "Values",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_NotificationIDs_Values_Unique := make(map[uint64]struct{}, len(m.GetValues()))
+
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
+
+		if _, exists := _NotificationIDs_Values_Unique[item]; exists {
+			err := NotificationIDsValidationError{
+				field:  fmt.Sprintf("Values[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_NotificationIDs_Values_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := NotificationIDsValidationError{
+				field:  fmt.Sprintf("Values[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NotificationIDsMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationIDsMultiError is an error wrapping multiple validation errors
+// returned by NotificationIDs.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationIDsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationIDsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationIDsMultiError) AllErrors() []error { return m }
+
+// NotificationIDsValidationError is the validation error returned by
+// NotificationIDs.Validate if the designated constraints aren't met.
+type NotificationIDsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationIDsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationIDsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationIDsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationIDsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationIDsValidationError) ErrorName() string { return "NotificationIDsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationIDsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationIDs.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationIDsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationIDsValidationError{}
+
+// Validate checks the field values on FilterSelector with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FilterSelector) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FilterSelector with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FilterSelectorMultiError,
+// or nil if none found.
+func (m *FilterSelector) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FilterSelector) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFilter() == nil {
+		err := FilterSelectorValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FilterSelectorValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FilterSelectorValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FilterSelectorValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 500 {
+		err := FilterSelectorValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FilterSelectorMultiError(errors)
+	}
+
+	return nil
+}
+
+// FilterSelectorMultiError is an error wrapping multiple validation errors
+// returned by FilterSelector.ValidateAll() if the designated constraints
+// aren't met.
+type FilterSelectorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FilterSelectorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FilterSelectorMultiError) AllErrors() []error { return m }
+
+// FilterSelectorValidationError is the validation error returned by
+// FilterSelector.Validate if the designated constraints aren't met.
+type FilterSelectorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FilterSelectorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FilterSelectorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FilterSelectorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FilterSelectorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FilterSelectorValidationError) ErrorName() string { return "FilterSelectorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FilterSelectorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFilterSelector.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FilterSelectorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FilterSelectorValidationError{}
+
+// Validate checks the field values on RequeueDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequeueDeadLettersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequeueDeadLettersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequeueDeadLettersRequestMultiError, or nil if none found.
+func (m *RequeueDeadLettersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequeueDeadLettersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofSelectorPresent := false
+	switch v := m.Selector.(type) {
+	case *RequeueDeadLettersRequest_NotificationIds:
+		if v == nil {
+			err := RequeueDeadLettersRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSelectorPresent = true
+
+		if all {
+			switch v := interface{}(m.GetNotificationIds()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RequeueDeadLettersRequestValidationError{
+						field:  "NotificationIds",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RequeueDeadLettersRequestValidationError{
+						field:  "NotificationIds",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNotificationIds()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RequeueDeadLettersRequestValidationError{
+					field:  "NotificationIds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *RequeueDeadLettersRequest_Filter:
+		if v == nil {
+			err := RequeueDeadLettersRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSelectorPresent = true
+
+		if all {
+			switch v := interface{}(m.GetFilter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RequeueDeadLettersRequestValidationError{
+						field:  "Filter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RequeueDeadLettersRequestValidationError{
+						field:  "Filter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RequeueDeadLettersRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofSelectorPresent {
+		err := RequeueDeadLettersRequestValidationError{
+			field:  "Selector",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequeueDeadLettersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequeueDeadLettersRequestMultiError is an error wrapping multiple validation
+// errors returned by RequeueDeadLettersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RequeueDeadLettersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequeueDeadLettersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequeueDeadLettersRequestMultiError) AllErrors() []error { return m }
+
+// RequeueDeadLettersRequestValidationError is the validation error returned by
+// RequeueDeadLettersRequest.Validate if the designated constraints aren't met.
+type RequeueDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequeueDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequeueDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequeueDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequeueDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequeueDeadLettersRequestValidationError) ErrorName() string {
+	return "RequeueDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequeueDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequeueDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequeueDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequeueDeadLettersRequestValidationError{}
+
+// Validate checks the field values on RequeueResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RequeueResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequeueResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RequeueResultMultiError, or
+// nil if none found.
+func (m *RequeueResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequeueResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Requeued
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return RequeueResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequeueResultMultiError is an error wrapping multiple validation errors
+// returned by RequeueResult.ValidateAll() if the designated constraints
+// aren't met.
+type RequeueResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequeueResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequeueResultMultiError) AllErrors() []error { return m }
+
+// RequeueResultValidationError is the validation error returned by
+// RequeueResult.Validate if the designated constraints aren't met.
+type RequeueResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequeueResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequeueResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequeueResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequeueResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequeueResultValidationError) ErrorName() string { return "RequeueResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RequeueResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequeueResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequeueResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequeueResultValidationError{}
+
+// Validate checks the field values on RequeueDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequeueDeadLettersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequeueDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequeueDeadLettersResponseMultiError, or nil if none found.
+func (m *RequeueDeadLettersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequeueDeadLettersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RequeueDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RequeueDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RequeueDeadLettersResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RequeueDeadLettersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequeueDeadLettersResponseMultiError is an error wrapping multiple
+// validation errors returned by RequeueDeadLettersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RequeueDeadLettersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequeueDeadLettersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequeueDeadLettersResponseMultiError) AllErrors() []error { return m }
+
+// RequeueDeadLettersResponseValidationError is the validation error returned
+// by RequeueDeadLettersResponse.Validate if the designated constraints aren't met.
+type RequeueDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequeueDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequeueDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequeueDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequeueDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequeueDeadLettersResponseValidationError) ErrorName() string {
+	return "RequeueDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequeueDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequeueDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequeueDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequeueDeadLettersResponseValidationError{}
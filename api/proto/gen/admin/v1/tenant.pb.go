@@ -0,0 +1,1547 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: admin/v1/tenant.proto
+
+package adminv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TenantSender 租户的默认发送方，通知未指定发送方时使用
+type TenantSender struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 短信签名，为空时使用供应商配置的签名
+	SignName string `protobuf:"bytes,1,opt,name=sign_name,json=signName,proto3" json:"sign_name,omitempty"`
+	// 邮件发件人，格式为 "名称 <地址>" 或仅地址，为空时使用供应商配置的发件人
+	EmailFrom     string `protobuf:"bytes,2,opt,name=email_from,json=emailFrom,proto3" json:"email_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantSender) Reset() {
+	*x = TenantSender{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantSender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantSender) ProtoMessage() {}
+
+func (x *TenantSender) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantSender.ProtoReflect.Descriptor instead.
+func (*TenantSender) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TenantSender) GetSignName() string {
+	if x != nil {
+		return x.SignName
+	}
+	return ""
+}
+
+func (x *TenantSender) GetEmailFrom() string {
+	if x != nil {
+		return x.EmailFrom
+	}
+	return ""
+}
+
+// ClockRange 一天中的时间段 [start, end)，end 早于 start 表示跨零点，如 22:00-08:00
+type ClockRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 开始时间，格式为 HH:MM
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// 结束时间（不含），格式为 HH:MM
+	End           string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClockRange) Reset() {
+	*x = ClockRange{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClockRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockRange) ProtoMessage() {}
+
+func (x *ClockRange) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockRange.ProtoReflect.Descriptor instead.
+func (*ClockRange) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClockRange) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ClockRange) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// DeliveryWindow 租户在一个渠道上的发送时段规则，按接收者所在时区判断
+// 非事务类通知在不允许发送的时间受理时，推迟到下一个允许发送的时间；事务类通知不受限制
+type DeliveryWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渠道
+	Channel v1.Channel `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 允许发送的时间段，为空表示全天允许
+	AllowedWindows []*ClockRange `protobuf:"bytes,2,rep,name=allowed_windows,json=allowedWindows,proto3" json:"allowed_windows,omitempty"`
+	// 免打扰时间段，优先于允许发送的时间段
+	QuietHours    []*ClockRange `protobuf:"bytes,3,rep,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryWindow) Reset() {
+	*x = DeliveryWindow{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryWindow) ProtoMessage() {}
+
+func (x *DeliveryWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryWindow.ProtoReflect.Descriptor instead.
+func (*DeliveryWindow) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeliveryWindow) GetChannel() v1.Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return v1.Channel(0)
+}
+
+func (x *DeliveryWindow) GetAllowedWindows() []*ClockRange {
+	if x != nil {
+		return x.AllowedWindows
+	}
+	return nil
+}
+
+func (x *DeliveryWindow) GetQuietHours() []*ClockRange {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+// Tenant 接入平台的业务方
+type Tenant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户 ID，只能包含小写字母、数字、下划线与中划线
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 租户名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 是否启用，停用后该租户的 API Key 全部失效
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// 已开通的渠道
+	AllowedChannels []v1.Channel `protobuf:"varint,4,rep,packed,name=allowed_channels,json=allowedChannels,proto3,enum=notification.v1.Channel" json:"allowed_channels,omitempty"`
+	// 每日最多受理的通知数，0 表示不限
+	DailyQuota int64 `protobuf:"varint,5,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`
+	// 每月最多受理的通知数，0 表示不限
+	MonthlyQuota int64 `protobuf:"varint,6,opt,name=monthly_quota,json=monthlyQuota,proto3" json:"monthly_quota,omitempty"`
+	// 默认发送方
+	Sender *TenantSender `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+	// 创建时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 各渠道的发送时段规则，未设置的渠道全天允许发送
+	DeliveryWindows []*DeliveryWindow `protobuf:"bytes,10,rep,name=delivery_windows,json=deliveryWindows,proto3" json:"delivery_windows,omitempty"`
+	// 接收者资料未设置时区时使用的 IANA 时区，如 Asia/Shanghai；为空时使用服务器所在时区
+	Timezone string `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// 回查两阶段通知事务状态的 gRPC 地址（host:port），业务方在该地址实现 notification.v1.TransactionCheckService；
+	// 为空时使用平台的默认回查地址
+	CheckEndpoint string `protobuf:"bytes,12,opt,name=check_endpoint,json=checkEndpoint,proto3" json:"check_endpoint,omitempty"`
+	// 通知请求未指定回调地址时使用的回调地址，为空表示不回调
+	CallbackUrl string `protobuf:"bytes,13,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	// 是否已生成回调签名密钥；未生成时使用平台的默认签名密钥
+	CallbackSecretSet bool `protobuf:"varint,14,opt,name=callback_secret_set,json=callbackSecretSet,proto3" json:"callback_secret_set,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Tenant) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Tenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tenant) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Tenant) GetAllowedChannels() []v1.Channel {
+	if x != nil {
+		return x.AllowedChannels
+	}
+	return nil
+}
+
+func (x *Tenant) GetDailyQuota() int64 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+func (x *Tenant) GetMonthlyQuota() int64 {
+	if x != nil {
+		return x.MonthlyQuota
+	}
+	return 0
+}
+
+func (x *Tenant) GetSender() *TenantSender {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *Tenant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetDeliveryWindows() []*DeliveryWindow {
+	if x != nil {
+		return x.DeliveryWindows
+	}
+	return nil
+}
+
+func (x *Tenant) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Tenant) GetCheckEndpoint() string {
+	if x != nil {
+		return x.CheckEndpoint
+	}
+	return ""
+}
+
+func (x *Tenant) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *Tenant) GetCallbackSecretSet() bool {
+	if x != nil {
+		return x.CallbackSecretSet
+	}
+	return false
+}
+
+// TenantSpec 创建或更新租户时可设置的字段
+type TenantSpec struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TenantId        string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled         bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AllowedChannels []v1.Channel           `protobuf:"varint,4,rep,packed,name=allowed_channels,json=allowedChannels,proto3,enum=notification.v1.Channel" json:"allowed_channels,omitempty"`
+	DailyQuota      int64                  `protobuf:"varint,5,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`
+	MonthlyQuota    int64                  `protobuf:"varint,6,opt,name=monthly_quota,json=monthlyQuota,proto3" json:"monthly_quota,omitempty"`
+	Sender          *TenantSender          `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+	DeliveryWindows []*DeliveryWindow      `protobuf:"bytes,8,rep,name=delivery_windows,json=deliveryWindows,proto3" json:"delivery_windows,omitempty"`
+	Timezone        string                 `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CheckEndpoint   string                 `protobuf:"bytes,10,opt,name=check_endpoint,json=checkEndpoint,proto3" json:"check_endpoint,omitempty"`
+	CallbackUrl     string                 `protobuf:"bytes,11,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TenantSpec) Reset() {
+	*x = TenantSpec{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantSpec) ProtoMessage() {}
+
+func (x *TenantSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantSpec.ProtoReflect.Descriptor instead.
+func (*TenantSpec) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TenantSpec) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TenantSpec) GetAllowedChannels() []v1.Channel {
+	if x != nil {
+		return x.AllowedChannels
+	}
+	return nil
+}
+
+func (x *TenantSpec) GetDailyQuota() int64 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+func (x *TenantSpec) GetMonthlyQuota() int64 {
+	if x != nil {
+		return x.MonthlyQuota
+	}
+	return 0
+}
+
+func (x *TenantSpec) GetSender() *TenantSender {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *TenantSpec) GetDeliveryWindows() []*DeliveryWindow {
+	if x != nil {
+		return x.DeliveryWindows
+	}
+	return nil
+}
+
+func (x *TenantSpec) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TenantSpec) GetCheckEndpoint() string {
+	if x != nil {
+		return x.CheckEndpoint
+	}
+	return ""
+}
+
+func (x *TenantSpec) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+// CreateTenantRequest 创建租户的请求
+type CreateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *TenantSpec            `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTenantRequest) GetTenant() *TenantSpec {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// CreateTenantResponse 创建租户的响应
+type CreateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantResponse) Reset() {
+	*x = CreateTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantResponse) ProtoMessage() {}
+
+func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// GetTenantRequest 查询租户的请求
+type GetTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRequest) ProtoMessage() {}
+
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// GetTenantResponse 查询租户的响应
+type GetTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantResponse) Reset() {
+	*x = GetTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantResponse) ProtoMessage() {}
+
+func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// ListTenantsRequest 查询全部租户的请求
+type ListTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsRequest) Reset() {
+	*x = ListTenantsRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsRequest) ProtoMessage() {}
+
+func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{9}
+}
+
+// ListTenantsResponse 查询全部租户的响应，按租户 ID 升序
+type ListTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsResponse) Reset() {
+	*x = ListTenantsResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsResponse) ProtoMessage() {}
+
+func (x *ListTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTenantsResponse) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+// UpdateTenantRequest 更新租户的请求，整体替换除租户 ID 外的全部字段，更新后立即生效
+type UpdateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *TenantSpec            `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateTenantRequest) GetTenant() *TenantSpec {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// UpdateTenantResponse 更新租户的响应
+type UpdateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantResponse) Reset() {
+	*x = UpdateTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantResponse) ProtoMessage() {}
+
+func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// APIKey 租户的 API Key，平台只保存其哈希
+type APIKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// API Key ID
+	ApiKeyId uint64 `protobuf:"varint,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	// 所属租户
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 用途说明
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 明文的前几位，仅用于辨认
+	Prefix string `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// 是否已吊销
+	Revoked bool `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// 创建时间
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *APIKey) GetApiKeyId() uint64 {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return 0
+}
+
+func (x *APIKey) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateAPIKeyRequest 签发 API Key 的请求
+type CreateAPIKeyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 用途说明
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateAPIKeyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CreateAPIKeyResponse 签发 API Key 的响应
+type CreateAPIKeyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// API Key 明文，只在签发时返回一次，平台不保存明文
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// ListAPIKeysRequest 查询租户 API Key 的请求
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAPIKeysRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// ListAPIKeysResponse 查询租户 API Key 的响应
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*APIKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+// RevokeAPIKeyRequest 吊销 API Key 的请求
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ApiKeyId      uint64                 `protobuf:"varint,2,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevokeAPIKeyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RevokeAPIKeyRequest) GetApiKeyId() uint64 {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return 0
+}
+
+// RevokeAPIKeyResponse 吊销 API Key 的响应
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RevokeAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+// RotateCallbackSecretRequest 生成回调签名密钥的请求
+type RotateCallbackSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCallbackSecretRequest) Reset() {
+	*x = RotateCallbackSecretRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCallbackSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCallbackSecretRequest) ProtoMessage() {}
+
+func (x *RotateCallbackSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCallbackSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateCallbackSecretRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RotateCallbackSecretRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// RotateCallbackSecretResponse 生成回调签名密钥的响应
+type RotateCallbackSecretResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tenant *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// 新的签名密钥，只在生成时返回一次；旧密钥立即失效
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCallbackSecretResponse) Reset() {
+	*x = RotateCallbackSecretResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCallbackSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCallbackSecretResponse) ProtoMessage() {}
+
+func (x *RotateCallbackSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCallbackSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateCallbackSecretResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RotateCallbackSecretResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *RotateCallbackSecretResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+var File_admin_v1_tenant_proto protoreflect.FileDescriptor
+
+const file_admin_v1_tenant_proto_rawDesc = "" +
+	"\n" +
+	"\x15admin/v1/tenant.proto\x12\badmin.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"notification/v1/notification.proto\x1a\x17validate/validate.proto\"]\n" +
+	"\fTenantSender\x12$\n" +
+	"\tsign_name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18@R\bsignName\x12'\n" +
+	"\n" +
+	"email_from\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xc0\x02R\temailFrom\"\x84\x01\n" +
+	"\n" +
+	"ClockRange\x12<\n" +
+	"\x05start\x18\x01 \x01(\tB&\xfaB#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x05start\x128\n" +
+	"\x03end\x18\x02 \x01(\tB&\xfaB#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x03end\"\xda\x01\n" +
+	"\x0eDeliveryWindow\x12>\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\achannel\x12G\n" +
+	"\x0fallowed_windows\x18\x02 \x03(\v2\x14.admin.v1.ClockRangeB\b\xfaB\x05\x92\x01\x02\x10\bR\x0eallowedWindows\x12?\n" +
+	"\vquiet_hours\x18\x03 \x03(\v2\x14.admin.v1.ClockRangeB\b\xfaB\x05\x92\x01\x02\x10\bR\n" +
+	"quietHours\"\xdf\x04\n" +
+	"\x06Tenant\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12C\n" +
+	"\x10allowed_channels\x18\x04 \x03(\x0e2\x18.notification.v1.ChannelR\x0fallowedChannels\x12\x1f\n" +
+	"\vdaily_quota\x18\x05 \x01(\x03R\n" +
+	"dailyQuota\x12#\n" +
+	"\rmonthly_quota\x18\x06 \x01(\x03R\fmonthlyQuota\x12.\n" +
+	"\x06sender\x18\a \x01(\v2\x16.admin.v1.TenantSenderR\x06sender\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12C\n" +
+	"\x10delivery_windows\x18\n" +
+	" \x03(\v2\x18.admin.v1.DeliveryWindowR\x0fdeliveryWindows\x12\x1a\n" +
+	"\btimezone\x18\v \x01(\tR\btimezone\x12%\n" +
+	"\x0echeck_endpoint\x18\f \x01(\tR\rcheckEndpoint\x12!\n" +
+	"\fcallback_url\x18\r \x01(\tR\vcallbackUrl\x12.\n" +
+	"\x13callback_secret_set\x18\x0e \x01(\bR\x11callbackSecretSet\"\xc2\x04\n" +
+	"\n" +
+	"TenantSpec\x126\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x19\xfaB\x16r\x142\x12^[a-z0-9_-]{1,64}$R\btenantId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\x04name\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12V\n" +
+	"\x10allowed_channels\x18\x04 \x03(\x0e2\x18.notification.v1.ChannelB\x11\xfaB\x0e\x92\x01\v\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\x0fallowedChannels\x12(\n" +
+	"\vdaily_quota\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"dailyQuota\x12,\n" +
+	"\rmonthly_quota\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\fmonthlyQuota\x12.\n" +
+	"\x06sender\x18\a \x01(\v2\x16.admin.v1.TenantSenderR\x06sender\x12M\n" +
+	"\x10delivery_windows\x18\b \x03(\v2\x18.admin.v1.DeliveryWindowB\b\xfaB\x05\x92\x01\x02\x10\x02R\x0fdeliveryWindows\x12#\n" +
+	"\btimezone\x18\t \x01(\tB\a\xfaB\x04r\x02\x18@R\btimezone\x12/\n" +
+	"\x0echeck_endpoint\x18\n" +
+	" \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\rcheckEndpoint\x12=\n" +
+	"\fcallback_url\x18\v \x01(\tB\x1a\xfaB\x17r\x15\x18\x80\x042\x10^(https?://.+)?$R\vcallbackUrl\"M\n" +
+	"\x13CreateTenantRequest\x126\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x14.admin.v1.TenantSpecB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06tenant\"@\n" +
+	"\x14CreateTenantResponse\x12(\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x10.admin.v1.TenantR\x06tenant\":\n" +
+	"\x10GetTenantRequest\x12&\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\btenantId\"=\n" +
+	"\x11GetTenantResponse\x12(\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x10.admin.v1.TenantR\x06tenant\"\x14\n" +
+	"\x12ListTenantsRequest\"A\n" +
+	"\x13ListTenantsResponse\x12*\n" +
+	"\atenants\x18\x01 \x03(\v2\x10.admin.v1.TenantR\atenants\"M\n" +
+	"\x13UpdateTenantRequest\x126\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x14.admin.v1.TenantSpecB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06tenant\"@\n" +
+	"\x14UpdateTenantResponse\x12(\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x10.admin.v1.TenantR\x06tenant\"\xc4\x01\n" +
+	"\x06APIKey\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\x04R\bapiKeyId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x18\n" +
+	"\arevoked\x18\x05 \x01(\bR\arevoked\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"[\n" +
+	"\x13CreateAPIKeyRequest\x12&\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\btenantId\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\x04name\"Y\n" +
+	"\x14CreateAPIKeyResponse\x12)\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x10.admin.v1.APIKeyR\x06apiKey\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"<\n" +
+	"\x12ListAPIKeysRequest\x12&\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\btenantId\"B\n" +
+	"\x13ListAPIKeysResponse\x12+\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x10.admin.v1.APIKeyR\aapiKeys\"d\n" +
+	"\x13RevokeAPIKeyRequest\x12&\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\btenantId\x12%\n" +
+	"\n" +
+	"api_key_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\bapiKeyId\"A\n" +
+	"\x14RevokeAPIKeyResponse\x12)\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x10.admin.v1.APIKeyR\x06apiKey\"E\n" +
+	"\x1bRotateCallbackSecretRequest\x12&\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\btenantId\"`\n" +
+	"\x1cRotateCallbackSecretResponse\x12(\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x10.admin.v1.TenantR\x06tenant\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret2\x90\x05\n" +
+	"\rTenantService\x12M\n" +
+	"\fCreateTenant\x12\x1d.admin.v1.CreateTenantRequest\x1a\x1e.admin.v1.CreateTenantResponse\x12D\n" +
+	"\tGetTenant\x12\x1a.admin.v1.GetTenantRequest\x1a\x1b.admin.v1.GetTenantResponse\x12J\n" +
+	"\vListTenants\x12\x1c.admin.v1.ListTenantsRequest\x1a\x1d.admin.v1.ListTenantsResponse\x12M\n" +
+	"\fUpdateTenant\x12\x1d.admin.v1.UpdateTenantRequest\x1a\x1e.admin.v1.UpdateTenantResponse\x12M\n" +
+	"\fCreateAPIKey\x12\x1d.admin.v1.CreateAPIKeyRequest\x1a\x1e.admin.v1.CreateAPIKeyResponse\x12J\n" +
+	"\vListAPIKeys\x12\x1c.admin.v1.ListAPIKeysRequest\x1a\x1d.admin.v1.ListAPIKeysResponse\x12M\n" +
+	"\fRevokeAPIKey\x12\x1d.admin.v1.RevokeAPIKeyRequest\x1a\x1e.admin.v1.RevokeAPIKeyResponse\x12e\n" +
+	"\x14RotateCallbackSecret\x12%.admin.v1.RotateCallbackSecretRequest\x1a&.admin.v1.RotateCallbackSecretResponseB\x98\x01\n" +
+	"\fcom.admin.v1B\vTenantProtoP\x01Z:github.com/dingdong-postman/api/proto/gen/admin/v1;adminv1\xa2\x02\x03AXX\xaa\x02\bAdmin.V1\xca\x02\bAdmin\\V1\xe2\x02\x14Admin\\V1\\GPBMetadata\xea\x02\tAdmin::V1b\x06proto3"
+
+var (
+	file_admin_v1_tenant_proto_rawDescOnce sync.Once
+	file_admin_v1_tenant_proto_rawDescData []byte
+)
+
+func file_admin_v1_tenant_proto_rawDescGZIP() []byte {
+	file_admin_v1_tenant_proto_rawDescOnce.Do(func() {
+		file_admin_v1_tenant_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_tenant_proto_rawDesc), len(file_admin_v1_tenant_proto_rawDesc)))
+	})
+	return file_admin_v1_tenant_proto_rawDescData
+}
+
+var file_admin_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_admin_v1_tenant_proto_goTypes = []any{
+	(*TenantSender)(nil),                 // 0: admin.v1.TenantSender
+	(*ClockRange)(nil),                   // 1: admin.v1.ClockRange
+	(*DeliveryWindow)(nil),               // 2: admin.v1.DeliveryWindow
+	(*Tenant)(nil),                       // 3: admin.v1.Tenant
+	(*TenantSpec)(nil),                   // 4: admin.v1.TenantSpec
+	(*CreateTenantRequest)(nil),          // 5: admin.v1.CreateTenantRequest
+	(*CreateTenantResponse)(nil),         // 6: admin.v1.CreateTenantResponse
+	(*GetTenantRequest)(nil),             // 7: admin.v1.GetTenantRequest
+	(*GetTenantResponse)(nil),            // 8: admin.v1.GetTenantResponse
+	(*ListTenantsRequest)(nil),           // 9: admin.v1.ListTenantsRequest
+	(*ListTenantsResponse)(nil),          // 10: admin.v1.ListTenantsResponse
+	(*UpdateTenantRequest)(nil),          // 11: admin.v1.UpdateTenantRequest
+	(*UpdateTenantResponse)(nil),         // 12: admin.v1.UpdateTenantResponse
+	(*APIKey)(nil),                       // 13: admin.v1.APIKey
+	(*CreateAPIKeyRequest)(nil),          // 14: admin.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),         // 15: admin.v1.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),           // 16: admin.v1.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),          // 17: admin.v1.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),          // 18: admin.v1.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),         // 19: admin.v1.RevokeAPIKeyResponse
+	(*RotateCallbackSecretRequest)(nil),  // 20: admin.v1.RotateCallbackSecretRequest
+	(*RotateCallbackSecretResponse)(nil), // 21: admin.v1.RotateCallbackSecretResponse
+	(v1.Channel)(0),                      // 22: notification.v1.Channel
+	(*timestamppb.Timestamp)(nil),        // 23: google.protobuf.Timestamp
+}
+var file_admin_v1_tenant_proto_depIdxs = []int32{
+	22, // 0: admin.v1.DeliveryWindow.channel:type_name -> notification.v1.Channel
+	1,  // 1: admin.v1.DeliveryWindow.allowed_windows:type_name -> admin.v1.ClockRange
+	1,  // 2: admin.v1.DeliveryWindow.quiet_hours:type_name -> admin.v1.ClockRange
+	22, // 3: admin.v1.Tenant.allowed_channels:type_name -> notification.v1.Channel
+	0,  // 4: admin.v1.Tenant.sender:type_name -> admin.v1.TenantSender
+	23, // 5: admin.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	23, // 6: admin.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: admin.v1.Tenant.delivery_windows:type_name -> admin.v1.DeliveryWindow
+	22, // 8: admin.v1.TenantSpec.allowed_channels:type_name -> notification.v1.Channel
+	0,  // 9: admin.v1.TenantSpec.sender:type_name -> admin.v1.TenantSender
+	2,  // 10: admin.v1.TenantSpec.delivery_windows:type_name -> admin.v1.DeliveryWindow
+	4,  // 11: admin.v1.CreateTenantRequest.tenant:type_name -> admin.v1.TenantSpec
+	3,  // 12: admin.v1.CreateTenantResponse.tenant:type_name -> admin.v1.Tenant
+	3,  // 13: admin.v1.GetTenantResponse.tenant:type_name -> admin.v1.Tenant
+	3,  // 14: admin.v1.ListTenantsResponse.tenants:type_name -> admin.v1.Tenant
+	4,  // 15: admin.v1.UpdateTenantRequest.tenant:type_name -> admin.v1.TenantSpec
+	3,  // 16: admin.v1.UpdateTenantResponse.tenant:type_name -> admin.v1.Tenant
+	23, // 17: admin.v1.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	13, // 18: admin.v1.CreateAPIKeyResponse.api_key:type_name -> admin.v1.APIKey
+	13, // 19: admin.v1.ListAPIKeysResponse.api_keys:type_name -> admin.v1.APIKey
+	13, // 20: admin.v1.RevokeAPIKeyResponse.api_key:type_name -> admin.v1.APIKey
+	3,  // 21: admin.v1.RotateCallbackSecretResponse.tenant:type_name -> admin.v1.Tenant
+	5,  // 22: admin.v1.TenantService.CreateTenant:input_type -> admin.v1.CreateTenantRequest
+	7,  // 23: admin.v1.TenantService.GetTenant:input_type -> admin.v1.GetTenantRequest
+	9,  // 24: admin.v1.TenantService.ListTenants:input_type -> admin.v1.ListTenantsRequest
+	11, // 25: admin.v1.TenantService.UpdateTenant:input_type -> admin.v1.UpdateTenantRequest
+	14, // 26: admin.v1.TenantService.CreateAPIKey:input_type -> admin.v1.CreateAPIKeyRequest
+	16, // 27: admin.v1.TenantService.ListAPIKeys:input_type -> admin.v1.ListAPIKeysRequest
+	18, // 28: admin.v1.TenantService.RevokeAPIKey:input_type -> admin.v1.RevokeAPIKeyRequest
+	20, // 29: admin.v1.TenantService.RotateCallbackSecret:input_type -> admin.v1.RotateCallbackSecretRequest
+	6,  // 30: admin.v1.TenantService.CreateTenant:output_type -> admin.v1.CreateTenantResponse
+	8,  // 31: admin.v1.TenantService.GetTenant:output_type -> admin.v1.GetTenantResponse
+	10, // 32: admin.v1.TenantService.ListTenants:output_type -> admin.v1.ListTenantsResponse
+	12, // 33: admin.v1.TenantService.UpdateTenant:output_type -> admin.v1.UpdateTenantResponse
+	15, // 34: admin.v1.TenantService.CreateAPIKey:output_type -> admin.v1.CreateAPIKeyResponse
+	17, // 35: admin.v1.TenantService.ListAPIKeys:output_type -> admin.v1.ListAPIKeysResponse
+	19, // 36: admin.v1.TenantService.RevokeAPIKey:output_type -> admin.v1.RevokeAPIKeyResponse
+	21, // 37: admin.v1.TenantService.RotateCallbackSecret:output_type -> admin.v1.RotateCallbackSecretResponse
+	30, // [30:38] is the sub-list for method output_type
+	22, // [22:30] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_tenant_proto_init() }
+func file_admin_v1_tenant_proto_init() {
+	if File_admin_v1_tenant_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_tenant_proto_rawDesc), len(file_admin_v1_tenant_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_tenant_proto_goTypes,
+		DependencyIndexes: file_admin_v1_tenant_proto_depIdxs,
+		MessageInfos:      file_admin_v1_tenant_proto_msgTypes,
+	}.Build()
+	File_admin_v1_tenant_proto = out.File
+	file_admin_v1_tenant_proto_goTypes = nil
+	file_admin_v1_tenant_proto_depIdxs = nil
+}
@@ -1,126 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             (unknown)
-// source: helloworld/v1/helloworld.proto
-
-package helloworldv1
-
-import (
-	context "context"
-
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	GreeterService_SayHello_FullMethodName = "/helloworld.v1.GreeterService/SayHello"
-)
-
-// GreeterServiceClient is the client API for GreeterService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// 定义一个 GreeterService 服务，包含一个 SayHello 方法
-type GreeterServiceClient interface {
-	// SayHello 方法接受 HelloRequest 消息，并返回 SayHelloResponse 消息
-	SayHello(ctx context.Context, in *SayHelloRequest, opts ...grpc.CallOption) (*SayHelloResponse, error)
-}
-
-type greeterServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewGreeterServiceClient(cc grpc.ClientConnInterface) GreeterServiceClient {
-	return &greeterServiceClient{cc}
-}
-
-func (c *greeterServiceClient) SayHello(ctx context.Context, in *SayHelloRequest, opts ...grpc.CallOption) (*SayHelloResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SayHelloResponse)
-	err := c.cc.Invoke(ctx, GreeterService_SayHello_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// GreeterServiceServer is the server API for GreeterService service.
-// All implementations should embed UnimplementedGreeterServiceServer
-// for forward compatibility.
-//
-// 定义一个 GreeterService 服务，包含一个 SayHello 方法
-type GreeterServiceServer interface {
-	// SayHello 方法接受 HelloRequest 消息，并返回 SayHelloResponse 消息
-	SayHello(context.Context, *SayHelloRequest) (*SayHelloResponse, error)
-}
-
-// UnimplementedGreeterServiceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGreeterServiceServer struct{}
-
-func (UnimplementedGreeterServiceServer) SayHello(context.Context, *SayHelloRequest) (*SayHelloResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SayHello not implemented")
-}
-func (UnimplementedGreeterServiceServer) testEmbeddedByValue() {}
-
-// UnsafeGreeterServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GreeterServiceServer will
-// result in compilation errors.
-type UnsafeGreeterServiceServer interface {
-	mustEmbedUnimplementedGreeterServiceServer()
-}
-
-func RegisterGreeterServiceServer(s grpc.ServiceRegistrar, srv GreeterServiceServer) {
-	// If the following call pancis, it indicates UnimplementedGreeterServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&GreeterService_ServiceDesc, srv)
-}
-
-func _GreeterService_SayHello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SayHelloRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GreeterServiceServer).SayHello(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GreeterService_SayHello_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServiceServer).SayHello(ctx, req.(*SayHelloRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// GreeterService_ServiceDesc is the grpc.ServiceDesc for GreeterService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var GreeterService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "helloworld.v1.GreeterService",
-	HandlerType: (*GreeterServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SayHello",
-			Handler:    _GreeterService_SayHello_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "helloworld/v1/helloworld.proto",
-}
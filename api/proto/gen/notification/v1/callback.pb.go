@@ -0,0 +1,537 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: notification/v1/callback.proto
+
+package notificationv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CallbackStatus 回调的投递状态
+type CallbackStatus int32
+
+const (
+	CallbackStatus_CALLBACK_STATUS_UNSPECIFIED CallbackStatus = 0
+	// 等待投递或等待重试
+	CallbackStatus_CALLBACK_STATUS_PENDING CallbackStatus = 1
+	// 业务方已返回 2xx
+	CallbackStatus_CALLBACK_STATUS_SUCCEEDED CallbackStatus = 2
+	// 重试次数用完仍未成功，或无法投递（如未配置签名密钥）
+	CallbackStatus_CALLBACK_STATUS_FAILED CallbackStatus = 3
+)
+
+// Enum value maps for CallbackStatus.
+var (
+	CallbackStatus_name = map[int32]string{
+		0: "CALLBACK_STATUS_UNSPECIFIED",
+		1: "CALLBACK_STATUS_PENDING",
+		2: "CALLBACK_STATUS_SUCCEEDED",
+		3: "CALLBACK_STATUS_FAILED",
+	}
+	CallbackStatus_value = map[string]int32{
+		"CALLBACK_STATUS_UNSPECIFIED": 0,
+		"CALLBACK_STATUS_PENDING":     1,
+		"CALLBACK_STATUS_SUCCEEDED":   2,
+		"CALLBACK_STATUS_FAILED":      3,
+	}
+)
+
+func (x CallbackStatus) Enum() *CallbackStatus {
+	p := new(CallbackStatus)
+	*p = x
+	return p
+}
+
+func (x CallbackStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallbackStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_callback_proto_enumTypes[0].Descriptor()
+}
+
+func (CallbackStatus) Type() protoreflect.EnumType {
+	return &file_notification_v1_callback_proto_enumTypes[0]
+}
+
+func (x CallbackStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallbackStatus.Descriptor instead.
+func (CallbackStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_callback_proto_rawDescGZIP(), []int{0}
+}
+
+// CallbackAttempt 回调的一次投递
+type CallbackAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 投递序号，首次投递为 1
+	Attempt int32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// 业务方返回的 HTTP 状态码，未收到响应时为 0
+	StatusCode int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// 投递失败的原因，成功时为空
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// 投递耗时
+	Duration *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// 投递时间
+	AttemptedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=attempted_at,json=attemptedAt,proto3" json:"attempted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallbackAttempt) Reset() {
+	*x = CallbackAttempt{}
+	mi := &file_notification_v1_callback_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallbackAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallbackAttempt) ProtoMessage() {}
+
+func (x *CallbackAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_callback_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallbackAttempt.ProtoReflect.Descriptor instead.
+func (*CallbackAttempt) Descriptor() ([]byte, []int) {
+	return file_notification_v1_callback_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CallbackAttempt) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *CallbackAttempt) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CallbackAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CallbackAttempt) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *CallbackAttempt) GetAttemptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AttemptedAt
+	}
+	return nil
+}
+
+// Callback 通知进入终态时的一次结果回调
+type Callback struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 回调 ID，与请求体中的 callback_id 一致
+	CallbackId uint64 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	// 回调地址
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// 回调告知的通知终态
+	NotificationStatus SendStatus `protobuf:"varint,3,opt,name=notification_status,json=notificationStatus,proto3,enum=notification.v1.SendStatus" json:"notification_status,omitempty"`
+	// 投递状态
+	Status CallbackStatus `protobuf:"varint,4,opt,name=status,proto3,enum=notification.v1.CallbackStatus" json:"status,omitempty"`
+	// 已投递次数
+	Attempts int32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// 下次投递时间，投递结束后不返回
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	// 最近一次投递失败的原因
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// 创建时间，即通知进入终态的时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 按投递顺序排列的投递记录
+	AttemptLog    []*CallbackAttempt `protobuf:"bytes,9,rep,name=attempt_log,json=attemptLog,proto3" json:"attempt_log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Callback) Reset() {
+	*x = Callback{}
+	mi := &file_notification_v1_callback_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Callback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Callback) ProtoMessage() {}
+
+func (x *Callback) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_callback_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Callback.ProtoReflect.Descriptor instead.
+func (*Callback) Descriptor() ([]byte, []int) {
+	return file_notification_v1_callback_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Callback) GetCallbackId() uint64 {
+	if x != nil {
+		return x.CallbackId
+	}
+	return 0
+}
+
+func (x *Callback) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Callback) GetNotificationStatus() SendStatus {
+	if x != nil {
+		return x.NotificationStatus
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *Callback) GetStatus() CallbackStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CallbackStatus_CALLBACK_STATUS_UNSPECIFIED
+}
+
+func (x *Callback) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Callback) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *Callback) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Callback) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Callback) GetAttemptLog() []*CallbackAttempt {
+	if x != nil {
+		return x.AttemptLog
+	}
+	return nil
+}
+
+// ListCallbacksRequest 查询通知回调历史的请求
+type ListCallbacksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Key:
+	//
+	//	*ListCallbacksRequest_NotificationId
+	//	*ListCallbacksRequest_BizKey
+	Key           isListCallbacksRequest_Key `protobuf_oneof:"key"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallbacksRequest) Reset() {
+	*x = ListCallbacksRequest{}
+	mi := &file_notification_v1_callback_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallbacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallbacksRequest) ProtoMessage() {}
+
+func (x *ListCallbacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_callback_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallbacksRequest.ProtoReflect.Descriptor instead.
+func (*ListCallbacksRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_callback_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCallbacksRequest) GetKey() isListCallbacksRequest_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ListCallbacksRequest) GetNotificationId() uint64 {
+	if x != nil {
+		if x, ok := x.Key.(*ListCallbacksRequest_NotificationId); ok {
+			return x.NotificationId
+		}
+	}
+	return 0
+}
+
+func (x *ListCallbacksRequest) GetBizKey() string {
+	if x != nil {
+		if x, ok := x.Key.(*ListCallbacksRequest_BizKey); ok {
+			return x.BizKey
+		}
+	}
+	return ""
+}
+
+type isListCallbacksRequest_Key interface {
+	isListCallbacksRequest_Key()
+}
+
+type ListCallbacksRequest_NotificationId struct {
+	// 通知 ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3,oneof"`
+}
+
+type ListCallbacksRequest_BizKey struct {
+	// 业务键
+	BizKey string `protobuf:"bytes,2,opt,name=biz_key,json=bizKey,proto3,oneof"`
+}
+
+func (*ListCallbacksRequest_NotificationId) isListCallbacksRequest_Key() {}
+
+func (*ListCallbacksRequest_BizKey) isListCallbacksRequest_Key() {}
+
+// ListCallbacksResponse 查询通知回调历史的响应
+type ListCallbacksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知 ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 按创建顺序排列的回调，通知未设置回调地址或尚未进入终态时为空
+	Callbacks     []*Callback `protobuf:"bytes,2,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallbacksResponse) Reset() {
+	*x = ListCallbacksResponse{}
+	mi := &file_notification_v1_callback_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallbacksResponse) ProtoMessage() {}
+
+func (x *ListCallbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_callback_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallbacksResponse.ProtoReflect.Descriptor instead.
+func (*ListCallbacksResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_callback_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCallbacksResponse) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *ListCallbacksResponse) GetCallbacks() []*Callback {
+	if x != nil {
+		return x.Callbacks
+	}
+	return nil
+}
+
+var File_notification_v1_callback_proto protoreflect.FileDescriptor
+
+const file_notification_v1_callback_proto_rawDesc = "" +
+	"\n" +
+	"\x1enotification/v1/callback.proto\x12\x0fnotification.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"notification/v1/notification.proto\x1a\x17validate/validate.proto\"\xd8\x01\n" +
+	"\x0fCallbackAttempt\x12\x18\n" +
+	"\aattempt\x18\x01 \x01(\x05R\aattempt\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12=\n" +
+	"\fattempted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vattemptedAt\"\xc1\x03\n" +
+	"\bCallback\x12\x1f\n" +
+	"\vcallback_id\x18\x01 \x01(\x04R\n" +
+	"callbackId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12L\n" +
+	"\x13notification_status\x18\x03 \x01(\x0e2\x1b.notification.v1.SendStatusR\x12notificationStatus\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.notification.v1.CallbackStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12B\n" +
+	"\x0fnext_attempt_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12A\n" +
+	"\vattempt_log\x18\t \x03(\v2 .notification.v1.CallbackAttemptR\n" +
+	"attemptLog\"|\n" +
+	"\x14ListCallbacksRequest\x122\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\x0enotificationId\x12$\n" +
+	"\abiz_key\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@H\x00R\x06bizKeyB\n" +
+	"\n" +
+	"\x03key\x12\x03\xf8B\x01\"y\n" +
+	"\x15ListCallbacksResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x127\n" +
+	"\tcallbacks\x18\x02 \x03(\v2\x19.notification.v1.CallbackR\tcallbacks*\x89\x01\n" +
+	"\x0eCallbackStatus\x12\x1f\n" +
+	"\x1bCALLBACK_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17CALLBACK_STATUS_PENDING\x10\x01\x12\x1d\n" +
+	"\x19CALLBACK_STATUS_SUCCEEDED\x10\x02\x12\x1a\n" +
+	"\x16CALLBACK_STATUS_FAILED\x10\x032q\n" +
+	"\x0fCallbackService\x12^\n" +
+	"\rListCallbacks\x12%.notification.v1.ListCallbacksRequest\x1a&.notification.v1.ListCallbacksResponseB\xcb\x01\n" +
+	"\x13com.notification.v1B\rCallbackProtoP\x01ZHgithub.com/dingdong-postman/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_callback_proto_rawDescOnce sync.Once
+	file_notification_v1_callback_proto_rawDescData []byte
+)
+
+func file_notification_v1_callback_proto_rawDescGZIP() []byte {
+	file_notification_v1_callback_proto_rawDescOnce.Do(func() {
+		file_notification_v1_callback_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_callback_proto_rawDesc), len(file_notification_v1_callback_proto_rawDesc)))
+	})
+	return file_notification_v1_callback_proto_rawDescData
+}
+
+var file_notification_v1_callback_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_v1_callback_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_notification_v1_callback_proto_goTypes = []any{
+	(CallbackStatus)(0),           // 0: notification.v1.CallbackStatus
+	(*CallbackAttempt)(nil),       // 1: notification.v1.CallbackAttempt
+	(*Callback)(nil),              // 2: notification.v1.Callback
+	(*ListCallbacksRequest)(nil),  // 3: notification.v1.ListCallbacksRequest
+	(*ListCallbacksResponse)(nil), // 4: notification.v1.ListCallbacksResponse
+	(*durationpb.Duration)(nil),   // 5: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(SendStatus)(0),               // 7: notification.v1.SendStatus
+}
+var file_notification_v1_callback_proto_depIdxs = []int32{
+	5, // 0: notification.v1.CallbackAttempt.duration:type_name -> google.protobuf.Duration
+	6, // 1: notification.v1.CallbackAttempt.attempted_at:type_name -> google.protobuf.Timestamp
+	7, // 2: notification.v1.Callback.notification_status:type_name -> notification.v1.SendStatus
+	0, // 3: notification.v1.Callback.status:type_name -> notification.v1.CallbackStatus
+	6, // 4: notification.v1.Callback.next_attempt_at:type_name -> google.protobuf.Timestamp
+	6, // 5: notification.v1.Callback.created_at:type_name -> google.protobuf.Timestamp
+	1, // 6: notification.v1.Callback.attempt_log:type_name -> notification.v1.CallbackAttempt
+	2, // 7: notification.v1.ListCallbacksResponse.callbacks:type_name -> notification.v1.Callback
+	3, // 8: notification.v1.CallbackService.ListCallbacks:input_type -> notification.v1.ListCallbacksRequest
+	4, // 9: notification.v1.CallbackService.ListCallbacks:output_type -> notification.v1.ListCallbacksResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_callback_proto_init() }
+func file_notification_v1_callback_proto_init() {
+	if File_notification_v1_callback_proto != nil {
+		return
+	}
+	file_notification_v1_notification_proto_init()
+	file_notification_v1_callback_proto_msgTypes[2].OneofWrappers = []any{
+		(*ListCallbacksRequest_NotificationId)(nil),
+		(*ListCallbacksRequest_BizKey)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_callback_proto_rawDesc), len(file_notification_v1_callback_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_callback_proto_goTypes,
+		DependencyIndexes: file_notification_v1_callback_proto_depIdxs,
+		EnumInfos:         file_notification_v1_callback_proto_enumTypes,
+		MessageInfos:      file_notification_v1_callback_proto_msgTypes,
+	}.Build()
+	File_notification_v1_callback_proto = out.File
+	file_notification_v1_callback_proto_goTypes = nil
+	file_notification_v1_callback_proto_depIdxs = nil
+}
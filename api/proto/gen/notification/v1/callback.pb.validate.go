@@ -0,0 +1,707 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/callback.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CallbackAttempt with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CallbackAttempt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CallbackAttempt with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CallbackAttemptMultiError, or nil if none found.
+func (m *CallbackAttempt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CallbackAttempt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Attempt
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CallbackAttemptValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CallbackAttemptValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CallbackAttemptValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttemptedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CallbackAttemptValidationError{
+					field:  "AttemptedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CallbackAttemptValidationError{
+					field:  "AttemptedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttemptedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CallbackAttemptValidationError{
+				field:  "AttemptedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CallbackAttemptMultiError(errors)
+	}
+
+	return nil
+}
+
+// CallbackAttemptMultiError is an error wrapping multiple validation errors
+// returned by CallbackAttempt.ValidateAll() if the designated constraints
+// aren't met.
+type CallbackAttemptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CallbackAttemptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CallbackAttemptMultiError) AllErrors() []error { return m }
+
+// CallbackAttemptValidationError is the validation error returned by
+// CallbackAttempt.Validate if the designated constraints aren't met.
+type CallbackAttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CallbackAttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CallbackAttemptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CallbackAttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CallbackAttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CallbackAttemptValidationError) ErrorName() string { return "CallbackAttemptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CallbackAttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCallbackAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CallbackAttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CallbackAttemptValidationError{}
+
+// Validate checks the field values on Callback with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Callback) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Callback with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CallbackMultiError, or nil
+// if none found.
+func (m *Callback) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Callback) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CallbackId
+
+	// no validation rules for Url
+
+	// no validation rules for NotificationStatus
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	if all {
+		switch v := interface{}(m.GetNextAttemptAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CallbackValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CallbackValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextAttemptAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CallbackValidationError{
+				field:  "NextAttemptAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CallbackValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CallbackValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CallbackValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAttemptLog() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CallbackValidationError{
+						field:  fmt.Sprintf("AttemptLog[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CallbackValidationError{
+						field:  fmt.Sprintf("AttemptLog[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CallbackValidationError{
+					field:  fmt.Sprintf("AttemptLog[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CallbackMultiError(errors)
+	}
+
+	return nil
+}
+
+// CallbackMultiError is an error wrapping multiple validation errors returned
+// by Callback.ValidateAll() if the designated constraints aren't met.
+type CallbackMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CallbackMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CallbackMultiError) AllErrors() []error { return m }
+
+// CallbackValidationError is the validation error returned by
+// Callback.Validate if the designated constraints aren't met.
+type CallbackValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CallbackValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CallbackValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CallbackValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CallbackValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CallbackValidationError) ErrorName() string { return "CallbackValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CallbackValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCallback.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CallbackValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CallbackValidationError{}
+
+// Validate checks the field values on ListCallbacksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCallbacksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCallbacksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCallbacksRequestMultiError, or nil if none found.
+func (m *ListCallbacksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCallbacksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofKeyPresent := false
+	switch v := m.Key.(type) {
+	case *ListCallbacksRequest_NotificationId:
+		if v == nil {
+			err := ListCallbacksRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if m.GetNotificationId() <= 0 {
+			err := ListCallbacksRequestValidationError{
+				field:  "NotificationId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ListCallbacksRequest_BizKey:
+		if v == nil {
+			err := ListCallbacksRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if l := utf8.RuneCountInString(m.GetBizKey()); l < 1 || l > 64 {
+			err := ListCallbacksRequestValidationError{
+				field:  "BizKey",
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofKeyPresent {
+		err := ListCallbacksRequestValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCallbacksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCallbacksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCallbacksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCallbacksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCallbacksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCallbacksRequestMultiError) AllErrors() []error { return m }
+
+// ListCallbacksRequestValidationError is the validation error returned by
+// ListCallbacksRequest.Validate if the designated constraints aren't met.
+type ListCallbacksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCallbacksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCallbacksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCallbacksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCallbacksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCallbacksRequestValidationError) ErrorName() string {
+	return "ListCallbacksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCallbacksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCallbacksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCallbacksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCallbacksRequestValidationError{}
+
+// Validate checks the field values on ListCallbacksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCallbacksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCallbacksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCallbacksResponseMultiError, or nil if none found.
+func (m *ListCallbacksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCallbacksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	for idx, item := range m.GetCallbacks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCallbacksResponseValidationError{
+						field:  fmt.Sprintf("Callbacks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCallbacksResponseValidationError{
+						field:  fmt.Sprintf("Callbacks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCallbacksResponseValidationError{
+					field:  fmt.Sprintf("Callbacks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCallbacksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCallbacksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCallbacksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCallbacksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCallbacksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCallbacksResponseMultiError) AllErrors() []error { return m }
+
+// ListCallbacksResponseValidationError is the validation error returned by
+// ListCallbacksResponse.Validate if the designated constraints aren't met.
+type ListCallbacksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCallbacksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCallbacksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCallbacksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCallbacksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCallbacksResponseValidationError) ErrorName() string {
+	return "ListCallbacksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCallbacksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCallbacksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCallbacksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCallbacksResponseValidationError{}
@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: notification/v1/callback.proto
+
+package notificationv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CallbackService_ListCallbacks_FullMethodName = "/notification.v1.CallbackService/ListCallbacks"
+)
+
+// CallbackServiceClient is the client API for CallbackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CallbackService 通知结果回调服务
+type CallbackServiceClient interface {
+	// ListCallbacks 按通知 ID 或业务键查询通知的回调历史与每次投递的记录
+	ListCallbacks(ctx context.Context, in *ListCallbacksRequest, opts ...grpc.CallOption) (*ListCallbacksResponse, error)
+}
+
+type callbackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCallbackServiceClient(cc grpc.ClientConnInterface) CallbackServiceClient {
+	return &callbackServiceClient{cc}
+}
+
+func (c *callbackServiceClient) ListCallbacks(ctx context.Context, in *ListCallbacksRequest, opts ...grpc.CallOption) (*ListCallbacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCallbacksResponse)
+	err := c.cc.Invoke(ctx, CallbackService_ListCallbacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CallbackServiceServer is the server API for CallbackService service.
+// All implementations should embed UnimplementedCallbackServiceServer
+// for forward compatibility.
+//
+// CallbackService 通知结果回调服务
+type CallbackServiceServer interface {
+	// ListCallbacks 按通知 ID 或业务键查询通知的回调历史与每次投递的记录
+	ListCallbacks(context.Context, *ListCallbacksRequest) (*ListCallbacksResponse, error)
+}
+
+// UnimplementedCallbackServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCallbackServiceServer struct{}
+
+func (UnimplementedCallbackServiceServer) ListCallbacks(context.Context, *ListCallbacksRequest) (*ListCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCallbacks not implemented")
+}
+func (UnimplementedCallbackServiceServer) testEmbeddedByValue() {}
+
+// UnsafeCallbackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CallbackServiceServer will
+// result in compilation errors.
+type UnsafeCallbackServiceServer interface {
+	mustEmbedUnimplementedCallbackServiceServer()
+}
+
+func RegisterCallbackServiceServer(s grpc.ServiceRegistrar, srv CallbackServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCallbackServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CallbackService_ServiceDesc, srv)
+}
+
+func _CallbackService_ListCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServiceServer).ListCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallbackService_ListCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServiceServer).ListCallbacks(ctx, req.(*ListCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CallbackService_ServiceDesc is the grpc.ServiceDesc for CallbackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CallbackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification.v1.CallbackService",
+	HandlerType: (*CallbackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCallbacks",
+			Handler:    _CallbackService_ListCallbacks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notification/v1/callback.proto",
+}
@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: notification/v1/event.proto
+
+package notificationv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StatusChangedEvent 通知状态变更事件，通知每次变更状态（含受理时的初始状态）发布一条
+// 事件发布到 Kafka 的状态事件 topic，消息键为通知 ID，同一通知的事件按发生顺序落在同一分区；
+// 事件至少投递一次，消费方可按 event_id 去重。
+// 消息头 event-type 为 notification.status_changed，schema-version 为事件结构的版本号，
+// 结构只做向后兼容的变更（新增字段），不兼容的变更会提升版本号
+type StatusChangedEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 事件 ID，单调递增，同一通知的事件 ID 越大越晚发生
+	EventId uint64 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// 平台生成的通知 ID
+	NotificationId uint64 `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 所属租户，未启用租户鉴权时为空
+	TenantId string `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 业务键
+	BizKey string `protobuf:"bytes,4,opt,name=biz_key,json=bizKey,proto3" json:"biz_key,omitempty"`
+	// 渠道
+	Channel Channel `protobuf:"varint,5,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 变更前的状态，受理时为 SEND_STATUS_UNSPECIFIED
+	PreviousStatus SendStatus `protobuf:"varint,6,opt,name=previous_status,json=previousStatus,proto3,enum=notification.v1.SendStatus" json:"previous_status,omitempty"`
+	// 变更后的状态
+	Status SendStatus `protobuf:"varint,7,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 失败原因，发送失败或等待重试时填写
+	ErrorMessage string `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 实际发送的供应商
+	Provider string `protobuf:"bytes,9,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 供应商侧的消息 ID
+	ProviderMessageId string `protobuf:"bytes,10,opt,name=provider_message_id,json=providerMessageId,proto3" json:"provider_message_id,omitempty"`
+	// 状态变更时间
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChangedEvent) Reset() {
+	*x = StatusChangedEvent{}
+	mi := &file_notification_v1_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChangedEvent) ProtoMessage() {}
+
+func (x *StatusChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChangedEvent.ProtoReflect.Descriptor instead.
+func (*StatusChangedEvent) Descriptor() ([]byte, []int) {
+	return file_notification_v1_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StatusChangedEvent) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *StatusChangedEvent) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *StatusChangedEvent) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *StatusChangedEvent) GetBizKey() string {
+	if x != nil {
+		return x.BizKey
+	}
+	return ""
+}
+
+func (x *StatusChangedEvent) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *StatusChangedEvent) GetPreviousStatus() SendStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *StatusChangedEvent) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *StatusChangedEvent) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *StatusChangedEvent) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *StatusChangedEvent) GetProviderMessageId() string {
+	if x != nil {
+		return x.ProviderMessageId
+	}
+	return ""
+}
+
+func (x *StatusChangedEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_notification_v1_event_proto protoreflect.FileDescriptor
+
+const file_notification_v1_event_proto_rawDesc = "" +
+	"\n" +
+	"\x1bnotification/v1/event.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"notification/v1/notification.proto\"\xeb\x03\n" +
+	"\x12StatusChangedEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x04R\x0enotificationId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x17\n" +
+	"\abiz_key\x18\x04 \x01(\tR\x06bizKey\x122\n" +
+	"\achannel\x18\x05 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12D\n" +
+	"\x0fprevious_status\x18\x06 \x01(\x0e2\x1b.notification.v1.SendStatusR\x0epreviousStatus\x123\n" +
+	"\x06status\x18\a \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12#\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12\x1a\n" +
+	"\bprovider\x18\t \x01(\tR\bprovider\x12.\n" +
+	"\x13provider_message_id\x18\n" +
+	" \x01(\tR\x11providerMessageId\x12;\n" +
+	"\voccurred_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\xc8\x01\n" +
+	"\x13com.notification.v1B\n" +
+	"EventProtoP\x01ZHgithub.com/dingdong-postman/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_event_proto_rawDescOnce sync.Once
+	file_notification_v1_event_proto_rawDescData []byte
+)
+
+func file_notification_v1_event_proto_rawDescGZIP() []byte {
+	file_notification_v1_event_proto_rawDescOnce.Do(func() {
+		file_notification_v1_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_event_proto_rawDesc), len(file_notification_v1_event_proto_rawDesc)))
+	})
+	return file_notification_v1_event_proto_rawDescData
+}
+
+var file_notification_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_notification_v1_event_proto_goTypes = []any{
+	(*StatusChangedEvent)(nil),    // 0: notification.v1.StatusChangedEvent
+	(Channel)(0),                  // 1: notification.v1.Channel
+	(SendStatus)(0),               // 2: notification.v1.SendStatus
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_notification_v1_event_proto_depIdxs = []int32{
+	1, // 0: notification.v1.StatusChangedEvent.channel:type_name -> notification.v1.Channel
+	2, // 1: notification.v1.StatusChangedEvent.previous_status:type_name -> notification.v1.SendStatus
+	2, // 2: notification.v1.StatusChangedEvent.status:type_name -> notification.v1.SendStatus
+	3, // 3: notification.v1.StatusChangedEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_event_proto_init() }
+func file_notification_v1_event_proto_init() {
+	if File_notification_v1_event_proto != nil {
+		return
+	}
+	file_notification_v1_notification_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_event_proto_rawDesc), len(file_notification_v1_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_notification_v1_event_proto_goTypes,
+		DependencyIndexes: file_notification_v1_event_proto_depIdxs,
+		MessageInfos:      file_notification_v1_event_proto_msgTypes,
+	}.Build()
+	File_notification_v1_event_proto = out.File
+	file_notification_v1_event_proto_goTypes = nil
+	file_notification_v1_event_proto_depIdxs = nil
+}
@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/event.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on StatusChangedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StatusChangedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusChangedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatusChangedEventMultiError, or nil if none found.
+func (m *StatusChangedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusChangedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for NotificationId
+
+	// no validation rules for TenantId
+
+	// no validation rules for BizKey
+
+	// no validation rules for Channel
+
+	// no validation rules for PreviousStatus
+
+	// no validation rules for Status
+
+	// no validation rules for ErrorMessage
+
+	// no validation rules for Provider
+
+	// no validation rules for ProviderMessageId
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatusChangedEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatusChangedEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatusChangedEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatusChangedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusChangedEventMultiError is an error wrapping multiple validation errors
+// returned by StatusChangedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type StatusChangedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusChangedEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusChangedEventMultiError) AllErrors() []error { return m }
+
+// StatusChangedEventValidationError is the validation error returned by
+// StatusChangedEvent.Validate if the designated constraints aren't met.
+type StatusChangedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusChangedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusChangedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusChangedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusChangedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusChangedEventValidationError) ErrorName() string {
+	return "StatusChangedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StatusChangedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusChangedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusChangedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusChangedEventValidationError{}
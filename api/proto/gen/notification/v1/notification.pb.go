@@ -0,0 +1,1934 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: notification/v1/notification.proto
+
+package notificationv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Channel 通知渠道
+type Channel int32
+
+const (
+	Channel_CHANNEL_UNSPECIFIED Channel = 0
+	// 短信
+	Channel_CHANNEL_SMS Channel = 1
+	// 邮件
+	Channel_CHANNEL_EMAIL Channel = 2
+)
+
+// Enum value maps for Channel.
+var (
+	Channel_name = map[int32]string{
+		0: "CHANNEL_UNSPECIFIED",
+		1: "CHANNEL_SMS",
+		2: "CHANNEL_EMAIL",
+	}
+	Channel_value = map[string]int32{
+		"CHANNEL_UNSPECIFIED": 0,
+		"CHANNEL_SMS":         1,
+		"CHANNEL_EMAIL":       2,
+	}
+)
+
+func (x Channel) Enum() *Channel {
+	p := new(Channel)
+	*p = x
+	return p
+}
+
+func (x Channel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Channel) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (Channel) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_proto_enumTypes[0]
+}
+
+func (x Channel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Channel.Descriptor instead.
+func (Channel) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+// SendStatus 通知发送状态
+type SendStatus int32
+
+const (
+	SendStatus_SEND_STATUS_UNSPECIFIED SendStatus = 0
+	// 已受理，等待发送
+	SendStatus_SEND_STATUS_PENDING SendStatus = 1
+	// 发送中
+	SendStatus_SEND_STATUS_SENDING SendStatus = 2
+	// 已成功提交给渠道供应商，收到供应商回执后变为 SEND_STATUS_DELIVERED 或 SEND_STATUS_FAILED
+	SendStatus_SEND_STATUS_SUCCEEDED SendStatus = 3
+	// 发送失败，或供应商回执告知未送达
+	SendStatus_SEND_STATUS_FAILED SendStatus = 4
+	// 发送失败，等待按重试策略再次发送
+	SendStatus_SEND_STATUS_RETRYING SendStatus = 5
+	// 定时通知，等待到达发送时间
+	SendStatus_SEND_STATUS_SCHEDULED SendStatus = 6
+	// 定时通知在发送前被取消，或两阶段通知被取消
+	SendStatus_SEND_STATUS_CANCELED SendStatus = 7
+	// 两阶段通知已预提交，等待调用方提交或取消
+	SendStatus_SEND_STATUS_PREPARED SendStatus = 8
+	// 供应商回执确认已送达（短信到达手机，邮件被收件服务器接收）
+	SendStatus_SEND_STATUS_DELIVERED SendStatus = 9
+)
+
+// Enum value maps for SendStatus.
+var (
+	SendStatus_name = map[int32]string{
+		0: "SEND_STATUS_UNSPECIFIED",
+		1: "SEND_STATUS_PENDING",
+		2: "SEND_STATUS_SENDING",
+		3: "SEND_STATUS_SUCCEEDED",
+		4: "SEND_STATUS_FAILED",
+		5: "SEND_STATUS_RETRYING",
+		6: "SEND_STATUS_SCHEDULED",
+		7: "SEND_STATUS_CANCELED",
+		8: "SEND_STATUS_PREPARED",
+		9: "SEND_STATUS_DELIVERED",
+	}
+	SendStatus_value = map[string]int32{
+		"SEND_STATUS_UNSPECIFIED": 0,
+		"SEND_STATUS_PENDING":     1,
+		"SEND_STATUS_SENDING":     2,
+		"SEND_STATUS_SUCCEEDED":   3,
+		"SEND_STATUS_FAILED":      4,
+		"SEND_STATUS_RETRYING":    5,
+		"SEND_STATUS_SCHEDULED":   6,
+		"SEND_STATUS_CANCELED":    7,
+		"SEND_STATUS_PREPARED":    8,
+		"SEND_STATUS_DELIVERED":   9,
+	}
+)
+
+func (x SendStatus) Enum() *SendStatus {
+	p := new(SendStatus)
+	*p = x
+	return p
+}
+
+func (x SendStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SendStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_proto_enumTypes[1].Descriptor()
+}
+
+func (SendStatus) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_proto_enumTypes[1]
+}
+
+func (x SendStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SendStatus.Descriptor instead.
+func (SendStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+// Notification 一条待发送的通知
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务方生成的唯一业务键，用于幂等与查询；相同业务键的重复请求只会发送一次
+	BizKey string `protobuf:"bytes,1,opt,name=biz_key,json=bizKey,proto3" json:"biz_key,omitempty"`
+	// 接收者：短信为手机号，邮件为邮箱地址
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// 发送渠道
+	Channel Channel `protobuf:"varint,3,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 模板 ID，须为已在 template.v1.TemplateService 中创建的模板；短信必填，邮件在未指定 email 内容时必填
+	TemplateId string `protobuf:"bytes,4,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 模板参数，须包含模板生效版本声明的全部必填变量，且取值符合变量类型
+	TemplateParams map[string]string `protobuf:"bytes,5,rep,name=template_params,json=templateParams,proto3" json:"template_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 邮件内容，仅邮件渠道使用；指定后直接按该内容发送
+	Email *EmailContent `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	// 接收者的语言，如 zh-CN、zh-TW、en-US，用于选择模板的语言版本与格式化数字、日期参数；
+	// 未指定时取接收者资料（recipient.v1.RecipientService）中的语言
+	Locale string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	// 定时发送：到达 send_at 或受理后经过 delay 时发送，二者只能指定一个；仅异步发送支持定时，
+	// 发送时间不晚于受理时刻时立即发送，最长定时时间由服务端配置
+	//
+	// Types that are valid to be assigned to Schedule:
+	//
+	//	*Notification_SendAt
+	//	*Notification_Delay
+	Schedule isNotification_Schedule `protobuf_oneof:"schedule"`
+	// 是否为事务类通知（如验证码、交易提醒）；事务类通知不受租户的免打扰时间限制，
+	// 其余通知落在免打扰时间内时推迟到接收者所在时区的下一个允许发送时间
+	Transactional bool `protobuf:"varint,10,opt,name=transactional,proto3" json:"transactional,omitempty"`
+	// 通知进入终态（成功、送达、失败、取消）时接收结果回调的 http 或 https 地址，请求体与签名格式见 pkg/callback；
+	// 未指定时使用租户的回调地址，均未设置时不回调
+	CallbackUrl   string `protobuf:"bytes,11,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Notification) GetBizKey() string {
+	if x != nil {
+		return x.BizKey
+	}
+	return ""
+}
+
+func (x *Notification) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Notification) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *Notification) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Notification) GetTemplateParams() map[string]string {
+	if x != nil {
+		return x.TemplateParams
+	}
+	return nil
+}
+
+func (x *Notification) GetEmail() *EmailContent {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *Notification) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Notification) GetSchedule() isNotification_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Notification) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Schedule.(*Notification_SendAt); ok {
+			return x.SendAt
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetDelay() *durationpb.Duration {
+	if x != nil {
+		if x, ok := x.Schedule.(*Notification_Delay); ok {
+			return x.Delay
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetTransactional() bool {
+	if x != nil {
+		return x.Transactional
+	}
+	return false
+}
+
+func (x *Notification) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+type isNotification_Schedule interface {
+	isNotification_Schedule()
+}
+
+type Notification_SendAt struct {
+	// 发送时间
+	SendAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=send_at,json=sendAt,proto3,oneof"`
+}
+
+type Notification_Delay struct {
+	// 受理后延迟发送的时长
+	Delay *durationpb.Duration `protobuf:"bytes,9,opt,name=delay,proto3,oneof"`
+}
+
+func (*Notification_SendAt) isNotification_Schedule() {}
+
+func (*Notification_Delay) isNotification_Schedule() {}
+
+// EmailContent 邮件内容
+type EmailContent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 邮件主题
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// HTML 正文
+	HtmlBody string `protobuf:"bytes,2,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	// 纯文本正文；与 html_body 同时存在时作为备选内容
+	TextBody string `protobuf:"bytes,3,opt,name=text_body,json=textBody,proto3" json:"text_body,omitempty"`
+	// 附件
+	Attachments []*Attachment `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// 自定义邮件头
+	Headers       map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailContent) Reset() {
+	*x = EmailContent{}
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailContent) ProtoMessage() {}
+
+func (x *EmailContent) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailContent.ProtoReflect.Descriptor instead.
+func (*EmailContent) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EmailContent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *EmailContent) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
+func (x *EmailContent) GetTextBody() string {
+	if x != nil {
+		return x.TextBody
+	}
+	return ""
+}
+
+func (x *EmailContent) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *EmailContent) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Attachment 邮件附件
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文件名
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// MIME 类型，为空时按文件扩展名推断
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// 文件内容
+	Content       []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// SendNotificationRequest 同步发送单条通知的请求；落在免打扰时间内的非事务类通知不会同步发送，
+// 推迟发送并返回 SEND_STATUS_SCHEDULED
+type SendNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationRequest) Reset() {
+	*x = SendNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationRequest) ProtoMessage() {}
+
+func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SendNotificationRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// SendNotificationResponse 同步发送单条通知的响应
+type SendNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 平台生成的通知 ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 发送状态
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 失败原因，仅在发送失败时有值
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 是否为重复请求；为 true 时返回的是首次请求的通知 ID 与当前状态，不会再次发送
+	Duplicated    bool `protobuf:"varint,4,opt,name=duplicated,proto3" json:"duplicated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationResponse) Reset() {
+	*x = SendNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationResponse) ProtoMessage() {}
+
+func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SendNotificationResponse) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *SendNotificationResponse) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *SendNotificationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SendNotificationResponse) GetDuplicated() bool {
+	if x != nil {
+		return x.Duplicated
+	}
+	return false
+}
+
+// BatchSendNotificationsRequest 批量同步发送通知的请求
+type BatchSendNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSendNotificationsRequest) Reset() {
+	*x = BatchSendNotificationsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSendNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSendNotificationsRequest) ProtoMessage() {}
+
+func (x *BatchSendNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSendNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*BatchSendNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchSendNotificationsRequest) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// BatchSendNotificationsResponse 批量同步发送通知的响应，结果顺序与请求一致
+type BatchSendNotificationsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Results       []*SendNotificationResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSendNotificationsResponse) Reset() {
+	*x = BatchSendNotificationsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSendNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSendNotificationsResponse) ProtoMessage() {}
+
+func (x *BatchSendNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSendNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*BatchSendNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchSendNotificationsResponse) GetResults() []*SendNotificationResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SendNotificationAsyncRequest 异步发送单条通知的请求
+type SendNotificationAsyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationAsyncRequest) Reset() {
+	*x = SendNotificationAsyncRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationAsyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationAsyncRequest) ProtoMessage() {}
+
+func (x *SendNotificationAsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationAsyncRequest.ProtoReflect.Descriptor instead.
+func (*SendNotificationAsyncRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SendNotificationAsyncRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// SendNotificationAsyncResponse 异步发送单条通知的响应，通知已受理，由后台异步投递
+type SendNotificationAsyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 平台生成的通知 ID，可用于后续查询发送结果
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 受理后的状态，正常情况下为 SEND_STATUS_PENDING
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 受理失败原因，仅在受理失败时有值
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 是否为重复请求；为 true 时返回的是首次请求的通知 ID 与当前状态，不会再次发送
+	Duplicated    bool `protobuf:"varint,4,opt,name=duplicated,proto3" json:"duplicated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationAsyncResponse) Reset() {
+	*x = SendNotificationAsyncResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationAsyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationAsyncResponse) ProtoMessage() {}
+
+func (x *SendNotificationAsyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationAsyncResponse.ProtoReflect.Descriptor instead.
+func (*SendNotificationAsyncResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SendNotificationAsyncResponse) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *SendNotificationAsyncResponse) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *SendNotificationAsyncResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SendNotificationAsyncResponse) GetDuplicated() bool {
+	if x != nil {
+		return x.Duplicated
+	}
+	return false
+}
+
+// BatchSendNotificationsAsyncRequest 批量异步发送通知的请求
+type BatchSendNotificationsAsyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSendNotificationsAsyncRequest) Reset() {
+	*x = BatchSendNotificationsAsyncRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSendNotificationsAsyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSendNotificationsAsyncRequest) ProtoMessage() {}
+
+func (x *BatchSendNotificationsAsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSendNotificationsAsyncRequest.ProtoReflect.Descriptor instead.
+func (*BatchSendNotificationsAsyncRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchSendNotificationsAsyncRequest) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// BatchSendNotificationsAsyncResponse 批量异步发送通知的响应，结果顺序与请求一致
+type BatchSendNotificationsAsyncResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Results       []*SendNotificationAsyncResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSendNotificationsAsyncResponse) Reset() {
+	*x = BatchSendNotificationsAsyncResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSendNotificationsAsyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSendNotificationsAsyncResponse) ProtoMessage() {}
+
+func (x *BatchSendNotificationsAsyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSendNotificationsAsyncResponse.ProtoReflect.Descriptor instead.
+func (*BatchSendNotificationsAsyncResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchSendNotificationsAsyncResponse) GetResults() []*SendNotificationAsyncResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// QueryNotificationRequest 查询通知的请求，按通知 ID 或业务键查询
+type QueryNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Key:
+	//
+	//	*QueryNotificationRequest_NotificationId
+	//	*QueryNotificationRequest_BizKey
+	Key           isQueryNotificationRequest_Key `protobuf_oneof:"key"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryNotificationRequest) Reset() {
+	*x = QueryNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNotificationRequest) ProtoMessage() {}
+
+func (x *QueryNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryNotificationRequest.ProtoReflect.Descriptor instead.
+func (*QueryNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryNotificationRequest) GetKey() isQueryNotificationRequest_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *QueryNotificationRequest) GetNotificationId() uint64 {
+	if x != nil {
+		if x, ok := x.Key.(*QueryNotificationRequest_NotificationId); ok {
+			return x.NotificationId
+		}
+	}
+	return 0
+}
+
+func (x *QueryNotificationRequest) GetBizKey() string {
+	if x != nil {
+		if x, ok := x.Key.(*QueryNotificationRequest_BizKey); ok {
+			return x.BizKey
+		}
+	}
+	return ""
+}
+
+type isQueryNotificationRequest_Key interface {
+	isQueryNotificationRequest_Key()
+}
+
+type QueryNotificationRequest_NotificationId struct {
+	// 通知 ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3,oneof"`
+}
+
+type QueryNotificationRequest_BizKey struct {
+	// 业务键
+	BizKey string `protobuf:"bytes,2,opt,name=biz_key,json=bizKey,proto3,oneof"`
+}
+
+func (*QueryNotificationRequest_NotificationId) isQueryNotificationRequest_Key() {}
+
+func (*QueryNotificationRequest_BizKey) isQueryNotificationRequest_Key() {}
+
+// NotificationRecord 通知的发送记录
+type NotificationRecord struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId uint64                 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	Notification   *Notification          `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
+	Status         SendStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	ErrorMessage   string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 实际发送的供应商名称
+	Provider string `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 供应商侧的消息 ID
+	ProviderMessageId string `protobuf:"bytes,8,opt,name=provider_message_id,json=providerMessageId,proto3" json:"provider_message_id,omitempty"`
+	// 受理时选中的模板语言版本，为空表示使用模板的默认内容
+	TemplateLocale string `protobuf:"bytes,9,opt,name=template_locale,json=templateLocale,proto3" json:"template_locale,omitempty"`
+	// 定时发送时间，立即发送的通知为空
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationRecord) Reset() {
+	*x = NotificationRecord{}
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationRecord) ProtoMessage() {}
+
+func (x *NotificationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationRecord.ProtoReflect.Descriptor instead.
+func (*NotificationRecord) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NotificationRecord) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *NotificationRecord) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *NotificationRecord) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *NotificationRecord) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *NotificationRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NotificationRecord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *NotificationRecord) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *NotificationRecord) GetProviderMessageId() string {
+	if x != nil {
+		return x.ProviderMessageId
+	}
+	return ""
+}
+
+func (x *NotificationRecord) GetTemplateLocale() string {
+	if x != nil {
+		return x.TemplateLocale
+	}
+	return ""
+}
+
+func (x *NotificationRecord) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+// QueryNotificationResponse 查询通知的响应
+type QueryNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *NotificationRecord    `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryNotificationResponse) Reset() {
+	*x = QueryNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNotificationResponse) ProtoMessage() {}
+
+func (x *QueryNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryNotificationResponse.ProtoReflect.Descriptor instead.
+func (*QueryNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryNotificationResponse) GetRecord() *NotificationRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// CancelScheduledNotificationRequest 取消定时通知的请求，按通知 ID 或业务键指定
+type CancelScheduledNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Key:
+	//
+	//	*CancelScheduledNotificationRequest_NotificationId
+	//	*CancelScheduledNotificationRequest_BizKey
+	Key           isCancelScheduledNotificationRequest_Key `protobuf_oneof:"key"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledNotificationRequest) Reset() {
+	*x = CancelScheduledNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledNotificationRequest) ProtoMessage() {}
+
+func (x *CancelScheduledNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelScheduledNotificationRequest) GetKey() isCancelScheduledNotificationRequest_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CancelScheduledNotificationRequest) GetNotificationId() uint64 {
+	if x != nil {
+		if x, ok := x.Key.(*CancelScheduledNotificationRequest_NotificationId); ok {
+			return x.NotificationId
+		}
+	}
+	return 0
+}
+
+func (x *CancelScheduledNotificationRequest) GetBizKey() string {
+	if x != nil {
+		if x, ok := x.Key.(*CancelScheduledNotificationRequest_BizKey); ok {
+			return x.BizKey
+		}
+	}
+	return ""
+}
+
+type isCancelScheduledNotificationRequest_Key interface {
+	isCancelScheduledNotificationRequest_Key()
+}
+
+type CancelScheduledNotificationRequest_NotificationId struct {
+	// 通知 ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3,oneof"`
+}
+
+type CancelScheduledNotificationRequest_BizKey struct {
+	// 业务键
+	BizKey string `protobuf:"bytes,2,opt,name=biz_key,json=bizKey,proto3,oneof"`
+}
+
+func (*CancelScheduledNotificationRequest_NotificationId) isCancelScheduledNotificationRequest_Key() {
+}
+
+func (*CancelScheduledNotificationRequest_BizKey) isCancelScheduledNotificationRequest_Key() {}
+
+// CancelScheduledNotificationResponse 取消定时通知的响应
+type CancelScheduledNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 取消后的发送记录，状态为 SEND_STATUS_CANCELED
+	Record        *NotificationRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledNotificationResponse) Reset() {
+	*x = CancelScheduledNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledNotificationResponse) ProtoMessage() {}
+
+func (x *CancelScheduledNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledNotificationResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelScheduledNotificationResponse) GetRecord() *NotificationRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// PrepareNotificationRequest 预提交两阶段通知的请求
+type PrepareNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareNotificationRequest) Reset() {
+	*x = PrepareNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareNotificationRequest) ProtoMessage() {}
+
+func (x *PrepareNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareNotificationRequest.ProtoReflect.Descriptor instead.
+func (*PrepareNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PrepareNotificationRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// PrepareNotificationResponse 预提交两阶段通知的响应，通知已保存但不会发送，等待提交或取消
+type PrepareNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 平台生成的通知 ID，提交或取消时使用
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 预提交后的状态，正常情况下为 SEND_STATUS_PREPARED
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 是否为重复请求；为 true 时返回的是首次请求的通知 ID 与当前状态
+	Duplicated    bool `protobuf:"varint,3,opt,name=duplicated,proto3" json:"duplicated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareNotificationResponse) Reset() {
+	*x = PrepareNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareNotificationResponse) ProtoMessage() {}
+
+func (x *PrepareNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareNotificationResponse.ProtoReflect.Descriptor instead.
+func (*PrepareNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PrepareNotificationResponse) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *PrepareNotificationResponse) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *PrepareNotificationResponse) GetDuplicated() bool {
+	if x != nil {
+		return x.Duplicated
+	}
+	return false
+}
+
+// CommitPreparedNotificationRequest 提交两阶段通知的请求
+type CommitPreparedNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Key:
+	//
+	//	*CommitPreparedNotificationRequest_NotificationId
+	//	*CommitPreparedNotificationRequest_BizKey
+	Key           isCommitPreparedNotificationRequest_Key `protobuf_oneof:"key"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitPreparedNotificationRequest) Reset() {
+	*x = CommitPreparedNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitPreparedNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitPreparedNotificationRequest) ProtoMessage() {}
+
+func (x *CommitPreparedNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitPreparedNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CommitPreparedNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CommitPreparedNotificationRequest) GetKey() isCommitPreparedNotificationRequest_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CommitPreparedNotificationRequest) GetNotificationId() uint64 {
+	if x != nil {
+		if x, ok := x.Key.(*CommitPreparedNotificationRequest_NotificationId); ok {
+			return x.NotificationId
+		}
+	}
+	return 0
+}
+
+func (x *CommitPreparedNotificationRequest) GetBizKey() string {
+	if x != nil {
+		if x, ok := x.Key.(*CommitPreparedNotificationRequest_BizKey); ok {
+			return x.BizKey
+		}
+	}
+	return ""
+}
+
+type isCommitPreparedNotificationRequest_Key interface {
+	isCommitPreparedNotificationRequest_Key()
+}
+
+type CommitPreparedNotificationRequest_NotificationId struct {
+	// 通知 ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3,oneof"`
+}
+
+type CommitPreparedNotificationRequest_BizKey struct {
+	// 业务键
+	BizKey string `protobuf:"bytes,2,opt,name=biz_key,json=bizKey,proto3,oneof"`
+}
+
+func (*CommitPreparedNotificationRequest_NotificationId) isCommitPreparedNotificationRequest_Key() {}
+
+func (*CommitPreparedNotificationRequest_BizKey) isCommitPreparedNotificationRequest_Key() {}
+
+// CommitPreparedNotificationResponse 提交两阶段通知的响应
+type CommitPreparedNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 提交后的发送记录，状态为 SEND_STATUS_PENDING 或 SEND_STATUS_SCHEDULED（定时或推迟发送），重复提交时为当前状态
+	Record        *NotificationRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitPreparedNotificationResponse) Reset() {
+	*x = CommitPreparedNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitPreparedNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitPreparedNotificationResponse) ProtoMessage() {}
+
+func (x *CommitPreparedNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitPreparedNotificationResponse.ProtoReflect.Descriptor instead.
+func (*CommitPreparedNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CommitPreparedNotificationResponse) GetRecord() *NotificationRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// CancelPreparedNotificationRequest 取消两阶段通知的请求
+type CancelPreparedNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Key:
+	//
+	//	*CancelPreparedNotificationRequest_NotificationId
+	//	*CancelPreparedNotificationRequest_BizKey
+	Key           isCancelPreparedNotificationRequest_Key `protobuf_oneof:"key"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPreparedNotificationRequest) Reset() {
+	*x = CancelPreparedNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPreparedNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPreparedNotificationRequest) ProtoMessage() {}
+
+func (x *CancelPreparedNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPreparedNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CancelPreparedNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelPreparedNotificationRequest) GetKey() isCancelPreparedNotificationRequest_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CancelPreparedNotificationRequest) GetNotificationId() uint64 {
+	if x != nil {
+		if x, ok := x.Key.(*CancelPreparedNotificationRequest_NotificationId); ok {
+			return x.NotificationId
+		}
+	}
+	return 0
+}
+
+func (x *CancelPreparedNotificationRequest) GetBizKey() string {
+	if x != nil {
+		if x, ok := x.Key.(*CancelPreparedNotificationRequest_BizKey); ok {
+			return x.BizKey
+		}
+	}
+	return ""
+}
+
+type isCancelPreparedNotificationRequest_Key interface {
+	isCancelPreparedNotificationRequest_Key()
+}
+
+type CancelPreparedNotificationRequest_NotificationId struct {
+	// 通知 ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3,oneof"`
+}
+
+type CancelPreparedNotificationRequest_BizKey struct {
+	// 业务键
+	BizKey string `protobuf:"bytes,2,opt,name=biz_key,json=bizKey,proto3,oneof"`
+}
+
+func (*CancelPreparedNotificationRequest_NotificationId) isCancelPreparedNotificationRequest_Key() {}
+
+func (*CancelPreparedNotificationRequest_BizKey) isCancelPreparedNotificationRequest_Key() {}
+
+// CancelPreparedNotificationResponse 取消两阶段通知的响应
+type CancelPreparedNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 取消后的发送记录，状态为 SEND_STATUS_CANCELED
+	Record        *NotificationRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPreparedNotificationResponse) Reset() {
+	*x = CancelPreparedNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPreparedNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPreparedNotificationResponse) ProtoMessage() {}
+
+func (x *CancelPreparedNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPreparedNotificationResponse.ProtoReflect.Descriptor instead.
+func (*CancelPreparedNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CancelPreparedNotificationResponse) GetRecord() *NotificationRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+var File_notification_v1_notification_proto protoreflect.FileDescriptor
+
+const file_notification_v1_notification_proto_rawDesc = "" +
+	"\n" +
+	"\"notification/v1/notification.proto\x12\x0fnotification.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xa0\x05\n" +
+	"\fNotification\x12\"\n" +
+	"\abiz_key\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x06bizKey\x12(\n" +
+	"\trecipient\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\trecipient\x12>\n" +
+	"\achannel\x18\x03 \x01(\x0e2\x18.notification.v1.ChannelB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\achannel\x12(\n" +
+	"\vtemplate_id\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\n" +
+	"templateId\x12Z\n" +
+	"\x0ftemplate_params\x18\x05 \x03(\v21.notification.v1.Notification.TemplateParamsEntryR\x0etemplateParams\x123\n" +
+	"\x05email\x18\x06 \x01(\v2\x1d.notification.v1.EmailContentR\x05email\x12\x1f\n" +
+	"\x06locale\x18\a \x01(\tB\a\xfaB\x04r\x02\x18#R\x06locale\x125\n" +
+	"\asend_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06sendAt\x12;\n" +
+	"\x05delay\x18\t \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00H\x00R\x05delay\x12$\n" +
+	"\rtransactional\x18\n" +
+	" \x01(\bR\rtransactional\x12=\n" +
+	"\fcallback_url\x18\v \x01(\tB\x1a\xfaB\x17r\x15\x18\x80\x042\x10^(https?://.+)?$R\vcallbackUrl\x1aA\n" +
+	"\x13TemplateParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\bschedule\"\xd0\x02\n" +
+	"\fEmailContent\x12\"\n" +
+	"\asubject\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\xe6\aR\asubject\x12\x1b\n" +
+	"\thtml_body\x18\x02 \x01(\tR\bhtmlBody\x12\x1b\n" +
+	"\ttext_body\x18\x03 \x01(\tR\btextBody\x12G\n" +
+	"\vattachments\x18\x04 \x03(\v2\x1b.notification.v1.AttachmentB\b\xfaB\x05\x92\x01\x02\x10\n" +
+	"R\vattachments\x12]\n" +
+	"\aheaders\x18\x05 \x03(\v2*.notification.v1.EmailContent.HeadersEntryB\x17\xfaB\x14\x9a\x01\x11\"\x0fr\r2\v^[!-9;-~]+$R\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x01\n" +
+	"\n" +
+	"Attachment\x12&\n" +
+	"\bfilename\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\bfilename\x12+\n" +
+	"\fcontent_type\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\vcontentType\x12&\n" +
+	"\acontent\x18\x03 \x01(\fB\f\xfaB\tz\a\x10\x01\x18\x80\x80\xc0\x01R\acontent\"f\n" +
+	"\x17SendNotificationRequest\x12K\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationB\b\xfaB\x05\x8a\x01\x02\x10\x01R\fnotification\"\xbd\x01\n" +
+	"\x18SendNotificationResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12\x1e\n" +
+	"\n" +
+	"duplicated\x18\x04 \x01(\bR\n" +
+	"duplicated\"w\n" +
+	"\x1dBatchSendNotificationsRequest\x12V\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1d.notification.v1.NotificationB\x11\xfaB\x0e\x92\x01\v\b\x01\x10d\"\x05\x8a\x01\x02\x10\x01R\rnotifications\"e\n" +
+	"\x1eBatchSendNotificationsResponse\x12C\n" +
+	"\aresults\x18\x01 \x03(\v2).notification.v1.SendNotificationResponseR\aresults\"k\n" +
+	"\x1cSendNotificationAsyncRequest\x12K\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationB\b\xfaB\x05\x8a\x01\x02\x10\x01R\fnotification\"\xc2\x01\n" +
+	"\x1dSendNotificationAsyncResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12\x1e\n" +
+	"\n" +
+	"duplicated\x18\x04 \x01(\bR\n" +
+	"duplicated\"|\n" +
+	"\"BatchSendNotificationsAsyncRequest\x12V\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1d.notification.v1.NotificationB\x11\xfaB\x0e\x92\x01\v\b\x01\x10d\"\x05\x8a\x01\x02\x10\x01R\rnotifications\"o\n" +
+	"#BatchSendNotificationsAsyncResponse\x12H\n" +
+	"\aresults\x18\x01 \x03(\v2..notification.v1.SendNotificationAsyncResponseR\aresults\"\x80\x01\n" +
+	"\x18QueryNotificationRequest\x122\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\x0enotificationId\x12$\n" +
+	"\abiz_key\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@H\x00R\x06bizKeyB\n" +
+	"\n" +
+	"\x03key\x12\x03\xf8B\x01\"\x84\x04\n" +
+	"\x12NotificationRecord\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x12A\n" +
+	"\fnotification\x18\x02 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bprovider\x18\a \x01(\tR\bprovider\x12.\n" +
+	"\x13provider_message_id\x18\b \x01(\tR\x11providerMessageId\x12'\n" +
+	"\x0ftemplate_locale\x18\t \x01(\tR\x0etemplateLocale\x12=\n" +
+	"\fscheduled_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"X\n" +
+	"\x19QueryNotificationResponse\x12;\n" +
+	"\x06record\x18\x01 \x01(\v2#.notification.v1.NotificationRecordR\x06record\"\x8a\x01\n" +
+	"\"CancelScheduledNotificationRequest\x122\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\x0enotificationId\x12$\n" +
+	"\abiz_key\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@H\x00R\x06bizKeyB\n" +
+	"\n" +
+	"\x03key\x12\x03\xf8B\x01\"b\n" +
+	"#CancelScheduledNotificationResponse\x12;\n" +
+	"\x06record\x18\x01 \x01(\v2#.notification.v1.NotificationRecordR\x06record\"i\n" +
+	"\x1aPrepareNotificationRequest\x12K\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationB\b\xfaB\x05\x8a\x01\x02\x10\x01R\fnotification\"\x9b\x01\n" +
+	"\x1bPrepareNotificationResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"duplicated\x18\x03 \x01(\bR\n" +
+	"duplicated\"\x89\x01\n" +
+	"!CommitPreparedNotificationRequest\x122\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\x0enotificationId\x12$\n" +
+	"\abiz_key\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@H\x00R\x06bizKeyB\n" +
+	"\n" +
+	"\x03key\x12\x03\xf8B\x01\"a\n" +
+	"\"CommitPreparedNotificationResponse\x12;\n" +
+	"\x06record\x18\x01 \x01(\v2#.notification.v1.NotificationRecordR\x06record\"\x89\x01\n" +
+	"!CancelPreparedNotificationRequest\x122\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\x0enotificationId\x12$\n" +
+	"\abiz_key\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@H\x00R\x06bizKeyB\n" +
+	"\n" +
+	"\x03key\x12\x03\xf8B\x01\"a\n" +
+	"\"CancelPreparedNotificationResponse\x12;\n" +
+	"\x06record\x18\x01 \x01(\v2#.notification.v1.NotificationRecordR\x06record*F\n" +
+	"\aChannel\x12\x17\n" +
+	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vCHANNEL_SMS\x10\x01\x12\x11\n" +
+	"\rCHANNEL_EMAIL\x10\x02*\x92\x02\n" +
+	"\n" +
+	"SendStatus\x12\x1b\n" +
+	"\x17SEND_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SEND_STATUS_PENDING\x10\x01\x12\x17\n" +
+	"\x13SEND_STATUS_SENDING\x10\x02\x12\x19\n" +
+	"\x15SEND_STATUS_SUCCEEDED\x10\x03\x12\x16\n" +
+	"\x12SEND_STATUS_FAILED\x10\x04\x12\x18\n" +
+	"\x14SEND_STATUS_RETRYING\x10\x05\x12\x19\n" +
+	"\x15SEND_STATUS_SCHEDULED\x10\x06\x12\x18\n" +
+	"\x14SEND_STATUS_CANCELED\x10\a\x12\x18\n" +
+	"\x14SEND_STATUS_PREPARED\x10\b\x12\x19\n" +
+	"\x15SEND_STATUS_DELIVERED\x10\t2\xf5\b\n" +
+	"\x13NotificationService\x12g\n" +
+	"\x10SendNotification\x12(.notification.v1.SendNotificationRequest\x1a).notification.v1.SendNotificationResponse\x12y\n" +
+	"\x16BatchSendNotifications\x12..notification.v1.BatchSendNotificationsRequest\x1a/.notification.v1.BatchSendNotificationsResponse\x12v\n" +
+	"\x15SendNotificationAsync\x12-.notification.v1.SendNotificationAsyncRequest\x1a..notification.v1.SendNotificationAsyncResponse\x12\x88\x01\n" +
+	"\x1bBatchSendNotificationsAsync\x123.notification.v1.BatchSendNotificationsAsyncRequest\x1a4.notification.v1.BatchSendNotificationsAsyncResponse\x12j\n" +
+	"\x11QueryNotification\x12).notification.v1.QueryNotificationRequest\x1a*.notification.v1.QueryNotificationResponse\x12\x88\x01\n" +
+	"\x1bCancelScheduledNotification\x123.notification.v1.CancelScheduledNotificationRequest\x1a4.notification.v1.CancelScheduledNotificationResponse\x12p\n" +
+	"\x13PrepareNotification\x12+.notification.v1.PrepareNotificationRequest\x1a,.notification.v1.PrepareNotificationResponse\x12\x85\x01\n" +
+	"\x1aCommitPreparedNotification\x122.notification.v1.CommitPreparedNotificationRequest\x1a3.notification.v1.CommitPreparedNotificationResponse\x12\x85\x01\n" +
+	"\x1aCancelPreparedNotification\x122.notification.v1.CancelPreparedNotificationRequest\x1a3.notification.v1.CancelPreparedNotificationResponseB\xcf\x01\n" +
+	"\x13com.notification.v1B\x11NotificationProtoP\x01ZHgithub.com/dingdong-postman/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_notification_proto_rawDescOnce sync.Once
+	file_notification_v1_notification_proto_rawDescData []byte
+)
+
+func file_notification_v1_notification_proto_rawDescGZIP() []byte {
+	file_notification_v1_notification_proto_rawDescOnce.Do(func() {
+		file_notification_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)))
+	})
+	return file_notification_v1_notification_proto_rawDescData
+}
+
+var file_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_notification_v1_notification_proto_goTypes = []any{
+	(Channel)(0),                                // 0: notification.v1.Channel
+	(SendStatus)(0),                             // 1: notification.v1.SendStatus
+	(*Notification)(nil),                        // 2: notification.v1.Notification
+	(*EmailContent)(nil),                        // 3: notification.v1.EmailContent
+	(*Attachment)(nil),                          // 4: notification.v1.Attachment
+	(*SendNotificationRequest)(nil),             // 5: notification.v1.SendNotificationRequest
+	(*SendNotificationResponse)(nil),            // 6: notification.v1.SendNotificationResponse
+	(*BatchSendNotificationsRequest)(nil),       // 7: notification.v1.BatchSendNotificationsRequest
+	(*BatchSendNotificationsResponse)(nil),      // 8: notification.v1.BatchSendNotificationsResponse
+	(*SendNotificationAsyncRequest)(nil),        // 9: notification.v1.SendNotificationAsyncRequest
+	(*SendNotificationAsyncResponse)(nil),       // 10: notification.v1.SendNotificationAsyncResponse
+	(*BatchSendNotificationsAsyncRequest)(nil),  // 11: notification.v1.BatchSendNotificationsAsyncRequest
+	(*BatchSendNotificationsAsyncResponse)(nil), // 12: notification.v1.BatchSendNotificationsAsyncResponse
+	(*QueryNotificationRequest)(nil),            // 13: notification.v1.QueryNotificationRequest
+	(*NotificationRecord)(nil),                  // 14: notification.v1.NotificationRecord
+	(*QueryNotificationResponse)(nil),           // 15: notification.v1.QueryNotificationResponse
+	(*CancelScheduledNotificationRequest)(nil),  // 16: notification.v1.CancelScheduledNotificationRequest
+	(*CancelScheduledNotificationResponse)(nil), // 17: notification.v1.CancelScheduledNotificationResponse
+	(*PrepareNotificationRequest)(nil),          // 18: notification.v1.PrepareNotificationRequest
+	(*PrepareNotificationResponse)(nil),         // 19: notification.v1.PrepareNotificationResponse
+	(*CommitPreparedNotificationRequest)(nil),   // 20: notification.v1.CommitPreparedNotificationRequest
+	(*CommitPreparedNotificationResponse)(nil),  // 21: notification.v1.CommitPreparedNotificationResponse
+	(*CancelPreparedNotificationRequest)(nil),   // 22: notification.v1.CancelPreparedNotificationRequest
+	(*CancelPreparedNotificationResponse)(nil),  // 23: notification.v1.CancelPreparedNotificationResponse
+	nil,                           // 24: notification.v1.Notification.TemplateParamsEntry
+	nil,                           // 25: notification.v1.EmailContent.HeadersEntry
+	(*timestamppb.Timestamp)(nil), // 26: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 27: google.protobuf.Duration
+}
+var file_notification_v1_notification_proto_depIdxs = []int32{
+	0,  // 0: notification.v1.Notification.channel:type_name -> notification.v1.Channel
+	24, // 1: notification.v1.Notification.template_params:type_name -> notification.v1.Notification.TemplateParamsEntry
+	3,  // 2: notification.v1.Notification.email:type_name -> notification.v1.EmailContent
+	26, // 3: notification.v1.Notification.send_at:type_name -> google.protobuf.Timestamp
+	27, // 4: notification.v1.Notification.delay:type_name -> google.protobuf.Duration
+	4,  // 5: notification.v1.EmailContent.attachments:type_name -> notification.v1.Attachment
+	25, // 6: notification.v1.EmailContent.headers:type_name -> notification.v1.EmailContent.HeadersEntry
+	2,  // 7: notification.v1.SendNotificationRequest.notification:type_name -> notification.v1.Notification
+	1,  // 8: notification.v1.SendNotificationResponse.status:type_name -> notification.v1.SendStatus
+	2,  // 9: notification.v1.BatchSendNotificationsRequest.notifications:type_name -> notification.v1.Notification
+	6,  // 10: notification.v1.BatchSendNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
+	2,  // 11: notification.v1.SendNotificationAsyncRequest.notification:type_name -> notification.v1.Notification
+	1,  // 12: notification.v1.SendNotificationAsyncResponse.status:type_name -> notification.v1.SendStatus
+	2,  // 13: notification.v1.BatchSendNotificationsAsyncRequest.notifications:type_name -> notification.v1.Notification
+	10, // 14: notification.v1.BatchSendNotificationsAsyncResponse.results:type_name -> notification.v1.SendNotificationAsyncResponse
+	2,  // 15: notification.v1.NotificationRecord.notification:type_name -> notification.v1.Notification
+	1,  // 16: notification.v1.NotificationRecord.status:type_name -> notification.v1.SendStatus
+	26, // 17: notification.v1.NotificationRecord.created_at:type_name -> google.protobuf.Timestamp
+	26, // 18: notification.v1.NotificationRecord.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 19: notification.v1.NotificationRecord.scheduled_at:type_name -> google.protobuf.Timestamp
+	14, // 20: notification.v1.QueryNotificationResponse.record:type_name -> notification.v1.NotificationRecord
+	14, // 21: notification.v1.CancelScheduledNotificationResponse.record:type_name -> notification.v1.NotificationRecord
+	2,  // 22: notification.v1.PrepareNotificationRequest.notification:type_name -> notification.v1.Notification
+	1,  // 23: notification.v1.PrepareNotificationResponse.status:type_name -> notification.v1.SendStatus
+	14, // 24: notification.v1.CommitPreparedNotificationResponse.record:type_name -> notification.v1.NotificationRecord
+	14, // 25: notification.v1.CancelPreparedNotificationResponse.record:type_name -> notification.v1.NotificationRecord
+	5,  // 26: notification.v1.NotificationService.SendNotification:input_type -> notification.v1.SendNotificationRequest
+	7,  // 27: notification.v1.NotificationService.BatchSendNotifications:input_type -> notification.v1.BatchSendNotificationsRequest
+	9,  // 28: notification.v1.NotificationService.SendNotificationAsync:input_type -> notification.v1.SendNotificationAsyncRequest
+	11, // 29: notification.v1.NotificationService.BatchSendNotificationsAsync:input_type -> notification.v1.BatchSendNotificationsAsyncRequest
+	13, // 30: notification.v1.NotificationService.QueryNotification:input_type -> notification.v1.QueryNotificationRequest
+	16, // 31: notification.v1.NotificationService.CancelScheduledNotification:input_type -> notification.v1.CancelScheduledNotificationRequest
+	18, // 32: notification.v1.NotificationService.PrepareNotification:input_type -> notification.v1.PrepareNotificationRequest
+	20, // 33: notification.v1.NotificationService.CommitPreparedNotification:input_type -> notification.v1.CommitPreparedNotificationRequest
+	22, // 34: notification.v1.NotificationService.CancelPreparedNotification:input_type -> notification.v1.CancelPreparedNotificationRequest
+	6,  // 35: notification.v1.NotificationService.SendNotification:output_type -> notification.v1.SendNotificationResponse
+	8,  // 36: notification.v1.NotificationService.BatchSendNotifications:output_type -> notification.v1.BatchSendNotificationsResponse
+	10, // 37: notification.v1.NotificationService.SendNotificationAsync:output_type -> notification.v1.SendNotificationAsyncResponse
+	12, // 38: notification.v1.NotificationService.BatchSendNotificationsAsync:output_type -> notification.v1.BatchSendNotificationsAsyncResponse
+	15, // 39: notification.v1.NotificationService.QueryNotification:output_type -> notification.v1.QueryNotificationResponse
+	17, // 40: notification.v1.NotificationService.CancelScheduledNotification:output_type -> notification.v1.CancelScheduledNotificationResponse
+	19, // 41: notification.v1.NotificationService.PrepareNotification:output_type -> notification.v1.PrepareNotificationResponse
+	21, // 42: notification.v1.NotificationService.CommitPreparedNotification:output_type -> notification.v1.CommitPreparedNotificationResponse
+	23, // 43: notification.v1.NotificationService.CancelPreparedNotification:output_type -> notification.v1.CancelPreparedNotificationResponse
+	35, // [35:44] is the sub-list for method output_type
+	26, // [26:35] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_notification_proto_init() }
+func file_notification_v1_notification_proto_init() {
+	if File_notification_v1_notification_proto != nil {
+		return
+	}
+	file_notification_v1_notification_proto_msgTypes[0].OneofWrappers = []any{
+		(*Notification_SendAt)(nil),
+		(*Notification_Delay)(nil),
+	}
+	file_notification_v1_notification_proto_msgTypes[11].OneofWrappers = []any{
+		(*QueryNotificationRequest_NotificationId)(nil),
+		(*QueryNotificationRequest_BizKey)(nil),
+	}
+	file_notification_v1_notification_proto_msgTypes[14].OneofWrappers = []any{
+		(*CancelScheduledNotificationRequest_NotificationId)(nil),
+		(*CancelScheduledNotificationRequest_BizKey)(nil),
+	}
+	file_notification_v1_notification_proto_msgTypes[18].OneofWrappers = []any{
+		(*CommitPreparedNotificationRequest_NotificationId)(nil),
+		(*CommitPreparedNotificationRequest_BizKey)(nil),
+	}
+	file_notification_v1_notification_proto_msgTypes[20].OneofWrappers = []any{
+		(*CancelPreparedNotificationRequest_NotificationId)(nil),
+		(*CancelPreparedNotificationRequest_BizKey)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_notification_proto_goTypes,
+		DependencyIndexes: file_notification_v1_notification_proto_depIdxs,
+		EnumInfos:         file_notification_v1_notification_proto_enumTypes,
+		MessageInfos:      file_notification_v1_notification_proto_msgTypes,
+	}.Build()
+	File_notification_v1_notification_proto = out.File
+	file_notification_v1_notification_proto_goTypes = nil
+	file_notification_v1_notification_proto_depIdxs = nil
+}
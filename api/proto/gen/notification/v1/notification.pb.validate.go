@@ -0,0 +1,3459 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/notification.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Notification with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationMultiError, or
+// nil if none found.
+func (m *Notification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetBizKey()); l < 1 || l > 64 {
+		err := NotificationValidationError{
+			field:  "BizKey",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetRecipient()); l < 1 || l > 256 {
+		err := NotificationValidationError{
+			field:  "Recipient",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Notification_Channel_NotInLookup[m.GetChannel()]; ok {
+		err := NotificationValidationError{
+			field:  "Channel",
+			reason: "value must not be in list [CHANNEL_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Channel_name[int32(m.GetChannel())]; !ok {
+		err := NotificationValidationError{
+			field:  "Channel",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTemplateId()) > 64 {
+		err := NotificationValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TemplateParams
+
+	if all {
+		switch v := interface{}(m.GetEmail()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationValidationError{
+					field:  "Email",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationValidationError{
+					field:  "Email",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmail()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationValidationError{
+				field:  "Email",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := NotificationValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Transactional
+
+	if utf8.RuneCountInString(m.GetCallbackUrl()) > 512 {
+		err := NotificationValidationError{
+			field:  "CallbackUrl",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Notification_CallbackUrl_Pattern.MatchString(m.GetCallbackUrl()) {
+		err := NotificationValidationError{
+			field:  "CallbackUrl",
+			reason: "value does not match regex pattern \"^(https?://.+)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch v := m.Schedule.(type) {
+	case *Notification_SendAt:
+		if v == nil {
+			err := NotificationValidationError{
+				field:  "Schedule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSendAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationValidationError{
+					field:  "SendAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Notification_Delay:
+		if v == nil {
+			err := NotificationValidationError{
+				field:  "Schedule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if d := m.GetDelay(); d != nil {
+			dur, err := d.AsDuration(), d.CheckValid()
+			if err != nil {
+				err = NotificationValidationError{
+					field:  "Delay",
+					reason: "value is not a valid duration",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			} else {
+
+				gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+				if dur < gte {
+					err := NotificationValidationError{
+						field:  "Delay",
+						reason: "value must be greater than or equal to 0s",
+					}
+					if !all {
+						return err
+					}
+					errors = append(errors, err)
+				}
+
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return NotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationMultiError is an error wrapping multiple validation errors
+// returned by Notification.ValidateAll() if the designated constraints aren't met.
+type NotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMultiError) AllErrors() []error { return m }
+
+// NotificationValidationError is the validation error returned by
+// Notification.Validate if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+var _Notification_Channel_NotInLookup = map[Channel]struct{}{
+	0: {},
+}
+
+var _Notification_CallbackUrl_Pattern = regexp.MustCompile("^(https?://.+)?$")
+
+// Validate checks the field values on EmailContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EmailContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmailContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EmailContentMultiError, or
+// nil if none found.
+func (m *EmailContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmailContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSubject()) > 998 {
+		err := EmailContentValidationError{
+			field:  "Subject",
+			reason: "value length must be at most 998 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HtmlBody
+
+	// no validation rules for TextBody
+
+	if len(m.GetAttachments()) > 10 {
+		err := EmailContentValidationError{
+			field:  "Attachments",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EmailContentValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EmailContentValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EmailContentValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			if !_EmailContent_Headers_Pattern.MatchString(key) {
+				err := EmailContentValidationError{
+					field:  fmt.Sprintf("Headers[%v]", key),
+					reason: "value does not match regex pattern \"^[!-9;-~]+$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Headers[key]
+		}
+	}
+
+	if len(errors) > 0 {
+		return EmailContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmailContentMultiError is an error wrapping multiple validation errors
+// returned by EmailContent.ValidateAll() if the designated constraints aren't met.
+type EmailContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmailContentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmailContentMultiError) AllErrors() []error { return m }
+
+// EmailContentValidationError is the validation error returned by
+// EmailContent.Validate if the designated constraints aren't met.
+type EmailContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmailContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmailContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmailContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmailContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmailContentValidationError) ErrorName() string { return "EmailContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EmailContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmailContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmailContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmailContentValidationError{}
+
+var _EmailContent_Headers_Pattern = regexp.MustCompile("^[!-9;-~]+$")
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentMultiError, or
+// nil if none found.
+func (m *Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetFilename()); l < 1 || l > 255 {
+		err := AttachmentValidationError{
+			field:  "Filename",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContentType()) > 128 {
+		err := AttachmentValidationError{
+			field:  "ContentType",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetContent()); l < 1 || l > 3145728 {
+		err := AttachmentValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 3145728 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't met.
+type AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMultiError) AllErrors() []error { return m }
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on SendNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendNotificationRequestMultiError, or nil if none found.
+func (m *SendNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNotification() == nil {
+		err := SendNotificationRequestValidationError{
+			field:  "Notification",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendNotificationRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendNotificationRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendNotificationRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by SendNotificationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendNotificationRequestMultiError) AllErrors() []error { return m }
+
+// SendNotificationRequestValidationError is the validation error returned by
+// SendNotificationRequest.Validate if the designated constraints aren't met.
+type SendNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendNotificationRequestValidationError) ErrorName() string {
+	return "SendNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendNotificationRequestValidationError{}
+
+// Validate checks the field values on SendNotificationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendNotificationResponseMultiError, or nil if none found.
+func (m *SendNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Status
+
+	// no validation rules for ErrorMessage
+
+	// no validation rules for Duplicated
+
+	if len(errors) > 0 {
+		return SendNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendNotificationResponseMultiError is an error wrapping multiple validation
+// errors returned by SendNotificationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SendNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendNotificationResponseMultiError) AllErrors() []error { return m }
+
+// SendNotificationResponseValidationError is the validation error returned by
+// SendNotificationResponse.Validate if the designated constraints aren't met.
+type SendNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendNotificationResponseValidationError) ErrorName() string {
+	return "SendNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendNotificationResponseValidationError{}
+
+// Validate checks the field values on BatchSendNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchSendNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchSendNotificationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchSendNotificationsRequestMultiError, or nil if none found.
+func (m *BatchSendNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchSendNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetNotifications()); l < 1 || l > 100 {
+		err := BatchSendNotificationsRequestValidationError{
+			field:  "Notifications",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := BatchSendNotificationsRequestValidationError{
+				field:  fmt.Sprintf("Notifications[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchSendNotificationsRequestValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchSendNotificationsRequestValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchSendNotificationsRequestValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchSendNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchSendNotificationsRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchSendNotificationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BatchSendNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchSendNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchSendNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// BatchSendNotificationsRequestValidationError is the validation error
+// returned by BatchSendNotificationsRequest.Validate if the designated
+// constraints aren't met.
+type BatchSendNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchSendNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchSendNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchSendNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchSendNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchSendNotificationsRequestValidationError) ErrorName() string {
+	return "BatchSendNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchSendNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchSendNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchSendNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchSendNotificationsRequestValidationError{}
+
+// Validate checks the field values on BatchSendNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchSendNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchSendNotificationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchSendNotificationsResponseMultiError, or nil if none found.
+func (m *BatchSendNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchSendNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchSendNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchSendNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchSendNotificationsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchSendNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchSendNotificationsResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchSendNotificationsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type BatchSendNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchSendNotificationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchSendNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// BatchSendNotificationsResponseValidationError is the validation error
+// returned by BatchSendNotificationsResponse.Validate if the designated
+// constraints aren't met.
+type BatchSendNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchSendNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchSendNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchSendNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchSendNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchSendNotificationsResponseValidationError) ErrorName() string {
+	return "BatchSendNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchSendNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchSendNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchSendNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchSendNotificationsResponseValidationError{}
+
+// Validate checks the field values on SendNotificationAsyncRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendNotificationAsyncRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendNotificationAsyncRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendNotificationAsyncRequestMultiError, or nil if none found.
+func (m *SendNotificationAsyncRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendNotificationAsyncRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNotification() == nil {
+		err := SendNotificationAsyncRequestValidationError{
+			field:  "Notification",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendNotificationAsyncRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendNotificationAsyncRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendNotificationAsyncRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendNotificationAsyncRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendNotificationAsyncRequestMultiError is an error wrapping multiple
+// validation errors returned by SendNotificationAsyncRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SendNotificationAsyncRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendNotificationAsyncRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendNotificationAsyncRequestMultiError) AllErrors() []error { return m }
+
+// SendNotificationAsyncRequestValidationError is the validation error returned
+// by SendNotificationAsyncRequest.Validate if the designated constraints
+// aren't met.
+type SendNotificationAsyncRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendNotificationAsyncRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendNotificationAsyncRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendNotificationAsyncRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendNotificationAsyncRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendNotificationAsyncRequestValidationError) ErrorName() string {
+	return "SendNotificationAsyncRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendNotificationAsyncRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendNotificationAsyncRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendNotificationAsyncRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendNotificationAsyncRequestValidationError{}
+
+// Validate checks the field values on SendNotificationAsyncResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendNotificationAsyncResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendNotificationAsyncResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendNotificationAsyncResponseMultiError, or nil if none found.
+func (m *SendNotificationAsyncResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendNotificationAsyncResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Status
+
+	// no validation rules for ErrorMessage
+
+	// no validation rules for Duplicated
+
+	if len(errors) > 0 {
+		return SendNotificationAsyncResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendNotificationAsyncResponseMultiError is an error wrapping multiple
+// validation errors returned by SendNotificationAsyncResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SendNotificationAsyncResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendNotificationAsyncResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendNotificationAsyncResponseMultiError) AllErrors() []error { return m }
+
+// SendNotificationAsyncResponseValidationError is the validation error
+// returned by SendNotificationAsyncResponse.Validate if the designated
+// constraints aren't met.
+type SendNotificationAsyncResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendNotificationAsyncResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendNotificationAsyncResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendNotificationAsyncResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendNotificationAsyncResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendNotificationAsyncResponseValidationError) ErrorName() string {
+	return "SendNotificationAsyncResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendNotificationAsyncResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendNotificationAsyncResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendNotificationAsyncResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendNotificationAsyncResponseValidationError{}
+
+// Validate checks the field values on BatchSendNotificationsAsyncRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BatchSendNotificationsAsyncRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchSendNotificationsAsyncRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// BatchSendNotificationsAsyncRequestMultiError, or nil if none found.
+func (m *BatchSendNotificationsAsyncRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchSendNotificationsAsyncRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetNotifications()); l < 1 || l > 100 {
+		err := BatchSendNotificationsAsyncRequestValidationError{
+			field:  "Notifications",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := BatchSendNotificationsAsyncRequestValidationError{
+				field:  fmt.Sprintf("Notifications[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchSendNotificationsAsyncRequestValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchSendNotificationsAsyncRequestValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchSendNotificationsAsyncRequestValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchSendNotificationsAsyncRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchSendNotificationsAsyncRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchSendNotificationsAsyncRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchSendNotificationsAsyncRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchSendNotificationsAsyncRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchSendNotificationsAsyncRequestMultiError) AllErrors() []error { return m }
+
+// BatchSendNotificationsAsyncRequestValidationError is the validation error
+// returned by BatchSendNotificationsAsyncRequest.Validate if the designated
+// constraints aren't met.
+type BatchSendNotificationsAsyncRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchSendNotificationsAsyncRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchSendNotificationsAsyncRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchSendNotificationsAsyncRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchSendNotificationsAsyncRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchSendNotificationsAsyncRequestValidationError) ErrorName() string {
+	return "BatchSendNotificationsAsyncRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchSendNotificationsAsyncRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchSendNotificationsAsyncRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchSendNotificationsAsyncRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchSendNotificationsAsyncRequestValidationError{}
+
+// Validate checks the field values on BatchSendNotificationsAsyncResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BatchSendNotificationsAsyncResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchSendNotificationsAsyncResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// BatchSendNotificationsAsyncResponseMultiError, or nil if none found.
+func (m *BatchSendNotificationsAsyncResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchSendNotificationsAsyncResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchSendNotificationsAsyncResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchSendNotificationsAsyncResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchSendNotificationsAsyncResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchSendNotificationsAsyncResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchSendNotificationsAsyncResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchSendNotificationsAsyncResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchSendNotificationsAsyncResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchSendNotificationsAsyncResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchSendNotificationsAsyncResponseMultiError) AllErrors() []error { return m }
+
+// BatchSendNotificationsAsyncResponseValidationError is the validation error
+// returned by BatchSendNotificationsAsyncResponse.Validate if the designated
+// constraints aren't met.
+type BatchSendNotificationsAsyncResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchSendNotificationsAsyncResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchSendNotificationsAsyncResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchSendNotificationsAsyncResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchSendNotificationsAsyncResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchSendNotificationsAsyncResponseValidationError) ErrorName() string {
+	return "BatchSendNotificationsAsyncResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchSendNotificationsAsyncResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchSendNotificationsAsyncResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchSendNotificationsAsyncResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchSendNotificationsAsyncResponseValidationError{}
+
+// Validate checks the field values on QueryNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryNotificationRequestMultiError, or nil if none found.
+func (m *QueryNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofKeyPresent := false
+	switch v := m.Key.(type) {
+	case *QueryNotificationRequest_NotificationId:
+		if v == nil {
+			err := QueryNotificationRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if m.GetNotificationId() <= 0 {
+			err := QueryNotificationRequestValidationError{
+				field:  "NotificationId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *QueryNotificationRequest_BizKey:
+		if v == nil {
+			err := QueryNotificationRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if l := utf8.RuneCountInString(m.GetBizKey()); l < 1 || l > 64 {
+			err := QueryNotificationRequestValidationError{
+				field:  "BizKey",
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofKeyPresent {
+		err := QueryNotificationRequestValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return QueryNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryNotificationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryNotificationRequestMultiError) AllErrors() []error { return m }
+
+// QueryNotificationRequestValidationError is the validation error returned by
+// QueryNotificationRequest.Validate if the designated constraints aren't met.
+type QueryNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryNotificationRequestValidationError) ErrorName() string {
+	return "QueryNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryNotificationRequestValidationError{}
+
+// Validate checks the field values on NotificationRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationRecordMultiError, or nil if none found.
+func (m *NotificationRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationRecordValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationRecordValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationRecordValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for ErrorMessage
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationRecordValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationRecordValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationRecordValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationRecordValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationRecordValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationRecordValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Provider
+
+	// no validation rules for ProviderMessageId
+
+	// no validation rules for TemplateLocale
+
+	if all {
+		switch v := interface{}(m.GetScheduledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationRecordValidationError{
+					field:  "ScheduledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationRecordValidationError{
+					field:  "ScheduledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationRecordValidationError{
+				field:  "ScheduledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NotificationRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationRecordMultiError is an error wrapping multiple validation errors
+// returned by NotificationRecord.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationRecordMultiError) AllErrors() []error { return m }
+
+// NotificationRecordValidationError is the validation error returned by
+// NotificationRecord.Validate if the designated constraints aren't met.
+type NotificationRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationRecordValidationError) ErrorName() string {
+	return "NotificationRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationRecordValidationError{}
+
+// Validate checks the field values on QueryNotificationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryNotificationResponseMultiError, or nil if none found.
+func (m *QueryNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryNotificationResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryNotificationResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryNotificationResponseValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return QueryNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryNotificationResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryNotificationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type QueryNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryNotificationResponseMultiError) AllErrors() []error { return m }
+
+// QueryNotificationResponseValidationError is the validation error returned by
+// QueryNotificationResponse.Validate if the designated constraints aren't met.
+type QueryNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryNotificationResponseValidationError) ErrorName() string {
+	return "QueryNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryNotificationResponseValidationError{}
+
+// Validate checks the field values on CancelScheduledNotificationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CancelScheduledNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledNotificationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CancelScheduledNotificationRequestMultiError, or nil if none found.
+func (m *CancelScheduledNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofKeyPresent := false
+	switch v := m.Key.(type) {
+	case *CancelScheduledNotificationRequest_NotificationId:
+		if v == nil {
+			err := CancelScheduledNotificationRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if m.GetNotificationId() <= 0 {
+			err := CancelScheduledNotificationRequestValidationError{
+				field:  "NotificationId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *CancelScheduledNotificationRequest_BizKey:
+		if v == nil {
+			err := CancelScheduledNotificationRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if l := utf8.RuneCountInString(m.GetBizKey()); l < 1 || l > 64 {
+			err := CancelScheduledNotificationRequestValidationError{
+				field:  "BizKey",
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofKeyPresent {
+		err := CancelScheduledNotificationRequestValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelScheduledNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelScheduledNotificationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CancelScheduledNotificationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelScheduledNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledNotificationRequestMultiError) AllErrors() []error { return m }
+
+// CancelScheduledNotificationRequestValidationError is the validation error
+// returned by CancelScheduledNotificationRequest.Validate if the designated
+// constraints aren't met.
+type CancelScheduledNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledNotificationRequestValidationError) ErrorName() string {
+	return "CancelScheduledNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledNotificationRequestValidationError{}
+
+// Validate checks the field values on CancelScheduledNotificationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CancelScheduledNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledNotificationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CancelScheduledNotificationResponseMultiError, or nil if none found.
+func (m *CancelScheduledNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelScheduledNotificationResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelScheduledNotificationResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelScheduledNotificationResponseValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelScheduledNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelScheduledNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CancelScheduledNotificationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelScheduledNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledNotificationResponseMultiError) AllErrors() []error { return m }
+
+// CancelScheduledNotificationResponseValidationError is the validation error
+// returned by CancelScheduledNotificationResponse.Validate if the designated
+// constraints aren't met.
+type CancelScheduledNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledNotificationResponseValidationError) ErrorName() string {
+	return "CancelScheduledNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledNotificationResponseValidationError{}
+
+// Validate checks the field values on PrepareNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrepareNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrepareNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrepareNotificationRequestMultiError, or nil if none found.
+func (m *PrepareNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrepareNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNotification() == nil {
+		err := PrepareNotificationRequestValidationError{
+			field:  "Notification",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrepareNotificationRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrepareNotificationRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrepareNotificationRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PrepareNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrepareNotificationRequestMultiError is an error wrapping multiple
+// validation errors returned by PrepareNotificationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PrepareNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrepareNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrepareNotificationRequestMultiError) AllErrors() []error { return m }
+
+// PrepareNotificationRequestValidationError is the validation error returned
+// by PrepareNotificationRequest.Validate if the designated constraints aren't met.
+type PrepareNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrepareNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrepareNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrepareNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrepareNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrepareNotificationRequestValidationError) ErrorName() string {
+	return "PrepareNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrepareNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrepareNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrepareNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrepareNotificationRequestValidationError{}
+
+// Validate checks the field values on PrepareNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrepareNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrepareNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrepareNotificationResponseMultiError, or nil if none found.
+func (m *PrepareNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrepareNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Status
+
+	// no validation rules for Duplicated
+
+	if len(errors) > 0 {
+		return PrepareNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrepareNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by PrepareNotificationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PrepareNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrepareNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrepareNotificationResponseMultiError) AllErrors() []error { return m }
+
+// PrepareNotificationResponseValidationError is the validation error returned
+// by PrepareNotificationResponse.Validate if the designated constraints
+// aren't met.
+type PrepareNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrepareNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrepareNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrepareNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrepareNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrepareNotificationResponseValidationError) ErrorName() string {
+	return "PrepareNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrepareNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrepareNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrepareNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrepareNotificationResponseValidationError{}
+
+// Validate checks the field values on CommitPreparedNotificationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CommitPreparedNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitPreparedNotificationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CommitPreparedNotificationRequestMultiError, or nil if none found.
+func (m *CommitPreparedNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitPreparedNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofKeyPresent := false
+	switch v := m.Key.(type) {
+	case *CommitPreparedNotificationRequest_NotificationId:
+		if v == nil {
+			err := CommitPreparedNotificationRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if m.GetNotificationId() <= 0 {
+			err := CommitPreparedNotificationRequestValidationError{
+				field:  "NotificationId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *CommitPreparedNotificationRequest_BizKey:
+		if v == nil {
+			err := CommitPreparedNotificationRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if l := utf8.RuneCountInString(m.GetBizKey()); l < 1 || l > 64 {
+			err := CommitPreparedNotificationRequestValidationError{
+				field:  "BizKey",
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofKeyPresent {
+		err := CommitPreparedNotificationRequestValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommitPreparedNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitPreparedNotificationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CommitPreparedNotificationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommitPreparedNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitPreparedNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitPreparedNotificationRequestMultiError) AllErrors() []error { return m }
+
+// CommitPreparedNotificationRequestValidationError is the validation error
+// returned by CommitPreparedNotificationRequest.Validate if the designated
+// constraints aren't met.
+type CommitPreparedNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitPreparedNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitPreparedNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitPreparedNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitPreparedNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitPreparedNotificationRequestValidationError) ErrorName() string {
+	return "CommitPreparedNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitPreparedNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitPreparedNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitPreparedNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitPreparedNotificationRequestValidationError{}
+
+// Validate checks the field values on CommitPreparedNotificationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CommitPreparedNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitPreparedNotificationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CommitPreparedNotificationResponseMultiError, or nil if none found.
+func (m *CommitPreparedNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitPreparedNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitPreparedNotificationResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitPreparedNotificationResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitPreparedNotificationResponseValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommitPreparedNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitPreparedNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CommitPreparedNotificationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CommitPreparedNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitPreparedNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitPreparedNotificationResponseMultiError) AllErrors() []error { return m }
+
+// CommitPreparedNotificationResponseValidationError is the validation error
+// returned by CommitPreparedNotificationResponse.Validate if the designated
+// constraints aren't met.
+type CommitPreparedNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitPreparedNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitPreparedNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitPreparedNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitPreparedNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitPreparedNotificationResponseValidationError) ErrorName() string {
+	return "CommitPreparedNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitPreparedNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitPreparedNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitPreparedNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitPreparedNotificationResponseValidationError{}
+
+// Validate checks the field values on CancelPreparedNotificationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CancelPreparedNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelPreparedNotificationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CancelPreparedNotificationRequestMultiError, or nil if none found.
+func (m *CancelPreparedNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelPreparedNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofKeyPresent := false
+	switch v := m.Key.(type) {
+	case *CancelPreparedNotificationRequest_NotificationId:
+		if v == nil {
+			err := CancelPreparedNotificationRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if m.GetNotificationId() <= 0 {
+			err := CancelPreparedNotificationRequestValidationError{
+				field:  "NotificationId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *CancelPreparedNotificationRequest_BizKey:
+		if v == nil {
+			err := CancelPreparedNotificationRequestValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if l := utf8.RuneCountInString(m.GetBizKey()); l < 1 || l > 64 {
+			err := CancelPreparedNotificationRequestValidationError{
+				field:  "BizKey",
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofKeyPresent {
+		err := CancelPreparedNotificationRequestValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelPreparedNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelPreparedNotificationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CancelPreparedNotificationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelPreparedNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelPreparedNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelPreparedNotificationRequestMultiError) AllErrors() []error { return m }
+
+// CancelPreparedNotificationRequestValidationError is the validation error
+// returned by CancelPreparedNotificationRequest.Validate if the designated
+// constraints aren't met.
+type CancelPreparedNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelPreparedNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelPreparedNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelPreparedNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelPreparedNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelPreparedNotificationRequestValidationError) ErrorName() string {
+	return "CancelPreparedNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelPreparedNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelPreparedNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelPreparedNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelPreparedNotificationRequestValidationError{}
+
+// Validate checks the field values on CancelPreparedNotificationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CancelPreparedNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelPreparedNotificationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CancelPreparedNotificationResponseMultiError, or nil if none found.
+func (m *CancelPreparedNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelPreparedNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelPreparedNotificationResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelPreparedNotificationResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelPreparedNotificationResponseValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelPreparedNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelPreparedNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CancelPreparedNotificationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelPreparedNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelPreparedNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelPreparedNotificationResponseMultiError) AllErrors() []error { return m }
+
+// CancelPreparedNotificationResponseValidationError is the validation error
+// returned by CancelPreparedNotificationResponse.Validate if the designated
+// constraints aren't met.
+type CancelPreparedNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelPreparedNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelPreparedNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelPreparedNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelPreparedNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelPreparedNotificationResponseValidationError) ErrorName() string {
+	return "CancelPreparedNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelPreparedNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelPreparedNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelPreparedNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelPreparedNotificationResponseValidationError{}
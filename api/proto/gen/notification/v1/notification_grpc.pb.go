@@ -0,0 +1,456 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: notification/v1/notification.proto
+
+package notificationv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NotificationService_SendNotification_FullMethodName            = "/notification.v1.NotificationService/SendNotification"
+	NotificationService_BatchSendNotifications_FullMethodName      = "/notification.v1.NotificationService/BatchSendNotifications"
+	NotificationService_SendNotificationAsync_FullMethodName       = "/notification.v1.NotificationService/SendNotificationAsync"
+	NotificationService_BatchSendNotificationsAsync_FullMethodName = "/notification.v1.NotificationService/BatchSendNotificationsAsync"
+	NotificationService_QueryNotification_FullMethodName           = "/notification.v1.NotificationService/QueryNotification"
+	NotificationService_CancelScheduledNotification_FullMethodName = "/notification.v1.NotificationService/CancelScheduledNotification"
+	NotificationService_PrepareNotification_FullMethodName         = "/notification.v1.NotificationService/PrepareNotification"
+	NotificationService_CommitPreparedNotification_FullMethodName  = "/notification.v1.NotificationService/CommitPreparedNotification"
+	NotificationService_CancelPreparedNotification_FullMethodName  = "/notification.v1.NotificationService/CancelPreparedNotification"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NotificationService 通知服务
+// 受理通知时被拒绝返回 RESOURCE_EXHAUSTED，错误详情中的 google.rpc.ErrorInfo（domain 为 dingdong-postman）区分原因：
+//   - RATE_LIMITED：触发限流规则，metadata 的 rule 为命中的规则；附带 google.rpc.RetryInfo 时按其建议的时间等待后重试
+//   - QUOTA_EXCEEDED：租户的日或月发送配额已用完，下一个配额周期或调整配额前重试不会成功
+type NotificationServiceClient interface {
+	// SendNotification 同步发送单条通知
+	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+	// BatchSendNotifications 同步批量发送通知
+	BatchSendNotifications(ctx context.Context, in *BatchSendNotificationsRequest, opts ...grpc.CallOption) (*BatchSendNotificationsResponse, error)
+	// SendNotificationAsync 异步发送单条通知，落库后立即返回通知 ID，由后台工作池投递
+	SendNotificationAsync(ctx context.Context, in *SendNotificationAsyncRequest, opts ...grpc.CallOption) (*SendNotificationAsyncResponse, error)
+	// BatchSendNotificationsAsync 异步批量发送通知
+	BatchSendNotificationsAsync(ctx context.Context, in *BatchSendNotificationsAsyncRequest, opts ...grpc.CallOption) (*BatchSendNotificationsAsyncResponse, error)
+	// QueryNotification 查询通知发送记录
+	QueryNotification(ctx context.Context, in *QueryNotificationRequest, opts ...grpc.CallOption) (*QueryNotificationResponse, error)
+	// CancelScheduledNotification 取消尚未发送的定时通知；通知已开始发送或已取消时返回 FAILED_PRECONDITION
+	CancelScheduledNotification(ctx context.Context, in *CancelScheduledNotificationRequest, opts ...grpc.CallOption) (*CancelScheduledNotificationResponse, error)
+	// PrepareNotification 预提交两阶段通知：校验并保存通知，但在提交前不会发送
+	// 调用方在本地事务前预提交，事务提交后调用 CommitPreparedNotification，回滚后调用 CancelPreparedNotification；
+	// 超时仍未提交或取消的通知由平台通过 TransactionCheckService 回查调用方的事务状态
+	PrepareNotification(ctx context.Context, in *PrepareNotificationRequest, opts ...grpc.CallOption) (*PrepareNotificationResponse, error)
+	// CommitPreparedNotification 提交两阶段通知，通知开始发送；重复提交返回当前状态，已取消时返回 FAILED_PRECONDITION
+	CommitPreparedNotification(ctx context.Context, in *CommitPreparedNotificationRequest, opts ...grpc.CallOption) (*CommitPreparedNotificationResponse, error)
+	// CancelPreparedNotification 取消两阶段通知；重复取消返回当前状态，已提交时返回 FAILED_PRECONDITION
+	CancelPreparedNotification(ctx context.Context, in *CancelPreparedNotificationRequest, opts ...grpc.CallOption) (*CancelPreparedNotificationResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SendNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) BatchSendNotifications(ctx context.Context, in *BatchSendNotificationsRequest, opts ...grpc.CallOption) (*BatchSendNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchSendNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_BatchSendNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendNotificationAsync(ctx context.Context, in *SendNotificationAsyncRequest, opts ...grpc.CallOption) (*SendNotificationAsyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendNotificationAsyncResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SendNotificationAsync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) BatchSendNotificationsAsync(ctx context.Context, in *BatchSendNotificationsAsyncRequest, opts ...grpc.CallOption) (*BatchSendNotificationsAsyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchSendNotificationsAsyncResponse)
+	err := c.cc.Invoke(ctx, NotificationService_BatchSendNotificationsAsync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) QueryNotification(ctx context.Context, in *QueryNotificationRequest, opts ...grpc.CallOption) (*QueryNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_QueryNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CancelScheduledNotification(ctx context.Context, in *CancelScheduledNotificationRequest, opts ...grpc.CallOption) (*CancelScheduledNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CancelScheduledNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) PrepareNotification(ctx context.Context, in *PrepareNotificationRequest, opts ...grpc.CallOption) (*PrepareNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_PrepareNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CommitPreparedNotification(ctx context.Context, in *CommitPreparedNotificationRequest, opts ...grpc.CallOption) (*CommitPreparedNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitPreparedNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CommitPreparedNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CancelPreparedNotification(ctx context.Context, in *CancelPreparedNotificationRequest, opts ...grpc.CallOption) (*CancelPreparedNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPreparedNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CancelPreparedNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations should embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+//
+// NotificationService 通知服务
+// 受理通知时被拒绝返回 RESOURCE_EXHAUSTED，错误详情中的 google.rpc.ErrorInfo（domain 为 dingdong-postman）区分原因：
+//   - RATE_LIMITED：触发限流规则，metadata 的 rule 为命中的规则；附带 google.rpc.RetryInfo 时按其建议的时间等待后重试
+//   - QUOTA_EXCEEDED：租户的日或月发送配额已用完，下一个配额周期或调整配额前重试不会成功
+type NotificationServiceServer interface {
+	// SendNotification 同步发送单条通知
+	SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error)
+	// BatchSendNotifications 同步批量发送通知
+	BatchSendNotifications(context.Context, *BatchSendNotificationsRequest) (*BatchSendNotificationsResponse, error)
+	// SendNotificationAsync 异步发送单条通知，落库后立即返回通知 ID，由后台工作池投递
+	SendNotificationAsync(context.Context, *SendNotificationAsyncRequest) (*SendNotificationAsyncResponse, error)
+	// BatchSendNotificationsAsync 异步批量发送通知
+	BatchSendNotificationsAsync(context.Context, *BatchSendNotificationsAsyncRequest) (*BatchSendNotificationsAsyncResponse, error)
+	// QueryNotification 查询通知发送记录
+	QueryNotification(context.Context, *QueryNotificationRequest) (*QueryNotificationResponse, error)
+	// CancelScheduledNotification 取消尚未发送的定时通知；通知已开始发送或已取消时返回 FAILED_PRECONDITION
+	CancelScheduledNotification(context.Context, *CancelScheduledNotificationRequest) (*CancelScheduledNotificationResponse, error)
+	// PrepareNotification 预提交两阶段通知：校验并保存通知，但在提交前不会发送
+	// 调用方在本地事务前预提交，事务提交后调用 CommitPreparedNotification，回滚后调用 CancelPreparedNotification；
+	// 超时仍未提交或取消的通知由平台通过 TransactionCheckService 回查调用方的事务状态
+	PrepareNotification(context.Context, *PrepareNotificationRequest) (*PrepareNotificationResponse, error)
+	// CommitPreparedNotification 提交两阶段通知，通知开始发送；重复提交返回当前状态，已取消时返回 FAILED_PRECONDITION
+	CommitPreparedNotification(context.Context, *CommitPreparedNotificationRequest) (*CommitPreparedNotificationResponse, error)
+	// CancelPreparedNotification 取消两阶段通知；重复取消返回当前状态，已提交时返回 FAILED_PRECONDITION
+	CancelPreparedNotification(context.Context, *CancelPreparedNotificationRequest) (*CancelPreparedNotificationResponse, error)
+}
+
+// UnimplementedNotificationServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) BatchSendNotifications(context.Context, *BatchSendNotificationsRequest) (*BatchSendNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSendNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendNotificationAsync(context.Context, *SendNotificationAsyncRequest) (*SendNotificationAsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotificationAsync not implemented")
+}
+func (UnimplementedNotificationServiceServer) BatchSendNotificationsAsync(context.Context, *BatchSendNotificationsAsyncRequest) (*BatchSendNotificationsAsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSendNotificationsAsync not implemented")
+}
+func (UnimplementedNotificationServiceServer) QueryNotification(context.Context, *QueryNotificationRequest) (*QueryNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) CancelScheduledNotification(context.Context, *CancelScheduledNotificationRequest) (*CancelScheduledNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) PrepareNotification(context.Context, *PrepareNotificationRequest) (*PrepareNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) CommitPreparedNotification(context.Context, *CommitPreparedNotificationRequest) (*CommitPreparedNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitPreparedNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) CancelPreparedNotification(context.Context, *CancelPreparedNotificationRequest) (*CancelPreparedNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPreparedNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_SendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendNotification(ctx, req.(*SendNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_BatchSendNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSendNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).BatchSendNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_BatchSendNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).BatchSendNotifications(ctx, req.(*BatchSendNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendNotificationAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationAsyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendNotificationAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendNotificationAsync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendNotificationAsync(ctx, req.(*SendNotificationAsyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_BatchSendNotificationsAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSendNotificationsAsyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).BatchSendNotificationsAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_BatchSendNotificationsAsync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).BatchSendNotificationsAsync(ctx, req.(*BatchSendNotificationsAsyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_QueryNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).QueryNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_QueryNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).QueryNotification(ctx, req.(*QueryNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CancelScheduledNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CancelScheduledNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CancelScheduledNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CancelScheduledNotification(ctx, req.(*CancelScheduledNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_PrepareNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).PrepareNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_PrepareNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).PrepareNotification(ctx, req.(*PrepareNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CommitPreparedNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitPreparedNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CommitPreparedNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CommitPreparedNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CommitPreparedNotification(ctx, req.(*CommitPreparedNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CancelPreparedNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPreparedNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CancelPreparedNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CancelPreparedNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CancelPreparedNotification(ctx, req.(*CancelPreparedNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification.v1.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendNotification",
+			Handler:    _NotificationService_SendNotification_Handler,
+		},
+		{
+			MethodName: "BatchSendNotifications",
+			Handler:    _NotificationService_BatchSendNotifications_Handler,
+		},
+		{
+			MethodName: "SendNotificationAsync",
+			Handler:    _NotificationService_SendNotificationAsync_Handler,
+		},
+		{
+			MethodName: "BatchSendNotificationsAsync",
+			Handler:    _NotificationService_BatchSendNotificationsAsync_Handler,
+		},
+		{
+			MethodName: "QueryNotification",
+			Handler:    _NotificationService_QueryNotification_Handler,
+		},
+		{
+			MethodName: "CancelScheduledNotification",
+			Handler:    _NotificationService_CancelScheduledNotification_Handler,
+		},
+		{
+			MethodName: "PrepareNotification",
+			Handler:    _NotificationService_PrepareNotification_Handler,
+		},
+		{
+			MethodName: "CommitPreparedNotification",
+			Handler:    _NotificationService_CommitPreparedNotification_Handler,
+		},
+		{
+			MethodName: "CancelPreparedNotification",
+			Handler:    _NotificationService_CancelPreparedNotification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notification/v1/notification.proto",
+}
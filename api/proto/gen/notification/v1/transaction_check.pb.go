@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: notification/v1/transaction_check.proto
+
+package notificationv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransactionState 调用方本地事务的状态
+type TransactionState int32
+
+const (
+	// 事务尚未结束或状态未知，平台稍后再次回查
+	TransactionState_TRANSACTION_STATE_UNSPECIFIED TransactionState = 0
+	// 事务已提交，平台提交并发送通知
+	TransactionState_TRANSACTION_STATE_COMMITTED TransactionState = 1
+	// 事务已回滚，平台取消通知
+	TransactionState_TRANSACTION_STATE_ROLLED_BACK TransactionState = 2
+)
+
+// Enum value maps for TransactionState.
+var (
+	TransactionState_name = map[int32]string{
+		0: "TRANSACTION_STATE_UNSPECIFIED",
+		1: "TRANSACTION_STATE_COMMITTED",
+		2: "TRANSACTION_STATE_ROLLED_BACK",
+	}
+	TransactionState_value = map[string]int32{
+		"TRANSACTION_STATE_UNSPECIFIED": 0,
+		"TRANSACTION_STATE_COMMITTED":   1,
+		"TRANSACTION_STATE_ROLLED_BACK": 2,
+	}
+)
+
+func (x TransactionState) Enum() *TransactionState {
+	p := new(TransactionState)
+	*p = x
+	return p
+}
+
+func (x TransactionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_transaction_check_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionState) Type() protoreflect.EnumType {
+	return &file_notification_v1_transaction_check_proto_enumTypes[0]
+}
+
+func (x TransactionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionState.Descriptor instead.
+func (TransactionState) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_transaction_check_proto_rawDescGZIP(), []int{0}
+}
+
+// CheckTransactionRequest 回查两阶段通知所属事务状态的请求
+type CheckTransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 平台生成的通知 ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 所属租户，未启用租户鉴权时为空
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 预提交时的业务键，调用方通常据此找到本地事务
+	BizKey string `protobuf:"bytes,3,opt,name=biz_key,json=bizKey,proto3" json:"biz_key,omitempty"`
+	// 第几次回查，从 1 开始
+	CheckCount int32 `protobuf:"varint,4,opt,name=check_count,json=checkCount,proto3" json:"check_count,omitempty"`
+	// 预提交时间
+	PreparedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=prepared_at,json=preparedAt,proto3" json:"prepared_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckTransactionRequest) Reset() {
+	*x = CheckTransactionRequest{}
+	mi := &file_notification_v1_transaction_check_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTransactionRequest) ProtoMessage() {}
+
+func (x *CheckTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_transaction_check_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CheckTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_transaction_check_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CheckTransactionRequest) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *CheckTransactionRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CheckTransactionRequest) GetBizKey() string {
+	if x != nil {
+		return x.BizKey
+	}
+	return ""
+}
+
+func (x *CheckTransactionRequest) GetCheckCount() int32 {
+	if x != nil {
+		return x.CheckCount
+	}
+	return 0
+}
+
+func (x *CheckTransactionRequest) GetPreparedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreparedAt
+	}
+	return nil
+}
+
+// CheckTransactionResponse 回查两阶段通知所属事务状态的响应
+type CheckTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         TransactionState       `protobuf:"varint,1,opt,name=state,proto3,enum=notification.v1.TransactionState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckTransactionResponse) Reset() {
+	*x = CheckTransactionResponse{}
+	mi := &file_notification_v1_transaction_check_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTransactionResponse) ProtoMessage() {}
+
+func (x *CheckTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_transaction_check_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CheckTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_transaction_check_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CheckTransactionResponse) GetState() TransactionState {
+	if x != nil {
+		return x.State
+	}
+	return TransactionState_TRANSACTION_STATE_UNSPECIFIED
+}
+
+var File_notification_v1_transaction_check_proto protoreflect.FileDescriptor
+
+const file_notification_v1_transaction_check_proto_rawDesc = "" +
+	"\n" +
+	"'notification/v1/transaction_check.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x01\n" +
+	"\x17CheckTransactionRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x17\n" +
+	"\abiz_key\x18\x03 \x01(\tR\x06bizKey\x12\x1f\n" +
+	"\vcheck_count\x18\x04 \x01(\x05R\n" +
+	"checkCount\x12;\n" +
+	"\vprepared_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"preparedAt\"S\n" +
+	"\x18CheckTransactionResponse\x127\n" +
+	"\x05state\x18\x01 \x01(\x0e2!.notification.v1.TransactionStateR\x05state*y\n" +
+	"\x10TransactionState\x12!\n" +
+	"\x1dTRANSACTION_STATE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTRANSACTION_STATE_COMMITTED\x10\x01\x12!\n" +
+	"\x1dTRANSACTION_STATE_ROLLED_BACK\x10\x022\x82\x01\n" +
+	"\x17TransactionCheckService\x12g\n" +
+	"\x10CheckTransaction\x12(.notification.v1.CheckTransactionRequest\x1a).notification.v1.CheckTransactionResponseB\xd3\x01\n" +
+	"\x13com.notification.v1B\x15TransactionCheckProtoP\x01ZHgithub.com/dingdong-postman/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_transaction_check_proto_rawDescOnce sync.Once
+	file_notification_v1_transaction_check_proto_rawDescData []byte
+)
+
+func file_notification_v1_transaction_check_proto_rawDescGZIP() []byte {
+	file_notification_v1_transaction_check_proto_rawDescOnce.Do(func() {
+		file_notification_v1_transaction_check_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_transaction_check_proto_rawDesc), len(file_notification_v1_transaction_check_proto_rawDesc)))
+	})
+	return file_notification_v1_transaction_check_proto_rawDescData
+}
+
+var file_notification_v1_transaction_check_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_v1_transaction_check_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_notification_v1_transaction_check_proto_goTypes = []any{
+	(TransactionState)(0),            // 0: notification.v1.TransactionState
+	(*CheckTransactionRequest)(nil),  // 1: notification.v1.CheckTransactionRequest
+	(*CheckTransactionResponse)(nil), // 2: notification.v1.CheckTransactionResponse
+	(*timestamppb.Timestamp)(nil),    // 3: google.protobuf.Timestamp
+}
+var file_notification_v1_transaction_check_proto_depIdxs = []int32{
+	3, // 0: notification.v1.CheckTransactionRequest.prepared_at:type_name -> google.protobuf.Timestamp
+	0, // 1: notification.v1.CheckTransactionResponse.state:type_name -> notification.v1.TransactionState
+	1, // 2: notification.v1.TransactionCheckService.CheckTransaction:input_type -> notification.v1.CheckTransactionRequest
+	2, // 3: notification.v1.TransactionCheckService.CheckTransaction:output_type -> notification.v1.CheckTransactionResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_transaction_check_proto_init() }
+func file_notification_v1_transaction_check_proto_init() {
+	if File_notification_v1_transaction_check_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_transaction_check_proto_rawDesc), len(file_notification_v1_transaction_check_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_transaction_check_proto_goTypes,
+		DependencyIndexes: file_notification_v1_transaction_check_proto_depIdxs,
+		EnumInfos:         file_notification_v1_transaction_check_proto_enumTypes,
+		MessageInfos:      file_notification_v1_transaction_check_proto_msgTypes,
+	}.Build()
+	File_notification_v1_transaction_check_proto = out.File
+	file_notification_v1_transaction_check_proto_goTypes = nil
+	file_notification_v1_transaction_check_proto_depIdxs = nil
+}
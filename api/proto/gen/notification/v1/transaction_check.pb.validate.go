@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/transaction_check.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CheckTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckTransactionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckTransactionRequestMultiError, or nil if none found.
+func (m *CheckTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for TenantId
+
+	// no validation rules for BizKey
+
+	// no validation rules for CheckCount
+
+	if all {
+		switch v := interface{}(m.GetPreparedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckTransactionRequestValidationError{
+					field:  "PreparedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckTransactionRequestValidationError{
+					field:  "PreparedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreparedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckTransactionRequestValidationError{
+				field:  "PreparedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckTransactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckTransactionRequestMultiError) AllErrors() []error { return m }
+
+// CheckTransactionRequestValidationError is the validation error returned by
+// CheckTransactionRequest.Validate if the designated constraints aren't met.
+type CheckTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckTransactionRequestValidationError) ErrorName() string {
+	return "CheckTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckTransactionRequestValidationError{}
+
+// Validate checks the field values on CheckTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckTransactionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckTransactionResponseMultiError, or nil if none found.
+func (m *CheckTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return CheckTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckTransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckTransactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckTransactionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckTransactionResponseMultiError) AllErrors() []error { return m }
+
+// CheckTransactionResponseValidationError is the validation error returned by
+// CheckTransactionResponse.Validate if the designated constraints aren't met.
+type CheckTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckTransactionResponseValidationError) ErrorName() string {
+	return "CheckTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckTransactionResponseValidationError{}
@@ -0,0 +1,128 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: notification/v1/transaction_check.proto
+
+package notificationv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TransactionCheckService_CheckTransaction_FullMethodName = "/notification.v1.TransactionCheckService/CheckTransaction"
+)
+
+// TransactionCheckServiceClient is the client API for TransactionCheckService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TransactionCheckService 由使用两阶段通知的调用方实现，平台对超时仍未提交或取消的通知回查调用方的事务状态
+// 回查地址按租户配置（admin.v1.Tenant.check_endpoint），未配置时使用平台的默认回查地址
+type TransactionCheckServiceClient interface {
+	// CheckTransaction 返回通知所属本地事务的状态
+	CheckTransaction(ctx context.Context, in *CheckTransactionRequest, opts ...grpc.CallOption) (*CheckTransactionResponse, error)
+}
+
+type transactionCheckServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionCheckServiceClient(cc grpc.ClientConnInterface) TransactionCheckServiceClient {
+	return &transactionCheckServiceClient{cc}
+}
+
+func (c *transactionCheckServiceClient) CheckTransaction(ctx context.Context, in *CheckTransactionRequest, opts ...grpc.CallOption) (*CheckTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionCheckService_CheckTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionCheckServiceServer is the server API for TransactionCheckService service.
+// All implementations should embed UnimplementedTransactionCheckServiceServer
+// for forward compatibility.
+//
+// TransactionCheckService 由使用两阶段通知的调用方实现，平台对超时仍未提交或取消的通知回查调用方的事务状态
+// 回查地址按租户配置（admin.v1.Tenant.check_endpoint），未配置时使用平台的默认回查地址
+type TransactionCheckServiceServer interface {
+	// CheckTransaction 返回通知所属本地事务的状态
+	CheckTransaction(context.Context, *CheckTransactionRequest) (*CheckTransactionResponse, error)
+}
+
+// UnimplementedTransactionCheckServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTransactionCheckServiceServer struct{}
+
+func (UnimplementedTransactionCheckServiceServer) CheckTransaction(context.Context, *CheckTransactionRequest) (*CheckTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTransaction not implemented")
+}
+func (UnimplementedTransactionCheckServiceServer) testEmbeddedByValue() {}
+
+// UnsafeTransactionCheckServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionCheckServiceServer will
+// result in compilation errors.
+type UnsafeTransactionCheckServiceServer interface {
+	mustEmbedUnimplementedTransactionCheckServiceServer()
+}
+
+func RegisterTransactionCheckServiceServer(s grpc.ServiceRegistrar, srv TransactionCheckServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTransactionCheckServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TransactionCheckService_ServiceDesc, srv)
+}
+
+func _TransactionCheckService_CheckTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCheckServiceServer).CheckTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionCheckService_CheckTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCheckServiceServer).CheckTransaction(ctx, req.(*CheckTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransactionCheckService_ServiceDesc is the grpc.ServiceDesc for TransactionCheckService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransactionCheckService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification.v1.TransactionCheckService",
+	HandlerType: (*TransactionCheckServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckTransaction",
+			Handler:    _TransactionCheckService_CheckTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notification/v1/transaction_check.proto",
+}
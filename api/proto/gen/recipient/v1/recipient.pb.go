@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: recipient/v1/recipient.proto
+
+package recipientv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RecipientProfile 接收者资料
+type RecipientProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者：手机号或邮箱地址，与发送通知时的 recipient 一致
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// 接收者的语言，如 zh-CN、zh-TW、en-US
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// 创建时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 接收者所在的 IANA 时区，如 Asia/Shanghai，用于按租户的发送时段规则推迟通知
+	Timezone      string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipientProfile) Reset() {
+	*x = RecipientProfile{}
+	mi := &file_recipient_v1_recipient_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipientProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipientProfile) ProtoMessage() {}
+
+func (x *RecipientProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_recipient_v1_recipient_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipientProfile.ProtoReflect.Descriptor instead.
+func (*RecipientProfile) Descriptor() ([]byte, []int) {
+	return file_recipient_v1_recipient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecipientProfile) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *RecipientProfile) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *RecipientProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RecipientProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RecipientProfile) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// PutRecipientProfileRequest 写入接收者资料的请求，已存在时覆盖；语言与时区至少设置一项
+type PutRecipientProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRecipientProfileRequest) Reset() {
+	*x = PutRecipientProfileRequest{}
+	mi := &file_recipient_v1_recipient_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRecipientProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRecipientProfileRequest) ProtoMessage() {}
+
+func (x *PutRecipientProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipient_v1_recipient_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRecipientProfileRequest.ProtoReflect.Descriptor instead.
+func (*PutRecipientProfileRequest) Descriptor() ([]byte, []int) {
+	return file_recipient_v1_recipient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PutRecipientProfileRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *PutRecipientProfileRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *PutRecipientProfileRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// PutRecipientProfileResponse 写入接收者资料的响应
+type PutRecipientProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *RecipientProfile      `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRecipientProfileResponse) Reset() {
+	*x = PutRecipientProfileResponse{}
+	mi := &file_recipient_v1_recipient_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRecipientProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRecipientProfileResponse) ProtoMessage() {}
+
+func (x *PutRecipientProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipient_v1_recipient_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRecipientProfileResponse.ProtoReflect.Descriptor instead.
+func (*PutRecipientProfileResponse) Descriptor() ([]byte, []int) {
+	return file_recipient_v1_recipient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PutRecipientProfileResponse) GetProfile() *RecipientProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// GetRecipientProfileRequest 查询接收者资料的请求
+type GetRecipientProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipientProfileRequest) Reset() {
+	*x = GetRecipientProfileRequest{}
+	mi := &file_recipient_v1_recipient_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipientProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipientProfileRequest) ProtoMessage() {}
+
+func (x *GetRecipientProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipient_v1_recipient_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipientProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipientProfileRequest) Descriptor() ([]byte, []int) {
+	return file_recipient_v1_recipient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRecipientProfileRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+// GetRecipientProfileResponse 查询接收者资料的响应
+type GetRecipientProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *RecipientProfile      `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipientProfileResponse) Reset() {
+	*x = GetRecipientProfileResponse{}
+	mi := &file_recipient_v1_recipient_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipientProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipientProfileResponse) ProtoMessage() {}
+
+func (x *GetRecipientProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipient_v1_recipient_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipientProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetRecipientProfileResponse) Descriptor() ([]byte, []int) {
+	return file_recipient_v1_recipient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRecipientProfileResponse) GetProfile() *RecipientProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// DeleteRecipientProfileRequest 删除接收者资料的请求
+type DeleteRecipientProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecipientProfileRequest) Reset() {
+	*x = DeleteRecipientProfileRequest{}
+	mi := &file_recipient_v1_recipient_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecipientProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecipientProfileRequest) ProtoMessage() {}
+
+func (x *DeleteRecipientProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipient_v1_recipient_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecipientProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecipientProfileRequest) Descriptor() ([]byte, []int) {
+	return file_recipient_v1_recipient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteRecipientProfileRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+// DeleteRecipientProfileResponse 删除接收者资料的响应
+type DeleteRecipientProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecipientProfileResponse) Reset() {
+	*x = DeleteRecipientProfileResponse{}
+	mi := &file_recipient_v1_recipient_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecipientProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecipientProfileResponse) ProtoMessage() {}
+
+func (x *DeleteRecipientProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipient_v1_recipient_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecipientProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecipientProfileResponse) Descriptor() ([]byte, []int) {
+	return file_recipient_v1_recipient_proto_rawDescGZIP(), []int{6}
+}
+
+var File_recipient_v1_recipient_proto protoreflect.FileDescriptor
+
+const file_recipient_v1_recipient_proto_rawDesc = "" +
+	"\n" +
+	"\x1crecipient/v1/recipient.proto\x12\frecipient.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xda\x01\n" +
+	"\x10RecipientProfile\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\"\xb9\x01\n" +
+	"\x1aPutRecipientProfileRequest\x12(\n" +
+	"\trecipient\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\trecipient\x12L\n" +
+	"\x06locale\x18\x02 \x01(\tB4\xfaB1r/2*^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8}){0,3}$\xd0\x01\x01R\x06locale\x12#\n" +
+	"\btimezone\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\btimezone\"W\n" +
+	"\x1bPutRecipientProfileResponse\x128\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1e.recipient.v1.RecipientProfileR\aprofile\"F\n" +
+	"\x1aGetRecipientProfileRequest\x12(\n" +
+	"\trecipient\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\trecipient\"W\n" +
+	"\x1bGetRecipientProfileResponse\x128\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1e.recipient.v1.RecipientProfileR\aprofile\"I\n" +
+	"\x1dDeleteRecipientProfileRequest\x12(\n" +
+	"\trecipient\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\trecipient\" \n" +
+	"\x1eDeleteRecipientProfileResponse2\xdf\x02\n" +
+	"\x10RecipientService\x12j\n" +
+	"\x13PutRecipientProfile\x12(.recipient.v1.PutRecipientProfileRequest\x1a).recipient.v1.PutRecipientProfileResponse\x12j\n" +
+	"\x13GetRecipientProfile\x12(.recipient.v1.GetRecipientProfileRequest\x1a).recipient.v1.GetRecipientProfileResponse\x12s\n" +
+	"\x16DeleteRecipientProfile\x12+.recipient.v1.DeleteRecipientProfileRequest\x1a,.recipient.v1.DeleteRecipientProfileResponseB\xb7\x01\n" +
+	"\x10com.recipient.v1B\x0eRecipientProtoP\x01ZBgithub.com/dingdong-postman/api/proto/gen/recipient/v1;recipientv1\xa2\x02\x03RXX\xaa\x02\fRecipient.V1\xca\x02\fRecipient\\V1\xe2\x02\x18Recipient\\V1\\GPBMetadata\xea\x02\rRecipient::V1b\x06proto3"
+
+var (
+	file_recipient_v1_recipient_proto_rawDescOnce sync.Once
+	file_recipient_v1_recipient_proto_rawDescData []byte
+)
+
+func file_recipient_v1_recipient_proto_rawDescGZIP() []byte {
+	file_recipient_v1_recipient_proto_rawDescOnce.Do(func() {
+		file_recipient_v1_recipient_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_recipient_v1_recipient_proto_rawDesc), len(file_recipient_v1_recipient_proto_rawDesc)))
+	})
+	return file_recipient_v1_recipient_proto_rawDescData
+}
+
+var file_recipient_v1_recipient_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_recipient_v1_recipient_proto_goTypes = []any{
+	(*RecipientProfile)(nil),               // 0: recipient.v1.RecipientProfile
+	(*PutRecipientProfileRequest)(nil),     // 1: recipient.v1.PutRecipientProfileRequest
+	(*PutRecipientProfileResponse)(nil),    // 2: recipient.v1.PutRecipientProfileResponse
+	(*GetRecipientProfileRequest)(nil),     // 3: recipient.v1.GetRecipientProfileRequest
+	(*GetRecipientProfileResponse)(nil),    // 4: recipient.v1.GetRecipientProfileResponse
+	(*DeleteRecipientProfileRequest)(nil),  // 5: recipient.v1.DeleteRecipientProfileRequest
+	(*DeleteRecipientProfileResponse)(nil), // 6: recipient.v1.DeleteRecipientProfileResponse
+	(*timestamppb.Timestamp)(nil),          // 7: google.protobuf.Timestamp
+}
+var file_recipient_v1_recipient_proto_depIdxs = []int32{
+	7, // 0: recipient.v1.RecipientProfile.created_at:type_name -> google.protobuf.Timestamp
+	7, // 1: recipient.v1.RecipientProfile.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: recipient.v1.PutRecipientProfileResponse.profile:type_name -> recipient.v1.RecipientProfile
+	0, // 3: recipient.v1.GetRecipientProfileResponse.profile:type_name -> recipient.v1.RecipientProfile
+	1, // 4: recipient.v1.RecipientService.PutRecipientProfile:input_type -> recipient.v1.PutRecipientProfileRequest
+	3, // 5: recipient.v1.RecipientService.GetRecipientProfile:input_type -> recipient.v1.GetRecipientProfileRequest
+	5, // 6: recipient.v1.RecipientService.DeleteRecipientProfile:input_type -> recipient.v1.DeleteRecipientProfileRequest
+	2, // 7: recipient.v1.RecipientService.PutRecipientProfile:output_type -> recipient.v1.PutRecipientProfileResponse
+	4, // 8: recipient.v1.RecipientService.GetRecipientProfile:output_type -> recipient.v1.GetRecipientProfileResponse
+	6, // 9: recipient.v1.RecipientService.DeleteRecipientProfile:output_type -> recipient.v1.DeleteRecipientProfileResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_recipient_v1_recipient_proto_init() }
+func file_recipient_v1_recipient_proto_init() {
+	if File_recipient_v1_recipient_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_recipient_v1_recipient_proto_rawDesc), len(file_recipient_v1_recipient_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_recipient_v1_recipient_proto_goTypes,
+		DependencyIndexes: file_recipient_v1_recipient_proto_depIdxs,
+		MessageInfos:      file_recipient_v1_recipient_proto_msgTypes,
+	}.Build()
+	File_recipient_v1_recipient_proto = out.File
+	file_recipient_v1_recipient_proto_goTypes = nil
+	file_recipient_v1_recipient_proto_depIdxs = nil
+}
@@ -0,0 +1,935 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: recipient/v1/recipient.proto
+
+package recipientv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RecipientProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RecipientProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecipientProfile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecipientProfileMultiError, or nil if none found.
+func (m *RecipientProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecipientProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Recipient
+
+	// no validation rules for Locale
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecipientProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecipientProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecipientProfileValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecipientProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecipientProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecipientProfileValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
+	if len(errors) > 0 {
+		return RecipientProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecipientProfileMultiError is an error wrapping multiple validation errors
+// returned by RecipientProfile.ValidateAll() if the designated constraints
+// aren't met.
+type RecipientProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecipientProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecipientProfileMultiError) AllErrors() []error { return m }
+
+// RecipientProfileValidationError is the validation error returned by
+// RecipientProfile.Validate if the designated constraints aren't met.
+type RecipientProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecipientProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecipientProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecipientProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecipientProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecipientProfileValidationError) ErrorName() string { return "RecipientProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecipientProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecipientProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecipientProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecipientProfileValidationError{}
+
+// Validate checks the field values on PutRecipientProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutRecipientProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutRecipientProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutRecipientProfileRequestMultiError, or nil if none found.
+func (m *PutRecipientProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutRecipientProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRecipient()); l < 1 || l > 256 {
+		err := PutRecipientProfileRequestValidationError{
+			field:  "Recipient",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLocale() != "" {
+
+		if !_PutRecipientProfileRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+			err := PutRecipientProfileRequestValidationError{
+				field:  "Locale",
+				reason: "value does not match regex pattern \"^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8}){0,3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+		err := PutRecipientProfileRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PutRecipientProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutRecipientProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by PutRecipientProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PutRecipientProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutRecipientProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutRecipientProfileRequestMultiError) AllErrors() []error { return m }
+
+// PutRecipientProfileRequestValidationError is the validation error returned
+// by PutRecipientProfileRequest.Validate if the designated constraints aren't met.
+type PutRecipientProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutRecipientProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutRecipientProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutRecipientProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutRecipientProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutRecipientProfileRequestValidationError) ErrorName() string {
+	return "PutRecipientProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutRecipientProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutRecipientProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutRecipientProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutRecipientProfileRequestValidationError{}
+
+var _PutRecipientProfileRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8}){0,3}$")
+
+// Validate checks the field values on PutRecipientProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutRecipientProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutRecipientProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutRecipientProfileResponseMultiError, or nil if none found.
+func (m *PutRecipientProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutRecipientProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PutRecipientProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PutRecipientProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PutRecipientProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PutRecipientProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutRecipientProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by PutRecipientProfileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PutRecipientProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutRecipientProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutRecipientProfileResponseMultiError) AllErrors() []error { return m }
+
+// PutRecipientProfileResponseValidationError is the validation error returned
+// by PutRecipientProfileResponse.Validate if the designated constraints
+// aren't met.
+type PutRecipientProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutRecipientProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutRecipientProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutRecipientProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutRecipientProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutRecipientProfileResponseValidationError) ErrorName() string {
+	return "PutRecipientProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutRecipientProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutRecipientProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutRecipientProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutRecipientProfileResponseValidationError{}
+
+// Validate checks the field values on GetRecipientProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecipientProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecipientProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRecipientProfileRequestMultiError, or nil if none found.
+func (m *GetRecipientProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecipientProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRecipient()); l < 1 || l > 256 {
+		err := GetRecipientProfileRequestValidationError{
+			field:  "Recipient",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRecipientProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecipientProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by GetRecipientProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetRecipientProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecipientProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecipientProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetRecipientProfileRequestValidationError is the validation error returned
+// by GetRecipientProfileRequest.Validate if the designated constraints aren't met.
+type GetRecipientProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecipientProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecipientProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecipientProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecipientProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecipientProfileRequestValidationError) ErrorName() string {
+	return "GetRecipientProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecipientProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecipientProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecipientProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecipientProfileRequestValidationError{}
+
+// Validate checks the field values on GetRecipientProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecipientProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecipientProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRecipientProfileResponseMultiError, or nil if none found.
+func (m *GetRecipientProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecipientProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRecipientProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRecipientProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRecipientProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRecipientProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecipientProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by GetRecipientProfileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetRecipientProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecipientProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecipientProfileResponseMultiError) AllErrors() []error { return m }
+
+// GetRecipientProfileResponseValidationError is the validation error returned
+// by GetRecipientProfileResponse.Validate if the designated constraints
+// aren't met.
+type GetRecipientProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecipientProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecipientProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecipientProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecipientProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecipientProfileResponseValidationError) ErrorName() string {
+	return "GetRecipientProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecipientProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecipientProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecipientProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecipientProfileResponseValidationError{}
+
+// Validate checks the field values on DeleteRecipientProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRecipientProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRecipientProfileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteRecipientProfileRequestMultiError, or nil if none found.
+func (m *DeleteRecipientProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRecipientProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRecipient()); l < 1 || l > 256 {
+		err := DeleteRecipientProfileRequestValidationError{
+			field:  "Recipient",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRecipientProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRecipientProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteRecipientProfileRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteRecipientProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRecipientProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRecipientProfileRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRecipientProfileRequestValidationError is the validation error
+// returned by DeleteRecipientProfileRequest.Validate if the designated
+// constraints aren't met.
+type DeleteRecipientProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRecipientProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRecipientProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRecipientProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRecipientProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRecipientProfileRequestValidationError) ErrorName() string {
+	return "DeleteRecipientProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRecipientProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRecipientProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRecipientProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRecipientProfileRequestValidationError{}
+
+// Validate checks the field values on DeleteRecipientProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRecipientProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRecipientProfileResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteRecipientProfileResponseMultiError, or nil if none found.
+func (m *DeleteRecipientProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRecipientProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteRecipientProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRecipientProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteRecipientProfileResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteRecipientProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRecipientProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRecipientProfileResponseMultiError) AllErrors() []error { return m }
+
+// DeleteRecipientProfileResponseValidationError is the validation error
+// returned by DeleteRecipientProfileResponse.Validate if the designated
+// constraints aren't met.
+type DeleteRecipientProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRecipientProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRecipientProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRecipientProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRecipientProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRecipientProfileResponseValidationError) ErrorName() string {
+	return "DeleteRecipientProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRecipientProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRecipientProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRecipientProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRecipientProfileResponseValidationError{}
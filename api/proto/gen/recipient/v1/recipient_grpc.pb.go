@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: recipient/v1/recipient.proto
+
+package recipientv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RecipientService_PutRecipientProfile_FullMethodName    = "/recipient.v1.RecipientService/PutRecipientProfile"
+	RecipientService_GetRecipientProfile_FullMethodName    = "/recipient.v1.RecipientService/GetRecipientProfile"
+	RecipientService_DeleteRecipientProfile_FullMethodName = "/recipient.v1.RecipientService/DeleteRecipientProfile"
+)
+
+// RecipientServiceClient is the client API for RecipientService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RecipientService 接收者资料管理服务
+// 发送通知时未指定 locale 的，按接收者资料中的语言选择模板的语言版本
+type RecipientServiceClient interface {
+	// PutRecipientProfile 写入接收者资料
+	PutRecipientProfile(ctx context.Context, in *PutRecipientProfileRequest, opts ...grpc.CallOption) (*PutRecipientProfileResponse, error)
+	// GetRecipientProfile 查询接收者资料
+	GetRecipientProfile(ctx context.Context, in *GetRecipientProfileRequest, opts ...grpc.CallOption) (*GetRecipientProfileResponse, error)
+	// DeleteRecipientProfile 删除接收者资料
+	DeleteRecipientProfile(ctx context.Context, in *DeleteRecipientProfileRequest, opts ...grpc.CallOption) (*DeleteRecipientProfileResponse, error)
+}
+
+type recipientServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecipientServiceClient(cc grpc.ClientConnInterface) RecipientServiceClient {
+	return &recipientServiceClient{cc}
+}
+
+func (c *recipientServiceClient) PutRecipientProfile(ctx context.Context, in *PutRecipientProfileRequest, opts ...grpc.CallOption) (*PutRecipientProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutRecipientProfileResponse)
+	err := c.cc.Invoke(ctx, RecipientService_PutRecipientProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipientServiceClient) GetRecipientProfile(ctx context.Context, in *GetRecipientProfileRequest, opts ...grpc.CallOption) (*GetRecipientProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecipientProfileResponse)
+	err := c.cc.Invoke(ctx, RecipientService_GetRecipientProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipientServiceClient) DeleteRecipientProfile(ctx context.Context, in *DeleteRecipientProfileRequest, opts ...grpc.CallOption) (*DeleteRecipientProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecipientProfileResponse)
+	err := c.cc.Invoke(ctx, RecipientService_DeleteRecipientProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecipientServiceServer is the server API for RecipientService service.
+// All implementations should embed UnimplementedRecipientServiceServer
+// for forward compatibility.
+//
+// RecipientService 接收者资料管理服务
+// 发送通知时未指定 locale 的，按接收者资料中的语言选择模板的语言版本
+type RecipientServiceServer interface {
+	// PutRecipientProfile 写入接收者资料
+	PutRecipientProfile(context.Context, *PutRecipientProfileRequest) (*PutRecipientProfileResponse, error)
+	// GetRecipientProfile 查询接收者资料
+	GetRecipientProfile(context.Context, *GetRecipientProfileRequest) (*GetRecipientProfileResponse, error)
+	// DeleteRecipientProfile 删除接收者资料
+	DeleteRecipientProfile(context.Context, *DeleteRecipientProfileRequest) (*DeleteRecipientProfileResponse, error)
+}
+
+// UnimplementedRecipientServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipientServiceServer struct{}
+
+func (UnimplementedRecipientServiceServer) PutRecipientProfile(context.Context, *PutRecipientProfileRequest) (*PutRecipientProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutRecipientProfile not implemented")
+}
+func (UnimplementedRecipientServiceServer) GetRecipientProfile(context.Context, *GetRecipientProfileRequest) (*GetRecipientProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipientProfile not implemented")
+}
+func (UnimplementedRecipientServiceServer) DeleteRecipientProfile(context.Context, *DeleteRecipientProfileRequest) (*DeleteRecipientProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecipientProfile not implemented")
+}
+func (UnimplementedRecipientServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRecipientServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipientServiceServer will
+// result in compilation errors.
+type UnsafeRecipientServiceServer interface {
+	mustEmbedUnimplementedRecipientServiceServer()
+}
+
+func RegisterRecipientServiceServer(s grpc.ServiceRegistrar, srv RecipientServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecipientServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipientService_ServiceDesc, srv)
+}
+
+func _RecipientService_PutRecipientProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRecipientProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipientServiceServer).PutRecipientProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipientService_PutRecipientProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipientServiceServer).PutRecipientProfile(ctx, req.(*PutRecipientProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipientService_GetRecipientProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipientProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipientServiceServer).GetRecipientProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipientService_GetRecipientProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipientServiceServer).GetRecipientProfile(ctx, req.(*GetRecipientProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipientService_DeleteRecipientProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecipientProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipientServiceServer).DeleteRecipientProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipientService_DeleteRecipientProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipientServiceServer).DeleteRecipientProfile(ctx, req.(*DeleteRecipientProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecipientService_ServiceDesc is the grpc.ServiceDesc for RecipientService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecipientService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "recipient.v1.RecipientService",
+	HandlerType: (*RecipientServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PutRecipientProfile",
+			Handler:    _RecipientService_PutRecipientProfile_Handler,
+		},
+		{
+			MethodName: "GetRecipientProfile",
+			Handler:    _RecipientService_GetRecipientProfile_Handler,
+		},
+		{
+			MethodName: "DeleteRecipientProfile",
+			Handler:    _RecipientService_DeleteRecipientProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "recipient/v1/recipient.proto",
+}
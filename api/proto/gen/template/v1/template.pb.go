@@ -0,0 +1,2347 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: template/v1/template.proto
+
+package templatev1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VariableType 模板变量类型，发送时按类型校验参数
+type VariableType int32
+
+const (
+	VariableType_VARIABLE_TYPE_UNSPECIFIED VariableType = 0
+	// 任意文本
+	VariableType_VARIABLE_TYPE_STRING VariableType = 1
+	// 数字，如 12、-3.5
+	VariableType_VARIABLE_TYPE_NUMBER VariableType = 2
+	// 日期，格式为 2006-01-02
+	VariableType_VARIABLE_TYPE_DATE VariableType = 3
+	// 日期时间，格式为 RFC 3339
+	VariableType_VARIABLE_TYPE_DATETIME VariableType = 4
+	// 以 http 或 https 开头的链接
+	VariableType_VARIABLE_TYPE_URL VariableType = 5
+)
+
+// Enum value maps for VariableType.
+var (
+	VariableType_name = map[int32]string{
+		0: "VARIABLE_TYPE_UNSPECIFIED",
+		1: "VARIABLE_TYPE_STRING",
+		2: "VARIABLE_TYPE_NUMBER",
+		3: "VARIABLE_TYPE_DATE",
+		4: "VARIABLE_TYPE_DATETIME",
+		5: "VARIABLE_TYPE_URL",
+	}
+	VariableType_value = map[string]int32{
+		"VARIABLE_TYPE_UNSPECIFIED": 0,
+		"VARIABLE_TYPE_STRING":      1,
+		"VARIABLE_TYPE_NUMBER":      2,
+		"VARIABLE_TYPE_DATE":        3,
+		"VARIABLE_TYPE_DATETIME":    4,
+		"VARIABLE_TYPE_URL":         5,
+	}
+)
+
+func (x VariableType) Enum() *VariableType {
+	p := new(VariableType)
+	*p = x
+	return p
+}
+
+func (x VariableType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VariableType) Descriptor() protoreflect.EnumDescriptor {
+	return file_template_v1_template_proto_enumTypes[0].Descriptor()
+}
+
+func (VariableType) Type() protoreflect.EnumType {
+	return &file_template_v1_template_proto_enumTypes[0]
+}
+
+func (x VariableType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VariableType.Descriptor instead.
+func (VariableType) EnumDescriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{0}
+}
+
+// ApprovalStatus 模板在供应商处的审核状态
+type ApprovalStatus int32
+
+const (
+	ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED ApprovalStatus = 0
+	// 等待提交给供应商，提交失败时保持该状态并稍后重试
+	ApprovalStatus_APPROVAL_STATUS_SUBMITTING ApprovalStatus = 1
+	// 已提交，供应商审核中
+	ApprovalStatus_APPROVAL_STATUS_PENDING ApprovalStatus = 2
+	// 审核通过，发送时可以路由到该供应商
+	ApprovalStatus_APPROVAL_STATUS_APPROVED ApprovalStatus = 3
+	// 审核未通过，可修改内容后创建新版本，或通过 SubmitTemplateApproval 重新提交
+	ApprovalStatus_APPROVAL_STATUS_REJECTED ApprovalStatus = 4
+)
+
+// Enum value maps for ApprovalStatus.
+var (
+	ApprovalStatus_name = map[int32]string{
+		0: "APPROVAL_STATUS_UNSPECIFIED",
+		1: "APPROVAL_STATUS_SUBMITTING",
+		2: "APPROVAL_STATUS_PENDING",
+		3: "APPROVAL_STATUS_APPROVED",
+		4: "APPROVAL_STATUS_REJECTED",
+	}
+	ApprovalStatus_value = map[string]int32{
+		"APPROVAL_STATUS_UNSPECIFIED": 0,
+		"APPROVAL_STATUS_SUBMITTING":  1,
+		"APPROVAL_STATUS_PENDING":     2,
+		"APPROVAL_STATUS_APPROVED":    3,
+		"APPROVAL_STATUS_REJECTED":    4,
+	}
+)
+
+func (x ApprovalStatus) Enum() *ApprovalStatus {
+	p := new(ApprovalStatus)
+	*p = x
+	return p
+}
+
+func (x ApprovalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_template_v1_template_proto_enumTypes[1].Descriptor()
+}
+
+func (ApprovalStatus) Type() protoreflect.EnumType {
+	return &file_template_v1_template_proto_enumTypes[1]
+}
+
+func (x ApprovalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalStatus.Descriptor instead.
+func (ApprovalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{1}
+}
+
+// SmsEncoding 短信的字符编码
+type SmsEncoding int32
+
+const (
+	SmsEncoding_SMS_ENCODING_UNSPECIFIED SmsEncoding = 0
+	// GSM 03.38 7 位默认字母表，单条 160 个字符，长短信每段 153 个字符
+	SmsEncoding_SMS_ENCODING_GSM7 SmsEncoding = 1
+	// 含 GSM 字母表以外的字符（如中文、emoji）时使用，单条 70 个字符，长短信每段 67 个字符
+	SmsEncoding_SMS_ENCODING_UCS2 SmsEncoding = 2
+)
+
+// Enum value maps for SmsEncoding.
+var (
+	SmsEncoding_name = map[int32]string{
+		0: "SMS_ENCODING_UNSPECIFIED",
+		1: "SMS_ENCODING_GSM7",
+		2: "SMS_ENCODING_UCS2",
+	}
+	SmsEncoding_value = map[string]int32{
+		"SMS_ENCODING_UNSPECIFIED": 0,
+		"SMS_ENCODING_GSM7":        1,
+		"SMS_ENCODING_UCS2":        2,
+	}
+)
+
+func (x SmsEncoding) Enum() *SmsEncoding {
+	p := new(SmsEncoding)
+	*p = x
+	return p
+}
+
+func (x SmsEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SmsEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_template_v1_template_proto_enumTypes[2].Descriptor()
+}
+
+func (SmsEncoding) Type() protoreflect.EnumType {
+	return &file_template_v1_template_proto_enumTypes[2]
+}
+
+func (x SmsEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SmsEncoding.Descriptor instead.
+func (SmsEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{2}
+}
+
+// TemplateVariable 模板中的一个占位变量，在模板内容中以 {{name}} 引用
+type TemplateVariable struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type  VariableType           `protobuf:"varint,2,opt,name=type,proto3,enum=template.v1.VariableType" json:"type,omitempty"`
+	// 是否必填；必填变量缺失时拒绝发送，非必填变量缺失时渲染为空
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// 变量说明
+	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateVariable) Reset() {
+	*x = TemplateVariable{}
+	mi := &file_template_v1_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateVariable) ProtoMessage() {}
+
+func (x *TemplateVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateVariable.ProtoReflect.Descriptor instead.
+func (*TemplateVariable) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TemplateVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateVariable) GetType() VariableType {
+	if x != nil {
+		return x.Type
+	}
+	return VariableType_VARIABLE_TYPE_UNSPECIFIED
+}
+
+func (x *TemplateVariable) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TemplateVariable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// TemplateContent 模板一个版本的内容
+type TemplateContent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 邮件主题，仅邮件模板使用
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// 正文：短信为短信内容，邮件为纯文本正文
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// HTML 正文，仅邮件模板使用，参数值会做 HTML 转义
+	HtmlBody string `protobuf:"bytes,3,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	// 内容中引用的全部变量，所有语言版本共用
+	Variables []*TemplateVariable `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+	// 其他语言版本；以上字段为默认内容，接收者的语言及其回退语言都没有对应版本时使用默认内容
+	Locales       []*LocalizedContent `protobuf:"bytes,5,rep,name=locales,proto3" json:"locales,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateContent) Reset() {
+	*x = TemplateContent{}
+	mi := &file_template_v1_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateContent) ProtoMessage() {}
+
+func (x *TemplateContent) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateContent.ProtoReflect.Descriptor instead.
+func (*TemplateContent) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TemplateContent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetVariables() []*TemplateVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *TemplateContent) GetLocales() []*LocalizedContent {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+// LocalizedContent 模板内容的一个语言版本，字段含义与 TemplateContent 相同
+type LocalizedContent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 语言标签，如 zh-TW、en-US
+	Locale        string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	Subject       string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body          string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	HtmlBody      string `protobuf:"bytes,4,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalizedContent) Reset() {
+	*x = LocalizedContent{}
+	mi := &file_template_v1_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalizedContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalizedContent) ProtoMessage() {}
+
+func (x *LocalizedContent) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalizedContent.ProtoReflect.Descriptor instead.
+func (*LocalizedContent) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LocalizedContent) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *LocalizedContent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *LocalizedContent) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *LocalizedContent) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
+// Template 消息模板
+type Template struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模板 ID，在租户内唯一
+	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 模板名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 适用渠道
+	Channel v1.Channel `protobuf:"varint,3,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 模板说明
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// 当前生效的版本号
+	ActiveVersion int32 `protobuf:"varint,5,opt,name=active_version,json=activeVersion,proto3" json:"active_version,omitempty"`
+	// 最新的版本号
+	LatestVersion int32 `protobuf:"varint,6,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// 创建时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_template_v1_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Template) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetChannel() v1.Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return v1.Channel(0)
+}
+
+func (x *Template) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Template) GetActiveVersion() int32 {
+	if x != nil {
+		return x.ActiveVersion
+	}
+	return 0
+}
+
+func (x *Template) GetLatestVersion() int32 {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return 0
+}
+
+func (x *Template) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Template) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// TemplateVersion 模板的一个版本，创建后内容不可修改
+type TemplateVersion struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 版本号，从 1 开始递增
+	Version int32            `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Content *TemplateContent `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// 创建时间
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateVersion) Reset() {
+	*x = TemplateVersion{}
+	mi := &file_template_v1_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateVersion) ProtoMessage() {}
+
+func (x *TemplateVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateVersion.ProtoReflect.Descriptor instead.
+func (*TemplateVersion) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TemplateVersion) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *TemplateVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TemplateVersion) GetContent() *TemplateContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *TemplateVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateTemplateRequest 创建模板的请求，content 作为第一个版本并立即生效
+type CreateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Channel       v1.Channel             `protobuf:"varint,3,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Content       *TemplateContent       `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetChannel() v1.Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return v1.Channel(0)
+}
+
+func (x *CreateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetContent() *TemplateContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// CreateTemplateResponse 创建模板的响应
+type CreateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Version       *TemplateVersion       `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CreateTemplateResponse) GetVersion() *TemplateVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// GetTemplateRequest 查询模板的请求
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// GetTemplateResponse 查询模板的响应，包含生效版本的内容
+type GetTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	ActiveVersion *TemplateVersion       `protobuf:"bytes,2,opt,name=active_version,json=activeVersion,proto3" json:"active_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *GetTemplateResponse) GetActiveVersion() *TemplateVersion {
+	if x != nil {
+		return x.ActiveVersion
+	}
+	return nil
+}
+
+// ListTemplatesRequest 分页查询模板的请求，按模板 ID 升序返回
+type ListTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 适用渠道，未设置时不筛选
+	Channel v1.Channel `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 每页条数，默认 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 上一页响应中的 next_page_token，为空时从头开始
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTemplatesRequest) GetChannel() v1.Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return v1.Channel(0)
+}
+
+func (x *ListTemplatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTemplatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListTemplatesResponse 分页查询模板的响应
+type ListTemplatesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Templates []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	// 下一页的分页令牌，为空表示没有更多数据
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListTemplatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateTemplateRequest 更新模板名称与说明的请求；内容通过创建新版本修改
+type UpdateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// UpdateTemplateResponse 更新模板的响应
+type UpdateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateResponse) Reset() {
+	*x = UpdateTemplateResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// DeleteTemplateRequest 删除模板的请求
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// DeleteTemplateResponse 删除模板的响应
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{14}
+}
+
+// CreateTemplateVersionRequest 创建模板版本的请求
+type CreateTemplateVersionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Content    *TemplateContent       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// 是否立即设为生效版本
+	Activate      bool `protobuf:"varint,3,opt,name=activate,proto3" json:"activate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateVersionRequest) Reset() {
+	*x = CreateTemplateVersionRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateVersionRequest) ProtoMessage() {}
+
+func (x *CreateTemplateVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateVersionRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateVersionRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateTemplateVersionRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateTemplateVersionRequest) GetContent() *TemplateContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CreateTemplateVersionRequest) GetActivate() bool {
+	if x != nil {
+		return x.Activate
+	}
+	return false
+}
+
+// CreateTemplateVersionResponse 创建模板版本的响应
+type CreateTemplateVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *TemplateVersion       `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateVersionResponse) Reset() {
+	*x = CreateTemplateVersionResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateVersionResponse) ProtoMessage() {}
+
+func (x *CreateTemplateVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateVersionResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateVersionResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateTemplateVersionResponse) GetVersion() *TemplateVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// ListTemplateVersionsRequest 查询模板全部版本的请求
+type ListTemplateVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplateVersionsRequest) Reset() {
+	*x = ListTemplateVersionsRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplateVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplateVersionsRequest) ProtoMessage() {}
+
+func (x *ListTemplateVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplateVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplateVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTemplateVersionsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// ListTemplateVersionsResponse 查询模板全部版本的响应，按版本号升序
+type ListTemplateVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*TemplateVersion     `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplateVersionsResponse) Reset() {
+	*x = ListTemplateVersionsResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplateVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplateVersionsResponse) ProtoMessage() {}
+
+func (x *ListTemplateVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplateVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplateVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTemplateVersionsResponse) GetVersions() []*TemplateVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// ActivateTemplateVersionRequest 切换生效版本的请求
+type ActivateTemplateVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateTemplateVersionRequest) Reset() {
+	*x = ActivateTemplateVersionRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateTemplateVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateTemplateVersionRequest) ProtoMessage() {}
+
+func (x *ActivateTemplateVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateTemplateVersionRequest.ProtoReflect.Descriptor instead.
+func (*ActivateTemplateVersionRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ActivateTemplateVersionRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ActivateTemplateVersionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// ActivateTemplateVersionResponse 切换生效版本的响应
+type ActivateTemplateVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateTemplateVersionResponse) Reset() {
+	*x = ActivateTemplateVersionResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateTemplateVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateTemplateVersionResponse) ProtoMessage() {}
+
+func (x *ActivateTemplateVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateTemplateVersionResponse.ProtoReflect.Descriptor instead.
+func (*ActivateTemplateVersionResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ActivateTemplateVersionResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// TemplateApproval 模板的一个版本的一个语言版本在一个供应商处的审核记录
+type TemplateApproval struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Version    int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// 供应商实例名称
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 供应商分配的模板 Code，提交成功后才有值
+	VendorCode string         `protobuf:"bytes,4,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	Status     ApprovalStatus `protobuf:"varint,5,opt,name=status,proto3,enum=template.v1.ApprovalStatus" json:"status,omitempty"`
+	// 审核未通过或提交失败的原因
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 提交与查询的累计次数
+	Attempts int32 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// 提交成功的时间
+	SubmittedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	// 下次提交或查询审核状态的时间
+	NextCheckAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_check_at,json=nextCheckAt,proto3" json:"next_check_at,omitempty"`
+	// 最近一次更新时间
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 语言版本，为空表示默认内容
+	Locale        string `protobuf:"bytes,11,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateApproval) Reset() {
+	*x = TemplateApproval{}
+	mi := &file_template_v1_template_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateApproval) ProtoMessage() {}
+
+func (x *TemplateApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateApproval.ProtoReflect.Descriptor instead.
+func (*TemplateApproval) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TemplateApproval) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *TemplateApproval) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TemplateApproval) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *TemplateApproval) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+func (x *TemplateApproval) GetStatus() ApprovalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+}
+
+func (x *TemplateApproval) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TemplateApproval) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *TemplateApproval) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *TemplateApproval) GetNextCheckAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextCheckAt
+	}
+	return nil
+}
+
+func (x *TemplateApproval) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TemplateApproval) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// ListTemplateApprovalsRequest 查询模板审核记录的请求
+type ListTemplateApprovalsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 版本号，为 0 时返回全部版本
+	Version       int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplateApprovalsRequest) Reset() {
+	*x = ListTemplateApprovalsRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplateApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplateApprovalsRequest) ProtoMessage() {}
+
+func (x *ListTemplateApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplateApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplateApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTemplateApprovalsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListTemplateApprovalsRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// ListTemplateApprovalsResponse 查询模板审核记录的响应，按版本号、语言、供应商排列
+type ListTemplateApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*TemplateApproval    `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplateApprovalsResponse) Reset() {
+	*x = ListTemplateApprovalsResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplateApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplateApprovalsResponse) ProtoMessage() {}
+
+func (x *ListTemplateApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplateApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplateApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTemplateApprovalsResponse) GetApprovals() []*TemplateApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+// SubmitTemplateApprovalRequest 重新提交模板审核的请求，版本的默认内容与全部语言版本一并提交
+type SubmitTemplateApprovalRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Version    int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// 供应商实例名称，为空时提交给所有需要审核模板的供应商
+	Provider      string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTemplateApprovalRequest) Reset() {
+	*x = SubmitTemplateApprovalRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTemplateApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTemplateApprovalRequest) ProtoMessage() {}
+
+func (x *SubmitTemplateApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTemplateApprovalRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTemplateApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubmitTemplateApprovalRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *SubmitTemplateApprovalRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SubmitTemplateApprovalRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// SubmitTemplateApprovalResponse 重新提交模板审核的响应，返回该版本当前的全部审核记录
+type SubmitTemplateApprovalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*TemplateApproval    `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTemplateApprovalResponse) Reset() {
+	*x = SubmitTemplateApprovalResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTemplateApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTemplateApprovalResponse) ProtoMessage() {}
+
+func (x *SubmitTemplateApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTemplateApprovalResponse.ProtoReflect.Descriptor instead.
+func (*SubmitTemplateApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SubmitTemplateApprovalResponse) GetApprovals() []*TemplateApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+// SmsStats 短信内容的编码与条数
+type SmsStats struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Encoding SmsEncoding            `protobuf:"varint,1,opt,name=encoding,proto3,enum=template.v1.SmsEncoding" json:"encoding,omitempty"`
+	// 字符数（含签名），供应商按该值判断是否超长
+	Characters int32 `protobuf:"varint,2,opt,name=characters,proto3" json:"characters,omitempty"`
+	// 拆分后的条数，运营商按条计费
+	Segments int32 `protobuf:"varint,3,opt,name=segments,proto3" json:"segments,omitempty"`
+	// 字符数是否超过 max_length，超长的短信会被供应商拒绝
+	OverLength bool `protobuf:"varint,4,opt,name=over_length,json=overLength,proto3" json:"over_length,omitempty"`
+	// 一条长短信允许的最大字符数
+	MaxLength     int32 `protobuf:"varint,5,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SmsStats) Reset() {
+	*x = SmsStats{}
+	mi := &file_template_v1_template_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SmsStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmsStats) ProtoMessage() {}
+
+func (x *SmsStats) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmsStats.ProtoReflect.Descriptor instead.
+func (*SmsStats) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SmsStats) GetEncoding() SmsEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return SmsEncoding_SMS_ENCODING_UNSPECIFIED
+}
+
+func (x *SmsStats) GetCharacters() int32 {
+	if x != nil {
+		return x.Characters
+	}
+	return 0
+}
+
+func (x *SmsStats) GetSegments() int32 {
+	if x != nil {
+		return x.Segments
+	}
+	return 0
+}
+
+func (x *SmsStats) GetOverLength() bool {
+	if x != nil {
+		return x.OverLength
+	}
+	return false
+}
+
+func (x *SmsStats) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+// RenderTemplateRequest 预览模板的请求
+type RenderTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 版本号，为 0 时使用生效版本
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// 接收者的语言，按回退链选择语言版本；为空时使用默认内容
+	Locale string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	// 示例参数，与发送通知时的 template_params 相同
+	Params map[string]string `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 短信签名；指定时按供应商添加签名后的内容（【签名】正文）计算字符数与条数
+	SignName      string `protobuf:"bytes,5,opt,name=sign_name,json=signName,proto3" json:"sign_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTemplateRequest) Reset() {
+	*x = RenderTemplateRequest{}
+	mi := &file_template_v1_template_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplateRequest) ProtoMessage() {}
+
+func (x *RenderTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplateRequest.ProtoReflect.Descriptor instead.
+func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RenderTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *RenderTemplateRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RenderTemplateRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *RenderTemplateRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *RenderTemplateRequest) GetSignName() string {
+	if x != nil {
+		return x.SignName
+	}
+	return ""
+}
+
+// RenderTemplateResponse 预览模板的响应
+type RenderTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渲染的版本号
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// 选中的语言版本，为空表示默认内容
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// 邮件主题
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// 短信内容（不含签名）或邮件纯文本正文
+	Body string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// 邮件 HTML 正文
+	HtmlBody string `protobuf:"bytes,5,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	// 短信的编码与条数，仅短信模板返回
+	Sms           *SmsStats `protobuf:"bytes,6,opt,name=sms,proto3" json:"sms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTemplateResponse) Reset() {
+	*x = RenderTemplateResponse{}
+	mi := &file_template_v1_template_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplateResponse) ProtoMessage() {}
+
+func (x *RenderTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_v1_template_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplateResponse.ProtoReflect.Descriptor instead.
+func (*RenderTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_template_v1_template_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RenderTemplateResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RenderTemplateResponse) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *RenderTemplateResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RenderTemplateResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *RenderTemplateResponse) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
+func (x *RenderTemplateResponse) GetSms() *SmsStats {
+	if x != nil {
+		return x.Sms
+	}
+	return nil
+}
+
+var File_template_v1_template_proto protoreflect.FileDescriptor
+
+const file_template_v1_template_proto_rawDesc = "" +
+	"\n" +
+	"\x1atemplate/v1/template.proto\x12\vtemplate.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"notification/v1/notification.proto\x1a\x17validate/validate.proto\"\xcf\x01\n" +
+	"\x10TemplateVariable\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xfaB!r\x1f2\x1d^[A-Za-z_][A-Za-z0-9_]{0,63}$R\x04name\x129\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x19.template.v1.VariableTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04type\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12*\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\vdescription\"\xf0\x01\n" +
+	"\x0fTemplateContent\x12\"\n" +
+	"\asubject\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\xe6\aR\asubject\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x1b\n" +
+	"\thtml_body\x18\x03 \x01(\tR\bhtmlBody\x12E\n" +
+	"\tvariables\x18\x04 \x03(\v2\x1d.template.v1.TemplateVariableB\b\xfaB\x05\x92\x01\x02\x10@R\tvariables\x12A\n" +
+	"\alocales\x18\x05 \x03(\v2\x1d.template.v1.LocalizedContentB\b\xfaB\x05\x92\x01\x02\x10 R\alocales\"\xb2\x01\n" +
+	"\x10LocalizedContent\x12I\n" +
+	"\x06locale\x18\x01 \x01(\tB1\xfaB.r,2*^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8}){0,3}$R\x06locale\x12\"\n" +
+	"\asubject\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xe6\aR\asubject\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1b\n" +
+	"\thtml_body\x18\x04 \x01(\tR\bhtmlBody\"\xd9\x02\n" +
+	"\bTemplate\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x122\n" +
+	"\achannel\x18\x03 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12%\n" +
+	"\x0eactive_version\x18\x05 \x01(\x05R\ractiveVersion\x12%\n" +
+	"\x0elatest_version\x18\x06 \x01(\x05R\rlatestVersion\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbf\x01\n" +
+	"\x0fTemplateVersion\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x126\n" +
+	"\acontent\x18\x03 \x01(\v2\x1c.template.v1.TemplateContentR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa5\x02\n" +
+	"\x15CreateTemplateRequest\x12>\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\x1d\xfaB\x1ar\x182\x16^[A-Za-z0-9_.-]{1,64}$R\n" +
+	"templateId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\x04name\x12>\n" +
+	"\achannel\x18\x03 \x01(\x0e2\x18.notification.v1.ChannelB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\achannel\x12*\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\vdescription\x12@\n" +
+	"\acontent\x18\x05 \x01(\v2\x1c.template.v1.TemplateContentB\b\xfaB\x05\x8a\x01\x02\x10\x01R\acontent\"\x83\x01\n" +
+	"\x16CreateTemplateResponse\x121\n" +
+	"\btemplate\x18\x01 \x01(\v2\x15.template.v1.TemplateR\btemplate\x126\n" +
+	"\aversion\x18\x02 \x01(\v2\x1c.template.v1.TemplateVersionR\aversion\"@\n" +
+	"\x12GetTemplateRequest\x12*\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
+	"templateId\"\x8d\x01\n" +
+	"\x13GetTemplateResponse\x121\n" +
+	"\btemplate\x18\x01 \x01(\v2\x15.template.v1.TemplateR\btemplate\x12C\n" +
+	"\x0eactive_version\x18\x02 \x01(\v2\x1c.template.v1.TemplateVersionR\ractiveVersion\"\xa5\x01\n" +
+	"\x14ListTemplatesRequest\x12<\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelB\b\xfaB\x05\x82\x01\x02\x10\x01R\achannel\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xf4\x03(\x00R\bpageSize\x12&\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\tpageToken\"t\n" +
+	"\x15ListTemplatesResponse\x123\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x15.template.v1.TemplateR\ttemplates\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8f\x01\n" +
+	"\x15UpdateTemplateRequest\x12*\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
+	"templateId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\vdescription\"K\n" +
+	"\x16UpdateTemplateResponse\x121\n" +
+	"\btemplate\x18\x01 \x01(\v2\x15.template.v1.TemplateR\btemplate\"C\n" +
+	"\x15DeleteTemplateRequest\x12*\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
+	"templateId\"\x18\n" +
+	"\x16DeleteTemplateResponse\"\xa8\x01\n" +
+	"\x1cCreateTemplateVersionRequest\x12*\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
+	"templateId\x12@\n" +
+	"\acontent\x18\x02 \x01(\v2\x1c.template.v1.TemplateContentB\b\xfaB\x05\x8a\x01\x02\x10\x01R\acontent\x12\x1a\n" +
+	"\bactivate\x18\x03 \x01(\bR\bactivate\"W\n" +
+	"\x1dCreateTemplateVersionResponse\x126\n" +
+	"\aversion\x18\x01 \x01(\v2\x1c.template.v1.TemplateVersionR\aversion\"I\n" +
+	"\x1bListTemplateVersionsRequest\x12*\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
+	"templateId\"X\n" +
+	"\x1cListTemplateVersionsResponse\x128\n" +
+	"\bversions\x18\x01 \x03(\v2\x1c.template.v1.TemplateVersionR\bversions\"o\n" +
+	"\x1eActivateTemplateVersionRequest\x12*\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
+	"templateId\x12!\n" +
+	"\aversion\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\aversion\"T\n" +
+	"\x1fActivateTemplateVersionResponse\x121\n" +
+	"\btemplate\x18\x01 \x01(\v2\x15.template.v1.TemplateR\btemplate\"\xc5\x03\n" +
+	"\x10TemplateApproval\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vvendor_code\x18\x04 \x01(\tR\n" +
+	"vendorCode\x123\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1b.template.v1.ApprovalStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12=\n" +
+	"\fsubmitted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12>\n" +
+	"\rnext_check_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vnextCheckAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06locale\x18\v \x01(\tR\x06locale\"m\n" +
+	"\x1cListTemplateApprovalsRequest\x12*\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
+	"templateId\x12!\n" +
+	"\aversion\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\aversion\"\\\n" +
+	"\x1dListTemplateApprovalsResponse\x12;\n" +
+	"\tapprovals\x18\x01 \x03(\v2\x1d.template.v1.TemplateApprovalR\tapprovals\"\x93\x01\n" +
+	"\x1dSubmitTemplateApprovalRequest\x12*\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
+	"templateId\x12!\n" +
+	"\aversion\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\aversion\x12#\n" +
+	"\bprovider\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\bprovider\"]\n" +
+	"\x1eSubmitTemplateApprovalResponse\x12;\n" +
+	"\tapprovals\x18\x01 \x03(\v2\x1d.template.v1.TemplateApprovalR\tapprovals\"\xbc\x01\n" +
+	"\bSmsStats\x124\n" +
+	"\bencoding\x18\x01 \x01(\x0e2\x18.template.v1.SmsEncodingR\bencoding\x12\x1e\n" +
+	"\n" +
+	"characters\x18\x02 \x01(\x05R\n" +
+	"characters\x12\x1a\n" +
+	"\bsegments\x18\x03 \x01(\x05R\bsegments\x12\x1f\n" +
+	"\vover_length\x18\x04 \x01(\bR\n" +
+	"overLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x05 \x01(\x05R\tmaxLength\"\xb0\x02\n" +
+	"\x15RenderTemplateRequest\x12*\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
+	"templateId\x12!\n" +
+	"\aversion\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\aversion\x12\x1f\n" +
+	"\x06locale\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18#R\x06locale\x12F\n" +
+	"\x06params\x18\x04 \x03(\v2..template.v1.RenderTemplateRequest.ParamsEntryR\x06params\x12$\n" +
+	"\tsign_name\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18@R\bsignName\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbe\x01\n" +
+	"\x16RenderTemplateResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1b\n" +
+	"\thtml_body\x18\x05 \x01(\tR\bhtmlBody\x12'\n" +
+	"\x03sms\x18\x06 \x01(\v2\x15.template.v1.SmsStatsR\x03sms*\xac\x01\n" +
+	"\fVariableType\x12\x1d\n" +
+	"\x19VARIABLE_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14VARIABLE_TYPE_STRING\x10\x01\x12\x18\n" +
+	"\x14VARIABLE_TYPE_NUMBER\x10\x02\x12\x16\n" +
+	"\x12VARIABLE_TYPE_DATE\x10\x03\x12\x1a\n" +
+	"\x16VARIABLE_TYPE_DATETIME\x10\x04\x12\x15\n" +
+	"\x11VARIABLE_TYPE_URL\x10\x05*\xaa\x01\n" +
+	"\x0eApprovalStatus\x12\x1f\n" +
+	"\x1bAPPROVAL_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aAPPROVAL_STATUS_SUBMITTING\x10\x01\x12\x1b\n" +
+	"\x17APPROVAL_STATUS_PENDING\x10\x02\x12\x1c\n" +
+	"\x18APPROVAL_STATUS_APPROVED\x10\x03\x12\x1c\n" +
+	"\x18APPROVAL_STATUS_REJECTED\x10\x04*Y\n" +
+	"\vSmsEncoding\x12\x1c\n" +
+	"\x18SMS_ENCODING_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SMS_ENCODING_GSM7\x10\x01\x12\x15\n" +
+	"\x11SMS_ENCODING_UCS2\x10\x022\xdd\b\n" +
+	"\x0fTemplateService\x12Y\n" +
+	"\x0eCreateTemplate\x12\".template.v1.CreateTemplateRequest\x1a#.template.v1.CreateTemplateResponse\x12P\n" +
+	"\vGetTemplate\x12\x1f.template.v1.GetTemplateRequest\x1a .template.v1.GetTemplateResponse\x12V\n" +
+	"\rListTemplates\x12!.template.v1.ListTemplatesRequest\x1a\".template.v1.ListTemplatesResponse\x12Y\n" +
+	"\x0eUpdateTemplate\x12\".template.v1.UpdateTemplateRequest\x1a#.template.v1.UpdateTemplateResponse\x12Y\n" +
+	"\x0eDeleteTemplate\x12\".template.v1.DeleteTemplateRequest\x1a#.template.v1.DeleteTemplateResponse\x12n\n" +
+	"\x15CreateTemplateVersion\x12).template.v1.CreateTemplateVersionRequest\x1a*.template.v1.CreateTemplateVersionResponse\x12k\n" +
+	"\x14ListTemplateVersions\x12(.template.v1.ListTemplateVersionsRequest\x1a).template.v1.ListTemplateVersionsResponse\x12t\n" +
+	"\x17ActivateTemplateVersion\x12+.template.v1.ActivateTemplateVersionRequest\x1a,.template.v1.ActivateTemplateVersionResponse\x12n\n" +
+	"\x15ListTemplateApprovals\x12).template.v1.ListTemplateApprovalsRequest\x1a*.template.v1.ListTemplateApprovalsResponse\x12q\n" +
+	"\x16SubmitTemplateApproval\x12*.template.v1.SubmitTemplateApprovalRequest\x1a+.template.v1.SubmitTemplateApprovalResponse\x12Y\n" +
+	"\x0eRenderTemplate\x12\".template.v1.RenderTemplateRequest\x1a#.template.v1.RenderTemplateResponseB\xaf\x01\n" +
+	"\x0fcom.template.v1B\rTemplateProtoP\x01Z@github.com/dingdong-postman/api/proto/gen/template/v1;templatev1\xa2\x02\x03TXX\xaa\x02\vTemplate.V1\xca\x02\vTemplate\\V1\xe2\x02\x17Template\\V1\\GPBMetadata\xea\x02\fTemplate::V1b\x06proto3"
+
+var (
+	file_template_v1_template_proto_rawDescOnce sync.Once
+	file_template_v1_template_proto_rawDescData []byte
+)
+
+func file_template_v1_template_proto_rawDescGZIP() []byte {
+	file_template_v1_template_proto_rawDescOnce.Do(func() {
+		file_template_v1_template_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_template_v1_template_proto_rawDesc), len(file_template_v1_template_proto_rawDesc)))
+	})
+	return file_template_v1_template_proto_rawDescData
+}
+
+var file_template_v1_template_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_template_v1_template_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_template_v1_template_proto_goTypes = []any{
+	(VariableType)(0),                       // 0: template.v1.VariableType
+	(ApprovalStatus)(0),                     // 1: template.v1.ApprovalStatus
+	(SmsEncoding)(0),                        // 2: template.v1.SmsEncoding
+	(*TemplateVariable)(nil),                // 3: template.v1.TemplateVariable
+	(*TemplateContent)(nil),                 // 4: template.v1.TemplateContent
+	(*LocalizedContent)(nil),                // 5: template.v1.LocalizedContent
+	(*Template)(nil),                        // 6: template.v1.Template
+	(*TemplateVersion)(nil),                 // 7: template.v1.TemplateVersion
+	(*CreateTemplateRequest)(nil),           // 8: template.v1.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),          // 9: template.v1.CreateTemplateResponse
+	(*GetTemplateRequest)(nil),              // 10: template.v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),             // 11: template.v1.GetTemplateResponse
+	(*ListTemplatesRequest)(nil),            // 12: template.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),           // 13: template.v1.ListTemplatesResponse
+	(*UpdateTemplateRequest)(nil),           // 14: template.v1.UpdateTemplateRequest
+	(*UpdateTemplateResponse)(nil),          // 15: template.v1.UpdateTemplateResponse
+	(*DeleteTemplateRequest)(nil),           // 16: template.v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),          // 17: template.v1.DeleteTemplateResponse
+	(*CreateTemplateVersionRequest)(nil),    // 18: template.v1.CreateTemplateVersionRequest
+	(*CreateTemplateVersionResponse)(nil),   // 19: template.v1.CreateTemplateVersionResponse
+	(*ListTemplateVersionsRequest)(nil),     // 20: template.v1.ListTemplateVersionsRequest
+	(*ListTemplateVersionsResponse)(nil),    // 21: template.v1.ListTemplateVersionsResponse
+	(*ActivateTemplateVersionRequest)(nil),  // 22: template.v1.ActivateTemplateVersionRequest
+	(*ActivateTemplateVersionResponse)(nil), // 23: template.v1.ActivateTemplateVersionResponse
+	(*TemplateApproval)(nil),                // 24: template.v1.TemplateApproval
+	(*ListTemplateApprovalsRequest)(nil),    // 25: template.v1.ListTemplateApprovalsRequest
+	(*ListTemplateApprovalsResponse)(nil),   // 26: template.v1.ListTemplateApprovalsResponse
+	(*SubmitTemplateApprovalRequest)(nil),   // 27: template.v1.SubmitTemplateApprovalRequest
+	(*SubmitTemplateApprovalResponse)(nil),  // 28: template.v1.SubmitTemplateApprovalResponse
+	(*SmsStats)(nil),                        // 29: template.v1.SmsStats
+	(*RenderTemplateRequest)(nil),           // 30: template.v1.RenderTemplateRequest
+	(*RenderTemplateResponse)(nil),          // 31: template.v1.RenderTemplateResponse
+	nil,                                     // 32: template.v1.RenderTemplateRequest.ParamsEntry
+	(v1.Channel)(0),                         // 33: notification.v1.Channel
+	(*timestamppb.Timestamp)(nil),           // 34: google.protobuf.Timestamp
+}
+var file_template_v1_template_proto_depIdxs = []int32{
+	0,  // 0: template.v1.TemplateVariable.type:type_name -> template.v1.VariableType
+	3,  // 1: template.v1.TemplateContent.variables:type_name -> template.v1.TemplateVariable
+	5,  // 2: template.v1.TemplateContent.locales:type_name -> template.v1.LocalizedContent
+	33, // 3: template.v1.Template.channel:type_name -> notification.v1.Channel
+	34, // 4: template.v1.Template.created_at:type_name -> google.protobuf.Timestamp
+	34, // 5: template.v1.Template.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 6: template.v1.TemplateVersion.content:type_name -> template.v1.TemplateContent
+	34, // 7: template.v1.TemplateVersion.created_at:type_name -> google.protobuf.Timestamp
+	33, // 8: template.v1.CreateTemplateRequest.channel:type_name -> notification.v1.Channel
+	4,  // 9: template.v1.CreateTemplateRequest.content:type_name -> template.v1.TemplateContent
+	6,  // 10: template.v1.CreateTemplateResponse.template:type_name -> template.v1.Template
+	7,  // 11: template.v1.CreateTemplateResponse.version:type_name -> template.v1.TemplateVersion
+	6,  // 12: template.v1.GetTemplateResponse.template:type_name -> template.v1.Template
+	7,  // 13: template.v1.GetTemplateResponse.active_version:type_name -> template.v1.TemplateVersion
+	33, // 14: template.v1.ListTemplatesRequest.channel:type_name -> notification.v1.Channel
+	6,  // 15: template.v1.ListTemplatesResponse.templates:type_name -> template.v1.Template
+	6,  // 16: template.v1.UpdateTemplateResponse.template:type_name -> template.v1.Template
+	4,  // 17: template.v1.CreateTemplateVersionRequest.content:type_name -> template.v1.TemplateContent
+	7,  // 18: template.v1.CreateTemplateVersionResponse.version:type_name -> template.v1.TemplateVersion
+	7,  // 19: template.v1.ListTemplateVersionsResponse.versions:type_name -> template.v1.TemplateVersion
+	6,  // 20: template.v1.ActivateTemplateVersionResponse.template:type_name -> template.v1.Template
+	1,  // 21: template.v1.TemplateApproval.status:type_name -> template.v1.ApprovalStatus
+	34, // 22: template.v1.TemplateApproval.submitted_at:type_name -> google.protobuf.Timestamp
+	34, // 23: template.v1.TemplateApproval.next_check_at:type_name -> google.protobuf.Timestamp
+	34, // 24: template.v1.TemplateApproval.updated_at:type_name -> google.protobuf.Timestamp
+	24, // 25: template.v1.ListTemplateApprovalsResponse.approvals:type_name -> template.v1.TemplateApproval
+	24, // 26: template.v1.SubmitTemplateApprovalResponse.approvals:type_name -> template.v1.TemplateApproval
+	2,  // 27: template.v1.SmsStats.encoding:type_name -> template.v1.SmsEncoding
+	32, // 28: template.v1.RenderTemplateRequest.params:type_name -> template.v1.RenderTemplateRequest.ParamsEntry
+	29, // 29: template.v1.RenderTemplateResponse.sms:type_name -> template.v1.SmsStats
+	8,  // 30: template.v1.TemplateService.CreateTemplate:input_type -> template.v1.CreateTemplateRequest
+	10, // 31: template.v1.TemplateService.GetTemplate:input_type -> template.v1.GetTemplateRequest
+	12, // 32: template.v1.TemplateService.ListTemplates:input_type -> template.v1.ListTemplatesRequest
+	14, // 33: template.v1.TemplateService.UpdateTemplate:input_type -> template.v1.UpdateTemplateRequest
+	16, // 34: template.v1.TemplateService.DeleteTemplate:input_type -> template.v1.DeleteTemplateRequest
+	18, // 35: template.v1.TemplateService.CreateTemplateVersion:input_type -> template.v1.CreateTemplateVersionRequest
+	20, // 36: template.v1.TemplateService.ListTemplateVersions:input_type -> template.v1.ListTemplateVersionsRequest
+	22, // 37: template.v1.TemplateService.ActivateTemplateVersion:input_type -> template.v1.ActivateTemplateVersionRequest
+	25, // 38: template.v1.TemplateService.ListTemplateApprovals:input_type -> template.v1.ListTemplateApprovalsRequest
+	27, // 39: template.v1.TemplateService.SubmitTemplateApproval:input_type -> template.v1.SubmitTemplateApprovalRequest
+	30, // 40: template.v1.TemplateService.RenderTemplate:input_type -> template.v1.RenderTemplateRequest
+	9,  // 41: template.v1.TemplateService.CreateTemplate:output_type -> template.v1.CreateTemplateResponse
+	11, // 42: template.v1.TemplateService.GetTemplate:output_type -> template.v1.GetTemplateResponse
+	13, // 43: template.v1.TemplateService.ListTemplates:output_type -> template.v1.ListTemplatesResponse
+	15, // 44: template.v1.TemplateService.UpdateTemplate:output_type -> template.v1.UpdateTemplateResponse
+	17, // 45: template.v1.TemplateService.DeleteTemplate:output_type -> template.v1.DeleteTemplateResponse
+	19, // 46: template.v1.TemplateService.CreateTemplateVersion:output_type -> template.v1.CreateTemplateVersionResponse
+	21, // 47: template.v1.TemplateService.ListTemplateVersions:output_type -> template.v1.ListTemplateVersionsResponse
+	23, // 48: template.v1.TemplateService.ActivateTemplateVersion:output_type -> template.v1.ActivateTemplateVersionResponse
+	26, // 49: template.v1.TemplateService.ListTemplateApprovals:output_type -> template.v1.ListTemplateApprovalsResponse
+	28, // 50: template.v1.TemplateService.SubmitTemplateApproval:output_type -> template.v1.SubmitTemplateApprovalResponse
+	31, // 51: template.v1.TemplateService.RenderTemplate:output_type -> template.v1.RenderTemplateResponse
+	41, // [41:52] is the sub-list for method output_type
+	30, // [30:41] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_template_v1_template_proto_init() }
+func file_template_v1_template_proto_init() {
+	if File_template_v1_template_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_template_v1_template_proto_rawDesc), len(file_template_v1_template_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_template_v1_template_proto_goTypes,
+		DependencyIndexes: file_template_v1_template_proto_depIdxs,
+		EnumInfos:         file_template_v1_template_proto_enumTypes,
+		MessageInfos:      file_template_v1_template_proto_msgTypes,
+	}.Build()
+	File_template_v1_template_proto = out.File
+	file_template_v1_template_proto_goTypes = nil
+	file_template_v1_template_proto_depIdxs = nil
+}
@@ -0,0 +1,4178 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: template/v1/template.proto
+
+package templatev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = notificationv1.Channel(0)
+)
+
+// Validate checks the field values on TemplateVariable with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateVariable) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateVariable with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TemplateVariableMultiError, or nil if none found.
+func (m *TemplateVariable) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateVariable) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_TemplateVariable_Name_Pattern.MatchString(m.GetName()) {
+		err := TemplateVariableValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z_][A-Za-z0-9_]{0,63}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _TemplateVariable_Type_NotInLookup[m.GetType()]; ok {
+		err := TemplateVariableValidationError{
+			field:  "Type",
+			reason: "value must not be in list [VARIABLE_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := VariableType_name[int32(m.GetType())]; !ok {
+		err := TemplateVariableValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Required
+
+	if utf8.RuneCountInString(m.GetDescription()) > 256 {
+		err := TemplateVariableValidationError{
+			field:  "Description",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TemplateVariableMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateVariableMultiError is an error wrapping multiple validation errors
+// returned by TemplateVariable.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateVariableMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateVariableMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateVariableMultiError) AllErrors() []error { return m }
+
+// TemplateVariableValidationError is the validation error returned by
+// TemplateVariable.Validate if the designated constraints aren't met.
+type TemplateVariableValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateVariableValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateVariableValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateVariableValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateVariableValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateVariableValidationError) ErrorName() string { return "TemplateVariableValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateVariableValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateVariable.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateVariableValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateVariableValidationError{}
+
+var _TemplateVariable_Name_Pattern = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]{0,63}$")
+
+var _TemplateVariable_Type_NotInLookup = map[VariableType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on TemplateContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateContent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TemplateContentMultiError, or nil if none found.
+func (m *TemplateContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSubject()) > 998 {
+		err := TemplateContentValidationError{
+			field:  "Subject",
+			reason: "value length must be at most 998 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Body
+
+	// no validation rules for HtmlBody
+
+	if len(m.GetVariables()) > 64 {
+		err := TemplateContentValidationError{
+			field:  "Variables",
+			reason: "value must contain no more than 64 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TemplateContentValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TemplateContentValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TemplateContentValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetLocales()) > 32 {
+		err := TemplateContentValidationError{
+			field:  "Locales",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetLocales() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TemplateContentValidationError{
+						field:  fmt.Sprintf("Locales[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TemplateContentValidationError{
+						field:  fmt.Sprintf("Locales[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TemplateContentValidationError{
+					field:  fmt.Sprintf("Locales[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TemplateContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateContentMultiError is an error wrapping multiple validation errors
+// returned by TemplateContent.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateContentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateContentMultiError) AllErrors() []error { return m }
+
+// TemplateContentValidationError is the validation error returned by
+// TemplateContent.Validate if the designated constraints aren't met.
+type TemplateContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateContentValidationError) ErrorName() string { return "TemplateContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateContentValidationError{}
+
+// Validate checks the field values on LocalizedContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LocalizedContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocalizedContent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocalizedContentMultiError, or nil if none found.
+func (m *LocalizedContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocalizedContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_LocalizedContent_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := LocalizedContentValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8}){0,3}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSubject()) > 998 {
+		err := LocalizedContentValidationError{
+			field:  "Subject",
+			reason: "value length must be at most 998 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Body
+
+	// no validation rules for HtmlBody
+
+	if len(errors) > 0 {
+		return LocalizedContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocalizedContentMultiError is an error wrapping multiple validation errors
+// returned by LocalizedContent.ValidateAll() if the designated constraints
+// aren't met.
+type LocalizedContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocalizedContentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocalizedContentMultiError) AllErrors() []error { return m }
+
+// LocalizedContentValidationError is the validation error returned by
+// LocalizedContent.Validate if the designated constraints aren't met.
+type LocalizedContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocalizedContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocalizedContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocalizedContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocalizedContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocalizedContentValidationError) ErrorName() string { return "LocalizedContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocalizedContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocalizedContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocalizedContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocalizedContentValidationError{}
+
+var _LocalizedContent_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8}){0,3}$")
+
+// Validate checks the field values on Template with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Template) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Template with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TemplateMultiError, or nil
+// if none found.
+func (m *Template) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Template) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Name
+
+	// no validation rules for Channel
+
+	// no validation rules for Description
+
+	// no validation rules for ActiveVersion
+
+	// no validation rules for LatestVersion
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateMultiError is an error wrapping multiple validation errors returned
+// by Template.ValidateAll() if the designated constraints aren't met.
+type TemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateMultiError) AllErrors() []error { return m }
+
+// TemplateValidationError is the validation error returned by
+// Template.Validate if the designated constraints aren't met.
+type TemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateValidationError) ErrorName() string { return "TemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateValidationError{}
+
+// Validate checks the field values on TemplateVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TemplateVersionMultiError, or nil if none found.
+func (m *TemplateVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateVersionValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateVersionValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateVersionValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateVersionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TemplateVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateVersionMultiError is an error wrapping multiple validation errors
+// returned by TemplateVersion.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateVersionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateVersionMultiError) AllErrors() []error { return m }
+
+// TemplateVersionValidationError is the validation error returned by
+// TemplateVersion.Validate if the designated constraints aren't met.
+type TemplateVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateVersionValidationError) ErrorName() string { return "TemplateVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateVersionValidationError{}
+
+// Validate checks the field values on CreateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTemplateRequestMultiError, or nil if none found.
+func (m *CreateTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CreateTemplateRequest_TemplateId_Pattern.MatchString(m.GetTemplateId()) {
+		err := CreateTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_.-]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 128 {
+		err := CreateTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateTemplateRequest_Channel_NotInLookup[m.GetChannel()]; ok {
+		err := CreateTemplateRequestValidationError{
+			field:  "Channel",
+			reason: "value must not be in list [CHANNEL_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := notificationv1.Channel_name[int32(m.GetChannel())]; !ok {
+		err := CreateTemplateRequestValidationError{
+			field:  "Channel",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 512 {
+		err := CreateTemplateRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetContent() == nil {
+		err := CreateTemplateRequestValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTemplateRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTemplateRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTemplateRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateTemplateRequestValidationError is the validation error returned by
+// CreateTemplateRequest.Validate if the designated constraints aren't met.
+type CreateTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTemplateRequestValidationError) ErrorName() string {
+	return "CreateTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTemplateRequestValidationError{}
+
+var _CreateTemplateRequest_TemplateId_Pattern = regexp.MustCompile("^[A-Za-z0-9_.-]{1,64}$")
+
+var _CreateTemplateRequest_Channel_NotInLookup = map[notificationv1.Channel]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTemplateResponseMultiError, or nil if none found.
+func (m *CreateTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTemplateResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTemplateResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTemplateResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTemplateResponseMultiError) AllErrors() []error { return m }
+
+// CreateTemplateResponseValidationError is the validation error returned by
+// CreateTemplateResponse.Validate if the designated constraints aren't met.
+type CreateTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTemplateResponseValidationError) ErrorName() string {
+	return "CreateTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTemplateResponseValidationError{}
+
+// Validate checks the field values on GetTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTemplateRequestMultiError, or nil if none found.
+func (m *GetTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateId()); l < 1 || l > 64 {
+		err := GetTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTemplateRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTemplateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GetTemplateRequestValidationError is the validation error returned by
+// GetTemplateRequest.Validate if the designated constraints aren't met.
+type GetTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTemplateRequestValidationError) ErrorName() string {
+	return "GetTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTemplateRequestValidationError{}
+
+// Validate checks the field values on GetTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTemplateResponseMultiError, or nil if none found.
+func (m *GetTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetActiveVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTemplateResponseValidationError{
+					field:  "ActiveVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTemplateResponseValidationError{
+					field:  "ActiveVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActiveVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTemplateResponseValidationError{
+				field:  "ActiveVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GetTemplateResponseValidationError is the validation error returned by
+// GetTemplateResponse.Validate if the designated constraints aren't met.
+type GetTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTemplateResponseValidationError) ErrorName() string {
+	return "GetTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTemplateResponseValidationError{}
+
+// Validate checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplatesRequestMultiError, or nil if none found.
+func (m *ListTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := notificationv1.Channel_name[int32(m.GetChannel())]; !ok {
+		err := ListTemplatesRequestValidationError{
+			field:  "Channel",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		err := ListTemplatesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 64 {
+		err := ListTemplatesRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTemplatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ListTemplatesRequestValidationError is the validation error returned by
+// ListTemplatesRequest.Validate if the designated constraints aren't met.
+type ListTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesRequestValidationError) ErrorName() string {
+	return "ListTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesRequestValidationError{}
+
+// Validate checks the field values on ListTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplatesResponseMultiError, or nil if none found.
+func (m *ListTemplatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTemplatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplatesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTemplatesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTemplatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplatesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplatesResponseMultiError) AllErrors() []error { return m }
+
+// ListTemplatesResponseValidationError is the validation error returned by
+// ListTemplatesResponse.Validate if the designated constraints aren't met.
+type ListTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesResponseValidationError) ErrorName() string {
+	return "ListTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesResponseValidationError{}
+
+// Validate checks the field values on UpdateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTemplateRequestMultiError, or nil if none found.
+func (m *UpdateTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateId()); l < 1 || l > 64 {
+		err := UpdateTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 128 {
+		err := UpdateTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 512 {
+		err := UpdateTemplateRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTemplateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTemplateRequestValidationError is the validation error returned by
+// UpdateTemplateRequest.Validate if the designated constraints aren't met.
+type UpdateTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTemplateRequestValidationError) ErrorName() string {
+	return "UpdateTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTemplateRequestValidationError{}
+
+// Validate checks the field values on UpdateTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTemplateResponseMultiError, or nil if none found.
+func (m *UpdateTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTemplateResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTemplateResponseValidationError is the validation error returned by
+// UpdateTemplateResponse.Validate if the designated constraints aren't met.
+type UpdateTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTemplateResponseValidationError) ErrorName() string {
+	return "UpdateTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTemplateResponseValidationError{}
+
+// Validate checks the field values on DeleteTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTemplateRequestMultiError, or nil if none found.
+func (m *DeleteTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateId()); l < 1 || l > 64 {
+		err := DeleteTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTemplateRequestValidationError is the validation error returned by
+// DeleteTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTemplateRequestValidationError) ErrorName() string {
+	return "DeleteTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTemplateResponseMultiError, or nil if none found.
+func (m *DeleteTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTemplateResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTemplateResponseValidationError is the validation error returned by
+// DeleteTemplateResponse.Validate if the designated constraints aren't met.
+type DeleteTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTemplateResponseValidationError) ErrorName() string {
+	return "DeleteTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTemplateResponseValidationError{}
+
+// Validate checks the field values on CreateTemplateVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTemplateVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTemplateVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTemplateVersionRequestMultiError, or nil if none found.
+func (m *CreateTemplateVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTemplateVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateId()); l < 1 || l > 64 {
+		err := CreateTemplateVersionRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetContent() == nil {
+		err := CreateTemplateVersionRequestValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTemplateVersionRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTemplateVersionRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTemplateVersionRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Activate
+
+	if len(errors) > 0 {
+		return CreateTemplateVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTemplateVersionRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateTemplateVersionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTemplateVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTemplateVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTemplateVersionRequestMultiError) AllErrors() []error { return m }
+
+// CreateTemplateVersionRequestValidationError is the validation error returned
+// by CreateTemplateVersionRequest.Validate if the designated constraints
+// aren't met.
+type CreateTemplateVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTemplateVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTemplateVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTemplateVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTemplateVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTemplateVersionRequestValidationError) ErrorName() string {
+	return "CreateTemplateVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTemplateVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTemplateVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTemplateVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTemplateVersionRequestValidationError{}
+
+// Validate checks the field values on CreateTemplateVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTemplateVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTemplateVersionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateTemplateVersionResponseMultiError, or nil if none found.
+func (m *CreateTemplateVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTemplateVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTemplateVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTemplateVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTemplateVersionResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTemplateVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTemplateVersionResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateTemplateVersionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateTemplateVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTemplateVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTemplateVersionResponseMultiError) AllErrors() []error { return m }
+
+// CreateTemplateVersionResponseValidationError is the validation error
+// returned by CreateTemplateVersionResponse.Validate if the designated
+// constraints aren't met.
+type CreateTemplateVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTemplateVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTemplateVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTemplateVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTemplateVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTemplateVersionResponseValidationError) ErrorName() string {
+	return "CreateTemplateVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTemplateVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTemplateVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTemplateVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTemplateVersionResponseValidationError{}
+
+// Validate checks the field values on ListTemplateVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplateVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplateVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplateVersionsRequestMultiError, or nil if none found.
+func (m *ListTemplateVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplateVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateId()); l < 1 || l > 64 {
+		err := ListTemplateVersionsRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTemplateVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplateVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListTemplateVersionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListTemplateVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplateVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplateVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListTemplateVersionsRequestValidationError is the validation error returned
+// by ListTemplateVersionsRequest.Validate if the designated constraints
+// aren't met.
+type ListTemplateVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplateVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplateVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplateVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplateVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplateVersionsRequestValidationError) ErrorName() string {
+	return "ListTemplateVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplateVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplateVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplateVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplateVersionsRequestValidationError{}
+
+// Validate checks the field values on ListTemplateVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplateVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplateVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplateVersionsResponseMultiError, or nil if none found.
+func (m *ListTemplateVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplateVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTemplateVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTemplateVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplateVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTemplateVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplateVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListTemplateVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListTemplateVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplateVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplateVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListTemplateVersionsResponseValidationError is the validation error returned
+// by ListTemplateVersionsResponse.Validate if the designated constraints
+// aren't met.
+type ListTemplateVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplateVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplateVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplateVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplateVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplateVersionsResponseValidationError) ErrorName() string {
+	return "ListTemplateVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplateVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplateVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplateVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplateVersionsResponseValidationError{}
+
+// Validate checks the field values on ActivateTemplateVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActivateTemplateVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivateTemplateVersionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ActivateTemplateVersionRequestMultiError, or nil if none found.
+func (m *ActivateTemplateVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivateTemplateVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateId()); l < 1 || l > 64 {
+		err := ActivateTemplateVersionRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() <= 0 {
+		err := ActivateTemplateVersionRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ActivateTemplateVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivateTemplateVersionRequestMultiError is an error wrapping multiple
+// validation errors returned by ActivateTemplateVersionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ActivateTemplateVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivateTemplateVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivateTemplateVersionRequestMultiError) AllErrors() []error { return m }
+
+// ActivateTemplateVersionRequestValidationError is the validation error
+// returned by ActivateTemplateVersionRequest.Validate if the designated
+// constraints aren't met.
+type ActivateTemplateVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivateTemplateVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivateTemplateVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivateTemplateVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivateTemplateVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivateTemplateVersionRequestValidationError) ErrorName() string {
+	return "ActivateTemplateVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivateTemplateVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivateTemplateVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivateTemplateVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivateTemplateVersionRequestValidationError{}
+
+// Validate checks the field values on ActivateTemplateVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActivateTemplateVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivateTemplateVersionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ActivateTemplateVersionResponseMultiError, or nil if none found.
+func (m *ActivateTemplateVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivateTemplateVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActivateTemplateVersionResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActivateTemplateVersionResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActivateTemplateVersionResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ActivateTemplateVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivateTemplateVersionResponseMultiError is an error wrapping multiple
+// validation errors returned by ActivateTemplateVersionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ActivateTemplateVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivateTemplateVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivateTemplateVersionResponseMultiError) AllErrors() []error { return m }
+
+// ActivateTemplateVersionResponseValidationError is the validation error
+// returned by ActivateTemplateVersionResponse.Validate if the designated
+// constraints aren't met.
+type ActivateTemplateVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivateTemplateVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivateTemplateVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivateTemplateVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivateTemplateVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivateTemplateVersionResponseValidationError) ErrorName() string {
+	return "ActivateTemplateVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivateTemplateVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivateTemplateVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivateTemplateVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivateTemplateVersionResponseValidationError{}
+
+// Validate checks the field values on TemplateApproval with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateApproval) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateApproval with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TemplateApprovalMultiError, or nil if none found.
+func (m *TemplateApproval) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateApproval) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Version
+
+	// no validation rules for Provider
+
+	// no validation rules for VendorCode
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Attempts
+
+	if all {
+		switch v := interface{}(m.GetSubmittedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateApprovalValidationError{
+					field:  "SubmittedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateApprovalValidationError{
+					field:  "SubmittedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubmittedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateApprovalValidationError{
+				field:  "SubmittedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextCheckAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateApprovalValidationError{
+					field:  "NextCheckAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateApprovalValidationError{
+					field:  "NextCheckAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextCheckAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateApprovalValidationError{
+				field:  "NextCheckAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateApprovalValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateApprovalValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateApprovalValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return TemplateApprovalMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateApprovalMultiError is an error wrapping multiple validation errors
+// returned by TemplateApproval.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateApprovalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateApprovalMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateApprovalMultiError) AllErrors() []error { return m }
+
+// TemplateApprovalValidationError is the validation error returned by
+// TemplateApproval.Validate if the designated constraints aren't met.
+type TemplateApprovalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateApprovalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateApprovalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateApprovalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateApprovalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateApprovalValidationError) ErrorName() string { return "TemplateApprovalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateApprovalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateApproval.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateApprovalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateApprovalValidationError{}
+
+// Validate checks the field values on ListTemplateApprovalsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplateApprovalsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplateApprovalsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplateApprovalsRequestMultiError, or nil if none found.
+func (m *ListTemplateApprovalsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplateApprovalsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateId()); l < 1 || l > 64 {
+		err := ListTemplateApprovalsRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 0 {
+		err := ListTemplateApprovalsRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTemplateApprovalsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplateApprovalsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListTemplateApprovalsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListTemplateApprovalsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplateApprovalsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplateApprovalsRequestMultiError) AllErrors() []error { return m }
+
+// ListTemplateApprovalsRequestValidationError is the validation error returned
+// by ListTemplateApprovalsRequest.Validate if the designated constraints
+// aren't met.
+type ListTemplateApprovalsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplateApprovalsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplateApprovalsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplateApprovalsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplateApprovalsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplateApprovalsRequestValidationError) ErrorName() string {
+	return "ListTemplateApprovalsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplateApprovalsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplateApprovalsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplateApprovalsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplateApprovalsRequestValidationError{}
+
+// Validate checks the field values on ListTemplateApprovalsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplateApprovalsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplateApprovalsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListTemplateApprovalsResponseMultiError, or nil if none found.
+func (m *ListTemplateApprovalsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplateApprovalsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApprovals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTemplateApprovalsResponseValidationError{
+						field:  fmt.Sprintf("Approvals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTemplateApprovalsResponseValidationError{
+						field:  fmt.Sprintf("Approvals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplateApprovalsResponseValidationError{
+					field:  fmt.Sprintf("Approvals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTemplateApprovalsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplateApprovalsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListTemplateApprovalsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListTemplateApprovalsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplateApprovalsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplateApprovalsResponseMultiError) AllErrors() []error { return m }
+
+// ListTemplateApprovalsResponseValidationError is the validation error
+// returned by ListTemplateApprovalsResponse.Validate if the designated
+// constraints aren't met.
+type ListTemplateApprovalsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplateApprovalsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplateApprovalsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplateApprovalsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplateApprovalsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplateApprovalsResponseValidationError) ErrorName() string {
+	return "ListTemplateApprovalsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplateApprovalsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplateApprovalsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplateApprovalsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplateApprovalsResponseValidationError{}
+
+// Validate checks the field values on SubmitTemplateApprovalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitTemplateApprovalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitTemplateApprovalRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SubmitTemplateApprovalRequestMultiError, or nil if none found.
+func (m *SubmitTemplateApprovalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitTemplateApprovalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateId()); l < 1 || l > 64 {
+		err := SubmitTemplateApprovalRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() <= 0 {
+		err := SubmitTemplateApprovalRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProvider()) > 64 {
+		err := SubmitTemplateApprovalRequestValidationError{
+			field:  "Provider",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitTemplateApprovalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitTemplateApprovalRequestMultiError is an error wrapping multiple
+// validation errors returned by SubmitTemplateApprovalRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SubmitTemplateApprovalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitTemplateApprovalRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitTemplateApprovalRequestMultiError) AllErrors() []error { return m }
+
+// SubmitTemplateApprovalRequestValidationError is the validation error
+// returned by SubmitTemplateApprovalRequest.Validate if the designated
+// constraints aren't met.
+type SubmitTemplateApprovalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitTemplateApprovalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitTemplateApprovalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitTemplateApprovalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitTemplateApprovalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitTemplateApprovalRequestValidationError) ErrorName() string {
+	return "SubmitTemplateApprovalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitTemplateApprovalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitTemplateApprovalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitTemplateApprovalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitTemplateApprovalRequestValidationError{}
+
+// Validate checks the field values on SubmitTemplateApprovalResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitTemplateApprovalResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitTemplateApprovalResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SubmitTemplateApprovalResponseMultiError, or nil if none found.
+func (m *SubmitTemplateApprovalResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitTemplateApprovalResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApprovals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubmitTemplateApprovalResponseValidationError{
+						field:  fmt.Sprintf("Approvals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubmitTemplateApprovalResponseValidationError{
+						field:  fmt.Sprintf("Approvals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubmitTemplateApprovalResponseValidationError{
+					field:  fmt.Sprintf("Approvals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubmitTemplateApprovalResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitTemplateApprovalResponseMultiError is an error wrapping multiple
+// validation errors returned by SubmitTemplateApprovalResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SubmitTemplateApprovalResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitTemplateApprovalResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitTemplateApprovalResponseMultiError) AllErrors() []error { return m }
+
+// SubmitTemplateApprovalResponseValidationError is the validation error
+// returned by SubmitTemplateApprovalResponse.Validate if the designated
+// constraints aren't met.
+type SubmitTemplateApprovalResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitTemplateApprovalResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitTemplateApprovalResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitTemplateApprovalResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitTemplateApprovalResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitTemplateApprovalResponseValidationError) ErrorName() string {
+	return "SubmitTemplateApprovalResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitTemplateApprovalResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitTemplateApprovalResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitTemplateApprovalResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitTemplateApprovalResponseValidationError{}
+
+// Validate checks the field values on SmsStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SmsStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SmsStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SmsStatsMultiError, or nil
+// if none found.
+func (m *SmsStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SmsStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Encoding
+
+	// no validation rules for Characters
+
+	// no validation rules for Segments
+
+	// no validation rules for OverLength
+
+	// no validation rules for MaxLength
+
+	if len(errors) > 0 {
+		return SmsStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SmsStatsMultiError is an error wrapping multiple validation errors returned
+// by SmsStats.ValidateAll() if the designated constraints aren't met.
+type SmsStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SmsStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SmsStatsMultiError) AllErrors() []error { return m }
+
+// SmsStatsValidationError is the validation error returned by
+// SmsStats.Validate if the designated constraints aren't met.
+type SmsStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SmsStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SmsStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SmsStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SmsStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SmsStatsValidationError) ErrorName() string { return "SmsStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SmsStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSmsStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SmsStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SmsStatsValidationError{}
+
+// Validate checks the field values on RenderTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenderTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenderTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenderTemplateRequestMultiError, or nil if none found.
+func (m *RenderTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenderTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateId()); l < 1 || l > 64 {
+		err := RenderTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 0 {
+		err := RenderTemplateRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := RenderTemplateRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Params
+
+	if utf8.RuneCountInString(m.GetSignName()) > 64 {
+		err := RenderTemplateRequestValidationError{
+			field:  "SignName",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenderTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenderTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by RenderTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenderTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenderTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenderTemplateRequestMultiError) AllErrors() []error { return m }
+
+// RenderTemplateRequestValidationError is the validation error returned by
+// RenderTemplateRequest.Validate if the designated constraints aren't met.
+type RenderTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenderTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenderTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenderTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenderTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenderTemplateRequestValidationError) ErrorName() string {
+	return "RenderTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenderTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenderTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenderTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenderTemplateRequestValidationError{}
+
+// Validate checks the field values on RenderTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenderTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenderTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenderTemplateResponseMultiError, or nil if none found.
+func (m *RenderTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenderTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Locale
+
+	// no validation rules for Subject
+
+	// no validation rules for Body
+
+	// no validation rules for HtmlBody
+
+	if all {
+		switch v := interface{}(m.GetSms()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenderTemplateResponseValidationError{
+					field:  "Sms",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenderTemplateResponseValidationError{
+					field:  "Sms",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSms()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenderTemplateResponseValidationError{
+				field:  "Sms",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenderTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenderTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by RenderTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RenderTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenderTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenderTemplateResponseMultiError) AllErrors() []error { return m }
+
+// RenderTemplateResponseValidationError is the validation error returned by
+// RenderTemplateResponse.Validate if the designated constraints aren't met.
+type RenderTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenderTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenderTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenderTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenderTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenderTemplateResponseValidationError) ErrorName() string {
+	return "RenderTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenderTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenderTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenderTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenderTemplateResponseValidationError{}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/service/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// adminMethodPrefix 管理接口的方法名前缀
+	adminMethodPrefix = "/admin.v1."
+	// apiKeyHeader 业务方携带 API Key 的 metadata 键
+	apiKeyHeader = "x-api-key"
+	// adminTokenHeader 管理员携带管理接口令牌的 metadata 键
+	adminTokenHeader = "x-admin-token"
+	// authorizationHeader 也可以通过 authorization: Bearer <API Key> 携带 API Key
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// AuthUnaryInterceptor 租户鉴权拦截器
+// 管理接口（admin.v1）校验管理接口令牌；其余接口按 API Key 解析租户并放入 context，
+// 渠道与配额限制由通知服务按 context 中的租户执行
+func AuthUnaryInterceptor(tenants tenant.Service, adminToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		if strings.HasPrefix(info.FullMethod, adminMethodPrefix) {
+			token := firstValue(md, adminTokenHeader)
+			if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+				return nil, status.Error(codes.Unauthenticated, "管理接口令牌无效")
+			}
+			return handler(ctx, req)
+		}
+
+		apiKey := firstValue(md, apiKeyHeader)
+		if apiKey == "" {
+			if auth := firstValue(md, authorizationHeader); strings.HasPrefix(auth, bearerPrefix) {
+				apiKey = strings.TrimPrefix(auth, bearerPrefix)
+			}
+		}
+		if apiKey == "" {
+			return nil, status.Error(codes.Unauthenticated, "缺少 API Key")
+		}
+		t, err := tenants.Authenticate(ctx, apiKey)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		return handler(domain.WithTenant(ctx, t), req)
+	}
+}
+
+// firstValue 取 metadata 中键的第一个值
+func firstValue(md metadata.MD, key string) string {
+	if vs := md.Get(key); len(vs) > 0 {
+		return vs[0]
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/service/callback"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CallbackServer 通知结果回调服务的 gRPC 实现
+type CallbackServer struct {
+	svc callback.Service
+}
+
+// NewCallbackServer 创建通知结果回调服务的 gRPC 实现
+func NewCallbackServer(svc callback.Service) *CallbackServer {
+	return &CallbackServer{
+		svc: svc,
+	}
+}
+
+// ListCallbacks 按通知 ID 或业务键查询通知的回调历史
+func (s *CallbackServer) ListCallbacks(
+	ctx context.Context,
+	req *notificationv1.ListCallbacksRequest,
+) (*notificationv1.ListCallbacksResponse, error) {
+	var (
+		id        uint64
+		histories []callback.History
+		err       error
+	)
+	switch key := req.GetKey().(type) {
+	case *notificationv1.ListCallbacksRequest_NotificationId:
+		id, histories, err = s.svc.ListByID(ctx, key.NotificationId)
+	case *notificationv1.ListCallbacksRequest_BizKey:
+		id, histories, err = s.svc.ListByBizKey(ctx, key.BizKey)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "notification_id 或 biz_key 必须指定其一")
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &notificationv1.ListCallbacksResponse{
+		NotificationId: id,
+		Callbacks:      make([]*notificationv1.Callback, 0, len(histories)),
+	}
+	for _, h := range histories {
+		resp.Callbacks = append(resp.Callbacks, toProtoCallback(h))
+	}
+	return resp, nil
+}
+
+func toProtoCallback(h callback.History) *notificationv1.Callback {
+	cb := h.Callback
+	attempts := make([]*notificationv1.CallbackAttempt, 0, len(h.Attempts))
+	for _, a := range h.Attempts {
+		attempts = append(attempts, &notificationv1.CallbackAttempt{
+			Attempt:     int32(a.Attempt),    //nolint:gosec // 投递次数不超过 callback.retry.max_attempts
+			StatusCode:  int32(a.StatusCode), //nolint:gosec // HTTP 状态码
+			Error:       a.Error,
+			Duration:    durationpb.New(a.Duration),
+			AttemptedAt: timestamppb.New(a.AttemptedAt),
+		})
+	}
+	return &notificationv1.Callback{
+		CallbackId:         cb.ID,
+		Url:                cb.URL,
+		NotificationStatus: toProtoStatus(cb.NotificationStatus),
+		Status:             toProtoCallbackStatus(cb.Status),
+		Attempts:           int32(cb.Attempts), //nolint:gosec // 投递次数不超过 callback.retry.max_attempts
+		NextAttemptAt:      toProtoTimestamp(cb.NextAttemptAt),
+		LastError:          cb.LastError,
+		CreatedAt:          timestamppb.New(cb.CreatedAt),
+		AttemptLog:         attempts,
+	}
+}
+
+func toProtoCallbackStatus(s domain.CallbackStatus) notificationv1.CallbackStatus {
+	switch s {
+	case domain.CallbackStatusPending:
+		return notificationv1.CallbackStatus_CALLBACK_STATUS_PENDING
+	case domain.CallbackStatusSucceeded:
+		return notificationv1.CallbackStatus_CALLBACK_STATUS_SUCCEEDED
+	case domain.CallbackStatusFailed:
+		return notificationv1.CallbackStatus_CALLBACK_STATUS_FAILED
+	default:
+		return notificationv1.CallbackStatus_CALLBACK_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,174 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+
+	adminv1 "github.com/dingdong-postman/api/proto/gen/admin/v1"
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/service/deadletter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DeadLetterServer 死信管理服务的 gRPC 实现
+type DeadLetterServer struct {
+	svc deadletter.Service
+}
+
+// NewDeadLetterServer 创建死信管理服务的 gRPC 实现
+func NewDeadLetterServer(svc deadletter.Service) *DeadLetterServer {
+	return &DeadLetterServer{
+		svc: svc,
+	}
+}
+
+// ListDeadLetters 分页查询死信，分页令牌为上一页最后一条死信的通知 ID
+func (s *DeadLetterServer) ListDeadLetters(
+	ctx context.Context,
+	req *adminv1.ListDeadLettersRequest,
+) (*adminv1.ListDeadLettersResponse, error) {
+	filter := toDomainDeadLetterFilter(req.GetFilter())
+	filter.Limit = int(req.GetPageSize())
+	if filter.Limit == 0 {
+		filter.Limit = deadletter.DefaultPageSize
+	}
+	if token := req.GetPageToken(); token != "" {
+		afterID, err := strconv.ParseUint(token, 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "page_token 无效")
+		}
+		filter.AfterID = afterID
+	}
+
+	dls, err := s.svc.List(ctx, filter)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &adminv1.ListDeadLettersResponse{
+		DeadLetters: make([]*adminv1.DeadLetter, 0, len(dls)),
+	}
+	for i := range dls {
+		resp.DeadLetters = append(resp.DeadLetters, toProtoDeadLetter(dls[i]))
+	}
+	if len(dls) == filter.Limit {
+		resp.NextPageToken = strconv.FormatUint(dls[len(dls)-1].NotificationID, 10)
+	}
+	return resp, nil
+}
+
+// GetDeadLetter 查看单条死信及通知内容
+func (s *DeadLetterServer) GetDeadLetter(
+	ctx context.Context,
+	req *adminv1.GetDeadLetterRequest,
+) (*adminv1.GetDeadLetterResponse, error) {
+	dl, n, err := s.svc.Get(ctx, req.GetNotificationId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &adminv1.GetDeadLetterResponse{
+		DeadLetter: toProtoDeadLetter(dl),
+		Record:     toRecord(n),
+	}, nil
+}
+
+// UpdateDeadLetter 修改死信通知的内容
+func (s *DeadLetterServer) UpdateDeadLetter(
+	ctx context.Context,
+	req *adminv1.UpdateDeadLetterRequest,
+) (*adminv1.UpdateDeadLetterResponse, error) {
+	patch := domain.NotificationPatch{
+		Recipient:  req.Recipient,
+		TemplateID: req.TemplateId,
+		Email:      toDomainEmail(req.GetEmail()),
+	}
+	if req.GetTemplateParams() != nil {
+		patch.TemplateParams = req.GetTemplateParams().GetValues()
+		if patch.TemplateParams == nil {
+			patch.TemplateParams = map[string]string{}
+		}
+	}
+
+	dl, n, err := s.svc.Edit(ctx, req.GetNotificationId(), patch)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &adminv1.UpdateDeadLetterResponse{
+		DeadLetter: toProtoDeadLetter(dl),
+		Record:     toRecord(n),
+	}, nil
+}
+
+// RequeueDeadLetters 批量将死信重新入队
+func (s *DeadLetterServer) RequeueDeadLetters(
+	ctx context.Context,
+	req *adminv1.RequeueDeadLettersRequest,
+) (*adminv1.RequeueDeadLettersResponse, error) {
+	var ids []uint64
+	switch selector := req.GetSelector().(type) {
+	case *adminv1.RequeueDeadLettersRequest_NotificationIds:
+		ids = selector.NotificationIds.GetValues()
+	case *adminv1.RequeueDeadLettersRequest_Filter:
+		filter := toDomainDeadLetterFilter(selector.Filter.GetFilter())
+		filter.Limit = int(selector.Filter.GetLimit())
+		dls, err := s.svc.List(ctx, filter)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		ids = make([]uint64, 0, len(dls))
+		for i := range dls {
+			ids = append(ids, dls[i].NotificationID)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "notification_ids 或 filter 必须指定其一")
+	}
+
+	results := s.svc.Requeue(ctx, ids)
+	resp := &adminv1.RequeueDeadLettersResponse{
+		Results: make([]*adminv1.RequeueResult, 0, len(results)),
+	}
+	for _, result := range results {
+		r := &adminv1.RequeueResult{
+			NotificationId: result.NotificationID,
+			Requeued:       result.Err == nil,
+		}
+		if result.Err != nil {
+			r.ErrorMessage = result.Err.Error()
+		}
+		resp.Results = append(resp.Results, r)
+	}
+	return resp, nil
+}
+
+func toDomainDeadLetterFilter(f *adminv1.DeadLetterFilter) domain.DeadLetterFilter {
+	if f == nil {
+		return domain.DeadLetterFilter{}
+	}
+	filter := domain.DeadLetterFilter{
+		Channel:       toDomainChannel(f.GetChannel()),
+		ErrorCategory: f.GetErrorCategory(),
+	}
+	if f.GetCreatedAfter() != nil {
+		filter.CreatedAfter = f.GetCreatedAfter().AsTime()
+	}
+	if f.GetCreatedBefore() != nil {
+		filter.CreatedBefore = f.GetCreatedBefore().AsTime()
+	}
+	return filter
+}
+
+func toProtoDeadLetter(dl domain.DeadLetter) *adminv1.DeadLetter {
+	return &adminv1.DeadLetter{
+		NotificationId: dl.NotificationID,
+		BizKey:         dl.BizKey,
+		Channel:        toProtoChannel(dl.Channel),
+		Recipient:      dl.Recipient,
+		Attempts:       int32(dl.Attempts), //nolint:gosec // 发送次数受重试策略限制，不会溢出
+		ErrorCategory:  dl.ErrorCategory,
+		LastError:      dl.LastError,
+		Provider:       dl.Provider,
+		CreatedAt:      timestamppb.New(dl.CreatedAt),
+		UpdatedAt:      timestamppb.New(dl.UpdatedAt),
+	}
+}
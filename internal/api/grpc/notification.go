@@ -0,0 +1,463 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	"github.com/dingdong-postman/internal/domain"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/service/notification"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 受理请求返回 ResourceExhausted 时，错误详情中 errdetails.ErrorInfo 的 Domain 与 Reason，用于区分被限流与配额用完
+const (
+	// ErrorDomain ErrorInfo 的 Domain
+	ErrorDomain = "dingdong-postman"
+	// ReasonRateLimited 触发限流规则，稍后重试可能成功；Metadata 的 rule 为命中的规则
+	ReasonRateLimited = "RATE_LIMITED"
+	// ReasonQuotaExceeded 租户的发送配额已用完，下一个配额周期或调整配额前重试不会成功
+	ReasonQuotaExceeded = "QUOTA_EXCEEDED"
+)
+
+// NotificationServer 通知服务的 gRPC 实现
+type NotificationServer struct {
+	svc notification.Service
+}
+
+// NewNotificationServer 创建通知服务的 gRPC 实现
+func NewNotificationServer(svc notification.Service) *NotificationServer {
+	return &NotificationServer{
+		svc: svc,
+	}
+}
+
+// SendNotification 同步发送单条通知
+func (s *NotificationServer) SendNotification(
+	ctx context.Context,
+	req *notificationv1.SendNotificationRequest,
+) (*notificationv1.SendNotificationResponse, error) {
+	result, err := s.svc.Send(ctx, toDomainNotification(req.GetNotification()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toSendResponse(result), nil
+}
+
+// BatchSendNotifications 同步批量发送通知
+func (s *NotificationServer) BatchSendNotifications(
+	ctx context.Context,
+	req *notificationv1.BatchSendNotificationsRequest,
+) (*notificationv1.BatchSendNotificationsResponse, error) {
+	ns := make([]domain.Notification, 0, len(req.GetNotifications()))
+	for _, n := range req.GetNotifications() {
+		ns = append(ns, toDomainNotification(n))
+	}
+	results, err := s.svc.BatchSend(ctx, ns)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &notificationv1.BatchSendNotificationsResponse{
+		Results: make([]*notificationv1.SendNotificationResponse, 0, len(results)),
+	}
+	for _, result := range results {
+		resp.Results = append(resp.Results, toSendResponse(result))
+	}
+	return resp, nil
+}
+
+// SendNotificationAsync 异步发送单条通知
+func (s *NotificationServer) SendNotificationAsync(
+	ctx context.Context,
+	req *notificationv1.SendNotificationAsyncRequest,
+) (*notificationv1.SendNotificationAsyncResponse, error) {
+	result, err := s.svc.SendAsync(ctx, toDomainNotification(req.GetNotification()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toSendAsyncResponse(result), nil
+}
+
+// BatchSendNotificationsAsync 异步批量发送通知
+func (s *NotificationServer) BatchSendNotificationsAsync(
+	ctx context.Context,
+	req *notificationv1.BatchSendNotificationsAsyncRequest,
+) (*notificationv1.BatchSendNotificationsAsyncResponse, error) {
+	ns := make([]domain.Notification, 0, len(req.GetNotifications()))
+	for _, n := range req.GetNotifications() {
+		ns = append(ns, toDomainNotification(n))
+	}
+	results, err := s.svc.BatchSendAsync(ctx, ns)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &notificationv1.BatchSendNotificationsAsyncResponse{
+		Results: make([]*notificationv1.SendNotificationAsyncResponse, 0, len(results)),
+	}
+	for _, result := range results {
+		resp.Results = append(resp.Results, toSendAsyncResponse(result))
+	}
+	return resp, nil
+}
+
+// QueryNotification 按通知 ID 或业务键查询通知发送记录
+func (s *NotificationServer) QueryNotification(
+	ctx context.Context,
+	req *notificationv1.QueryNotificationRequest,
+) (*notificationv1.QueryNotificationResponse, error) {
+	var (
+		n   domain.Notification
+		err error
+	)
+	switch key := req.GetKey().(type) {
+	case *notificationv1.QueryNotificationRequest_NotificationId:
+		n, err = s.svc.FindByID(ctx, key.NotificationId)
+	case *notificationv1.QueryNotificationRequest_BizKey:
+		n, err = s.svc.FindByBizKey(ctx, key.BizKey)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "notification_id 或 biz_key 必须指定其一")
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &notificationv1.QueryNotificationResponse{
+		Record: toRecord(n),
+	}, nil
+}
+
+// CancelScheduledNotification 按通知 ID 或业务键取消尚未发送的定时通知
+func (s *NotificationServer) CancelScheduledNotification(
+	ctx context.Context,
+	req *notificationv1.CancelScheduledNotificationRequest,
+) (*notificationv1.CancelScheduledNotificationResponse, error) {
+	var (
+		n   domain.Notification
+		err error
+	)
+	switch key := req.GetKey().(type) {
+	case *notificationv1.CancelScheduledNotificationRequest_NotificationId:
+		n, err = s.svc.Cancel(ctx, key.NotificationId)
+	case *notificationv1.CancelScheduledNotificationRequest_BizKey:
+		n, err = s.svc.CancelByBizKey(ctx, key.BizKey)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "notification_id 或 biz_key 必须指定其一")
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &notificationv1.CancelScheduledNotificationResponse{
+		Record: toRecord(n),
+	}, nil
+}
+
+// PrepareNotification 预提交两阶段通知
+func (s *NotificationServer) PrepareNotification(
+	ctx context.Context,
+	req *notificationv1.PrepareNotificationRequest,
+) (*notificationv1.PrepareNotificationResponse, error) {
+	result, err := s.svc.Prepare(ctx, toDomainNotification(req.GetNotification()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &notificationv1.PrepareNotificationResponse{
+		NotificationId: result.NotificationID,
+		Status:         toProtoStatus(result.Status),
+		Duplicated:     result.Duplicated,
+	}, nil
+}
+
+// CommitPreparedNotification 按通知 ID 或业务键提交两阶段通知
+func (s *NotificationServer) CommitPreparedNotification(
+	ctx context.Context,
+	req *notificationv1.CommitPreparedNotificationRequest,
+) (*notificationv1.CommitPreparedNotificationResponse, error) {
+	var (
+		n   domain.Notification
+		err error
+	)
+	switch key := req.GetKey().(type) {
+	case *notificationv1.CommitPreparedNotificationRequest_NotificationId:
+		n, err = s.svc.Commit(ctx, key.NotificationId)
+	case *notificationv1.CommitPreparedNotificationRequest_BizKey:
+		n, err = s.svc.CommitByBizKey(ctx, key.BizKey)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "notification_id 或 biz_key 必须指定其一")
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &notificationv1.CommitPreparedNotificationResponse{
+		Record: toRecord(n),
+	}, nil
+}
+
+// CancelPreparedNotification 按通知 ID 或业务键取消两阶段通知
+func (s *NotificationServer) CancelPreparedNotification(
+	ctx context.Context,
+	req *notificationv1.CancelPreparedNotificationRequest,
+) (*notificationv1.CancelPreparedNotificationResponse, error) {
+	var (
+		n   domain.Notification
+		err error
+	)
+	switch key := req.GetKey().(type) {
+	case *notificationv1.CancelPreparedNotificationRequest_NotificationId:
+		n, err = s.svc.CancelPrepared(ctx, key.NotificationId)
+	case *notificationv1.CancelPreparedNotificationRequest_BizKey:
+		n, err = s.svc.CancelPreparedByBizKey(ctx, key.BizKey)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "notification_id 或 biz_key 必须指定其一")
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &notificationv1.CancelPreparedNotificationResponse{
+		Record: toRecord(n),
+	}, nil
+}
+
+// toStatusError 将领域错误转换为 gRPC 状态错误；未识别的错误记录日志后返回不含细节的 Internal
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotificationNotFound), errors.Is(err, domain.ErrDeadLetterNotFound),
+		errors.Is(err, domain.ErrTenantNotFound), errors.Is(err, domain.ErrAPIKeyNotFound),
+		errors.Is(err, domain.ErrTemplateNotFound), errors.Is(err, domain.ErrTemplateVersionNotFound),
+		errors.Is(err, domain.ErrRecipientProfileNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrUnsupportedChannel), errors.Is(err, domain.ErrInvalidNotification),
+		errors.Is(err, domain.ErrInvalidTenant), errors.Is(err, domain.ErrInvalidTemplate),
+		errors.Is(err, domain.ErrInvalidRecipientProfile):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicateTenant), errors.Is(err, domain.ErrDuplicateTemplate):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrNotCancelable), errors.Is(err, domain.ErrNotPrepared):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrTenantDisabled), errors.Is(err, domain.ErrChannelNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrRateLimited):
+		return rateLimitedError(err)
+	case errors.Is(err, domain.ErrQuotaExceeded):
+		return withDetails(status.New(codes.ResourceExhausted, err.Error()), &errdetails.ErrorInfo{
+			Domain: ErrorDomain,
+			Reason: ReasonQuotaExceeded,
+		})
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, context.Canceled.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	default:
+		// 未识别的错误多为 MySQL、Redis 等内部错误，错误信息可能包含表名、SQL 与内部地址，只写入日志
+		appLogger.GetGlobal().Error("gRPC 请求处理失败", zap.Error(err))
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// rateLimitedError 被限流的请求返回 ResourceExhausted，以 ErrorInfo 标明被限流，并通过 RetryInfo 告知建议的重试等待时间
+func rateLimitedError(err error) error {
+	st := status.New(codes.ResourceExhausted, err.Error())
+	info := &errdetails.ErrorInfo{
+		Domain: ErrorDomain,
+		Reason: ReasonRateLimited,
+	}
+	var rle *domain.RateLimitError
+	if !errors.As(err, &rle) {
+		return withDetails(st, info)
+	}
+	if rle.Rule != "" {
+		info.Metadata = map[string]string{"rule": rle.Rule}
+	}
+	if rle.RetryAfter <= 0 {
+		return withDetails(st, info)
+	}
+	return withDetails(st, info, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(rle.RetryAfter),
+	})
+}
+
+// withDetails 附加错误详情，附加失败时返回不带详情的错误
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func toDomainNotification(n *notificationv1.Notification) domain.Notification {
+	return domain.Notification{
+		BizKey:    n.GetBizKey(),
+		Channel:   toDomainChannel(n.GetChannel()),
+		Recipient: n.GetRecipient(),
+		Template: domain.Template{
+			ID:     n.GetTemplateId(),
+			Params: n.GetTemplateParams(),
+		},
+		Locale:        n.GetLocale(),
+		Email:         toDomainEmail(n.GetEmail()),
+		ScheduledAt:   toScheduledAt(n),
+		Transactional: n.GetTransactional(),
+		CallbackURL:   n.GetCallbackUrl(),
+	}
+}
+
+// toScheduledAt 由 send_at 或 delay 计算定时发送时间，均未指定时为零值
+func toScheduledAt(n *notificationv1.Notification) time.Time {
+	switch {
+	case n.GetSendAt() != nil:
+		return n.GetSendAt().AsTime()
+	case n.GetDelay() != nil:
+		return time.Now().Add(n.GetDelay().AsDuration())
+	default:
+		return time.Time{}
+	}
+}
+
+// toProtoTimestamp 零值时间转换为空
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func toDomainEmail(e *notificationv1.EmailContent) *domain.EmailContent {
+	if e == nil {
+		return nil
+	}
+	attachments := make([]domain.Attachment, 0, len(e.GetAttachments()))
+	for _, a := range e.GetAttachments() {
+		attachments = append(attachments, domain.Attachment{
+			Filename:    a.GetFilename(),
+			ContentType: a.GetContentType(),
+			Content:     a.GetContent(),
+		})
+	}
+	return &domain.EmailContent{
+		Subject:     e.GetSubject(),
+		HTMLBody:    e.GetHtmlBody(),
+		TextBody:    e.GetTextBody(),
+		Attachments: attachments,
+		Headers:     e.GetHeaders(),
+	}
+}
+
+func toProtoEmail(e *domain.EmailContent) *notificationv1.EmailContent {
+	if e == nil {
+		return nil
+	}
+	attachments := make([]*notificationv1.Attachment, 0, len(e.Attachments))
+	for _, a := range e.Attachments {
+		attachments = append(attachments, &notificationv1.Attachment{
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Content:     a.Content,
+		})
+	}
+	return &notificationv1.EmailContent{
+		Subject:     e.Subject,
+		HtmlBody:    e.HTMLBody,
+		TextBody:    e.TextBody,
+		Attachments: attachments,
+		Headers:     e.Headers,
+	}
+}
+
+func toSendResponse(result domain.SendResult) *notificationv1.SendNotificationResponse {
+	return &notificationv1.SendNotificationResponse{
+		NotificationId: result.NotificationID,
+		Status:         toProtoStatus(result.Status),
+		ErrorMessage:   result.ErrorMessage,
+		Duplicated:     result.Duplicated,
+	}
+}
+
+func toSendAsyncResponse(result domain.SendResult) *notificationv1.SendNotificationAsyncResponse {
+	return &notificationv1.SendNotificationAsyncResponse{
+		NotificationId: result.NotificationID,
+		Status:         toProtoStatus(result.Status),
+		ErrorMessage:   result.ErrorMessage,
+		Duplicated:     result.Duplicated,
+	}
+}
+
+func toRecord(n domain.Notification) *notificationv1.NotificationRecord {
+	return &notificationv1.NotificationRecord{
+		NotificationId: n.ID,
+		Notification: &notificationv1.Notification{
+			BizKey:         n.BizKey,
+			Recipient:      n.Recipient,
+			Channel:        toProtoChannel(n.Channel),
+			TemplateId:     n.Template.ID,
+			TemplateParams: n.Template.Params,
+			Email:          toProtoEmail(n.Email),
+			Locale:         n.Locale,
+			Transactional:  n.Transactional,
+			CallbackUrl:    n.CallbackURL,
+		},
+		Status:            toProtoStatus(n.Status),
+		ErrorMessage:      n.ErrorMessage,
+		CreatedAt:         timestamppb.New(n.CreatedAt),
+		UpdatedAt:         timestamppb.New(n.UpdatedAt),
+		Provider:          n.Receipt.Provider,
+		ProviderMessageId: n.Receipt.MessageID,
+		TemplateLocale:    n.Template.Locale,
+		ScheduledAt:       toProtoTimestamp(n.ScheduledAt),
+	}
+}
+
+func toDomainChannel(c notificationv1.Channel) domain.Channel {
+	switch c {
+	case notificationv1.Channel_CHANNEL_SMS:
+		return domain.ChannelSMS
+	case notificationv1.Channel_CHANNEL_EMAIL:
+		return domain.ChannelEmail
+	default:
+		return ""
+	}
+}
+
+func toProtoChannel(c domain.Channel) notificationv1.Channel {
+	switch c {
+	case domain.ChannelSMS:
+		return notificationv1.Channel_CHANNEL_SMS
+	case domain.ChannelEmail:
+		return notificationv1.Channel_CHANNEL_EMAIL
+	default:
+		return notificationv1.Channel_CHANNEL_UNSPECIFIED
+	}
+}
+
+func toProtoStatus(s domain.SendStatus) notificationv1.SendStatus {
+	switch s {
+	case domain.SendStatusPending:
+		return notificationv1.SendStatus_SEND_STATUS_PENDING
+	case domain.SendStatusSending:
+		return notificationv1.SendStatus_SEND_STATUS_SENDING
+	case domain.SendStatusSucceeded:
+		return notificationv1.SendStatus_SEND_STATUS_SUCCEEDED
+	case domain.SendStatusFailed:
+		return notificationv1.SendStatus_SEND_STATUS_FAILED
+	case domain.SendStatusRetrying:
+		return notificationv1.SendStatus_SEND_STATUS_RETRYING
+	case domain.SendStatusScheduled:
+		return notificationv1.SendStatus_SEND_STATUS_SCHEDULED
+	case domain.SendStatusCanceled:
+		return notificationv1.SendStatus_SEND_STATUS_CANCELED
+	case domain.SendStatusPrepared:
+		return notificationv1.SendStatus_SEND_STATUS_PREPARED
+	case domain.SendStatusDelivered:
+		return notificationv1.SendStatus_SEND_STATUS_DELIVERED
+	default:
+		return notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+
+	recipientv1 "github.com/dingdong-postman/api/proto/gen/recipient/v1"
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/service/recipient"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RecipientServer 接收者资料管理服务的 gRPC 实现
+type RecipientServer struct {
+	svc recipient.Service
+}
+
+// NewRecipientServer 创建接收者资料管理服务的 gRPC 实现
+func NewRecipientServer(svc recipient.Service) *RecipientServer {
+	return &RecipientServer{
+		svc: svc,
+	}
+}
+
+// PutRecipientProfile 写入接收者资料
+func (s *RecipientServer) PutRecipientProfile(
+	ctx context.Context,
+	req *recipientv1.PutRecipientProfileRequest,
+) (*recipientv1.PutRecipientProfileResponse, error) {
+	p, err := s.svc.Put(ctx, domain.RecipientProfile{
+		Recipient: req.GetRecipient(),
+		Locale:    req.GetLocale(),
+		Timezone:  req.GetTimezone(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &recipientv1.PutRecipientProfileResponse{
+		Profile: toProtoRecipientProfile(p),
+	}, nil
+}
+
+// GetRecipientProfile 查询接收者资料
+func (s *RecipientServer) GetRecipientProfile(
+	ctx context.Context,
+	req *recipientv1.GetRecipientProfileRequest,
+) (*recipientv1.GetRecipientProfileResponse, error) {
+	p, err := s.svc.Get(ctx, req.GetRecipient())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &recipientv1.GetRecipientProfileResponse{
+		Profile: toProtoRecipientProfile(p),
+	}, nil
+}
+
+// DeleteRecipientProfile 删除接收者资料
+func (s *RecipientServer) DeleteRecipientProfile(
+	ctx context.Context,
+	req *recipientv1.DeleteRecipientProfileRequest,
+) (*recipientv1.DeleteRecipientProfileResponse, error) {
+	if err := s.svc.Delete(ctx, req.GetRecipient()); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &recipientv1.DeleteRecipientProfileResponse{}, nil
+}
+
+func toProtoRecipientProfile(p domain.RecipientProfile) *recipientv1.RecipientProfile {
+	return &recipientv1.RecipientProfile{
+		Recipient: p.Recipient,
+		Locale:    p.Locale,
+		Timezone:  p.Timezone,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+		UpdatedAt: timestamppb.New(p.UpdatedAt),
+	}
+}
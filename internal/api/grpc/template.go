@@ -0,0 +1,274 @@
+package grpc
+
+import (
+	"context"
+
+	templatev1 "github.com/dingdong-postman/api/proto/gen/template/v1"
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/service/approval"
+	"github.com/dingdong-postman/internal/service/template"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TemplateServer 模板管理服务的 gRPC 实现
+type TemplateServer struct {
+	svc       template.Service
+	approvals *approval.Syncer
+}
+
+// NewTemplateServer 创建模板管理服务的 gRPC 实现，approvals 为 nil 表示未启用模板审核同步
+func NewTemplateServer(svc template.Service, approvals *approval.Syncer) *TemplateServer {
+	return &TemplateServer{
+		svc:       svc,
+		approvals: approvals,
+	}
+}
+
+// CreateTemplate 创建模板及其第一个版本
+func (s *TemplateServer) CreateTemplate(
+	ctx context.Context,
+	req *templatev1.CreateTemplateRequest,
+) (*templatev1.CreateTemplateResponse, error) {
+	t := domain.MessageTemplate{
+		ID:          req.GetTemplateId(),
+		Name:        req.GetName(),
+		Channel:     toDomainChannel(req.GetChannel()),
+		Description: req.GetDescription(),
+	}
+	created, v, err := s.svc.Create(ctx, t, toDomainTemplateVersion(req.GetTemplateId(), req.GetContent()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &templatev1.CreateTemplateResponse{
+		Template: toProtoTemplate(created),
+		Version:  toProtoTemplateVersion(v),
+	}, nil
+}
+
+// GetTemplate 查询模板及生效版本
+func (s *TemplateServer) GetTemplate(
+	ctx context.Context,
+	req *templatev1.GetTemplateRequest,
+) (*templatev1.GetTemplateResponse, error) {
+	t, v, err := s.svc.Get(ctx, req.GetTemplateId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &templatev1.GetTemplateResponse{
+		Template:      toProtoTemplate(t),
+		ActiveVersion: toProtoTemplateVersion(v),
+	}, nil
+}
+
+// ListTemplates 分页查询模板，分页令牌为上一页最后一个模板的 ID
+func (s *TemplateServer) ListTemplates(
+	ctx context.Context,
+	req *templatev1.ListTemplatesRequest,
+) (*templatev1.ListTemplatesResponse, error) {
+	filter := domain.TemplateFilter{
+		Channel: toDomainChannel(req.GetChannel()),
+		AfterID: req.GetPageToken(),
+		Limit:   int(req.GetPageSize()),
+	}
+	if filter.Limit == 0 {
+		filter.Limit = template.DefaultPageSize
+	}
+	ts, err := s.svc.List(ctx, filter)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &templatev1.ListTemplatesResponse{
+		Templates: make([]*templatev1.Template, 0, len(ts)),
+	}
+	for i := range ts {
+		resp.Templates = append(resp.Templates, toProtoTemplate(ts[i]))
+	}
+	if len(ts) == filter.Limit {
+		resp.NextPageToken = ts[len(ts)-1].ID
+	}
+	return resp, nil
+}
+
+// UpdateTemplate 更新模板名称与说明
+func (s *TemplateServer) UpdateTemplate(
+	ctx context.Context,
+	req *templatev1.UpdateTemplateRequest,
+) (*templatev1.UpdateTemplateResponse, error) {
+	t, err := s.svc.Update(ctx, domain.MessageTemplate{
+		ID:          req.GetTemplateId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &templatev1.UpdateTemplateResponse{
+		Template: toProtoTemplate(t),
+	}, nil
+}
+
+// DeleteTemplate 删除模板及其全部版本
+func (s *TemplateServer) DeleteTemplate(
+	ctx context.Context,
+	req *templatev1.DeleteTemplateRequest,
+) (*templatev1.DeleteTemplateResponse, error) {
+	if err := s.svc.Delete(ctx, req.GetTemplateId()); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &templatev1.DeleteTemplateResponse{}, nil
+}
+
+// CreateTemplateVersion 为模板追加一个版本
+func (s *TemplateServer) CreateTemplateVersion(
+	ctx context.Context,
+	req *templatev1.CreateTemplateVersionRequest,
+) (*templatev1.CreateTemplateVersionResponse, error) {
+	v, err := s.svc.CreateVersion(ctx, toDomainTemplateVersion(req.GetTemplateId(), req.GetContent()), req.GetActivate())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &templatev1.CreateTemplateVersionResponse{
+		Version: toProtoTemplateVersion(v),
+	}, nil
+}
+
+// ListTemplateVersions 查询模板的全部版本
+func (s *TemplateServer) ListTemplateVersions(
+	ctx context.Context,
+	req *templatev1.ListTemplateVersionsRequest,
+) (*templatev1.ListTemplateVersionsResponse, error) {
+	vs, err := s.svc.ListVersions(ctx, req.GetTemplateId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &templatev1.ListTemplateVersionsResponse{
+		Versions: make([]*templatev1.TemplateVersion, 0, len(vs)),
+	}
+	for i := range vs {
+		resp.Versions = append(resp.Versions, toProtoTemplateVersion(vs[i]))
+	}
+	return resp, nil
+}
+
+// ActivateTemplateVersion 切换模板的生效版本
+func (s *TemplateServer) ActivateTemplateVersion(
+	ctx context.Context,
+	req *templatev1.ActivateTemplateVersionRequest,
+) (*templatev1.ActivateTemplateVersionResponse, error) {
+	t, err := s.svc.Activate(ctx, req.GetTemplateId(), int(req.GetVersion()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &templatev1.ActivateTemplateVersionResponse{
+		Template: toProtoTemplate(t),
+	}, nil
+}
+
+func toDomainTemplateVersion(templateID string, c *templatev1.TemplateContent) domain.TemplateVersion {
+	variables := make([]domain.TemplateVariable, 0, len(c.GetVariables()))
+	for _, v := range c.GetVariables() {
+		variables = append(variables, domain.TemplateVariable{
+			Name:        v.GetName(),
+			Type:        toDomainVariableType(v.GetType()),
+			Required:    v.GetRequired(),
+			Description: v.GetDescription(),
+		})
+	}
+	var locales []domain.LocalizedContent
+	for _, l := range c.GetLocales() {
+		locales = append(locales, domain.LocalizedContent{
+			Locale:   l.GetLocale(),
+			Subject:  l.GetSubject(),
+			Body:     l.GetBody(),
+			HTMLBody: l.GetHtmlBody(),
+		})
+	}
+	return domain.TemplateVersion{
+		TemplateID: templateID,
+		Subject:    c.GetSubject(),
+		Body:       c.GetBody(),
+		HTMLBody:   c.GetHtmlBody(),
+		Locales:    locales,
+		Variables:  variables,
+	}
+}
+
+func toProtoTemplate(t domain.MessageTemplate) *templatev1.Template {
+	return &templatev1.Template{
+		TemplateId:    t.ID,
+		Name:          t.Name,
+		Channel:       toProtoChannel(t.Channel),
+		Description:   t.Description,
+		ActiveVersion: int32(t.ActiveVersion), //nolint:gosec // 版本号远小于 int32 上限
+		LatestVersion: int32(t.LatestVersion), //nolint:gosec // 版本号远小于 int32 上限
+		CreatedAt:     timestamppb.New(t.CreatedAt),
+		UpdatedAt:     timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func toProtoTemplateVersion(v domain.TemplateVersion) *templatev1.TemplateVersion {
+	variables := make([]*templatev1.TemplateVariable, 0, len(v.Variables))
+	for _, variable := range v.Variables {
+		variables = append(variables, &templatev1.TemplateVariable{
+			Name:        variable.Name,
+			Type:        toProtoVariableType(variable.Type),
+			Required:    variable.Required,
+			Description: variable.Description,
+		})
+	}
+	locales := make([]*templatev1.LocalizedContent, 0, len(v.Locales))
+	for _, l := range v.Locales {
+		locales = append(locales, &templatev1.LocalizedContent{
+			Locale:   l.Locale,
+			Subject:  l.Subject,
+			Body:     l.Body,
+			HtmlBody: l.HTMLBody,
+		})
+	}
+	return &templatev1.TemplateVersion{
+		TemplateId: v.TemplateID,
+		Version:    int32(v.Version), //nolint:gosec // 版本号远小于 int32 上限
+		Content: &templatev1.TemplateContent{
+			Subject:   v.Subject,
+			Body:      v.Body,
+			HtmlBody:  v.HTMLBody,
+			Variables: variables,
+			Locales:   locales,
+		},
+		CreatedAt: timestamppb.New(v.CreatedAt),
+	}
+}
+
+func toDomainVariableType(t templatev1.VariableType) domain.VariableType {
+	switch t {
+	case templatev1.VariableType_VARIABLE_TYPE_STRING:
+		return domain.VariableTypeString
+	case templatev1.VariableType_VARIABLE_TYPE_NUMBER:
+		return domain.VariableTypeNumber
+	case templatev1.VariableType_VARIABLE_TYPE_DATE:
+		return domain.VariableTypeDate
+	case templatev1.VariableType_VARIABLE_TYPE_DATETIME:
+		return domain.VariableTypeDateTime
+	case templatev1.VariableType_VARIABLE_TYPE_URL:
+		return domain.VariableTypeURL
+	default:
+		return ""
+	}
+}
+
+func toProtoVariableType(t domain.VariableType) templatev1.VariableType {
+	switch t {
+	case domain.VariableTypeString:
+		return templatev1.VariableType_VARIABLE_TYPE_STRING
+	case domain.VariableTypeNumber:
+		return templatev1.VariableType_VARIABLE_TYPE_NUMBER
+	case domain.VariableTypeDate:
+		return templatev1.VariableType_VARIABLE_TYPE_DATE
+	case domain.VariableTypeDateTime:
+		return templatev1.VariableType_VARIABLE_TYPE_DATETIME
+	case domain.VariableTypeURL:
+		return templatev1.VariableType_VARIABLE_TYPE_URL
+	default:
+		return templatev1.VariableType_VARIABLE_TYPE_UNSPECIFIED
+	}
+}
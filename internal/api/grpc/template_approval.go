@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+
+	templatev1 "github.com/dingdong-postman/api/proto/gen/template/v1"
+	"github.com/dingdong-postman/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errApprovalDisabled 未启用模板审核同步时调用审核相关接口返回的错误
+var errApprovalDisabled = status.Error(codes.FailedPrecondition, "template approval is not enabled")
+
+// ListTemplateApprovals 查询模板在各供应商处的审核记录
+func (s *TemplateServer) ListTemplateApprovals(
+	ctx context.Context,
+	req *templatev1.ListTemplateApprovalsRequest,
+) (*templatev1.ListTemplateApprovalsResponse, error) {
+	if s.approvals == nil {
+		return nil, errApprovalDisabled
+	}
+	as, err := s.approvals.List(ctx, req.GetTemplateId(), int(req.GetVersion()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &templatev1.ListTemplateApprovalsResponse{
+		Approvals: toProtoTemplateApprovals(as),
+	}, nil
+}
+
+// SubmitTemplateApproval 将模板版本重新提交给供应商审核
+func (s *TemplateServer) SubmitTemplateApproval(
+	ctx context.Context,
+	req *templatev1.SubmitTemplateApprovalRequest,
+) (*templatev1.SubmitTemplateApprovalResponse, error) {
+	if s.approvals == nil {
+		return nil, errApprovalDisabled
+	}
+	as, err := s.approvals.Resubmit(ctx, req.GetTemplateId(), int(req.GetVersion()), req.GetProvider())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &templatev1.SubmitTemplateApprovalResponse{
+		Approvals: toProtoTemplateApprovals(as),
+	}, nil
+}
+
+func toProtoTemplateApprovals(as []domain.TemplateApproval) []*templatev1.TemplateApproval {
+	out := make([]*templatev1.TemplateApproval, 0, len(as))
+	for i := range as {
+		a := as[i]
+		pa := &templatev1.TemplateApproval{
+			TemplateId:  a.TemplateID,
+			Version:     int32(a.Version), //nolint:gosec // 版本号远小于 int32 上限
+			Locale:      a.Locale,
+			Provider:    a.Provider,
+			VendorCode:  a.VendorCode,
+			Status:      toProtoApprovalStatus(a.Status),
+			Reason:      a.Reason,
+			Attempts:    int32(a.Attempts), //nolint:gosec // 次数远小于 int32 上限
+			NextCheckAt: timestamppb.New(a.NextCheckAt),
+			UpdatedAt:   timestamppb.New(a.UpdatedAt),
+		}
+		if !a.SubmittedAt.IsZero() {
+			pa.SubmittedAt = timestamppb.New(a.SubmittedAt)
+		}
+		out = append(out, pa)
+	}
+	return out
+}
+
+func toProtoApprovalStatus(s domain.ApprovalStatus) templatev1.ApprovalStatus {
+	switch s {
+	case domain.ApprovalStatusSubmitting:
+		return templatev1.ApprovalStatus_APPROVAL_STATUS_SUBMITTING
+	case domain.ApprovalStatusPending:
+		return templatev1.ApprovalStatus_APPROVAL_STATUS_PENDING
+	case domain.ApprovalStatusApproved:
+		return templatev1.ApprovalStatus_APPROVAL_STATUS_APPROVED
+	case domain.ApprovalStatusRejected:
+		return templatev1.ApprovalStatus_APPROVAL_STATUS_REJECTED
+	default:
+		return templatev1.ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+
+	templatev1 "github.com/dingdong-postman/api/proto/gen/template/v1"
+	"github.com/dingdong-postman/internal/domain"
+)
+
+// RenderTemplate 按示例参数渲染模板版本
+func (s *TemplateServer) RenderTemplate(
+	ctx context.Context,
+	req *templatev1.RenderTemplateRequest,
+) (*templatev1.RenderTemplateResponse, error) {
+	preview, err := s.svc.Preview(ctx, domain.TemplatePreviewRequest{
+		TemplateID: req.GetTemplateId(),
+		Version:    int(req.GetVersion()),
+		Locale:     req.GetLocale(),
+		Params:     req.GetParams(),
+		SignName:   req.GetSignName(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &templatev1.RenderTemplateResponse{
+		Version:  int32(preview.Version), //nolint:gosec // 版本号远小于 int32 上限
+		Locale:   preview.Locale,
+		Subject:  preview.Rendered.Subject,
+		Body:     preview.Rendered.Body,
+		HtmlBody: preview.Rendered.HTMLBody,
+		Sms:      toProtoSmsStats(preview.SMS),
+	}, nil
+}
+
+func toProtoSmsStats(stats *domain.SMSStats) *templatev1.SmsStats {
+	if stats == nil {
+		return nil
+	}
+	encoding := templatev1.SmsEncoding_SMS_ENCODING_GSM7
+	if stats.Encoding == domain.SMSEncodingUCS2 {
+		encoding = templatev1.SmsEncoding_SMS_ENCODING_UCS2
+	}
+	return &templatev1.SmsStats{
+		Encoding:   encoding,
+		Characters: int32(stats.Characters), //nolint:gosec // 模板内容长度远小于 int32 上限
+		Segments:   int32(stats.Segments),   //nolint:gosec // 条数远小于 int32 上限
+		OverLength: stats.OverLength,
+		MaxLength:  domain.MaxSMSLength,
+	}
+}
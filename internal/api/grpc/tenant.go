@@ -0,0 +1,251 @@
+package grpc
+
+import (
+	"context"
+
+	adminv1 "github.com/dingdong-postman/api/proto/gen/admin/v1"
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/service/tenant"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TenantServer 租户管理服务的 gRPC 实现
+type TenantServer struct {
+	svc tenant.Service
+}
+
+// NewTenantServer 创建租户管理服务的 gRPC 实现
+func NewTenantServer(svc tenant.Service) *TenantServer {
+	return &TenantServer{
+		svc: svc,
+	}
+}
+
+// CreateTenant 创建租户
+func (s *TenantServer) CreateTenant(
+	ctx context.Context,
+	req *adminv1.CreateTenantRequest,
+) (*adminv1.CreateTenantResponse, error) {
+	t, err := s.svc.Create(ctx, toDomainTenant(req.GetTenant()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &adminv1.CreateTenantResponse{
+		Tenant: toProtoTenant(t),
+	}, nil
+}
+
+// GetTenant 查询租户
+func (s *TenantServer) GetTenant(
+	ctx context.Context,
+	req *adminv1.GetTenantRequest,
+) (*adminv1.GetTenantResponse, error) {
+	t, err := s.svc.Get(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &adminv1.GetTenantResponse{
+		Tenant: toProtoTenant(t),
+	}, nil
+}
+
+// ListTenants 查询全部租户
+func (s *TenantServer) ListTenants(
+	ctx context.Context,
+	_ *adminv1.ListTenantsRequest,
+) (*adminv1.ListTenantsResponse, error) {
+	ts, err := s.svc.List(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &adminv1.ListTenantsResponse{
+		Tenants: make([]*adminv1.Tenant, 0, len(ts)),
+	}
+	for i := range ts {
+		resp.Tenants = append(resp.Tenants, toProtoTenant(ts[i]))
+	}
+	return resp, nil
+}
+
+// UpdateTenant 更新租户
+func (s *TenantServer) UpdateTenant(
+	ctx context.Context,
+	req *adminv1.UpdateTenantRequest,
+) (*adminv1.UpdateTenantResponse, error) {
+	t, err := s.svc.Update(ctx, toDomainTenant(req.GetTenant()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &adminv1.UpdateTenantResponse{
+		Tenant: toProtoTenant(t),
+	}, nil
+}
+
+// CreateAPIKey 签发 API Key，明文只在响应中返回一次
+func (s *TenantServer) CreateAPIKey(
+	ctx context.Context,
+	req *adminv1.CreateAPIKeyRequest,
+) (*adminv1.CreateAPIKeyResponse, error) {
+	key, secret, err := s.svc.CreateAPIKey(ctx, req.GetTenantId(), req.GetName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &adminv1.CreateAPIKeyResponse{
+		ApiKey: toProtoAPIKey(key),
+		Secret: secret,
+	}, nil
+}
+
+// ListAPIKeys 查询租户的 API Key
+func (s *TenantServer) ListAPIKeys(
+	ctx context.Context,
+	req *adminv1.ListAPIKeysRequest,
+) (*adminv1.ListAPIKeysResponse, error) {
+	keys, err := s.svc.ListAPIKeys(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &adminv1.ListAPIKeysResponse{
+		ApiKeys: make([]*adminv1.APIKey, 0, len(keys)),
+	}
+	for i := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, toProtoAPIKey(keys[i]))
+	}
+	return resp, nil
+}
+
+// RevokeAPIKey 吊销 API Key
+func (s *TenantServer) RevokeAPIKey(
+	ctx context.Context,
+	req *adminv1.RevokeAPIKeyRequest,
+) (*adminv1.RevokeAPIKeyResponse, error) {
+	key, err := s.svc.RevokeAPIKey(ctx, req.GetTenantId(), req.GetApiKeyId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &adminv1.RevokeAPIKeyResponse{
+		ApiKey: toProtoAPIKey(key),
+	}, nil
+}
+
+// RotateCallbackSecret 为租户生成新的回调签名密钥
+func (s *TenantServer) RotateCallbackSecret(
+	ctx context.Context,
+	req *adminv1.RotateCallbackSecretRequest,
+) (*adminv1.RotateCallbackSecretResponse, error) {
+	t, secret, err := s.svc.RotateCallbackSecret(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &adminv1.RotateCallbackSecretResponse{
+		Tenant: toProtoTenant(t),
+		Secret: secret,
+	}, nil
+}
+
+func toDomainTenant(spec *adminv1.TenantSpec) domain.Tenant {
+	channels := make([]domain.Channel, 0, len(spec.GetAllowedChannels()))
+	for _, c := range spec.GetAllowedChannels() {
+		channels = append(channels, toDomainChannel(c))
+	}
+	return domain.Tenant{
+		ID:              spec.GetTenantId(),
+		Name:            spec.GetName(),
+		Enabled:         spec.GetEnabled(),
+		AllowedChannels: channels,
+		DailyQuota:      spec.GetDailyQuota(),
+		MonthlyQuota:    spec.GetMonthlyQuota(),
+		Sender: domain.Sender{
+			SignName:  spec.GetSender().GetSignName(),
+			EmailFrom: spec.GetSender().GetEmailFrom(),
+		},
+		DeliveryWindows: toDomainDeliveryWindows(spec.GetDeliveryWindows()),
+		Timezone:        spec.GetTimezone(),
+		CheckEndpoint:   spec.GetCheckEndpoint(),
+		CallbackURL:     spec.GetCallbackUrl(),
+	}
+}
+
+func toProtoTenant(t domain.Tenant) *adminv1.Tenant {
+	channels := make([]notificationv1.Channel, 0, len(t.AllowedChannels))
+	for _, c := range t.AllowedChannels {
+		channels = append(channels, toProtoChannel(c))
+	}
+	return &adminv1.Tenant{
+		TenantId:        t.ID,
+		Name:            t.Name,
+		Enabled:         t.Enabled,
+		AllowedChannels: channels,
+		DailyQuota:      t.DailyQuota,
+		MonthlyQuota:    t.MonthlyQuota,
+		Sender: &adminv1.TenantSender{
+			SignName:  t.Sender.SignName,
+			EmailFrom: t.Sender.EmailFrom,
+		},
+		CreatedAt:         timestamppb.New(t.CreatedAt),
+		UpdatedAt:         timestamppb.New(t.UpdatedAt),
+		DeliveryWindows:   toProtoDeliveryWindows(t.DeliveryWindows),
+		Timezone:          t.Timezone,
+		CheckEndpoint:     t.CheckEndpoint,
+		CallbackUrl:       t.CallbackURL,
+		CallbackSecretSet: t.CallbackSecret != "",
+	}
+}
+
+func toDomainDeliveryWindows(ws []*adminv1.DeliveryWindow) []domain.DeliveryWindow {
+	windows := make([]domain.DeliveryWindow, 0, len(ws))
+	for _, w := range ws {
+		windows = append(windows, domain.DeliveryWindow{
+			Channel:        toDomainChannel(w.GetChannel()),
+			AllowedWindows: toDomainClockRanges(w.GetAllowedWindows()),
+			QuietHours:     toDomainClockRanges(w.GetQuietHours()),
+		})
+	}
+	return windows
+}
+
+// toDomainClockRanges 转换时间段，HH:MM 格式已由 proto 校验规则保证
+func toDomainClockRanges(rs []*adminv1.ClockRange) []domain.ClockRange {
+	ranges := make([]domain.ClockRange, 0, len(rs))
+	for _, r := range rs {
+		start, _ := domain.ParseClock(r.GetStart())
+		end, _ := domain.ParseClock(r.GetEnd())
+		ranges = append(ranges, domain.ClockRange{Start: start, End: end})
+	}
+	return ranges
+}
+
+func toProtoDeliveryWindows(ws []domain.DeliveryWindow) []*adminv1.DeliveryWindow {
+	windows := make([]*adminv1.DeliveryWindow, 0, len(ws))
+	for _, w := range ws {
+		windows = append(windows, &adminv1.DeliveryWindow{
+			Channel:        toProtoChannel(w.Channel),
+			AllowedWindows: toProtoClockRanges(w.AllowedWindows),
+			QuietHours:     toProtoClockRanges(w.QuietHours),
+		})
+	}
+	return windows
+}
+
+func toProtoClockRanges(rs []domain.ClockRange) []*adminv1.ClockRange {
+	ranges := make([]*adminv1.ClockRange, 0, len(rs))
+	for _, r := range rs {
+		ranges = append(ranges, &adminv1.ClockRange{
+			Start: domain.FormatClock(r.Start),
+			End:   domain.FormatClock(r.End),
+		})
+	}
+	return ranges
+}
+
+func toProtoAPIKey(key domain.APIKey) *adminv1.APIKey {
+	return &adminv1.APIKey{
+		ApiKeyId:  key.ID,
+		TenantId:  key.TenantID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Revoked:   key.Revoked,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+}
@@ -0,0 +1,274 @@
+// Package kafka 平台的 Kafka 接入：受理通知请求，发布通知状态变更事件
+// 消费者按消费组订阅通知请求 topic，消息经校验与租户鉴权后交给 SendNotificationAsync 处理，
+// 与 gRPC 异步发送走同一套受理逻辑（模板校验、限流、配额、业务键去重、定时与免打扰推迟）。
+// 通知落库后才提交位点：提交前进程退出时消息会被重新投递，由业务键去重保证只受理一次；
+// 受理失败且可能恢复的（如 MySQL 不可用、被限流）退避后重试同一条消息，不会跳过；
+// 无法受理的消息（如格式错误、参数不合法、API Key 无效）记录日志后提交位点，避免阻塞分区
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	grpcapi "github.com/dingdong-postman/internal/api/grpc"
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/service/tenant"
+	"github.com/dingdong-postman/pkg/ingest"
+	kafkago "github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Consumer 通知请求消费者
+type Consumer struct {
+	cfg           *config.KafkaConfig
+	notifications notificationv1.NotificationServiceServer
+	tenants       tenant.Service
+	logger        appLogger.Logger
+
+	readers []*kafkago.Reader
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewConsumer 创建通知请求消费者
+// tenants 为 nil 时（如未启用租户鉴权）不校验消息头中的 API Key，通知不归属任何租户
+func NewConsumer(
+	cfg *config.KafkaConfig,
+	notifications notificationv1.NotificationServiceServer,
+	tenants tenant.Service,
+	logger appLogger.Logger,
+) *Consumer {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &Consumer{
+		cfg:           cfg,
+		notifications: notifications,
+		tenants:       tenants,
+		logger:        logger,
+	}
+}
+
+// Start 启动消费者，每个消费者以独立的成员加入消费组
+func (c *Consumer) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+
+	dialer := &kafkago.Dialer{
+		Timeout:       time.Duration(c.cfg.DialTimeout) * time.Second,
+		DualStack:     true,
+		SASLMechanism: saslMechanism(c.cfg),
+		TLS:           tlsConfig(c.cfg),
+	}
+
+	for i := 0; i < c.cfg.Consumers; i++ {
+		r := kafkago.NewReader(kafkago.ReaderConfig{
+			Brokers:     c.cfg.Brokers,
+			GroupID:     c.cfg.GroupID,
+			Topic:       c.cfg.IngestTopic,
+			Dialer:      dialer,
+			MaxBytes:    c.cfg.MaxBytes,
+			StartOffset: kafkago.FirstOffset,
+			// 位点只在通知落库后同步提交
+			CommitInterval: 0,
+		})
+		c.readers = append(c.readers, r)
+		c.wg.Add(1)
+		go c.consume(ctx, r)
+	}
+
+	c.logger.Info("Kafka 通知受理消费者启动",
+		zap.Strings("brokers", c.cfg.Brokers),
+		zap.String("topic", c.cfg.IngestTopic),
+		zap.String("group_id", c.cfg.GroupID),
+		zap.Int("consumers", c.cfg.Consumers),
+	)
+}
+
+// Stop 停止消费者并离开消费组；正在重试的消息未提交位点，由消费组中的其他成员重新消费
+func (c *Consumer) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+	for _, r := range c.readers {
+		if err := r.Close(); err != nil {
+			c.logger.Warn("关闭 Kafka 消费者失败", zap.Error(err))
+		}
+	}
+	c.logger.Info("Kafka 通知受理消费者已停止")
+}
+
+// consume 逐条拉取消息，受理成功或确定无法受理后提交位点
+func (c *Consumer) consume(ctx context.Context, r *kafkago.Reader) {
+	defer c.wg.Done()
+	for {
+		msg, err := r.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.Error("拉取 Kafka 消息失败", zap.String("topic", c.cfg.IngestTopic), zap.Error(err))
+			if !sleep(ctx, c.backoff(0)) {
+				return
+			}
+			continue
+		}
+		if !c.handle(ctx, msg) {
+			return
+		}
+		if err := r.CommitMessages(ctx, msg); err != nil {
+			// 未提交的消息会被重新投递，由业务键去重保证只受理一次
+			c.logger.Warn("提交 Kafka 位点失败",
+				zap.Int("partition", msg.Partition),
+				zap.Int64("offset", msg.Offset),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// handle 受理一条消息，可能恢复的失败按退避重试；返回 false 表示消费者正在停止，消息未处理完
+func (c *Consumer) handle(ctx context.Context, msg kafkago.Message) bool {
+	n, apiKey, err := ingest.Decode(msg)
+	if err != nil {
+		c.skip(msg, "", err)
+		return true
+	}
+
+	for attempt := 0; ; attempt++ {
+		// 受理过程不受消费者停止影响，避免落库后未提交位点
+		resp, err := c.accept(context.WithoutCancel(ctx), n, apiKey)
+		if err == nil {
+			c.logger.Debug("Kafka 消息已受理",
+				zap.Int("partition", msg.Partition),
+				zap.Int64("offset", msg.Offset),
+				zap.String("biz_key", n.GetBizKey()),
+				zap.Uint64("notification_id", resp.GetNotificationId()),
+				zap.Bool("duplicated", resp.GetDuplicated()),
+			)
+			return true
+		}
+		delay, retryable := c.retryDelay(err, attempt)
+		if !retryable {
+			c.skip(msg, n.GetBizKey(), err)
+			return true
+		}
+		c.logger.Warn("受理 Kafka 消息失败，稍后重试",
+			zap.Int("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+			zap.String("biz_key", n.GetBizKey()),
+			zap.Int("attempt", attempt+1),
+			zap.Duration("delay", delay),
+			zap.Error(err),
+		)
+		if !sleep(ctx, delay) {
+			return false
+		}
+	}
+}
+
+// accept 校验消息、按 API Key 鉴权后以异步发送受理
+func (c *Consumer) accept(
+	ctx context.Context,
+	n *notificationv1.Notification,
+	apiKey string,
+) (*notificationv1.SendNotificationAsyncResponse, error) {
+	req := &notificationv1.SendNotificationAsyncRequest{Notification: n}
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if c.tenants != nil {
+		if apiKey == "" {
+			return nil, status.Error(codes.Unauthenticated, "缺少 API Key")
+		}
+		t, err := c.tenants.Authenticate(ctx, apiKey)
+		if err != nil {
+			return nil, err
+		}
+		ctx = domain.WithTenant(ctx, t)
+	}
+	return c.notifications.SendNotificationAsync(ctx, req)
+}
+
+// retryDelay 判断受理失败是否可能恢复，并返回重试前的等待时间
+// 被限流时按 RetryInfo 建议的时间等待；鉴权失败（API Key 无效、租户停用）与参数错误不会因重试而恢复
+func (c *Consumer) retryDelay(err error, attempt int) (time.Duration, bool) {
+	if errors.Is(err, domain.ErrUnauthenticated) || errors.Is(err, domain.ErrTenantDisabled) {
+		return 0, false
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		// 鉴权时查询租户失败（如 MySQL、Redis 不可用）
+		return c.backoff(attempt), true
+	}
+	switch st.Code() {
+	case codes.Unavailable, codes.Internal, codes.Unknown, codes.DeadlineExceeded, codes.Aborted, codes.Canceled:
+		return c.backoff(attempt), true
+	case codes.ResourceExhausted:
+		// 被限流时按 RetryInfo 建议的时间等待；配额用完需等到下个周期或调整配额，不重试
+		var (
+			reason string
+			delay  time.Duration
+		)
+		for _, d := range st.Details() {
+			switch info := d.(type) {
+			case *errdetails.ErrorInfo:
+				reason = info.GetReason()
+			case *errdetails.RetryInfo:
+				delay = info.GetRetryDelay().AsDuration()
+			}
+		}
+		if reason != grpcapi.ReasonRateLimited {
+			return 0, false
+		}
+		if delay > 0 {
+			return max(delay, c.backoff(0)), true
+		}
+		// 限流判断失败（如 Redis 不可用）时不附带 RetryInfo，按退避等待
+		return c.backoff(attempt), true
+	default:
+		return 0, false
+	}
+}
+
+// backoff 第 attempt 次重试前的等待时间，按倍数增长且不超过上限
+func (c *Consumer) backoff(attempt int) time.Duration {
+	delay := time.Duration(c.cfg.RetryBackoff) * time.Millisecond
+	limit := time.Duration(c.cfg.MaxRetryBackoff) * time.Millisecond
+	for i := 0; i < attempt && delay < limit; i++ {
+		delay *= 2
+	}
+	return min(delay, limit)
+}
+
+// skip 记录无法受理的消息，调用方随后提交位点
+func (c *Consumer) skip(msg kafkago.Message, bizKey string, err error) {
+	c.logger.Error("无法受理的 Kafka 消息，已跳过",
+		zap.String("topic", msg.Topic),
+		zap.Int("partition", msg.Partition),
+		zap.Int64("offset", msg.Offset),
+		zap.String("biz_key", bizKey),
+		zap.Error(err),
+	)
+}
+
+// sleep 等待 d，context 结束时返回 false
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
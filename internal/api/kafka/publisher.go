@@ -0,0 +1,100 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	"github.com/dingdong-postman/pkg/statusevent"
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StatusPublisher 将通知状态变更事件发布到状态事件 topic
+type StatusPublisher struct {
+	writer *kafkago.Writer
+}
+
+// NewStatusPublisher 创建状态变更事件发布器
+// 消息键为通知 ID，同一通知的事件落在同一分区；一批事件全部被所有副本确认后才算发布成功
+func NewStatusPublisher(cfg *config.KafkaConfig) *StatusPublisher {
+	return &StatusPublisher{
+		writer: &kafkago.Writer{
+			Addr:         kafkago.TCP(cfg.Brokers...),
+			Topic:        cfg.StatusTopic,
+			Balancer:     &kafkago.Hash{},
+			RequiredAcks: kafkago.RequireAll,
+			BatchTimeout: 10 * time.Millisecond,
+			Transport: &kafkago.Transport{
+				DialTimeout: time.Duration(cfg.DialTimeout) * time.Second,
+				SASL:        saslMechanism(cfg),
+				TLS:         tlsConfig(cfg),
+			},
+		},
+	}
+}
+
+// Publish 按顺序发布一批状态变更事件
+func (p *StatusPublisher) Publish(ctx context.Context, events []domain.StatusEvent) error {
+	msgs := make([]kafkago.Message, 0, len(events))
+	for i := range events {
+		msg, err := statusevent.Encode(toProtoStatusEvent(events[i]))
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	if err := p.writer.WriteMessages(ctx, msgs...); err != nil {
+		return fmt.Errorf("publish status events: %w", err)
+	}
+	return nil
+}
+
+// Close 关闭发布器
+func (p *StatusPublisher) Close() error {
+	return p.writer.Close()
+}
+
+// toProtoStatusEvent 将状态变更事件转换为 protobuf 消息
+// 领域层的渠道与状态取值与 proto 枚举名的后缀一致，按名称转换，未知的取值为 UNSPECIFIED
+func toProtoStatusEvent(e domain.StatusEvent) *notificationv1.StatusChangedEvent {
+	return &notificationv1.StatusChangedEvent{
+		EventId:           e.ID,
+		NotificationId:    e.NotificationID,
+		TenantId:          e.TenantID,
+		BizKey:            e.BizKey,
+		Channel:           notificationv1.Channel(notificationv1.Channel_value["CHANNEL_"+string(e.Channel)]),
+		PreviousStatus:    toProtoStatus(e.PreviousStatus),
+		Status:            toProtoStatus(e.Status),
+		ErrorMessage:      e.ErrorMessage,
+		Provider:          e.Receipt.Provider,
+		ProviderMessageId: e.Receipt.MessageID,
+		OccurredAt:        timestamppb.New(e.OccurredAt),
+	}
+}
+
+func toProtoStatus(s domain.SendStatus) notificationv1.SendStatus {
+	return notificationv1.SendStatus(notificationv1.SendStatus_value["SEND_STATUS_"+string(s)])
+}
+
+// saslMechanism 按配置返回 SASL/PLAIN 认证方式，未配置用户名时返回 nil
+func saslMechanism(cfg *config.KafkaConfig) sasl.Mechanism {
+	if cfg.Username == "" {
+		return nil
+	}
+	return plain.Mechanism{Username: cfg.Username, Password: cfg.GetPassword()}
+}
+
+// tlsConfig 按配置返回 TLS 配置，未启用 TLS 时返回 nil
+func tlsConfig(cfg *config.KafkaConfig) *tls.Config {
+	if !cfg.TLS {
+		return nil
+	}
+	return &tls.Config{MinVersion: tls.VersionTLS12}
+}
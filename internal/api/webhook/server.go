@@ -0,0 +1,141 @@
+// Package webhook 接收供应商推送的 HTTP 服务
+// 供应商将投递回执 POST 到 /dlr/{供应商名称}，请求须携带配置的令牌（查询参数 token 或请求头 X-Dingdong-Token）
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/service/dlr"
+	"go.uber.org/zap"
+)
+
+const (
+	// HeaderToken 携带推送令牌的请求头，供应商不支持自定义请求头时可改用查询参数 token
+	HeaderToken = "X-Dingdong-Token"
+	// readHeaderTimeout 读取请求头的超时时间
+	readHeaderTimeout = 10 * time.Second
+)
+
+// Receiver 处理供应商推送的回执，由投递回执接收器实现
+type Receiver interface {
+	// Receive 处理推送的请求体，返回告知供应商推送成功的响应 Content-Type 与响应体
+	Receive(ctx context.Context, provider string, body []byte) (string, []byte, error)
+}
+
+// Server 接收供应商推送的 HTTP 服务，负责监听端口与优雅退出
+type Server struct {
+	cfg      *config.DeliveryReportConfig
+	token    string
+	receiver Receiver
+	server   *http.Server
+	logger   appLogger.Logger
+}
+
+// NewServer 创建接收供应商推送的 HTTP 服务
+func NewServer(cfg *config.DeliveryReportConfig, receiver Receiver, logger appLogger.Logger) *Server {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	s := &Server{
+		cfg:      cfg,
+		token:    cfg.GetToken(),
+		receiver: receiver,
+		logger:   logger,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /dlr/{provider}", s.handleReport)
+	s.server = &http.Server{
+		Addr:              cfg.Addr(),
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	return s
+}
+
+// Start 监听配置的端口并阻塞提供服务，直到 Stop 被调用
+func (s *Server) Start() error {
+	addr := s.cfg.Addr()
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	s.logger.Info("回执接收服务启动", zap.String("addr", addr))
+	if err := s.server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("http serve: %w", err)
+	}
+	return nil
+}
+
+// Stop 优雅退出；超过配置的等待时间后强制关闭
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(s.cfg.GracefulStopTimeout)*time.Second)
+	defer cancel()
+
+	if err := s.server.Shutdown(ctx); err != nil {
+		_ = s.server.Close()
+		s.logger.Warn("回执接收服务优雅退出超时，已强制关闭", zap.Error(err))
+		return
+	}
+	s.logger.Info("回执接收服务已优雅退出")
+}
+
+// handleReport 处理一次回执推送：校验令牌、读取请求体并交给接收器，处理成功后按供应商要求的格式应答
+func (s *Server) handleReport(w http.ResponseWriter, r *http.Request) {
+	providerName := r.PathValue("provider")
+	token := r.Header.Get(HeaderToken)
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+	if s.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+		s.logger.Warn("回执推送令牌无效", zap.String("provider", providerName), zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.cfg.MaxBodyBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "read request body failed", http.StatusBadRequest)
+		return
+	}
+
+	contentType, ack, err := s.receiver.Receive(r.Context(), providerName, body)
+	if err != nil {
+		s.logger.Warn("处理回执推送失败", zap.String("provider", providerName), zap.Error(err))
+		code := statusCode(err)
+		msg := err.Error()
+		if code == http.StatusInternalServerError {
+			msg = http.StatusText(code)
+		}
+		http.Error(w, msg, code)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(ack)
+}
+
+// statusCode 将接收器返回的错误映射为 HTTP 状态码；服务端错误时供应商会重新推送
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, dlr.ErrUnknownProvider), errors.Is(err, dlr.ErrPushNotSupported):
+		return http.StatusNotFound
+	case errors.Is(err, dlr.ErrInvalidReport):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// SendAttempt 一次向供应商发送的尝试，渠道兜底时一次发送可能包含多次尝试
+type SendAttempt struct {
+	// ID 尝试记录 ID
+	ID uint64
+	// NotificationID 所属通知 ID
+	NotificationID uint64
+	// Seq 通知的第几次发送尝试，从 1 开始，跨重试与供应商兜底递增
+	Seq int
+	// Provider 供应商实例名称
+	Provider string
+	// Succeeded 供应商是否受理成功
+	Succeeded bool
+	// MessageID 供应商侧的消息 ID，失败时为空
+	MessageID string
+	// ErrorCategory 失败时的错误分类（RETRYABLE、PERMANENT、THROTTLED）
+	ErrorCategory string
+	// ErrorCode 失败时供应商返回的错误码
+	ErrorCode string
+	// ErrorMessage 失败原因
+	ErrorMessage string
+	// Duration 本次尝试耗时
+	Duration time.Duration
+	// CreatedAt 尝试时间
+	CreatedAt time.Time
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// maxCallbackURLLen 回调地址的最大长度
+const maxCallbackURLLen = 512
+
+// CallbackStatus 回调的投递状态
+type CallbackStatus string
+
+const (
+	// CallbackStatusPending 等待投递或等待重试
+	CallbackStatusPending CallbackStatus = "PENDING"
+	// CallbackStatusSucceeded 业务方已返回 2xx
+	CallbackStatusSucceeded CallbackStatus = "SUCCEEDED"
+	// CallbackStatusFailed 重试次数用完仍未成功，或无法投递（如未配置签名密钥）
+	CallbackStatusFailed CallbackStatus = "FAILED"
+)
+
+// Callback 通知进入终态时向业务方投递的一次回调，与通知状态在同一事务中写入，提交后由回调投递器投递
+type Callback struct {
+	// ID 回调 ID，业务方可按回调 ID 去重
+	ID uint64
+	// NotificationID 通知 ID
+	NotificationID uint64
+	// TenantID 所属租户，按租户的签名密钥签名
+	TenantID string
+	// BizKey 业务键
+	BizKey string
+	// Channel 渠道
+	Channel Channel
+	// URL 回调地址
+	URL string
+	// NotificationStatus 回调告知的通知终态
+	NotificationStatus SendStatus
+	// ErrorMessage 通知的失败原因
+	ErrorMessage string
+	// Receipt 供应商回执
+	Receipt Receipt
+	// OccurredAt 通知进入终态的时间
+	OccurredAt time.Time
+	// Status 投递状态
+	Status CallbackStatus
+	// Attempts 已投递次数
+	Attempts int
+	// NextAttemptAt 下次投递时间，投递结束后为零值
+	NextAttemptAt time.Time
+	// LastError 最近一次投递失败的原因
+	LastError string
+	// CreatedAt 创建时间
+	CreatedAt time.Time
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time
+}
+
+// CallbackAttempt 回调的一次投递记录
+type CallbackAttempt struct {
+	// ID 投递记录 ID
+	ID uint64
+	// CallbackID 回调 ID
+	CallbackID uint64
+	// NotificationID 通知 ID
+	NotificationID uint64
+	// Attempt 投递序号，首次投递为 1
+	Attempt int
+	// URL 回调地址
+	URL string
+	// StatusCode 业务方返回的 HTTP 状态码，未收到响应时为 0
+	StatusCode int
+	// Error 投递失败的原因，成功时为空
+	Error string
+	// Duration 投递耗时
+	Duration time.Duration
+	// AttemptedAt 投递时间
+	AttemptedAt time.Time
+}
+
+// ValidateCallbackURL 校验回调地址：须为带主机名的 http 或 https 地址
+// 主机解析到的地址是否允许访问由回调投递器在建立连接时校验
+func ValidateCallbackURL(raw string) error {
+	if len(raw) > maxCallbackURLLen {
+		return fmt.Errorf("回调地址长度不能超过 %d", maxCallbackURLLen)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("回调地址应为 http 或 https 地址: %q", raw)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrDeadLetterNotFound 死信不存在，或通知已不在死信中
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter 一条死信：重试耗尽或遇到永久性错误而最终发送失败的通知
+type DeadLetter struct {
+	// NotificationID 通知 ID
+	NotificationID uint64
+	// BizKey 业务键
+	BizKey string
+	// Channel 发送渠道
+	Channel Channel
+	// Recipient 接收者
+	Recipient string
+	// Attempts 累计发送次数
+	Attempts int
+	// ErrorCategory 最后一次失败的错误类别
+	ErrorCategory string
+	// LastError 最后一次失败的原因
+	LastError string
+	// Provider 最后一次尝试的供应商
+	Provider string
+	// CreatedAt 移入死信的时间
+	CreatedAt time.Time
+	// UpdatedAt 最后修改时间
+	UpdatedAt time.Time
+}
+
+// DeadLetterFilter 死信筛选条件，零值字段不参与筛选
+type DeadLetterFilter struct {
+	// Channel 发送渠道
+	Channel Channel
+	// ErrorCategory 错误类别
+	ErrorCategory string
+	// CreatedAfter 移入死信的时间下限（含）
+	CreatedAfter time.Time
+	// CreatedBefore 移入死信的时间上限（不含）
+	CreatedBefore time.Time
+	// AfterID 只返回通知 ID 大于该值的死信，用于分页
+	AfterID uint64
+	// Limit 最多返回的条数
+	Limit int
+}
+
+// NotificationPatch 对通知内容的修改，nil 字段保持不变
+type NotificationPatch struct {
+	// Recipient 新的接收者
+	Recipient *string
+	// TemplateID 新的模板 ID
+	TemplateID *string
+	// TemplateParams 新的模板参数，整体替换
+	TemplateParams map[string]string
+	// Email 新的邮件内容，整体替换
+	Email *EmailContent
+}
+
+// IsEmpty 判断是否没有任何修改
+func (p *NotificationPatch) IsEmpty() bool {
+	return p.Recipient == nil && p.TemplateID == nil && p.TemplateParams == nil && p.Email == nil
+}
+
+// TemplateChanged 判断是否修改了模板或模板参数
+func (p *NotificationPatch) TemplateChanged() bool {
+	return p.TemplateID != nil || p.TemplateParams != nil
+}
+
+// Apply 将修改应用到通知上；修改模板或模板参数时清除原模板版本与语言，
+// 未同时指定邮件内容的模板邮件清除原渲染结果，由模板服务重新校验与渲染
+func (p *NotificationPatch) Apply(n *Notification) {
+	if p.Recipient != nil {
+		n.Recipient = *p.Recipient
+	}
+	if p.TemplateID != nil {
+		n.Template.ID = *p.TemplateID
+	}
+	if p.TemplateParams != nil {
+		n.Template.Params = p.TemplateParams
+	}
+	if p.TemplateChanged() {
+		n.Template.Version = 0
+		n.Template.Locale = ""
+		if p.Email == nil && n.Template.ID != "" {
+			n.Email = nil
+		}
+	}
+	if p.Email != nil {
+		n.Email = p.Email
+	}
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// ReportSource 投递回执的来源
+type ReportSource string
+
+const (
+	// ReportSourcePush 供应商通过 HTTP 推送的回执（短信状态报告、邮件退信与投诉）
+	ReportSourcePush ReportSource = "PUSH"
+	// ReportSourcePoll 回执拉取器向供应商查询得到的回执
+	ReportSourcePoll ReportSource = "POLL"
+)
+
+// DeliveryReport 供应商给出的一条投递回执（DLR），按供应商与消息 ID 对应到已成功提交的通知
+type DeliveryReport struct {
+	// Provider 供应商实例名称
+	Provider string
+	// MessageID 发送时供应商返回的消息 ID
+	MessageID string
+	// Recipient 接收者；同一消息 ID 对应多条通知（如阿里云批量发送）时按接收者区分
+	Recipient string
+	// Status 回执对应的通知状态：SendStatusDelivered 或 SendStatusFailed
+	Status SendStatus
+	// Code 供应商返回的状态码
+	Code string
+	// Message 供应商返回的状态描述
+	Message string
+	// Source 回执来源
+	Source ReportSource
+	// ReportedAt 供应商给出结果的时间，未知时为零值
+	ReportedAt time.Time
+}
+
+// ReportOutcome 投递回执的处理结果
+type ReportOutcome string
+
+const (
+	// ReportOutcomeApplied 通知已按回执变为已送达或发送失败
+	ReportOutcomeApplied ReportOutcome = "APPLIED"
+	// ReportOutcomeIgnored 通知已不处于已成功提交状态（如重复推送的回执），回执仅记录
+	ReportOutcomeIgnored ReportOutcome = "IGNORED"
+	// ReportOutcomeUnmatched 尚未找到对应的通知（如回执先于发送结果落库），回执暂存并在一段时间内重新匹配
+	ReportOutcomeUnmatched ReportOutcome = "UNMATCHED"
+)
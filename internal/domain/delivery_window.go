@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// minutesPerDay 一天的分钟数
+const minutesPerDay = 24 * 60
+
+// ClockRange 一天中的时间段 [Start, End)，以自零点起的分钟数表示；End 小于 Start 表示跨零点，如 22:00-08:00
+type ClockRange struct {
+	// Start 开始时间（含）
+	Start int
+	// End 结束时间（不含）
+	End int
+}
+
+// Contains 判断一天中的第 minute 分钟是否在时间段内
+func (r ClockRange) Contains(minute int) bool {
+	if r.Start < r.End {
+		return minute >= r.Start && minute < r.End
+	}
+	return minute >= r.Start || minute < r.End
+}
+
+// String 以 HH:MM-HH:MM 格式表示时间段
+func (r ClockRange) String() string {
+	return FormatClock(r.Start) + "-" + FormatClock(r.End)
+}
+
+// validate 校验时间段的起止时间
+func (r ClockRange) validate() error {
+	if r.Start < 0 || r.Start >= minutesPerDay || r.End < 0 || r.End >= minutesPerDay {
+		return fmt.Errorf("%w: 时间段超出 00:00-23:59", ErrInvalidTenant)
+	}
+	if r.Start == r.End {
+		return fmt.Errorf("%w: 时间段的开始与结束时间不能相同: %s", ErrInvalidTenant, r)
+	}
+	return nil
+}
+
+// ParseClock 将 HH:MM 格式的时间解析为自零点起的分钟数
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: 时间格式应为 HH:MM: %q", ErrInvalidTenant, s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// FormatClock 将自零点起的分钟数格式化为 HH:MM
+func FormatClock(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// DeliveryWindow 租户在一个渠道上的发送时段规则，按接收者所在时区判断
+// 非事务类通知在不允许发送的时间受理时，推迟到下一个允许发送的时间
+type DeliveryWindow struct {
+	// Channel 渠道
+	Channel Channel
+	// AllowedWindows 允许发送的时间段，为空表示全天允许
+	AllowedWindows []ClockRange
+	// QuietHours 免打扰时间段，优先于允许发送的时间段
+	QuietHours []ClockRange
+}
+
+// Allows 判断一天中的第 minute 分钟是否允许发送
+func (w DeliveryWindow) Allows(minute int) bool {
+	for _, r := range w.QuietHours {
+		if r.Contains(minute) {
+			return false
+		}
+	}
+	if len(w.AllowedWindows) == 0 {
+		return true
+	}
+	for _, r := range w.AllowedWindows {
+		if r.Contains(minute) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextAllowed 返回不早于 t 的最早允许发送时间，按 t 所在的时区判断；规则不允许任何时间发送时返回 t
+// 允许发送的起点只可能是允许时间段的开始或免打扰时间段的结束，因此只需检查之后两天内的这些时间点
+func (w DeliveryWindow) NextAllowed(t time.Time) time.Time {
+	if w.Allows(minuteOfDay(t)) {
+		return t
+	}
+	var next time.Time
+	year, month, day := t.Date()
+	for d := 0; d <= 2; d++ {
+		for _, minute := range w.boundaries() {
+			c := time.Date(year, month, day+d, minute/60, minute%60, 0, 0, t.Location())
+			if minuteOfDay(c) != minute {
+				c = nearestTransition(c)
+			}
+			if c.After(t) && w.Allows(minuteOfDay(c)) && (next.IsZero() || c.Before(next)) {
+				next = c
+			}
+		}
+	}
+	if next.IsZero() {
+		return t
+	}
+	return next
+}
+
+// nearestTransition 返回离 c 最近的时区切换时刻
+// 夏令时开始时跳过的墙上时间不存在，time.Date 规范化后的结果可能落在跳过的时间之前，
+// 此时真正的起点是切换后的第一个时刻
+func nearestTransition(c time.Time) time.Time {
+	start, end := c.ZoneBounds()
+	if start.IsZero() && end.IsZero() {
+		return c
+	}
+	if end.IsZero() || (!start.IsZero() && c.Sub(start) < end.Sub(c)) {
+		return start
+	}
+	return end
+}
+
+// boundaries 可能成为允许发送起点的时间点
+func (w DeliveryWindow) boundaries() []int {
+	minutes := make([]int, 0, len(w.AllowedWindows)+len(w.QuietHours))
+	for _, r := range w.AllowedWindows {
+		minutes = append(minutes, r.Start)
+	}
+	for _, r := range w.QuietHours {
+		minutes = append(minutes, r.End)
+	}
+	return minutes
+}
+
+// validate 校验发送时段规则，规则须至少允许一天中的某个时间发送
+func (w DeliveryWindow) validate() error {
+	if !w.Channel.IsValid() {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, w.Channel)
+	}
+	for _, r := range append(append([]ClockRange{}, w.AllowedWindows...), w.QuietHours...) {
+		if err := r.validate(); err != nil {
+			return err
+		}
+	}
+	for minute := 0; minute < minutesPerDay; minute++ {
+		if w.Allows(minute) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: 渠道 %s 的发送时段规则不允许任何时间发送", ErrInvalidTenant, w.Channel)
+}
+
+// minuteOfDay 返回 t 在其所在时区中是一天中的第几分钟
+func minuteOfDay(t time.Time) int {
+	return t.Hour()*60 + t.Minute()
+}
+
+// validateTimezone 校验 IANA 时区名，空字符串表示未设置
+func validateTimezone(name string) error {
+	if name == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("未知的时区: %q", name)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // 运行环境可能没有时区数据
+
+	"github.com/dingdong-postman/internal/domain"
+)
+
+// clockRange 解析 HH:MM-HH:MM 格式的时间段
+func clockRange(t *testing.T, start, end string) domain.ClockRange {
+	t.Helper()
+	s, err := domain.ParseClock(start)
+	if err != nil {
+		t.Fatalf("ParseClock(%q): %v", start, err)
+	}
+	e, err := domain.ParseClock(end)
+	if err != nil {
+		t.Fatalf("ParseClock(%q): %v", end, err)
+	}
+	return domain.ClockRange{Start: s, End: e}
+}
+
+// location 加载时区
+func location(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q): %v", name, err)
+	}
+	return loc
+}
+
+func TestDeliveryWindowNextAllowed(t *testing.T) {
+	shanghai := location(t, "Asia/Shanghai")
+	newYork := location(t, "America/New_York")
+	at := func(loc *time.Location, year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, loc)
+	}
+
+	quietNight := domain.DeliveryWindow{
+		Channel:    domain.ChannelSMS,
+		QuietHours: []domain.ClockRange{clockRange(t, "22:00", "08:00")},
+	}
+	officeHours := domain.DeliveryWindow{
+		Channel: domain.ChannelSMS,
+		AllowedWindows: []domain.ClockRange{
+			clockRange(t, "09:00", "12:00"),
+			clockRange(t, "14:00", "18:00"),
+		},
+	}
+	eveningAcrossMidnight := domain.DeliveryWindow{
+		Channel:        domain.ChannelSMS,
+		AllowedWindows: []domain.ClockRange{clockRange(t, "20:00", "02:00")},
+	}
+	lunchBreak := domain.DeliveryWindow{
+		Channel:        domain.ChannelSMS,
+		AllowedWindows: []domain.ClockRange{clockRange(t, "08:00", "23:00")},
+		QuietHours:     []domain.ClockRange{clockRange(t, "12:00", "14:00")},
+	}
+
+	tests := []struct {
+		name   string
+		window domain.DeliveryWindow
+		t      time.Time
+		want   time.Time
+	}{
+		{
+			name:   "allowed time is kept",
+			window: quietNight,
+			t:      at(shanghai, 2024, 3, 15, 21, 59),
+			want:   at(shanghai, 2024, 3, 15, 21, 59),
+		},
+		{
+			name:   "quiet hours start is inclusive",
+			window: quietNight,
+			t:      at(shanghai, 2024, 3, 15, 22, 0),
+			want:   at(shanghai, 2024, 3, 16, 8, 0),
+		},
+		{
+			name:   "before midnight defers to next morning",
+			window: quietNight,
+			t:      at(shanghai, 2024, 3, 15, 23, 30),
+			want:   at(shanghai, 2024, 3, 16, 8, 0),
+		},
+		{
+			name:   "after midnight defers to same morning",
+			window: quietNight,
+			t:      at(shanghai, 2024, 3, 16, 3, 0),
+			want:   at(shanghai, 2024, 3, 16, 8, 0),
+		},
+		{
+			name:   "quiet hours end is exclusive",
+			window: quietNight,
+			t:      at(shanghai, 2024, 3, 16, 8, 0),
+			want:   at(shanghai, 2024, 3, 16, 8, 0),
+		},
+		{
+			name:   "across month and year end",
+			window: quietNight,
+			t:      at(shanghai, 2024, 12, 31, 23, 0),
+			want:   at(shanghai, 2025, 1, 1, 8, 0),
+		},
+		{
+			name:   "before first allowed window",
+			window: officeHours,
+			t:      at(shanghai, 2024, 3, 15, 7, 0),
+			want:   at(shanghai, 2024, 3, 15, 9, 0),
+		},
+		{
+			name:   "between allowed windows",
+			window: officeHours,
+			t:      at(shanghai, 2024, 3, 15, 12, 30),
+			want:   at(shanghai, 2024, 3, 15, 14, 0),
+		},
+		{
+			name:   "after last allowed window",
+			window: officeHours,
+			t:      at(shanghai, 2024, 3, 15, 18, 0),
+			want:   at(shanghai, 2024, 3, 16, 9, 0),
+		},
+		{
+			name:   "allowed window across midnight, inside after midnight",
+			window: eveningAcrossMidnight,
+			t:      at(shanghai, 2024, 3, 16, 1, 0),
+			want:   at(shanghai, 2024, 3, 16, 1, 0),
+		},
+		{
+			name:   "allowed window across midnight, outside",
+			window: eveningAcrossMidnight,
+			t:      at(shanghai, 2024, 3, 16, 3, 0),
+			want:   at(shanghai, 2024, 3, 16, 20, 0),
+		},
+		{
+			name:   "quiet hours inside allowed window",
+			window: lunchBreak,
+			t:      at(shanghai, 2024, 3, 15, 12, 30),
+			want:   at(shanghai, 2024, 3, 15, 14, 0),
+		},
+		{
+			name:   "same instant allowed in another timezone",
+			window: quietNight,
+			// 北京时间 22:00，纽约时间 10:00
+			t:    at(shanghai, 2024, 3, 15, 22, 0).In(newYork),
+			want: at(shanghai, 2024, 3, 15, 22, 0).In(newYork),
+		},
+		{
+			name:   "same instant deferred in recipient timezone",
+			window: quietNight,
+			// 纽约时间 22:00，北京时间次日 10:00
+			t:    at(newYork, 2024, 3, 15, 22, 0),
+			want: at(newYork, 2024, 3, 16, 8, 0),
+		},
+		{
+			name: "quiet hours ending in daylight saving gap",
+			window: domain.DeliveryWindow{
+				Channel:    domain.ChannelSMS,
+				QuietHours: []domain.ClockRange{clockRange(t, "22:00", "02:30")},
+			},
+			// 2024-03-10 02:00 纽约时间拨快到 03:00，02:30 不存在，切换后立即允许发送
+			t:    at(newYork, 2024, 3, 9, 23, 0),
+			want: at(newYork, 2024, 3, 10, 3, 0),
+		},
+		{
+			name: "allowed window starting in daylight saving gap",
+			window: domain.DeliveryWindow{
+				Channel:        domain.ChannelSMS,
+				AllowedWindows: []domain.ClockRange{clockRange(t, "02:15", "05:00")},
+			},
+			t:    at(newYork, 2024, 3, 10, 1, 0),
+			want: at(newYork, 2024, 3, 10, 3, 0),
+		},
+		{
+			name: "no allowed time returns t",
+			window: domain.DeliveryWindow{
+				Channel:        domain.ChannelSMS,
+				AllowedWindows: []domain.ClockRange{clockRange(t, "10:00", "11:00")},
+				QuietHours:     []domain.ClockRange{clockRange(t, "09:00", "12:00")},
+			},
+			t:    at(shanghai, 2024, 3, 15, 10, 30),
+			want: at(shanghai, 2024, 3, 15, 10, 30),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.window.NextAllowed(tt.t)
+			if !got.Equal(tt.want) {
+				t.Errorf("NextAllowed(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+			if got.Location() != tt.t.Location() {
+				t.Errorf("NextAllowed(%v) location = %v, want %v", tt.t, got.Location(), tt.t.Location())
+			}
+		})
+	}
+}
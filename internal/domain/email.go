@@ -0,0 +1,25 @@
+package domain
+
+// EmailContent 邮件内容
+type EmailContent struct {
+	// Subject 邮件主题
+	Subject string
+	// HTMLBody HTML 正文
+	HTMLBody string
+	// TextBody 纯文本正文；与 HTMLBody 同时存在时作为 multipart/alternative 的备选内容
+	TextBody string
+	// Attachments 附件
+	Attachments []Attachment
+	// Headers 自定义邮件头
+	Headers map[string]string
+}
+
+// Attachment 邮件附件
+type Attachment struct {
+	// Filename 文件名
+	Filename string
+	// ContentType MIME 类型，为空时按文件扩展名推断
+	ContentType string
+	// Content 文件内容
+	Content []byte
+}
@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrNotificationNotFound 通知记录不存在
+	ErrNotificationNotFound = errors.New("notification not found")
+	// ErrUnsupportedChannel 不支持的通知渠道
+	ErrUnsupportedChannel = errors.New("unsupported channel")
+	// ErrInvalidNotification 通知内容不完整
+	ErrInvalidNotification = errors.New("invalid notification")
+	// ErrDuplicateBizKey 业务键已存在
+	ErrDuplicateBizKey = errors.New("duplicate biz key")
+	// ErrNotCancelable 通知不是待发送的定时通知（已开始发送或已取消），无法取消
+	ErrNotCancelable = errors.New("notification not cancelable")
+	// ErrNotPrepared 通知不是预提交的两阶段通知，或已按相反的结果结束（已提交的不能取消，已取消的不能提交）
+	ErrNotPrepared = errors.New("notification not prepared")
+)
+
+// Channel 通知渠道
+type Channel string
+
+const (
+	// ChannelSMS 短信渠道
+	ChannelSMS Channel = "SMS"
+	// ChannelEmail 邮件渠道
+	ChannelEmail Channel = "EMAIL"
+)
+
+// IsValid 判断渠道是否为平台支持的渠道
+func (c Channel) IsValid() bool {
+	return c == ChannelSMS || c == ChannelEmail
+}
+
+// SendStatus 通知发送状态
+type SendStatus string
+
+const (
+	// SendStatusPending 已受理，等待发送
+	SendStatusPending SendStatus = "PENDING"
+	// SendStatusSending 发送中
+	SendStatusSending SendStatus = "SENDING"
+	// SendStatusSucceeded 已成功提交给渠道供应商，收到供应商回执后变为已送达或发送失败
+	SendStatusSucceeded SendStatus = "SUCCEEDED"
+	// SendStatusFailed 发送失败，或供应商回执告知未送达
+	SendStatusFailed SendStatus = "FAILED"
+	// SendStatusRetrying 发送失败，等待按重试策略再次发送
+	SendStatusRetrying SendStatus = "RETRYING"
+	// SendStatusScheduled 定时通知，等待到达发送时间
+	SendStatusScheduled SendStatus = "SCHEDULED"
+	// SendStatusCanceled 定时通知在发送前被取消，或两阶段通知被取消
+	SendStatusCanceled SendStatus = "CANCELED"
+	// SendStatusPrepared 两阶段通知已预提交，等待调用方提交或取消
+	SendStatusPrepared SendStatus = "PREPARED"
+	// SendStatusDelivered 供应商回执确认已送达（短信到达手机，邮件被收件服务器接收）
+	SendStatusDelivered SendStatus = "DELIVERED"
+)
+
+// IsFinal 判断状态是否为终态；已成功提交的通知收到供应商回执后仍会变为已送达或发送失败
+func (s SendStatus) IsFinal() bool {
+	return s == SendStatusSucceeded || s == SendStatusDelivered || s == SendStatusFailed || s == SendStatusCanceled
+}
+
+// Template 通知使用的模板及其参数
+type Template struct {
+	// ID 模板 ID
+	ID string
+	// Params 模板参数
+	Params map[string]string
+	// Version 受理时使用的平台模板版本，受理后不随模板生效版本的切换而变化
+	Version int
+	// Locale 受理时选中的模板语言版本，为空表示使用默认内容
+	Locale string
+	// ParamNames 变量的位置顺序，供按位置传参的供应商使用；发送前由供应商模板审核记录填充，不落库
+	ParamNames []string
+}
+
+// Notification 一条通知
+type Notification struct {
+	// ID 平台生成的通知 ID
+	ID uint64
+	// TenantID 所属租户；未接入租户鉴权时为空
+	TenantID string
+	// BizKey 业务方生成的唯一业务键
+	BizKey string
+	// Channel 发送渠道
+	Channel Channel
+	// Recipient 接收者：短信为手机号，邮件为邮箱地址
+	Recipient string
+	// Template 模板及参数
+	Template Template
+	// Locale 接收者的语言，如 zh-TW、en-US；请求未指定时取接收者资料中的语言
+	Locale string
+	// Email 邮件内容，仅邮件渠道使用；为空时由模板生成
+	Email *EmailContent
+	// Sender 发送方，未设置的字段使用供应商配置的默认值
+	Sender Sender
+	// ScheduledAt 定时发送时间，为零值表示立即发送
+	ScheduledAt time.Time
+	// Transactional 是否为事务类通知（如验证码、交易提醒），事务类通知不受租户发送时段规则限制
+	Transactional bool
+	// CallbackURL 通知进入终态时接收回调的 HTTP(S) 地址；请求未指定时使用租户的回调地址，为空表示不回调
+	CallbackURL string
+	// Status 发送状态
+	Status SendStatus
+	// ErrorMessage 失败原因
+	ErrorMessage string
+	// Receipt 供应商受理回执
+	Receipt Receipt
+	// CreatedAt 创建时间
+	CreatedAt time.Time
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time
+}
+
+// Validate 校验通知内容是否足以发送
+func (n *Notification) Validate() error {
+	if !n.Channel.IsValid() {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, n.Channel)
+	}
+	switch n.Channel {
+	case ChannelSMS:
+		if n.Template.ID == "" {
+			return fmt.Errorf("%w: 短信必须指定模板 ID", ErrInvalidNotification)
+		}
+	case ChannelEmail:
+		if n.Template.ID == "" && n.Email == nil {
+			return fmt.Errorf("%w: 邮件必须指定模板 ID 或邮件内容", ErrInvalidNotification)
+		}
+		if n.Email != nil && n.Email.HTMLBody == "" && n.Email.TextBody == "" {
+			return fmt.Errorf("%w: 邮件正文不能为空", ErrInvalidNotification)
+		}
+	}
+	if n.CallbackURL != "" {
+		if err := ValidateCallbackURL(n.CallbackURL); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidNotification, err)
+		}
+	}
+	return nil
+}
+
+// Sender 通知的发送方
+type Sender struct {
+	// SignName 短信签名
+	SignName string
+	// EmailFrom 邮件发件人，格式为 "名称 <地址>" 或仅地址
+	EmailFrom string
+}
+
+// Receipt 供应商受理回执
+type Receipt struct {
+	// Provider 实际发送的供应商名称
+	Provider string
+	// MessageID 供应商侧的消息 ID
+	MessageID string
+}
+
+// SendResult 一次发送的结果
+type SendResult struct {
+	// NotificationID 通知 ID
+	NotificationID uint64
+	// Status 发送状态
+	Status SendStatus
+	// ErrorMessage 失败原因
+	ErrorMessage string
+	// Duplicated 是否为重复请求；为 true 时返回的是首次请求的通知 ID 与当前状态
+	Duplicated bool
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrRateLimited 请求超出限流规则
+var ErrRateLimited = errors.New("rate limited")
+
+// RateLimitError 请求被限流规则拒绝
+type RateLimitError struct {
+	// Rule 拒绝请求的规则名称
+	Rule string
+	// RetryAfter 建议的重试等待时间
+	RetryAfter time.Duration
+}
+
+// Error 实现 error 接口
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited by rule %s, retry after %s", e.Rule, e.RetryAfter)
+}
+
+// Unwrap 使 errors.Is(err, ErrRateLimited) 成立
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
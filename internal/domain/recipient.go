@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrRecipientProfileNotFound 接收者资料不存在
+	ErrRecipientProfileNotFound = errors.New("recipient profile not found")
+	// ErrInvalidRecipientProfile 接收者资料不完整或不合法
+	ErrInvalidRecipientProfile = errors.New("invalid recipient profile")
+)
+
+// maxRecipientLen 接收者的最大长度，与通知记录的 recipient 列一致
+const maxRecipientLen = 256
+
+// RecipientProfile 租户维护的接收者资料，受理通知时用于补全请求未指定的语言等偏好
+type RecipientProfile struct {
+	// TenantID 所属租户；未接入租户鉴权时为空
+	TenantID string
+	// Recipient 接收者：手机号或邮箱地址，与发送通知时的 recipient 一致
+	Recipient string
+	// Locale 接收者的语言，如 zh-CN、zh-TW、en-US
+	Locale string
+	// Timezone 接收者所在的 IANA 时区，如 Asia/Shanghai，用于按发送时段规则判断是否允许发送
+	Timezone string
+	// CreatedAt 创建时间
+	CreatedAt time.Time
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time
+}
+
+// Validate 校验接收者资料
+func (p *RecipientProfile) Validate() error {
+	if p.Recipient == "" || utf8.RuneCountInString(p.Recipient) > maxRecipientLen {
+		return fmt.Errorf("%w: 接收者不能为空，长度不超过 %d", ErrInvalidRecipientProfile, maxRecipientLen)
+	}
+	if p.Locale == "" && p.Timezone == "" {
+		return fmt.Errorf("%w: 语言与时区至少设置一项", ErrInvalidRecipientProfile)
+	}
+	if p.Locale != "" && !localePattern.MatchString(p.Locale) {
+		return fmt.Errorf("%w: 语言标签不合法: %q", ErrInvalidRecipientProfile, p.Locale)
+	}
+	if err := validateTimezone(p.Timezone); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRecipientProfile, err)
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// RetryTask 一条通知的重试计划
+type RetryTask struct {
+	// NotificationID 通知 ID
+	NotificationID uint64
+	// Channel 通知渠道，用于选择重试策略
+	Channel Channel
+	// Attempts 已发送次数（含首次发送）；领取后为本次重试的序号
+	Attempts int
+	// NextRetryAt 下次重试时间
+	NextRetryAt time.Time
+	// LastError 最近一次失败原因
+	LastError string
+}
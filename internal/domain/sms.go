@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// SMSEncoding 短信的字符编码
+type SMSEncoding string
+
+const (
+	// SMSEncodingGSM7 GSM 03.38 7 位默认字母表，单条 160 个字符，长短信每段 153 个字符
+	SMSEncodingGSM7 SMSEncoding = "GSM7"
+	// SMSEncodingUCS2 含 GSM 字母表以外的字符（如中文、emoji）时使用，单条 70 个字符，长短信每段 67 个字符
+	SMSEncodingUCS2 SMSEncoding = "UCS2"
+)
+
+// MaxSMSLength 一条长短信（含签名）允许的最大字符数，阿里云与腾讯云均为 500
+const MaxSMSLength = 500
+
+// 单条与长短信每段可容纳的编码单元数，以及 GSM 默认字母表与扩展字符（扩展字符以转义符开头，占两个单元）
+const (
+	gsm7SingleLimit     = 160
+	gsm7SegmentLimit    = 153
+	ucs2SingleLimit     = 70
+	ucs2SegmentLimit    = 67
+	gsm7BasicCharset    = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+	gsm7ExtendedCharset = "\f^{}\\[~]|€"
+)
+
+// SMSStats 短信内容的编码与计费条数
+type SMSStats struct {
+	// Encoding 字符编码
+	Encoding SMSEncoding
+	// Characters 字符数，供应商按该值判断是否超长
+	Characters int
+	// Segments 按编码拆分后的条数，运营商按条计费
+	Segments int
+	// OverLength 字符数是否超过 MaxSMSLength，超长的短信会被供应商拒绝
+	OverLength bool
+}
+
+// AnalyzeSMS 计算短信内容的编码、字符数与条数
+// GSM 扩展字符（如 €、{）占两个 7 位编码单元，UCS-2 下 emoji 等辅助平面字符占两个编码单元，
+// 拆分长短信时这些字符不会跨段
+func AnalyzeSMS(text string) SMSStats {
+	stats := SMSStats{
+		Encoding:   SMSEncodingGSM7,
+		Characters: utf8.RuneCountInString(text),
+	}
+	stats.OverLength = stats.Characters > MaxSMSLength
+
+	if units, ok := gsm7Units(text); ok {
+		stats.Segments = segments(units, gsm7SingleLimit, gsm7SegmentLimit)
+		return stats
+	}
+	stats.Encoding = SMSEncodingUCS2
+	units := make([]int, 0, stats.Characters)
+	for _, r := range text {
+		units = append(units, utf16.RuneLen(r))
+	}
+	stats.Segments = segments(units, ucs2SingleLimit, ucs2SegmentLimit)
+	return stats
+}
+
+// gsm7Units 返回每个字符在 GSM 7 位字母表中占用的编码单元数，含字母表以外的字符时返回 false
+func gsm7Units(text string) ([]int, bool) {
+	units := make([]int, 0, len(text))
+	for _, r := range text {
+		switch {
+		case strings.ContainsRune(gsm7BasicCharset, r):
+			units = append(units, 1)
+		case strings.ContainsRune(gsm7ExtendedCharset, r):
+			units = append(units, 2)
+		default:
+			return nil, false
+		}
+	}
+	return units, true
+}
+
+// segments 按每个字符占用的编码单元数计算条数：总数不超过 single 时为一条，否则按每段 perSegment 拆分，字符不跨段
+func segments(units []int, single, perSegment int) int {
+	total := 0
+	for _, u := range units {
+		total += u
+	}
+	switch {
+	case total == 0:
+		return 0
+	case total <= single:
+		return 1
+	}
+	count, used := 1, 0
+	for _, u := range units {
+		if used+u > perSegment {
+			count++
+			used = 0
+		}
+		used += u
+	}
+	return count
+}
@@ -0,0 +1,160 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dingdong-postman/internal/domain"
+)
+
+func TestAnalyzeSMS(t *testing.T) {
+	tests := []struct {
+		name           string
+		text           string
+		wantEncoding   domain.SMSEncoding
+		wantCharacters int
+		wantSegments   int
+		wantOverLength bool
+	}{
+		{
+			name:         "empty",
+			text:         "",
+			wantEncoding: domain.SMSEncodingGSM7,
+		},
+		{
+			name:           "gsm7 single limit",
+			text:           strings.Repeat("a", 160),
+			wantEncoding:   domain.SMSEncodingGSM7,
+			wantCharacters: 160,
+			wantSegments:   1,
+		},
+		{
+			name:           "gsm7 one over single limit",
+			text:           strings.Repeat("a", 161),
+			wantEncoding:   domain.SMSEncodingGSM7,
+			wantCharacters: 161,
+			wantSegments:   2,
+		},
+		{
+			name:           "gsm7 two full segments",
+			text:           strings.Repeat("a", 306),
+			wantEncoding:   domain.SMSEncodingGSM7,
+			wantCharacters: 306,
+			wantSegments:   2,
+		},
+		{
+			name:           "gsm7 one over two segments",
+			text:           strings.Repeat("a", 307),
+			wantEncoding:   domain.SMSEncodingGSM7,
+			wantCharacters: 307,
+			wantSegments:   3,
+		},
+		{
+			name:           "gsm7 basic charset symbols",
+			text:           "Prix: 5£ ou 6$? Oui @home_Δ! è é",
+			wantEncoding:   domain.SMSEncodingGSM7,
+			wantCharacters: 32,
+			wantSegments:   1,
+		},
+		{
+			name:           "gsm7 extended characters take two units",
+			text:           strings.Repeat("€", 80),
+			wantEncoding:   domain.SMSEncodingGSM7,
+			wantCharacters: 80,
+			wantSegments:   1,
+		},
+		{
+			name:           "gsm7 extended characters over single limit",
+			text:           strings.Repeat("a", 159) + "€",
+			wantEncoding:   domain.SMSEncodingGSM7,
+			wantCharacters: 160,
+			wantSegments:   2,
+		},
+		{
+			name:           "gsm7 extended character not split across segments",
+			text:           strings.Repeat("a", 152) + "€" + strings.Repeat("a", 152),
+			wantEncoding:   domain.SMSEncodingGSM7,
+			wantCharacters: 305,
+			wantSegments:   3,
+		},
+		{
+			name:           "ucs2 single limit",
+			text:           strings.Repeat("中", 70),
+			wantEncoding:   domain.SMSEncodingUCS2,
+			wantCharacters: 70,
+			wantSegments:   1,
+		},
+		{
+			name:           "ucs2 one over single limit",
+			text:           strings.Repeat("中", 71),
+			wantEncoding:   domain.SMSEncodingUCS2,
+			wantCharacters: 71,
+			wantSegments:   2,
+		},
+		{
+			name:           "ucs2 two full segments",
+			text:           strings.Repeat("中", 134),
+			wantEncoding:   domain.SMSEncodingUCS2,
+			wantCharacters: 134,
+			wantSegments:   2,
+		},
+		{
+			name:           "ucs2 one over two segments",
+			text:           strings.Repeat("中", 135),
+			wantEncoding:   domain.SMSEncodingUCS2,
+			wantCharacters: 135,
+			wantSegments:   3,
+		},
+		{
+			name:           "single non-gsm character switches to ucs2",
+			text:           strings.Repeat("a", 70) + "ç",
+			wantEncoding:   domain.SMSEncodingUCS2,
+			wantCharacters: 71,
+			wantSegments:   2,
+		},
+		{
+			name:           "emoji takes two ucs2 units",
+			text:           strings.Repeat("中", 69) + "😀",
+			wantEncoding:   domain.SMSEncodingUCS2,
+			wantCharacters: 70,
+			wantSegments:   2,
+		},
+		{
+			name:           "emoji not split across segments",
+			text:           strings.Repeat("中", 66) + "😀" + strings.Repeat("中", 66),
+			wantEncoding:   domain.SMSEncodingUCS2,
+			wantCharacters: 133,
+			wantSegments:   3,
+		},
+		{
+			name:           "max length",
+			text:           strings.Repeat("中", domain.MaxSMSLength),
+			wantEncoding:   domain.SMSEncodingUCS2,
+			wantCharacters: 500,
+			wantSegments:   8,
+		},
+		{
+			name:           "over length",
+			text:           strings.Repeat("a", domain.MaxSMSLength+1),
+			wantEncoding:   domain.SMSEncodingGSM7,
+			wantCharacters: 501,
+			wantSegments:   4,
+			wantOverLength: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domain.AnalyzeSMS(tt.text)
+			want := domain.SMSStats{
+				Encoding:   tt.wantEncoding,
+				Characters: tt.wantCharacters,
+				Segments:   tt.wantSegments,
+				OverLength: tt.wantOverLength,
+			}
+			if got != want {
+				t.Errorf("AnalyzeSMS() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
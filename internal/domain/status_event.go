@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// StatusEvent 通知的一次状态变更，与状态变更在同一事务中写入事件发件箱，提交后由发布器发布到 Kafka
+type StatusEvent struct {
+	// ID 事件 ID，按写入顺序递增
+	ID uint64
+	// NotificationID 通知 ID
+	NotificationID uint64
+	// TenantID 所属租户
+	TenantID string
+	// BizKey 业务键
+	BizKey string
+	// Channel 渠道
+	Channel Channel
+	// PreviousStatus 变更前的状态，受理时为空
+	PreviousStatus SendStatus
+	// Status 变更后的状态
+	Status SendStatus
+	// ErrorMessage 失败原因
+	ErrorMessage string
+	// Receipt 供应商回执
+	Receipt Receipt
+	// OccurredAt 状态变更时间
+	OccurredAt time.Time
+}
@@ -0,0 +1,357 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrTemplateNotFound 模板不存在
+	ErrTemplateNotFound = errors.New("template not found")
+	// ErrTemplateVersionNotFound 模板版本不存在
+	ErrTemplateVersionNotFound = errors.New("template version not found")
+	// ErrDuplicateTemplate 模板 ID 已存在
+	ErrDuplicateTemplate = errors.New("duplicate template")
+	// ErrInvalidTemplate 模板内容不完整或不合法
+	ErrInvalidTemplate = errors.New("invalid template")
+)
+
+// VariableType 模板变量类型
+type VariableType string
+
+const (
+	// VariableTypeString 任意文本
+	VariableTypeString VariableType = "STRING"
+	// VariableTypeNumber 数字，如 12、-3.5
+	VariableTypeNumber VariableType = "NUMBER"
+	// VariableTypeDate 日期，格式为 2006-01-02
+	VariableTypeDate VariableType = "DATE"
+	// VariableTypeDateTime 日期时间，格式为 RFC 3339
+	VariableTypeDateTime VariableType = "DATETIME"
+	// VariableTypeURL 以 http 或 https 开头的链接
+	VariableTypeURL VariableType = "URL"
+)
+
+// IsValid 判断变量类型是否为平台支持的类型
+func (t VariableType) IsValid() bool {
+	switch t {
+	case VariableTypeString, VariableTypeNumber, VariableTypeDate, VariableTypeDateTime, VariableTypeURL:
+		return true
+	default:
+		return false
+	}
+}
+
+// check 校验参数值是否符合变量类型
+func (t VariableType) check(value string) error {
+	var err error
+	switch t {
+	case VariableTypeNumber:
+		_, err = strconv.ParseFloat(value, 64)
+	case VariableTypeDate:
+		_, err = time.Parse(time.DateOnly, value)
+	case VariableTypeDateTime:
+		_, err = time.Parse(time.RFC3339, value)
+	case VariableTypeURL:
+		var u *url.URL
+		u, err = url.Parse(value)
+		if err == nil && (u.Scheme != "http" && u.Scheme != "https" || u.Host == "") {
+			err = errors.New("not an http(s) url")
+		}
+	}
+	return err
+}
+
+// TemplateVariable 模板中的一个占位变量
+type TemplateVariable struct {
+	// Name 变量名，在模板内容中以 {{name}} 引用
+	Name string
+	// Type 变量类型，发送时按类型校验参数
+	Type VariableType
+	// Required 是否必填；非必填的变量缺失时渲染为空
+	Required bool
+	// Description 变量说明
+	Description string
+}
+
+// MessageTemplate 平台管理的消息模板，内容保存在各个版本中，发送时使用当前生效的版本
+type MessageTemplate struct {
+	// TenantID 所属租户；未接入租户鉴权时为空
+	TenantID string
+	// ID 模板 ID，在租户内唯一
+	ID string
+	// Name 模板名称
+	Name string
+	// Channel 模板适用的渠道
+	Channel Channel
+	// Description 模板说明
+	Description string
+	// ActiveVersion 当前生效的版本号
+	ActiveVersion int
+	// LatestVersion 最新的版本号
+	LatestVersion int
+	// CreatedAt 创建时间
+	CreatedAt time.Time
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time
+}
+
+// TemplateVersion 模板的一个版本，创建后内容不可修改
+type TemplateVersion struct {
+	// TenantID 所属租户
+	TenantID string
+	// TemplateID 模板 ID
+	TemplateID string
+	// Version 版本号，从 1 开始递增
+	Version int
+	// Subject 邮件主题，仅邮件模板使用
+	Subject string
+	// Body 正文：短信为短信内容，邮件为纯文本正文
+	Body string
+	// HTMLBody HTML 正文，仅邮件模板使用
+	HTMLBody string
+	// Locales 其他语言版本；通知指定的语言及其回退语言都没有对应版本时使用上面的默认内容
+	Locales []LocalizedContent
+	// Variables 占位变量，所有语言版本共用
+	Variables []TemplateVariable
+	// CreatedAt 创建时间
+	CreatedAt time.Time
+}
+
+// LocalizedContent 模板版本的一个语言版本
+type LocalizedContent struct {
+	// Locale 语言标签，如 zh-TW、en-US；默认内容为空
+	Locale string
+	// Subject 邮件主题，仅邮件模板使用
+	Subject string
+	// Body 正文：短信为短信内容，邮件为纯文本正文
+	Body string
+	// HTMLBody HTML 正文，仅邮件模板使用
+	HTMLBody string
+}
+
+// RenderedTemplate 填入参数后的模板内容
+type RenderedTemplate struct {
+	// Subject 邮件主题
+	Subject string
+	// Body 短信内容或邮件纯文本正文
+	Body string
+	// HTMLBody 邮件 HTML 正文
+	HTMLBody string
+}
+
+var (
+	// templateIDPattern 模板 ID 只允许字母、数字、下划线、中划线与点
+	templateIDPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+	// variableNamePattern 变量名只允许字母、数字与下划线，且不以数字开头
+	variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+	// placeholderPattern 模板内容中的占位符 {{name}}，花括号内允许空白
+	placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+	// localePattern BCP 47 语言标签，如 zh-CN、zh-Hant-TW、en-US
+	localePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8}){0,3}$`)
+)
+
+// Validate 校验模板基本信息
+func (t *MessageTemplate) Validate() error {
+	if !templateIDPattern.MatchString(t.ID) {
+		return fmt.Errorf("%w: 模板 ID 只能包含字母、数字、下划线、中划线与点，长度不超过 64", ErrInvalidTemplate)
+	}
+	if t.Name == "" {
+		return fmt.Errorf("%w: 模板名称不能为空", ErrInvalidTemplate)
+	}
+	if !t.Channel.IsValid() {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, t.Channel)
+	}
+	return nil
+}
+
+// Validate 校验模板版本的默认内容与各语言版本是否适用于渠道，且内容中引用的变量都已声明
+func (v *TemplateVersion) Validate(channel Channel) error {
+	if err := v.Content(nil).validate(channel); err != nil {
+		return err
+	}
+	locales := make(map[string]struct{}, len(v.Locales))
+	for _, c := range v.Locales {
+		if !localePattern.MatchString(c.Locale) {
+			return fmt.Errorf("%w: 语言标签不合法: %q", ErrInvalidTemplate, c.Locale)
+		}
+		key := strings.ToLower(c.Locale)
+		if _, dup := locales[key]; dup {
+			return fmt.Errorf("%w: 语言版本 %s 重复", ErrInvalidTemplate, c.Locale)
+		}
+		locales[key] = struct{}{}
+		if err := c.validate(channel); err != nil {
+			return fmt.Errorf("%w（语言版本 %s）", err, c.Locale)
+		}
+	}
+
+	declared := make(map[string]struct{}, len(v.Variables))
+	for _, variable := range v.Variables {
+		if !variableNamePattern.MatchString(variable.Name) {
+			return fmt.Errorf("%w: 变量名不合法: %q", ErrInvalidTemplate, variable.Name)
+		}
+		if !variable.Type.IsValid() {
+			return fmt.Errorf("%w: 变量 %s 的类型不合法: %q", ErrInvalidTemplate, variable.Name, variable.Type)
+		}
+		if _, dup := declared[variable.Name]; dup {
+			return fmt.Errorf("%w: 变量 %s 重复声明", ErrInvalidTemplate, variable.Name)
+		}
+		declared[variable.Name] = struct{}{}
+	}
+	for _, name := range v.Placeholders() {
+		if _, ok := declared[name]; !ok {
+			return fmt.Errorf("%w: 模板内容引用了未声明的变量 %s", ErrInvalidTemplate, name)
+		}
+	}
+	return nil
+}
+
+// validate 校验内容是否适用于渠道
+func (c LocalizedContent) validate(channel Channel) error {
+	switch channel {
+	case ChannelSMS:
+		if c.Body == "" {
+			return fmt.Errorf("%w: 短信模板内容不能为空", ErrInvalidTemplate)
+		}
+		if c.Subject != "" || c.HTMLBody != "" {
+			return fmt.Errorf("%w: 短信模板不能设置主题与 HTML 正文", ErrInvalidTemplate)
+		}
+	case ChannelEmail:
+		if c.Subject == "" {
+			return fmt.Errorf("%w: 邮件模板主题不能为空", ErrInvalidTemplate)
+		}
+		if c.Body == "" && c.HTMLBody == "" {
+			return fmt.Errorf("%w: 邮件模板正文不能为空", ErrInvalidTemplate)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, channel)
+	}
+	return nil
+}
+
+// Placeholders 返回默认内容与各语言版本中引用的变量名，按首次出现的顺序去重
+func (v *TemplateVersion) Placeholders() []string {
+	var names []string
+	for _, c := range v.Contents() {
+		for _, name := range c.Placeholders() {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// Placeholders 返回内容中引用的变量名，按首次出现的顺序去重
+func (c LocalizedContent) Placeholders() []string {
+	var names []string
+	for _, content := range []string{c.Subject, c.Body, c.HTMLBody} {
+		for _, m := range placeholderPattern.FindAllStringSubmatch(content, -1) {
+			if !slices.Contains(names, m[1]) {
+				names = append(names, m[1])
+			}
+		}
+	}
+	return names
+}
+
+// Contents 返回默认内容及全部语言版本，默认内容在最前
+func (v *TemplateVersion) Contents() []LocalizedContent {
+	return append([]LocalizedContent{v.Content(nil)}, v.Locales...)
+}
+
+// Content 按语言回退链选择语言版本，语言标签不区分大小写；链上的语言都没有对应版本时返回默认内容
+func (v *TemplateVersion) Content(chain []string) LocalizedContent {
+	for _, locale := range chain {
+		for _, c := range v.Locales {
+			if strings.EqualFold(c.Locale, locale) {
+				return c
+			}
+		}
+	}
+	return LocalizedContent{Subject: v.Subject, Body: v.Body, HTMLBody: v.HTMLBody}
+}
+
+// CheckParams 校验发送参数：必填变量不能缺失，参数值须符合变量类型
+// 所有问题合并为一个错误返回，便于调用方一次修正
+func (v *TemplateVersion) CheckParams(params map[string]string) error {
+	var missing, malformed []string
+	for _, variable := range v.Variables {
+		value, ok := params[variable.Name]
+		if !ok || value == "" {
+			if variable.Required {
+				missing = append(missing, variable.Name)
+			}
+			continue
+		}
+		if err := variable.Type.check(value); err != nil {
+			malformed = append(malformed, fmt.Sprintf("%s(%s)", variable.Name, variable.Type))
+		}
+	}
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, "缺少必填变量 "+strings.Join(missing, ", "))
+	}
+	if len(malformed) > 0 {
+		problems = append(problems, "变量类型不符 "+strings.Join(malformed, ", "))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: 模板 %s 版本 %d %s", ErrInvalidNotification, v.TemplateID, v.Version, strings.Join(problems, "；"))
+	}
+	return nil
+}
+
+// Render 将参数填入模板的默认内容
+func (v *TemplateVersion) Render(params map[string]string) RenderedTemplate {
+	return v.Content(nil).Render(params)
+}
+
+// Render 将参数填入内容；HTML 正文中的参数值会做 HTML 转义，未提供的变量渲染为空
+func (c LocalizedContent) Render(params map[string]string) RenderedTemplate {
+	return RenderedTemplate{
+		Subject:  render(c.Subject, params, false),
+		Body:     render(c.Body, params, false),
+		HTMLBody: render(c.HTMLBody, params, true),
+	}
+}
+
+// render 替换内容中的占位符
+func render(content string, params map[string]string, escape bool) string {
+	return ReplacePlaceholders(content, func(name string) string {
+		if escape {
+			return html.EscapeString(params[name])
+		}
+		return params[name]
+	})
+}
+
+// ReplacePlaceholders 将内容中的每个 {{name}} 占位符替换为 replace 的返回值，
+// 供应商提交模板审核时据此转换为各自的占位符语法
+func ReplacePlaceholders(content string, replace func(name string) string) string {
+	if content == "" {
+		return ""
+	}
+	return placeholderPattern.ReplaceAllStringFunc(content, func(m string) string {
+		return replace(placeholderPattern.FindStringSubmatch(m)[1])
+	})
+}
+
+// TemplateFilter 模板查询条件，未设置的条件不生效
+type TemplateFilter struct {
+	// TenantID 所属租户
+	TenantID string
+	// Channel 适用渠道
+	Channel Channel
+	// AfterID 只返回模板 ID 大于该值的模板，用于分页
+	AfterID string
+	// Limit 最多返回的条数
+	Limit int
+}
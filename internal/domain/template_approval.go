@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrTemplateApprovalNotFound 模板版本在该供应商处没有审核记录
+	ErrTemplateApprovalNotFound = errors.New("template approval not found")
+	// ErrTemplateNotApproved 模板版本尚未在供应商处审核通过
+	ErrTemplateNotApproved = errors.New("template not approved")
+)
+
+// ApprovalStatus 模板在供应商处的审核状态
+type ApprovalStatus string
+
+const (
+	// ApprovalStatusSubmitting 等待提交给供应商，提交失败时保持该状态并稍后重试
+	ApprovalStatusSubmitting ApprovalStatus = "SUBMITTING"
+	// ApprovalStatusPending 已提交，供应商审核中
+	ApprovalStatusPending ApprovalStatus = "PENDING"
+	// ApprovalStatusApproved 审核通过，可以路由到该供应商
+	ApprovalStatusApproved ApprovalStatus = "APPROVED"
+	// ApprovalStatusRejected 审核未通过，需要修改模板后以新版本重新提交
+	ApprovalStatusRejected ApprovalStatus = "REJECTED"
+)
+
+// IsFinal 判断审核状态是否为终态
+func (s ApprovalStatus) IsFinal() bool {
+	return s == ApprovalStatusApproved || s == ApprovalStatusRejected
+}
+
+// TemplateApproval 模板一个版本的一个语言版本在一个供应商处的审核记录
+type TemplateApproval struct {
+	// TenantID 所属租户
+	TenantID string
+	// TemplateID 平台模板 ID
+	TemplateID string
+	// Version 平台模板版本
+	Version int
+	// Locale 语言版本，为空表示默认内容
+	Locale string
+	// Provider 供应商实例名称
+	Provider string
+	// VendorCode 供应商为模板副本分配的模板 Code，提交成功后才有值
+	VendorCode string
+	// ParamNames 提交时模板变量的位置顺序
+	ParamNames []string
+	// Status 审核状态
+	Status ApprovalStatus
+	// Reason 审核未通过或提交失败的原因
+	Reason string
+	// Attempts 提交与查询的累计次数
+	Attempts int
+	// NextCheckAt 下次提交或查询审核状态的时间
+	NextCheckAt time.Time
+	// SubmittedAt 提交成功的时间
+	SubmittedAt time.Time
+	// CreatedAt 创建时间
+	CreatedAt time.Time
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time
+}
@@ -0,0 +1,27 @@
+package domain
+
+// TemplatePreviewRequest 模板预览请求：按示例参数渲染模板版本，不发送任何消息
+type TemplatePreviewRequest struct {
+	// TemplateID 模板 ID
+	TemplateID string
+	// Version 版本号，为 0 时使用生效版本
+	Version int
+	// Locale 接收者的语言，按回退链选择语言版本；为空时使用默认内容
+	Locale string
+	// Params 示例参数，与发送通知时的模板参数相同
+	Params map[string]string
+	// SignName 短信签名；指定时按供应商添加签名后的内容（【签名】正文）计算长度与条数
+	SignName string
+}
+
+// TemplatePreview 模板预览结果
+type TemplatePreview struct {
+	// Version 渲染的版本号
+	Version int
+	// Locale 选中的语言版本，为空表示默认内容
+	Locale string
+	// Rendered 填入参数后的内容
+	Rendered RenderedTemplate
+	// SMS 短信的编码与条数，仅短信模板有值
+	SMS *SMSStats
+}
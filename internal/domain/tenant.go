@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"regexp"
+	"slices"
+	"time"
+)
+
+var (
+	// ErrTenantNotFound 租户不存在
+	ErrTenantNotFound = errors.New("tenant not found")
+	// ErrDuplicateTenant 租户 ID 已存在
+	ErrDuplicateTenant = errors.New("duplicate tenant")
+	// ErrInvalidTenant 租户信息不完整或不合法
+	ErrInvalidTenant = errors.New("invalid tenant")
+	// ErrAPIKeyNotFound API Key 不存在
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrUnauthenticated 未提供凭证或凭证无效
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrTenantDisabled 租户已停用
+	ErrTenantDisabled = errors.New("tenant disabled")
+	// ErrChannelNotAllowed 租户未开通该渠道
+	ErrChannelNotAllowed = errors.New("channel not allowed for tenant")
+	// ErrQuotaExceeded 租户的发送配额已用完
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// Tenant 接入平台的业务方（租户）
+type Tenant struct {
+	// ID 租户 ID，由管理员创建时指定，如 mall、payment
+	ID string
+	// Name 租户名称
+	Name string
+	// Enabled 是否启用；停用后该租户的 API Key 全部失效
+	Enabled bool
+	// AllowedChannels 已开通的渠道
+	AllowedChannels []Channel
+	// DailyQuota 每日最多受理的通知数，0 表示不限
+	DailyQuota int64
+	// MonthlyQuota 每月最多受理的通知数，0 表示不限
+	MonthlyQuota int64
+	// Sender 默认发送方，通知未指定发送方时使用
+	Sender Sender
+	// DeliveryWindows 各渠道的发送时段规则，未设置的渠道全天允许发送
+	DeliveryWindows []DeliveryWindow
+	// Timezone 接收者资料未设置时区时使用的 IANA 时区，如 Asia/Shanghai；为空时使用服务器所在时区
+	Timezone string
+	// CheckEndpoint 回查两阶段通知事务状态的 gRPC 地址（host:port），为空时使用平台的默认回查地址
+	CheckEndpoint string
+	// CallbackURL 通知请求未指定回调地址时使用的回调地址，为空表示不回调
+	CallbackURL string
+	// CallbackSecret 回调签名密钥，由平台生成；为空时使用平台的默认签名密钥。
+	// 不参与 JSON 序列化，不写入 Redis 缓存，投递回调时从 MySQL 读取
+	CallbackSecret string `json:"-"`
+	// CreatedAt 创建时间
+	CreatedAt time.Time
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time
+}
+
+// tenantIDPattern 租户 ID 只允许小写字母、数字、下划线与中划线
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9_-]{1,64}$`)
+
+// Validate 校验租户信息是否完整
+func (t *Tenant) Validate() error {
+	if !tenantIDPattern.MatchString(t.ID) {
+		return fmt.Errorf("%w: 租户 ID 只能包含小写字母、数字、下划线与中划线，长度不超过 64", ErrInvalidTenant)
+	}
+	if t.Name == "" {
+		return fmt.Errorf("%w: 租户名称不能为空", ErrInvalidTenant)
+	}
+	for _, c := range t.AllowedChannels {
+		if !c.IsValid() {
+			return fmt.Errorf("%w: %s", ErrUnsupportedChannel, c)
+		}
+	}
+	if t.DailyQuota < 0 || t.MonthlyQuota < 0 {
+		return fmt.Errorf("%w: 配额不能为负数", ErrInvalidTenant)
+	}
+	if t.Sender.EmailFrom != "" {
+		if _, err := mail.ParseAddress(t.Sender.EmailFrom); err != nil {
+			return fmt.Errorf("%w: 默认发件人格式不正确: %s", ErrInvalidTenant, t.Sender.EmailFrom)
+		}
+	}
+	if err := validateTimezone(t.Timezone); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidTenant, err)
+	}
+	if t.CheckEndpoint != "" {
+		if _, _, err := net.SplitHostPort(t.CheckEndpoint); err != nil {
+			return fmt.Errorf("%w: 回查地址应为 host:port: %q", ErrInvalidTenant, t.CheckEndpoint)
+		}
+	}
+	if t.CallbackURL != "" {
+		if err := ValidateCallbackURL(t.CallbackURL); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidTenant, err)
+		}
+	}
+	seen := make(map[Channel]bool, len(t.DeliveryWindows))
+	for _, w := range t.DeliveryWindows {
+		if seen[w.Channel] {
+			return fmt.Errorf("%w: 渠道 %s 的发送时段规则重复", ErrInvalidTenant, w.Channel)
+		}
+		seen[w.Channel] = true
+		if err := w.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AllowsChannel 判断租户是否开通了渠道
+func (t *Tenant) AllowsChannel(c Channel) bool {
+	return slices.Contains(t.AllowedChannels, c)
+}
+
+// DeliveryWindow 返回租户在渠道上的发送时段规则
+func (t *Tenant) DeliveryWindow(c Channel) (DeliveryWindow, bool) {
+	for _, w := range t.DeliveryWindows {
+		if w.Channel == c {
+			return w, true
+		}
+	}
+	return DeliveryWindow{}, false
+}
+
+// APIKey 租户的 API Key，平台只保存其哈希
+type APIKey struct {
+	// ID API Key ID
+	ID uint64
+	// TenantID 所属租户
+	TenantID string
+	// Name 用途说明
+	Name string
+	// Prefix 明文的前几位，用于辨认，不能用于鉴权
+	Prefix string
+	// Hash 明文的 SHA-256 哈希
+	Hash string
+	// Revoked 是否已吊销
+	Revoked bool
+	// CreatedAt 创建时间
+	CreatedAt time.Time
+}
+
+// tenantContextKey 租户在 context 中的键
+type tenantContextKey struct{}
+
+// WithTenant 将已鉴权的租户放入 context
+func WithTenant(ctx context.Context, t Tenant) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, t)
+}
+
+// TenantFromContext 取出已鉴权的租户；未启用租户鉴权时 ok 为 false
+func TenantFromContext(ctx context.Context) (Tenant, bool) {
+	t, ok := ctx.Value(tenantContextKey{}).(Tenant)
+	return t, ok
+}
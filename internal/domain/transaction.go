@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// TransactionState 两阶段通知所属的调用方事务的状态，由回查调用方得到
+type TransactionState int
+
+const (
+	// TransactionUnknown 调用方事务尚未结束或状态未知，稍后再次回查
+	TransactionUnknown TransactionState = iota
+	// TransactionCommitted 调用方事务已提交，通知应当发送
+	TransactionCommitted
+	// TransactionRolledBack 调用方事务已回滚，通知应当取消
+	TransactionRolledBack
+)
+
+// String 返回事务状态的名称
+func (s TransactionState) String() string {
+	switch s {
+	case TransactionCommitted:
+		return "COMMITTED"
+	case TransactionRolledBack:
+		return "ROLLED_BACK"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// PreparedCheck 一条等待回查的两阶段通知
+type PreparedCheck struct {
+	// NotificationID 通知 ID
+	NotificationID uint64
+	// TenantID 所属租户
+	TenantID string
+	// BizKey 业务键
+	BizKey string
+	// Checks 已回查次数，含本次
+	Checks int
+	// PreparedAt 预提交时间
+	PreparedAt time.Time
+}
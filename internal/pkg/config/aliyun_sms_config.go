@@ -0,0 +1,67 @@
+package config
+
+import "os"
+
+// AliyunSMSConfig 阿里云短信供应商配置结构，对应 providers[].aliyun_sms
+// 访问密钥默认与阿里云日志服务共用环境变量 ALIYUN_ACCESS_KEY_ID / ALIYUN_ACCESS_KEY_SECRET
+type AliyunSMSConfig struct {
+	// Endpoint Dysmsapi 服务地址，本地联调时可指向替身服务
+	Endpoint string `yaml:"endpoint" mapstructure:"endpoint" default:"https://dysmsapi.aliyuncs.com"`
+
+	// RegionID 地域 ID
+	RegionID string `yaml:"region_id" mapstructure:"region_id" default:"cn-hangzhou"`
+
+	// AccessKeyID 访问密钥 ID（可从环境变量读取，见 access_key_id_env_var）
+	AccessKeyID string `yaml:"access_key_id" mapstructure:"access_key_id"`
+
+	// AccessKeySecret 访问密钥密码（可从环境变量读取，见 access_key_secret_env_var）
+	AccessKeySecret string `yaml:"access_key_secret" mapstructure:"access_key_secret"`
+
+	// AccessKeyIDEnvVar 访问密钥 ID 环境变量名称
+	AccessKeyIDEnvVar string `yaml:"access_key_id_env_var" mapstructure:"access_key_id_env_var" default:"ALIYUN_ACCESS_KEY_ID"`
+
+	// AccessKeySecretEnvVar 访问密钥密码环境变量名称
+	AccessKeySecretEnvVar string `yaml:"access_key_secret_env_var" mapstructure:"access_key_secret_env_var" default:"ALIYUN_ACCESS_KEY_SECRET"`
+
+	// SignName 默认短信签名
+	SignName string `yaml:"sign_name" mapstructure:"sign_name"`
+
+	// TemplateType 提交模板审核时的模板类型：VERIFICATION（验证码）、NOTIFICATION（短信通知）、
+	// PROMOTION（推广短信）或 INTERNATIONAL（国际/港澳台消息）
+	TemplateType string `yaml:"template_type" mapstructure:"template_type" default:"NOTIFICATION"`
+
+	// Timeout 单次请求超时时间（秒）
+	Timeout int `yaml:"timeout" mapstructure:"timeout" default:"5"`
+}
+
+// DefaultAliyunSMSConfig 返回默认阿里云短信配置
+func DefaultAliyunSMSConfig() *AliyunSMSConfig {
+	return &AliyunSMSConfig{
+		Endpoint:              "https://dysmsapi.aliyuncs.com",
+		RegionID:              "cn-hangzhou",
+		AccessKeyIDEnvVar:     "ALIYUN_ACCESS_KEY_ID",
+		AccessKeySecretEnvVar: "ALIYUN_ACCESS_KEY_SECRET",
+		TemplateType:          "NOTIFICATION",
+		Timeout:               5,
+	}
+}
+
+// GetAccessKeyID 获取访问密钥 ID，优先从环境变量读取
+func (c *AliyunSMSConfig) GetAccessKeyID() string {
+	if c.AccessKeyIDEnvVar != "" {
+		if v := os.Getenv(c.AccessKeyIDEnvVar); v != "" {
+			return v
+		}
+	}
+	return c.AccessKeyID
+}
+
+// GetAccessKeySecret 获取访问密钥密码，优先从环境变量读取
+func (c *AliyunSMSConfig) GetAccessKeySecret() string {
+	if c.AccessKeySecretEnvVar != "" {
+		if v := os.Getenv(c.AccessKeySecretEnvVar); v != "" {
+			return v
+		}
+	}
+	return c.AccessKeySecret
+}
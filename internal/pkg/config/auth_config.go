@@ -0,0 +1,44 @@
+package config
+
+import "os"
+
+// AuthConfig 租户鉴权配置结构
+// 启用后业务接口必须携带租户的 API Key，管理接口必须携带管理员令牌
+type AuthConfig struct {
+	// Enabled 是否启用租户鉴权；未启用时不校验凭证，也不做渠道与配额限制
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+
+	// AdminToken 管理接口令牌（建议通过环境变量设置）
+	AdminToken string `yaml:"admin_token" mapstructure:"admin_token"`
+
+	// AdminTokenEnvVar 管理接口令牌环境变量名称
+	AdminTokenEnvVar string `yaml:"admin_token_env_var" mapstructure:"admin_token_env_var" default:"DINGDONG_ADMIN_TOKEN"`
+
+	// CacheTTL 租户与 API Key 在 Redis 中的缓存时间（秒）
+	CacheTTL int `yaml:"cache_ttl" mapstructure:"cache_ttl" default:"300"`
+
+	// CacheKeyPrefix Redis 缓存键前缀
+	CacheKeyPrefix string `yaml:"cache_key_prefix" mapstructure:"cache_key_prefix" default:"tenant:"`
+}
+
+// DefaultAuthConfig 返回默认租户鉴权配置
+func DefaultAuthConfig() *AuthConfig {
+	return &AuthConfig{
+		Enabled:          false,
+		AdminTokenEnvVar: "DINGDONG_ADMIN_TOKEN",
+		CacheTTL:         300,
+		CacheKeyPrefix:   "tenant:",
+	}
+}
+
+// GetAdminToken 获取管理接口令牌，优先从环境变量读取
+func (c *AuthConfig) GetAdminToken() string {
+	// 优先从环境变量读取
+	if c.AdminTokenEnvVar != "" {
+		if v := os.Getenv(c.AdminTokenEnvVar); v != "" {
+			return v
+		}
+	}
+	// 其次使用配置文件中的令牌
+	return c.AdminToken
+}
@@ -0,0 +1,67 @@
+package config
+
+import "os"
+
+// CallbackConfig 通知结果回调配置结构
+// 通知进入终态且设置了回调地址（请求指定或租户默认）时，平台以 POST 发送签名的 JSON 结果；
+// 业务方未返回 2xx 时按 retry 策略退避重试，每次投递都记录在 MySQL 中，可通过 CallbackService 查询
+type CallbackConfig struct {
+	// Secret 默认签名密钥，租户未生成回调签名密钥或未启用租户鉴权时使用（建议通过环境变量设置）；
+	// 无可用密钥的回调不会投递，直接标记为失败
+	Secret string `yaml:"secret" mapstructure:"secret"`
+
+	// SecretEnvVar 默认签名密钥环境变量名称
+	SecretEnvVar string `yaml:"secret_env_var" mapstructure:"secret_env_var" default:"DINGDONG_CALLBACK_SECRET"`
+
+	// Timeout 单次投递的超时时间（毫秒）
+	Timeout int `yaml:"timeout" mapstructure:"timeout" default:"5000"`
+
+	// ScanInterval 扫描到期回调的间隔（秒）
+	ScanInterval int `yaml:"scan_interval" mapstructure:"scan_interval" default:"2"`
+
+	// BatchSize 每次扫描最多领取的回调数
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size" default:"100"`
+
+	// Concurrency 并发投递的数量
+	Concurrency int `yaml:"concurrency" mapstructure:"concurrency" default:"8"`
+
+	// Lease 领取后的租约时长（秒），须大于投递超时；实例在投递中途退出时，租约到期后由其他实例重新投递
+	Lease int `yaml:"lease" mapstructure:"lease" default:"60"`
+
+	// AllowPrivateNetworks 是否允许回调到内网地址（回环、私有网段、链路本地等），默认拒绝，
+	// 解析后的地址在建立连接时校验，仅在业务方与平台同处内网且租户可信时开启
+	AllowPrivateNetworks bool `yaml:"allow_private_networks" mapstructure:"allow_private_networks" default:"false"`
+
+	// Retry 投递失败后的重试策略，max_attempts 为最多投递次数（含首次投递）
+	Retry RetryPolicyConfig `yaml:"retry" mapstructure:"retry"`
+}
+
+// DefaultCallbackConfig 返回默认回调配置
+func DefaultCallbackConfig() *CallbackConfig {
+	return &CallbackConfig{
+		SecretEnvVar: "DINGDONG_CALLBACK_SECRET",
+		Timeout:      5000,
+		ScanInterval: 2,
+		BatchSize:    100,
+		Concurrency:  8,
+		Lease:        60,
+		Retry: RetryPolicyConfig{
+			MaxAttempts: 8,
+			BaseDelay:   10,
+			MaxDelay:    3600,
+			Jitter:      0.2,
+		},
+	}
+}
+
+// GetSecret 获取默认签名密钥，优先从环境变量读取
+func (c *CallbackConfig) GetSecret() string {
+	// 优先从环境变量读取
+	if c.SecretEnvVar != "" {
+		if v := os.Getenv(c.SecretEnvVar); v != "" {
+			return v
+		}
+	}
+	// 其次使用配置文件中的密钥
+	return c.Secret
+}
@@ -27,6 +27,60 @@ type AppConfig struct {
 
 	// MySQL 配置
 	MySQL MySQLConfig `yaml:"mysql" mapstructure:"mysql"`
+
+	// Kafka 配置
+	Kafka KafkaConfig `yaml:"kafka" mapstructure:"kafka"`
+
+	// gRPC 服务配置
+	GRPC GRPCConfig `yaml:"grpc" mapstructure:"grpc"`
+
+	// 异步发送工作池配置
+	Worker WorkerConfig `yaml:"worker" mapstructure:"worker"`
+
+	// 幂等配置
+	Idempotency IdempotencyConfig `yaml:"idempotency" mapstructure:"idempotency"`
+
+	// 供应商路由配置
+	Routing RoutingConfig `yaml:"routing" mapstructure:"routing"`
+
+	// 渠道兜底配置
+	Failover FailoverConfig `yaml:"failover" mapstructure:"failover"`
+
+	// 失败重试配置
+	Retry RetryConfig `yaml:"retry" mapstructure:"retry"`
+
+	// 定时发送配置
+	Schedule ScheduleConfig `yaml:"schedule" mapstructure:"schedule"`
+
+	// 状态变更事件配置
+	StatusEvent StatusEventConfig `yaml:"status_event" mapstructure:"status_event"`
+
+	// 两阶段通知配置
+	Transaction TransactionConfig `yaml:"transaction" mapstructure:"transaction"`
+
+	// 通知结果回调配置
+	Callback CallbackConfig `yaml:"callback" mapstructure:"callback"`
+
+	// 供应商投递回执配置
+	DeliveryReport DeliveryReportConfig `yaml:"delivery_report" mapstructure:"delivery_report"`
+
+	// 限流配置
+	RateLimit RateLimitConfig `yaml:"rate_limit" mapstructure:"rate_limit"`
+
+	// 供应商限速配置
+	Shaping ShapingConfig `yaml:"shaping" mapstructure:"shaping"`
+
+	// 租户鉴权配置
+	Auth AuthConfig `yaml:"auth" mapstructure:"auth"`
+
+	// 供应商模板审核同步配置
+	TemplateApproval TemplateApprovalConfig `yaml:"template_approval" mapstructure:"template_approval"`
+
+	// 多语言模板配置
+	I18n I18nConfig `yaml:"i18n" mapstructure:"i18n"`
+
+	// 渠道供应商配置
+	Providers []ProviderConfig `yaml:"providers" mapstructure:"providers"`
 }
 
 // Default 返回项目的默认配置
@@ -39,6 +93,23 @@ func Default() *AppConfig {
 	cfg.Logger = *DefaultLoggerConfig()
 	cfg.Redis = *DefaultRedisConfig()
 	cfg.MySQL = *DefaultMySQLConfig()
+	cfg.Kafka = *DefaultKafkaConfig()
+	cfg.GRPC = *DefaultGRPCConfig()
+	cfg.Worker = *DefaultWorkerConfig()
+	cfg.Idempotency = *DefaultIdempotencyConfig()
+	cfg.Routing = *DefaultRoutingConfig()
+	cfg.Failover = *DefaultFailoverConfig()
+	cfg.Retry = *DefaultRetryConfig()
+	cfg.Schedule = *DefaultScheduleConfig()
+	cfg.StatusEvent = *DefaultStatusEventConfig()
+	cfg.Transaction = *DefaultTransactionConfig()
+	cfg.Callback = *DefaultCallbackConfig()
+	cfg.DeliveryReport = *DefaultDeliveryReportConfig()
+	cfg.RateLimit = *DefaultRateLimitConfig()
+	cfg.Shaping = *DefaultShapingConfig()
+	cfg.Auth = *DefaultAuthConfig()
+	cfg.TemplateApproval = *DefaultTemplateApprovalConfig()
+	cfg.I18n = *DefaultI18nConfig()
 	return cfg
 }
 
@@ -60,9 +131,221 @@ func (c *AppConfig) Validate() error {
 			return fmt.Errorf("logger.aliyun.logstore 不能为空（已启用 aliyun）")
 		}
 	}
+
+	// 校验 gRPC 服务配置
+	if c.GRPC.Port <= 0 || c.GRPC.Port > maxPort {
+		return fmt.Errorf("grpc.port 不合法: %d", c.GRPC.Port)
+	}
+
+	// 校验 Kafka 配置
+	if err := c.Kafka.validate(); err != nil {
+		return err
+	}
+
+	// 校验异步发送工作池配置
+	if c.Worker.Concurrency <= 0 {
+		return fmt.Errorf("worker.concurrency 必须大于 0")
+	}
+	if c.Worker.QueueSize < 0 {
+		return fmt.Errorf("worker.queue_size 不能为负数")
+	}
+	if c.Worker.PollInterval <= 0 || c.Worker.PollBatchSize <= 0 {
+		return fmt.Errorf("worker.poll_interval 与 worker.poll_batch_size 必须大于 0")
+	}
+	if maxSend := c.maxSendDuration(); c.Worker.SendingLease <= maxSend {
+		return fmt.Errorf("worker.sending_lease（%d 秒）必须大于单条通知最长的发送耗时（%d 秒）", c.Worker.SendingLease, maxSend)
+	}
+
+	// 校验幂等配置
+	if c.Idempotency.Window <= 0 {
+		return fmt.Errorf("idempotency.window 必须大于 0")
+	}
+
+	// 校验渠道兜底配置
+	if c.Failover.AttemptTimeout <= 0 {
+		return fmt.Errorf("failover.attempt_timeout 必须大于 0")
+	}
+	if c.Failover.HealthBucket <= 0 || c.Failover.HealthWindow < c.Failover.HealthBucket {
+		return fmt.Errorf("failover.health_window 必须不小于 failover.health_bucket，且均大于 0")
+	}
+	if c.Failover.UnhealthyErrorRate <= 0 || c.Failover.UnhealthyErrorRate > 1 {
+		return fmt.Errorf("failover.unhealthy_error_rate 必须在 (0, 1] 之间")
+	}
+
+	// 校验失败重试配置
+	if c.Retry.ScanInterval <= 0 || c.Retry.ScanBatchSize <= 0 || c.Retry.Concurrency <= 0 || c.Retry.Lease <= 0 {
+		return fmt.Errorf("retry.scan_interval、retry.scan_batch_size、retry.concurrency 与 retry.lease 必须大于 0")
+	}
+	if maxSend := c.maxSendDuration(); c.Retry.Lease <= maxSend {
+		return fmt.Errorf("retry.lease（%d 秒）必须大于单条通知最长的发送耗时（%d 秒）", c.Retry.Lease, maxSend)
+	}
+	def := c.Retry.Default
+	if def.MaxAttempts <= 0 {
+		return fmt.Errorf("retry.default.max_attempts 必须大于 0")
+	}
+	if def.BaseDelay <= 0 || def.MaxDelay < def.BaseDelay {
+		return fmt.Errorf("retry.default.base_delay 必须大于 0，且 max_delay 不能小于 base_delay")
+	}
+	if def.Jitter < 0 || def.Jitter > 1 {
+		return fmt.Errorf("retry.default.jitter 必须在 [0, 1] 之间")
+	}
+	for ch, p := range c.Retry.Channels {
+		if p.MaxAttempts < 0 || p.BaseDelay < 0 || p.MaxDelay < 0 || p.Jitter < 0 || p.Jitter > 1 {
+			return fmt.Errorf("retry.channels.%s 配置不合法", ch)
+		}
+		// 渠道策略未设置的字段沿用 default，按合并后的取值校验
+		base, maxDelay := def.BaseDelay, def.MaxDelay
+		if p.BaseDelay > 0 {
+			base = p.BaseDelay
+		}
+		if p.MaxDelay > 0 {
+			maxDelay = p.MaxDelay
+		}
+		if maxDelay < base {
+			return fmt.Errorf("retry.channels.%s.max_delay 不能小于 base_delay", ch)
+		}
+	}
+
+	// 校验定时发送配置
+	if c.Schedule.PollInterval <= 0 || c.Schedule.BatchSize <= 0 || c.Schedule.RecoverInterval <= 0 || c.Schedule.MaxDelay <= 0 {
+		return fmt.Errorf("schedule 的 poll_interval、batch_size、recover_interval 与 max_delay 必须大于 0")
+	}
+
+	// 校验状态变更事件配置
+	if c.StatusEvent.PollInterval <= 0 || c.StatusEvent.BatchSize <= 0 || c.StatusEvent.PublishTimeout <= 0 ||
+		c.StatusEvent.Retention <= 0 {
+		return fmt.Errorf("status_event 的 poll_interval、batch_size、publish_timeout 与 retention 必须大于 0")
+	}
+
+	// 校验两阶段通知配置
+	if c.Transaction.CheckTimeout <= 0 || c.Transaction.CheckInterval <= 0 || c.Transaction.MaxChecks <= 0 ||
+		c.Transaction.ScanInterval <= 0 || c.Transaction.BatchSize <= 0 || c.Transaction.CallTimeout <= 0 {
+		return fmt.Errorf("transaction 的 check_timeout、check_interval、max_checks、scan_interval、batch_size 与 call_timeout 必须大于 0")
+	}
+
+	// 校验通知结果回调配置
+	cb := c.Callback
+	if cb.Timeout <= 0 || cb.ScanInterval <= 0 || cb.BatchSize <= 0 || cb.Concurrency <= 0 || cb.Lease <= 0 {
+		return fmt.Errorf("callback 的 timeout、scan_interval、batch_size、concurrency 与 lease 必须大于 0")
+	}
+	if cb.Lease*1000 <= cb.Timeout {
+		return fmt.Errorf("callback.lease 必须大于 callback.timeout")
+	}
+	if cb.Retry.MaxAttempts <= 0 || cb.Retry.BaseDelay <= 0 || cb.Retry.MaxDelay < cb.Retry.BaseDelay {
+		return fmt.Errorf("callback.retry 的 max_attempts 与 base_delay 必须大于 0，max_delay 不能小于 base_delay")
+	}
+	if cb.Retry.Jitter < 0 || cb.Retry.Jitter > 1 {
+		return fmt.Errorf("callback.retry.jitter 必须在 [0, 1] 之间")
+	}
+
+	// 校验投递回执配置
+	dr := c.DeliveryReport
+	if dr.Enabled {
+		if dr.Port <= 0 || dr.Port > maxPort {
+			return fmt.Errorf("delivery_report.port 不合法: %d", dr.Port)
+		}
+		if dr.GetToken() == "" {
+			return fmt.Errorf("delivery_report.token 不能为空（已启用 delivery_report），可通过 %s 环境变量设置", dr.TokenEnvVar)
+		}
+		if dr.MaxBodyBytes <= 0 || dr.GracefulStopTimeout <= 0 {
+			return fmt.Errorf("delivery_report 的 max_body_bytes 与 graceful_stop_timeout 必须大于 0")
+		}
+	}
+	if dr.RematchWindow <= 0 {
+		return fmt.Errorf("delivery_report.rematch_window 必须大于 0")
+	}
+	if poll := dr.Poll; poll.ScanInterval <= 0 || poll.Interval <= 0 || poll.MaxAge <= poll.Interval ||
+		poll.BatchSize <= 0 || poll.Concurrency <= 0 || poll.Timeout <= 0 {
+		return fmt.Errorf("delivery_report.poll 的 scan_interval、interval、batch_size、concurrency 与 timeout 必须大于 0，" +
+			"max_age 必须大于 interval")
+	}
+
+	// 校验限流配置
+	if err := c.RateLimit.validate(); err != nil {
+		return err
+	}
+
+	// 校验供应商限速配置
+	if c.Shaping.MaxWait <= 0 || c.Shaping.ReportInterval <= 0 {
+		return fmt.Errorf("shaping.max_wait 与 shaping.report_interval 必须大于 0")
+	}
+
+	// 校验租户鉴权配置
+	if c.Auth.Enabled {
+		if c.Auth.GetAdminToken() == "" {
+			return fmt.Errorf("auth.admin_token 不能为空（已启用 auth），可通过 %s 环境变量设置", c.Auth.AdminTokenEnvVar)
+		}
+		if c.Auth.CacheTTL <= 0 {
+			return fmt.Errorf("auth.cache_ttl 必须大于 0")
+		}
+	}
+
+	// 校验模板审核同步配置
+	if c.TemplateApproval.Enabled {
+		ta := c.TemplateApproval
+		if ta.ScanInterval <= 0 || ta.ScanBatchSize <= 0 || ta.PollInterval <= 0 || ta.Lease <= 0 || ta.CallTimeout <= 0 {
+			return fmt.Errorf("template_approval 的 scan_interval、scan_batch_size、poll_interval、lease 与 call_timeout 必须大于 0")
+		}
+		if ta.Lease <= ta.CallTimeout {
+			return fmt.Errorf("template_approval.lease 必须大于 template_approval.call_timeout")
+		}
+	}
+
+	// 校验多语言模板配置
+	if err := c.I18n.validate(); err != nil {
+		return err
+	}
+
+	// 校验渠道供应商配置
+	names := make(map[string]struct{}, len(c.Providers))
+	for i, p := range c.Providers {
+		if p.Name == "" {
+			return fmt.Errorf("providers[%d].name 不能为空", i)
+		}
+		if p.Type == "" {
+			return fmt.Errorf("providers[%d].type 不能为空", i)
+		}
+		if p.Weight != nil && *p.Weight < 0 {
+			return fmt.Errorf("providers[%d].weight 不能为负数", i)
+		}
+		if p.QPS < 0 || p.Burst < 0 {
+			return fmt.Errorf("providers[%d].qps 与 burst 不能为负数", i)
+		}
+		for country, price := range p.Prices {
+			if price < 0 {
+				return fmt.Errorf("providers[%d].prices.%s 不能为负数", i, country)
+			}
+		}
+		if _, dup := names[p.Name]; dup {
+			return fmt.Errorf("providers[%d].name 重复: %s", i, p.Name)
+		}
+		names[p.Name] = struct{}{}
+	}
+	for i, name := range c.DeliveryReport.Poll.Providers {
+		if _, ok := names[name]; !ok {
+			return fmt.Errorf("delivery_report.poll.providers[%d] 不是已配置的供应商: %s", i, name)
+		}
+	}
 	return nil
 }
 
+// maxSendDuration 估算单条通知一次发送的最长耗时（秒）：依次尝试全部启用的供应商，每个供应商最多等待
+// failover.attempt_timeout，配置了 qps 的供应商另需排队 shaping.max_wait
+func (c *AppConfig) maxSendDuration() int {
+	total := 0
+	for i := range c.Providers {
+		p := &c.Providers[i]
+		if !p.Enabled {
+			continue
+		}
+		total += c.Failover.AttemptTimeout
+		if p.QPS > 0 {
+			total += c.Shaping.MaxWait
+		}
+	}
+	return total
+}
+
 // ToLoggerConfig 将 AppConfig 中的日志配置转换为 logger 模块的配置
 // 这个方法用于在 logger 模块中使用配置
 func (c *AppConfig) ToLoggerConfig() *LoggerConfig {
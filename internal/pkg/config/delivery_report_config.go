@@ -0,0 +1,100 @@
+package config
+
+import (
+	"net"
+	"os"
+	"strconv"
+)
+
+// DeliveryReportConfig 供应商投递回执（DLR）配置结构
+// 已成功提交给供应商的通知收到回执后变为已送达或发送失败：支持推送的供应商将回执 POST 到
+// /dlr/{供应商名称}?token=令牌，只支持查询的供应商由拉取器按 poll 配置定期查询
+type DeliveryReportConfig struct {
+	// Enabled 是否启动接收回执推送的 HTTP 服务
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+
+	// Host 监听地址，留空表示监听所有网卡
+	Host string `yaml:"host" mapstructure:"host" default:""`
+
+	// Port 监听端口
+	Port int `yaml:"port" mapstructure:"port" default:"8081"`
+
+	// GracefulStopTimeout 优雅退出的最长等待时间（秒）
+	GracefulStopTimeout int `yaml:"graceful_stop_timeout" mapstructure:"graceful_stop_timeout" default:"10"`
+
+	// Token 推送地址携带的令牌（建议通过环境变量设置），启用 HTTP 服务时必填
+	Token string `yaml:"token" mapstructure:"token"`
+
+	// TokenEnvVar 令牌环境变量名称
+	TokenEnvVar string `yaml:"token_env_var" mapstructure:"token_env_var" default:"DINGDONG_DLR_TOKEN"`
+
+	// MaxBodyBytes 单次推送请求体的最大字节数
+	MaxBodyBytes int64 `yaml:"max_body_bytes" mapstructure:"max_body_bytes" default:"1048576"`
+
+	// RematchWindow 未匹配回执的重新匹配窗口（秒）；回执可能先于发送结果落库到达，窗口内由拉取器再次匹配
+	RematchWindow int `yaml:"rematch_window" mapstructure:"rematch_window" default:"600"`
+
+	// Poll 拉取回执配置
+	Poll DeliveryReportPollConfig `yaml:"poll" mapstructure:"poll"`
+}
+
+// DeliveryReportPollConfig 拉取回执配置结构
+type DeliveryReportPollConfig struct {
+	// Providers 需要拉取回执的供应商名称，对应 providers[].name；为空时不拉取
+	Providers []string `yaml:"providers" mapstructure:"providers"`
+
+	// ScanInterval 扫描等待拉取回执的通知的间隔（秒）
+	ScanInterval int `yaml:"scan_interval" mapstructure:"scan_interval" default:"10"`
+
+	// Interval 通知提交给供应商后首次拉取与两次拉取之间的间隔（秒）
+	Interval int `yaml:"interval" mapstructure:"interval" default:"300"`
+
+	// MaxAge 提交给供应商超过该时长（秒）仍未拿到回执的通知不再拉取，保持已成功提交状态
+	MaxAge int `yaml:"max_age" mapstructure:"max_age" default:"86400"`
+
+	// BatchSize 每次扫描最多领取的通知数
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size" default:"100"`
+
+	// Concurrency 并发查询的数量
+	Concurrency int `yaml:"concurrency" mapstructure:"concurrency" default:"8"`
+
+	// Timeout 单次查询的超时时间（毫秒）
+	Timeout int `yaml:"timeout" mapstructure:"timeout" default:"5000"`
+}
+
+// DefaultDeliveryReportConfig 返回默认投递回执配置
+func DefaultDeliveryReportConfig() *DeliveryReportConfig {
+	return &DeliveryReportConfig{
+		Enabled:             false,
+		Port:                8081,
+		GracefulStopTimeout: 10,
+		TokenEnvVar:         "DINGDONG_DLR_TOKEN",
+		MaxBodyBytes:        1 << 20,
+		RematchWindow:       600,
+		Poll: DeliveryReportPollConfig{
+			ScanInterval: 10,
+			Interval:     300,
+			MaxAge:       86400,
+			BatchSize:    100,
+			Concurrency:  8,
+			Timeout:      5000,
+		},
+	}
+}
+
+// Addr 返回回执接收服务的监听地址 (host:port)
+func (c *DeliveryReportConfig) Addr() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// GetToken 获取推送令牌，优先从环境变量读取
+func (c *DeliveryReportConfig) GetToken() string {
+	// 优先从环境变量读取
+	if c.TokenEnvVar != "" {
+		if v := os.Getenv(c.TokenEnvVar); v != "" {
+			return v
+		}
+	}
+	// 其次使用配置文件中的令牌
+	return c.Token
+}
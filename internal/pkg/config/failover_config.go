@@ -0,0 +1,36 @@
+package config
+
+// FailoverConfig 渠道兜底配置结构
+// 同一渠道配置了多个供应商时，按 providers[].priority 依次尝试；
+// 主供应商返回可重试错误、被限流或超时时自动切换到下一个供应商
+type FailoverConfig struct {
+	// AttemptTimeout 单个供应商的发送超时时间（秒），超时视为可重试错误并切换供应商
+	AttemptTimeout int `yaml:"attempt_timeout" mapstructure:"attempt_timeout" default:"5"`
+
+	// HealthWindow 健康度统计窗口（秒），按窗口内的错误率判断供应商是否健康
+	HealthWindow int `yaml:"health_window" mapstructure:"health_window" default:"300"`
+
+	// HealthBucket 健康度统计的分桶粒度（秒）
+	HealthBucket int `yaml:"health_bucket" mapstructure:"health_bucket" default:"60"`
+
+	// MinRequests 窗口内请求数达到该值后才按错误率判断健康度，避免少量请求造成误判
+	MinRequests int `yaml:"min_requests" mapstructure:"min_requests" default:"20"`
+
+	// UnhealthyErrorRate 错误率达到该值时视为不健康，不健康的供应商排到最后尝试
+	UnhealthyErrorRate float64 `yaml:"unhealthy_error_rate" mapstructure:"unhealthy_error_rate" default:"0.5"`
+
+	// KeyPrefix Redis 健康度统计键前缀
+	KeyPrefix string `yaml:"key_prefix" mapstructure:"key_prefix" default:"provider:health:"`
+}
+
+// DefaultFailoverConfig 返回默认渠道兜底配置
+func DefaultFailoverConfig() *FailoverConfig {
+	return &FailoverConfig{
+		AttemptTimeout:     5,
+		HealthWindow:       300,
+		HealthBucket:       60,
+		MinRequests:        20,
+		UnhealthyErrorRate: 0.5,
+		KeyPrefix:          "provider:health:",
+	}
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"net"
+	"strconv"
+)
+
+// maxPort 合法端口号的上限
+const maxPort = 65535
+
+// GRPCConfig gRPC 服务配置结构
+type GRPCConfig struct {
+	// Host 监听地址，留空表示监听所有网卡
+	Host string `yaml:"host" mapstructure:"host" default:""`
+
+	// Port 监听端口
+	Port int `yaml:"port" mapstructure:"port" default:"9090"`
+
+	// GracefulStopTimeout 优雅退出的最长等待时间（秒）
+	GracefulStopTimeout int `yaml:"graceful_stop_timeout" mapstructure:"graceful_stop_timeout" default:"10"`
+}
+
+// DefaultGRPCConfig 返回默认 gRPC 服务配置
+func DefaultGRPCConfig() *GRPCConfig {
+	return &GRPCConfig{
+		Host:                "",
+		Port:                9090,
+		GracefulStopTimeout: 10,
+	}
+}
+
+// Addr 返回 gRPC 服务的监听地址 (host:port)
+func (c *GRPCConfig) Addr() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// I18nConfig 多语言模板配置结构
+// 通知的语言取自请求，未指定时取接收者资料中的语言；模板按回退链选择语言版本，
+// 均未找到时使用模板的默认内容，默认内容的语言为 default_locale
+type I18nConfig struct {
+	// DefaultLocale 模板默认内容的语言，也是数字、日期等参数在默认内容中的格式
+	DefaultLocale string `yaml:"default_locale" mapstructure:"default_locale" default:"zh-CN"`
+
+	// Fallbacks 语言回退链：语言 -> 依次尝试的回退语言，语言的基础语种（如 zh-TW 的 zh）总在最后尝试
+	Fallbacks map[string][]string `yaml:"fallbacks" mapstructure:"fallbacks"`
+}
+
+// DefaultI18nConfig 返回默认多语言模板配置
+func DefaultI18nConfig() *I18nConfig {
+	return &I18nConfig{
+		DefaultLocale: "zh-CN",
+		Fallbacks: map[string][]string{
+			"zh-TW": {"zh-CN"},
+			"zh-HK": {"zh-TW", "zh-CN"},
+			"en-GB": {"en-US"},
+		},
+	}
+}
+
+// validate 校验语言标签是否合法
+func (c *I18nConfig) validate() error {
+	if _, err := language.Parse(c.DefaultLocale); err != nil {
+		return fmt.Errorf("i18n.default_locale 不合法: %q", c.DefaultLocale)
+	}
+	for from, tos := range c.Fallbacks {
+		if _, err := language.Parse(from); err != nil {
+			return fmt.Errorf("i18n.fallbacks 的语言 %q 不合法", from)
+		}
+		for _, to := range tos {
+			if _, err := language.Parse(to); err != nil {
+				return fmt.Errorf("i18n.fallbacks.%s 的回退语言 %q 不合法", from, to)
+			}
+		}
+	}
+	return nil
+}
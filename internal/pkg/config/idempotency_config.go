@@ -0,0 +1,19 @@
+package config
+
+// IdempotencyConfig 幂等配置结构
+type IdempotencyConfig struct {
+	// Window 业务键在 Redis 中的去重窗口（秒），窗口内的重复请求直接由 Redis 拦截；
+	// 窗口外的重复请求仍会被 MySQL 唯一索引拦截
+	Window int `yaml:"window" mapstructure:"window" default:"86400"`
+
+	// KeyPrefix Redis 去重键前缀
+	KeyPrefix string `yaml:"key_prefix" mapstructure:"key_prefix" default:"notification:idempotent:"`
+}
+
+// DefaultIdempotencyConfig 返回默认幂等配置
+func DefaultIdempotencyConfig() *IdempotencyConfig {
+	return &IdempotencyConfig{
+		Window:    86400,
+		KeyPrefix: "notification:idempotent:",
+	}
+}
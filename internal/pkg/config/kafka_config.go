@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// KafkaConfig Kafka 配置结构
+// 启用后消费 ingest_topic 中的通知请求，按异步发送受理；同一 group_id 的实例组成消费组，按分区水平扩展。
+// 通知的状态变更事件发布到 status_topic，供下游系统订阅
+type KafkaConfig struct {
+	// Enabled 是否启用 Kafka（受理通道与状态变更事件发布）
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+
+	// Brokers Kafka 集群地址列表 (host:port)
+	Brokers []string `yaml:"brokers" mapstructure:"brokers"`
+
+	// Username SASL/PLAIN 用户名，留空则不使用 SASL
+	Username string `yaml:"username" mapstructure:"username" default:""`
+
+	// Password SASL/PLAIN 密码（可从环境变量 KAFKA_PASSWORD 读取）
+	Password string `yaml:"password" mapstructure:"password" default:""`
+
+	// PasswordEnvVar 密码环境变量名称
+	PasswordEnvVar string `yaml:"password_env_var" mapstructure:"password_env_var" default:"KAFKA_PASSWORD"`
+
+	// TLS 是否使用 TLS 连接
+	TLS bool `yaml:"tls" mapstructure:"tls" default:"false"`
+
+	// DialTimeout 连接超时时间（秒）
+	DialTimeout int `yaml:"dial_timeout" mapstructure:"dial_timeout" default:"10"`
+
+	// IngestTopic 通知请求所在的 topic，留空则不消费通知请求
+	IngestTopic string `yaml:"ingest_topic" mapstructure:"ingest_topic" default:"notification.requests"`
+
+	// StatusTopic 状态变更事件发布的 topic，留空则不发布
+	StatusTopic string `yaml:"status_topic" mapstructure:"status_topic" default:"notification.status-events"`
+
+	// GroupID 消费组 ID，同一消费组内的实例分摊 topic 的分区
+	GroupID string `yaml:"group_id" mapstructure:"group_id" default:"dingdong-postman"`
+
+	// Consumers 每个实例的消费者数，总消费者数超过分区数时多余的消费者空闲
+	Consumers int `yaml:"consumers" mapstructure:"consumers" default:"1"`
+
+	// MaxBytes 单次拉取的最大字节数
+	MaxBytes int `yaml:"max_bytes" mapstructure:"max_bytes" default:"10485760"`
+
+	// RetryBackoff 受理失败（如 MySQL 不可用）后首次重试的等待时间（毫秒），之后按倍数增长
+	RetryBackoff int `yaml:"retry_backoff" mapstructure:"retry_backoff" default:"500"`
+
+	// MaxRetryBackoff 重试等待时间上限（毫秒）
+	MaxRetryBackoff int `yaml:"max_retry_backoff" mapstructure:"max_retry_backoff" default:"30000"`
+}
+
+// DefaultKafkaConfig 返回默认 Kafka 配置
+func DefaultKafkaConfig() *KafkaConfig {
+	return &KafkaConfig{
+		Enabled:         false,
+		Brokers:         []string{"localhost:9094"},
+		Username:        "",
+		Password:        "",
+		PasswordEnvVar:  "KAFKA_PASSWORD",
+		TLS:             false,
+		DialTimeout:     10,
+		IngestTopic:     "notification.requests",
+		StatusTopic:     "notification.status-events",
+		GroupID:         "dingdong-postman",
+		Consumers:       1,
+		MaxBytes:        10 << 20,
+		RetryBackoff:    500,
+		MaxRetryBackoff: 30000,
+	}
+}
+
+// GetPassword 获取 SASL 密码，优先从环境变量读取
+func (c *KafkaConfig) GetPassword() string {
+	if c.PasswordEnvVar != "" {
+		if envPassword := os.Getenv(c.PasswordEnvVar); envPassword != "" {
+			return envPassword
+		}
+	}
+	return c.Password
+}
+
+// validate 校验 Kafka 配置，未启用时不校验
+func (c *KafkaConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if len(c.Brokers) == 0 {
+		return fmt.Errorf("kafka.brokers 不能为空（已启用 kafka）")
+	}
+	if c.IngestTopic == "" && c.StatusTopic == "" {
+		return fmt.Errorf("kafka.ingest_topic 与 kafka.status_topic 不能同时为空（已启用 kafka）")
+	}
+	if c.IngestTopic != "" && c.GroupID == "" {
+		return fmt.Errorf("kafka.group_id 不能为空（已设置 kafka.ingest_topic）")
+	}
+	if c.Consumers <= 0 || c.MaxBytes <= 0 || c.DialTimeout <= 0 {
+		return fmt.Errorf("kafka 的 consumers、max_bytes 与 dial_timeout 必须大于 0")
+	}
+	if c.RetryBackoff <= 0 || c.MaxRetryBackoff < c.RetryBackoff {
+		return fmt.Errorf("kafka.retry_backoff 必须大于 0 且不大于 max_retry_backoff")
+	}
+	return nil
+}
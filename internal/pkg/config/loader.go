@@ -116,6 +116,117 @@ func setDefaultsFrom(v *viper.Viper, def *AppConfig) {
 		v.SetDefault("logger.aliyun.batch_size", def.Logger.Aliyun.BatchSize)
 		v.SetDefault("logger.aliyun.flush_interval", def.Logger.Aliyun.FlushInterval)
 	}
+
+	v.SetDefault("kafka.enabled", def.Kafka.Enabled)
+	v.SetDefault("kafka.brokers", def.Kafka.Brokers)
+	v.SetDefault("kafka.password_env_var", def.Kafka.PasswordEnvVar)
+	v.SetDefault("kafka.dial_timeout", def.Kafka.DialTimeout)
+	v.SetDefault("kafka.ingest_topic", def.Kafka.IngestTopic)
+	v.SetDefault("kafka.status_topic", def.Kafka.StatusTopic)
+	v.SetDefault("kafka.group_id", def.Kafka.GroupID)
+	v.SetDefault("kafka.consumers", def.Kafka.Consumers)
+	v.SetDefault("kafka.max_bytes", def.Kafka.MaxBytes)
+	v.SetDefault("kafka.retry_backoff", def.Kafka.RetryBackoff)
+	v.SetDefault("kafka.max_retry_backoff", def.Kafka.MaxRetryBackoff)
+
+	v.SetDefault("grpc.host", def.GRPC.Host)
+	v.SetDefault("grpc.port", def.GRPC.Port)
+	v.SetDefault("grpc.graceful_stop_timeout", def.GRPC.GracefulStopTimeout)
+
+	v.SetDefault("worker.concurrency", def.Worker.Concurrency)
+	v.SetDefault("worker.queue_size", def.Worker.QueueSize)
+	v.SetDefault("worker.poll_interval", def.Worker.PollInterval)
+	v.SetDefault("worker.poll_batch_size", def.Worker.PollBatchSize)
+	v.SetDefault("worker.sending_lease", def.Worker.SendingLease)
+
+	v.SetDefault("idempotency.window", def.Idempotency.Window)
+	v.SetDefault("idempotency.key_prefix", def.Idempotency.KeyPrefix)
+
+	v.SetDefault("routing.default_strategy", def.Routing.DefaultStrategy)
+	v.SetDefault("routing.default_country", def.Routing.DefaultCountry)
+
+	v.SetDefault("failover.attempt_timeout", def.Failover.AttemptTimeout)
+	v.SetDefault("failover.health_window", def.Failover.HealthWindow)
+	v.SetDefault("failover.health_bucket", def.Failover.HealthBucket)
+	v.SetDefault("failover.min_requests", def.Failover.MinRequests)
+	v.SetDefault("failover.unhealthy_error_rate", def.Failover.UnhealthyErrorRate)
+	v.SetDefault("failover.key_prefix", def.Failover.KeyPrefix)
+
+	v.SetDefault("retry.scan_interval", def.Retry.ScanInterval)
+	v.SetDefault("retry.scan_batch_size", def.Retry.ScanBatchSize)
+	v.SetDefault("retry.concurrency", def.Retry.Concurrency)
+	v.SetDefault("retry.lease", def.Retry.Lease)
+	v.SetDefault("retry.default.max_attempts", def.Retry.Default.MaxAttempts)
+	v.SetDefault("retry.default.base_delay", def.Retry.Default.BaseDelay)
+	v.SetDefault("retry.default.max_delay", def.Retry.Default.MaxDelay)
+	v.SetDefault("retry.default.jitter", def.Retry.Default.Jitter)
+
+	v.SetDefault("schedule.key_prefix", def.Schedule.KeyPrefix)
+	v.SetDefault("schedule.poll_interval", def.Schedule.PollInterval)
+	v.SetDefault("schedule.batch_size", def.Schedule.BatchSize)
+	v.SetDefault("schedule.recover_interval", def.Schedule.RecoverInterval)
+	v.SetDefault("schedule.max_delay", def.Schedule.MaxDelay)
+
+	v.SetDefault("status_event.poll_interval", def.StatusEvent.PollInterval)
+	v.SetDefault("status_event.batch_size", def.StatusEvent.BatchSize)
+	v.SetDefault("status_event.publish_timeout", def.StatusEvent.PublishTimeout)
+	v.SetDefault("status_event.retention", def.StatusEvent.Retention)
+
+	v.SetDefault("transaction.check_timeout", def.Transaction.CheckTimeout)
+	v.SetDefault("transaction.check_interval", def.Transaction.CheckInterval)
+	v.SetDefault("transaction.max_checks", def.Transaction.MaxChecks)
+	v.SetDefault("transaction.scan_interval", def.Transaction.ScanInterval)
+	v.SetDefault("transaction.batch_size", def.Transaction.BatchSize)
+	v.SetDefault("transaction.call_timeout", def.Transaction.CallTimeout)
+
+	v.SetDefault("callback.secret_env_var", def.Callback.SecretEnvVar)
+	v.SetDefault("callback.timeout", def.Callback.Timeout)
+	v.SetDefault("callback.scan_interval", def.Callback.ScanInterval)
+	v.SetDefault("callback.batch_size", def.Callback.BatchSize)
+	v.SetDefault("callback.concurrency", def.Callback.Concurrency)
+	v.SetDefault("callback.lease", def.Callback.Lease)
+	v.SetDefault("callback.allow_private_networks", def.Callback.AllowPrivateNetworks)
+	v.SetDefault("callback.retry.max_attempts", def.Callback.Retry.MaxAttempts)
+	v.SetDefault("callback.retry.base_delay", def.Callback.Retry.BaseDelay)
+	v.SetDefault("callback.retry.max_delay", def.Callback.Retry.MaxDelay)
+	v.SetDefault("callback.retry.jitter", def.Callback.Retry.Jitter)
+
+	v.SetDefault("delivery_report.enabled", def.DeliveryReport.Enabled)
+	v.SetDefault("delivery_report.host", def.DeliveryReport.Host)
+	v.SetDefault("delivery_report.port", def.DeliveryReport.Port)
+	v.SetDefault("delivery_report.graceful_stop_timeout", def.DeliveryReport.GracefulStopTimeout)
+	v.SetDefault("delivery_report.token_env_var", def.DeliveryReport.TokenEnvVar)
+	v.SetDefault("delivery_report.max_body_bytes", def.DeliveryReport.MaxBodyBytes)
+	v.SetDefault("delivery_report.rematch_window", def.DeliveryReport.RematchWindow)
+	v.SetDefault("delivery_report.poll.scan_interval", def.DeliveryReport.Poll.ScanInterval)
+	v.SetDefault("delivery_report.poll.interval", def.DeliveryReport.Poll.Interval)
+	v.SetDefault("delivery_report.poll.max_age", def.DeliveryReport.Poll.MaxAge)
+	v.SetDefault("delivery_report.poll.batch_size", def.DeliveryReport.Poll.BatchSize)
+	v.SetDefault("delivery_report.poll.concurrency", def.DeliveryReport.Poll.Concurrency)
+	v.SetDefault("delivery_report.poll.timeout", def.DeliveryReport.Poll.Timeout)
+
+	v.SetDefault("rate_limit.enabled", def.RateLimit.Enabled)
+	v.SetDefault("rate_limit.key_prefix", def.RateLimit.KeyPrefix)
+	v.SetDefault("rate_limit.fail_open", def.RateLimit.FailOpen)
+
+	v.SetDefault("shaping.key_prefix", def.Shaping.KeyPrefix)
+	v.SetDefault("shaping.max_wait", def.Shaping.MaxWait)
+	v.SetDefault("shaping.report_interval", def.Shaping.ReportInterval)
+
+	v.SetDefault("auth.enabled", def.Auth.Enabled)
+	v.SetDefault("auth.admin_token_env_var", def.Auth.AdminTokenEnvVar)
+	v.SetDefault("auth.cache_ttl", def.Auth.CacheTTL)
+	v.SetDefault("auth.cache_key_prefix", def.Auth.CacheKeyPrefix)
+
+	v.SetDefault("template_approval.enabled", def.TemplateApproval.Enabled)
+	v.SetDefault("template_approval.scan_interval", def.TemplateApproval.ScanInterval)
+	v.SetDefault("template_approval.scan_batch_size", def.TemplateApproval.ScanBatchSize)
+	v.SetDefault("template_approval.poll_interval", def.TemplateApproval.PollInterval)
+	v.SetDefault("template_approval.lease", def.TemplateApproval.Lease)
+	v.SetDefault("template_approval.call_timeout", def.TemplateApproval.CallTimeout)
+
+	v.SetDefault("i18n.default_locale", def.I18n.DefaultLocale)
+	v.SetDefault("i18n.fallbacks", def.I18n.Fallbacks)
 }
 
 // 注意：config 模块现在不依赖 logger 模块
@@ -156,6 +267,16 @@ func bindEnvKeys(v *viper.Viper) {
 
 		// Redis配置
 		"redis.password": "REDIS_PASSWORD",
+
+		// Kafka 配置
+		"kafka.password": "KAFKA_PASSWORD",
+
+		// gRPC 服务
+		"grpc.host": "GRPC_HOST",
+		"grpc.port": "GRPC_PORT",
+
+		// 异步发送工作池
+		"worker.concurrency": "WORKER_CONCURRENCY",
 	}
 	for key, env := range pairs {
 		_ = v.BindEnv(key, env)
@@ -0,0 +1,42 @@
+package config
+
+// ProviderConfig 渠道供应商配置结构，对应 providers 列表中的一项
+type ProviderConfig struct {
+	// Name 供应商实例名称，全局唯一
+	Name string `yaml:"name" mapstructure:"name"`
+
+	// Type 供应商类型，对应供应商插件注册的类型名
+	Type string `yaml:"type" mapstructure:"type"`
+
+	// Enabled 是否启用该供应商
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+
+	// Priority 优先级，数值越小越先尝试；同一渠道的多个供应商按优先级依次兜底
+	Priority int `yaml:"priority" mapstructure:"priority" default:"0"`
+
+	// Weight 权重，weighted 路由策略按权重比例分配流量，未配置时按 1 处理；
+	// 0 表示不分配流量，供应商只作为兜底，用于摘除供应商
+	Weight *int `yaml:"weight" mapstructure:"weight" default:"1"`
+
+	// Prices 单条消息价格，键为目的地国家/地区（ISO 3166-1 二位代码，不区分大小写），
+	// default 为未列出国家/地区的价格；lowest_cost 路由策略按价格从低到高选取
+	Prices map[string]float64 `yaml:"prices" mapstructure:"prices"`
+
+	// QPS 供应商允许的每秒调用次数，所有实例共享；0 表示不限速
+	QPS float64 `yaml:"qps" mapstructure:"qps" default:"0"`
+
+	// Burst 允许的突发调用次数，未配置（0）时取 QPS 向上取整
+	Burst int64 `yaml:"burst" mapstructure:"burst" default:"0"`
+
+	// Channel 发送渠道 (SMS, EMAIL)，仅对可用于多个渠道的供应商类型生效
+	Channel string `yaml:"channel" mapstructure:"channel"`
+
+	// SMTP SMTP 邮件供应商配置，type 为 smtp 时使用
+	SMTP *SMTPConfig `yaml:"smtp" mapstructure:"smtp"`
+
+	// AliyunSMS 阿里云短信供应商配置，type 为 aliyun_sms 时使用
+	AliyunSMS *AliyunSMSConfig `yaml:"aliyun_sms" mapstructure:"aliyun_sms"`
+
+	// TencentSMS 腾讯云短信供应商配置，type 为 tencent_sms 时使用
+	TencentSMS *TencentSMSConfig `yaml:"tencent_sms" mapstructure:"tencent_sms"`
+}
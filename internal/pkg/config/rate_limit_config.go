@@ -0,0 +1,138 @@
+package config
+
+import "fmt"
+
+// 限流算法
+const (
+	// RateLimitSlidingWindow 滑动窗口：窗口内最多放行 limit 次
+	RateLimitSlidingWindow = "sliding_window"
+	// RateLimitTokenBucket 令牌桶：每秒补充 rate 个令牌，允许不超过 capacity 的突发
+	RateLimitTokenBucket = "token_bucket"
+)
+
+// 限流维度
+const (
+	RateLimitKeyTenant    = "tenant"
+	RateLimitKeyChannel   = "channel"
+	RateLimitKeyProvider  = "provider"
+	RateLimitKeyRecipient = "recipient"
+	RateLimitKeyTemplate  = "template"
+)
+
+// RateLimitConfig 限流配置结构
+// 限流计数保存在 Redis 中，各实例共享；未启用 Redis 时不限流
+type RateLimitConfig struct {
+	// Enabled 是否启用限流
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"true"`
+
+	// KeyPrefix Redis 限流键前缀
+	KeyPrefix string `yaml:"key_prefix" mapstructure:"key_prefix" default:"ratelimit:"`
+
+	// FailOpen Redis 异常时是否放行；为 false 时 Redis 异常的请求按被限流处理
+	FailOpen bool `yaml:"fail_open" mapstructure:"fail_open" default:"true"`
+
+	// Rules 限流规则，每条命中的规则都会单独计数，任意一条超限即拒绝
+	Rules []RateLimitRule `yaml:"rules" mapstructure:"rules"`
+}
+
+// RateLimitRule 一条限流规则
+type RateLimitRule struct {
+	// Name 规则名称，全局唯一，同时作为 Redis 键的一部分
+	Name string `yaml:"name" mapstructure:"name"`
+
+	// Algorithm 限流算法: sliding_window, token_bucket
+	Algorithm string `yaml:"algorithm" mapstructure:"algorithm"`
+
+	// Match 规则适用的范围，未设置的字段匹配任意值
+	Match RateLimitMatch `yaml:"match" mapstructure:"match"`
+
+	// KeyBy 计数维度: tenant, channel, provider, recipient, template；为空时命中规则的请求共用一个计数
+	KeyBy []string `yaml:"key_by" mapstructure:"key_by"`
+
+	// Limit 滑动窗口内最多放行的次数
+	Limit int64 `yaml:"limit" mapstructure:"limit"`
+
+	// Window 滑动窗口长度（秒）
+	Window int `yaml:"window" mapstructure:"window"`
+
+	// Capacity 令牌桶容量，即允许的最大突发
+	Capacity int64 `yaml:"capacity" mapstructure:"capacity"`
+
+	// Rate 令牌桶每秒补充的令牌数
+	Rate float64 `yaml:"rate" mapstructure:"rate"`
+}
+
+// RateLimitMatch 限流规则的适用范围
+type RateLimitMatch struct {
+	// Tenant 租户
+	Tenant string `yaml:"tenant" mapstructure:"tenant"`
+
+	// Channel 渠道（SMS、EMAIL，不区分大小写）
+	Channel string `yaml:"channel" mapstructure:"channel"`
+
+	// Provider 供应商实例名称
+	Provider string `yaml:"provider" mapstructure:"provider"`
+
+	// TemplateID 模板 ID
+	TemplateID string `yaml:"template_id" mapstructure:"template_id"`
+}
+
+// IsProviderScoped 规则是否与供应商相关；与供应商相关的规则在选定供应商后、调用供应商前判断，
+// 其余规则在受理通知时判断
+func (r *RateLimitRule) IsProviderScoped() bool {
+	if r.Match.Provider != "" {
+		return true
+	}
+	for _, k := range r.KeyBy {
+		if k == RateLimitKeyProvider {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultRateLimitConfig 返回默认限流配置
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Enabled:   true,
+		KeyPrefix: "ratelimit:",
+		FailOpen:  true,
+	}
+}
+
+// validate 校验限流规则
+func (c *RateLimitConfig) validate() error {
+	names := make(map[string]struct{}, len(c.Rules))
+	for i := range c.Rules {
+		r := &c.Rules[i]
+		if r.Name == "" {
+			return fmt.Errorf("rate_limit.rules[%d].name 不能为空", i)
+		}
+		if _, dup := names[r.Name]; dup {
+			return fmt.Errorf("rate_limit.rules[%d].name 重复: %s", i, r.Name)
+		}
+		names[r.Name] = struct{}{}
+
+		switch r.Algorithm {
+		case RateLimitSlidingWindow:
+			if r.Limit <= 0 || r.Window <= 0 {
+				return fmt.Errorf("rate_limit.rules[%d] 滑动窗口的 limit 与 window 必须大于 0", i)
+			}
+		case RateLimitTokenBucket:
+			if r.Capacity <= 0 || r.Rate <= 0 {
+				return fmt.Errorf("rate_limit.rules[%d] 令牌桶的 capacity 与 rate 必须大于 0", i)
+			}
+		default:
+			return fmt.Errorf("rate_limit.rules[%d].algorithm 不支持: %s", i, r.Algorithm)
+		}
+
+		for _, k := range r.KeyBy {
+			switch k {
+			case RateLimitKeyTenant, RateLimitKeyChannel, RateLimitKeyProvider, RateLimitKeyRecipient, RateLimitKeyTemplate:
+			default:
+				return fmt.Errorf("rate_limit.rules[%d].key_by 不支持: %s", i, k)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package config
+
+// RetryPolicyConfig 重试策略配置结构
+// 第 n 次重试前的等待时间为 base_delay * 2^(n-1)，不超过 max_delay，并按 jitter 比例随机浮动
+type RetryPolicyConfig struct {
+	// MaxAttempts 最多发送次数（含首次发送），1 表示不重试
+	MaxAttempts int `yaml:"max_attempts" mapstructure:"max_attempts" default:"3"`
+
+	// BaseDelay 首次重试前的等待时间（秒）
+	BaseDelay int `yaml:"base_delay" mapstructure:"base_delay" default:"10"`
+
+	// MaxDelay 重试等待时间上限（秒）
+	MaxDelay int `yaml:"max_delay" mapstructure:"max_delay" default:"600"`
+
+	// Jitter 等待时间的随机浮动比例，取值 [0, 1]，0.2 表示在 ±20% 范围内浮动
+	Jitter float64 `yaml:"jitter" mapstructure:"jitter" default:"0.2"`
+}
+
+// RetryConfig 失败重试配置结构
+type RetryConfig struct {
+	// ScanInterval 扫描到期重试任务的间隔（秒）
+	ScanInterval int `yaml:"scan_interval" mapstructure:"scan_interval" default:"5"`
+
+	// ScanBatchSize 每次扫描最多领取的重试任务数
+	ScanBatchSize int `yaml:"scan_batch_size" mapstructure:"scan_batch_size" default:"100"`
+
+	// Concurrency 并发执行重试的数量
+	Concurrency int `yaml:"concurrency" mapstructure:"concurrency" default:"4"`
+
+	// Lease 领取后的租约时长（秒），租约内其他实例不会再次领取；实例在重试中途退出时，租约到期后由其他实例接手
+	// 须大于单条通知最长的发送耗时，否则发送尚未结束计划就可能被再次领取
+	Lease int `yaml:"lease" mapstructure:"lease" default:"120"`
+
+	// Default 未单独配置的渠道使用的重试策略
+	Default RetryPolicyConfig `yaml:"default" mapstructure:"default"`
+
+	// Channels 按渠道指定重试策略，键为渠道（SMS、EMAIL，不区分大小写），未设置的字段沿用 default
+	Channels map[string]RetryPolicyConfig `yaml:"channels" mapstructure:"channels"`
+}
+
+// DefaultRetryConfig 返回默认重试配置
+func DefaultRetryConfig() *RetryConfig {
+	return &RetryConfig{
+		ScanInterval:  5,
+		ScanBatchSize: 100,
+		Concurrency:   4,
+		Lease:         120,
+		Default: RetryPolicyConfig{
+			MaxAttempts: 3,
+			BaseDelay:   10,
+			MaxDelay:    600,
+			Jitter:      0.2,
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package config
+
+// RoutingConfig 供应商路由配置结构
+// 路由策略决定同一渠道下各供应商的尝试顺序：首位为首选供应商，其余为兜底顺序
+type RoutingConfig struct {
+	// DefaultStrategy 未单独配置的渠道使用的路由策略: priority, weighted, lowest_cost, round_robin
+	DefaultStrategy string `yaml:"default_strategy" mapstructure:"default_strategy" default:"priority"`
+
+	// Strategies 按渠道指定路由策略，键为渠道（SMS、EMAIL，不区分大小写）
+	Strategies map[string]string `yaml:"strategies" mapstructure:"strategies"`
+
+	// DefaultCountry 接收号码不带国家码时视为该国家/地区（ISO 3166-1 二位代码），用于按目的地计费
+	DefaultCountry string `yaml:"default_country" mapstructure:"default_country" default:"CN"`
+}
+
+// DefaultRoutingConfig 返回默认路由配置
+func DefaultRoutingConfig() *RoutingConfig {
+	return &RoutingConfig{
+		DefaultStrategy: "priority",
+		DefaultCountry:  "CN",
+	}
+}
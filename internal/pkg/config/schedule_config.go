@@ -0,0 +1,33 @@
+package config
+
+// ScheduleConfig 定时发送配置结构
+// 定时通知落库为 SCHEDULED 状态作为持久记录，同时登记到 Redis 有序集合（分值为发送时间）；
+// 调度器按 poll_interval 领取到期的通知交给异步发送工作池，并按 recover_interval 扫描数据库，
+// 兜底处理 Redis 不可用或登记失败的通知
+type ScheduleConfig struct {
+	// KeyPrefix Redis 有序集合的键前缀
+	KeyPrefix string `yaml:"key_prefix" mapstructure:"key_prefix" default:"schedule:"`
+
+	// PollInterval 领取 Redis 中到期通知的间隔（毫秒）
+	PollInterval int `yaml:"poll_interval" mapstructure:"poll_interval" default:"500"`
+
+	// BatchSize 每次最多领取的通知数
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size" default:"100"`
+
+	// RecoverInterval 扫描数据库中已到期但仍未投递的定时通知的间隔（秒）
+	RecoverInterval int `yaml:"recover_interval" mapstructure:"recover_interval" default:"30"`
+
+	// MaxDelay 允许的最长定时时间（秒），从受理时刻起算
+	MaxDelay int `yaml:"max_delay" mapstructure:"max_delay" default:"2592000"`
+}
+
+// DefaultScheduleConfig 返回默认定时发送配置
+func DefaultScheduleConfig() *ScheduleConfig {
+	return &ScheduleConfig{
+		KeyPrefix:       "schedule:",
+		PollInterval:    500,
+		BatchSize:       100,
+		RecoverInterval: 30,
+		MaxDelay:        30 * 24 * 3600,
+	}
+}
@@ -0,0 +1,24 @@
+package config
+
+// ShapingConfig 供应商限速配置结构
+// 按 providers 中各供应商的 qps 与 burst 控制调用速率，超出速率的调用排队等待而不是直接失败；
+// Redis 可用时各实例共享速率，否则每个实例各自按配置的速率限速
+type ShapingConfig struct {
+	// KeyPrefix Redis 令牌桶键前缀
+	KeyPrefix string `yaml:"key_prefix" mapstructure:"key_prefix" default:"shaper:"`
+
+	// MaxWait 单次调用最长排队时间（秒），超过后按被限流处理，交给兜底与重试
+	MaxWait int `yaml:"max_wait" mapstructure:"max_wait" default:"30"`
+
+	// ReportInterval 输出排队统计日志的间隔（秒）
+	ReportInterval int `yaml:"report_interval" mapstructure:"report_interval" default:"60"`
+}
+
+// DefaultShapingConfig 返回默认供应商限速配置
+func DefaultShapingConfig() *ShapingConfig {
+	return &ShapingConfig{
+		KeyPrefix:      "shaper:",
+		MaxWait:        30,
+		ReportInterval: 60,
+	}
+}
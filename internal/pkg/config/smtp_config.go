@@ -0,0 +1,71 @@
+package config
+
+import "os"
+
+// SMTPConfig SMTP 邮件供应商配置结构，对应 providers[].smtp
+type SMTPConfig struct {
+	// Host SMTP 服务器地址
+	Host string `yaml:"host" mapstructure:"host"`
+
+	// Port SMTP 服务器端口（常见取值：587 STARTTLS，465 隐式 TLS，25 明文）
+	Port int `yaml:"port" mapstructure:"port" default:"587"`
+
+	// Security 加密方式: starttls, tls（隐式 TLS）, none
+	Security string `yaml:"security" mapstructure:"security" default:"starttls"`
+
+	// InsecureSkipVerify 是否跳过服务器证书校验（仅用于测试环境）
+	InsecureSkipVerify bool `yaml:"insecure_skip_verify" mapstructure:"insecure_skip_verify" default:"false"`
+
+	// AuthMechanism 认证方式: plain, login, none
+	AuthMechanism string `yaml:"auth_mechanism" mapstructure:"auth_mechanism" default:"plain"`
+
+	// Username SMTP 用户名
+	Username string `yaml:"username" mapstructure:"username"`
+
+	// Password SMTP 密码（可从环境变量读取，见 password_env_var）
+	Password string `yaml:"password" mapstructure:"password"`
+
+	// PasswordEnvVar 密码环境变量名称
+	PasswordEnvVar string `yaml:"password_env_var" mapstructure:"password_env_var" default:"SMTP_PASSWORD"`
+
+	// From 发件人，如 "叮咚邮差 <noreply@example.com>"
+	From string `yaml:"from" mapstructure:"from"`
+
+	// LocalName EHLO 时使用的主机名
+	LocalName string `yaml:"local_name" mapstructure:"local_name" default:"localhost"`
+
+	// PoolSize 连接池最大连接数
+	PoolSize int `yaml:"pool_size" mapstructure:"pool_size" default:"4"`
+
+	// IdleTimeout 空闲连接的最长保留时间（秒）
+	IdleTimeout int `yaml:"idle_timeout" mapstructure:"idle_timeout" default:"30"`
+
+	// Timeout 建立连接与单次读写的超时时间（秒）
+	Timeout int `yaml:"timeout" mapstructure:"timeout" default:"10"`
+}
+
+// DefaultSMTPConfig 返回默认 SMTP 配置
+func DefaultSMTPConfig() *SMTPConfig {
+	return &SMTPConfig{
+		Port:           587,
+		Security:       "starttls",
+		AuthMechanism:  "plain",
+		PasswordEnvVar: "SMTP_PASSWORD",
+		LocalName:      "localhost",
+		PoolSize:       4,
+		IdleTimeout:    30,
+		Timeout:        10,
+	}
+}
+
+// GetPassword 获取 SMTP 密码，优先从环境变量读取
+func (c *SMTPConfig) GetPassword() string {
+	// 优先从环境变量读取
+	if c.PasswordEnvVar != "" {
+		if envPassword := os.Getenv(c.PasswordEnvVar); envPassword != "" {
+			return envPassword
+		}
+	}
+	// 其次使用配置文件中的密码
+	return c.Password
+}
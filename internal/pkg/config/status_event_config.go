@@ -0,0 +1,29 @@
+package config
+
+// StatusEventConfig 状态变更事件配置结构
+// 通知状态的每次变更都与状态在同一事务中写入事件发件箱（notification_status_event 表），
+// 启用 Kafka 且设置了 kafka.status_topic 时，发布器按 poll_interval 把未发布的事件按顺序发布到 Kafka；
+// 已发布的事件保留 retention 后删除，未启用发布时事件保留 retention 后直接删除
+type StatusEventConfig struct {
+	// PollInterval 发布未发布事件的间隔（毫秒）
+	PollInterval int `yaml:"poll_interval" mapstructure:"poll_interval" default:"200"`
+
+	// BatchSize 每次最多发布的事件数
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size" default:"100"`
+
+	// PublishTimeout 发布一批事件的超时时间（毫秒），领取事件的租约为其两倍
+	PublishTimeout int `yaml:"publish_timeout" mapstructure:"publish_timeout" default:"10000"`
+
+	// Retention 事件在发件箱中的保留时间（小时）
+	Retention int `yaml:"retention" mapstructure:"retention" default:"72"`
+}
+
+// DefaultStatusEventConfig 返回默认状态变更事件配置
+func DefaultStatusEventConfig() *StatusEventConfig {
+	return &StatusEventConfig{
+		PollInterval:   200,
+		BatchSize:      100,
+		PublishTimeout: 10000,
+		Retention:      72,
+	}
+}
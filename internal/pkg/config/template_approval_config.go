@@ -0,0 +1,36 @@
+package config
+
+// TemplateApprovalConfig 供应商模板审核同步配置结构
+// 启用后平台短信模板的每个版本都会提交给需要报备模板的供应商（如阿里云、腾讯云短信），
+// 由后台同步器定时轮询审核状态；模板版本在某个供应商处审核通过前，不会路由到该供应商
+type TemplateApprovalConfig struct {
+	// Enabled 是否启用模板审核同步；未启用时模板 ID 直接作为供应商模板 Code 使用
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+
+	// ScanInterval 扫描到期审核记录的间隔（秒）
+	ScanInterval int `yaml:"scan_interval" mapstructure:"scan_interval" default:"30"`
+
+	// ScanBatchSize 每次扫描最多领取的审核记录数
+	ScanBatchSize int `yaml:"scan_batch_size" mapstructure:"scan_batch_size" default:"50"`
+
+	// PollInterval 查询审核中模板状态的间隔（秒），提交失败后同样按该间隔重新提交
+	PollInterval int `yaml:"poll_interval" mapstructure:"poll_interval" default:"300"`
+
+	// Lease 领取后的租约时长（秒），租约内其他实例不会再次领取
+	Lease int `yaml:"lease" mapstructure:"lease" default:"120"`
+
+	// CallTimeout 单次调用供应商模板接口的超时时间（秒）
+	CallTimeout int `yaml:"call_timeout" mapstructure:"call_timeout" default:"10"`
+}
+
+// DefaultTemplateApprovalConfig 返回默认模板审核同步配置
+func DefaultTemplateApprovalConfig() *TemplateApprovalConfig {
+	return &TemplateApprovalConfig{
+		Enabled:       false,
+		ScanInterval:  30,
+		ScanBatchSize: 50,
+		PollInterval:  300,
+		Lease:         120,
+		CallTimeout:   10,
+	}
+}
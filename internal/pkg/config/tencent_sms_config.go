@@ -0,0 +1,75 @@
+package config
+
+import "os"
+
+// TencentSMSConfig 腾讯云短信供应商配置结构，对应 providers[].tencent_sms
+type TencentSMSConfig struct {
+	// Endpoint 短信服务地址，本地联调时可指向替身服务
+	Endpoint string `yaml:"endpoint" mapstructure:"endpoint" default:"https://sms.tencentcloudapi.com"`
+
+	// Region 地域
+	Region string `yaml:"region" mapstructure:"region" default:"ap-guangzhou"`
+
+	// SecretID 访问密钥 ID（可从环境变量读取，见 secret_id_env_var）
+	SecretID string `yaml:"secret_id" mapstructure:"secret_id"`
+
+	// SecretKey 访问密钥（可从环境变量读取，见 secret_key_env_var）
+	SecretKey string `yaml:"secret_key" mapstructure:"secret_key"`
+
+	// SecretIDEnvVar 访问密钥 ID 环境变量名称
+	SecretIDEnvVar string `yaml:"secret_id_env_var" mapstructure:"secret_id_env_var" default:"TENCENTCLOUD_SECRET_ID"`
+
+	// SecretKeyEnvVar 访问密钥环境变量名称
+	SecretKeyEnvVar string `yaml:"secret_key_env_var" mapstructure:"secret_key_env_var" default:"TENCENTCLOUD_SECRET_KEY"`
+
+	// SdkAppID 短信应用 ID
+	SdkAppID string `yaml:"sdk_app_id" mapstructure:"sdk_app_id"`
+
+	// SignName 默认短信签名
+	SignName string `yaml:"sign_name" mapstructure:"sign_name"`
+
+	// DefaultCountryCode 接收号码不带国家码时补充的国家码
+	DefaultCountryCode string `yaml:"default_country_code" mapstructure:"default_country_code" default:"+86"`
+
+	// TemplateParamNames 模板变量顺序：模板 ID -> 变量名列表
+	// 腾讯云模板变量按位置（{1}、{2}...）填充，未配置的模板要求变量名为 "1"、"2"... 的位置序号
+	TemplateParamNames map[string][]string `yaml:"template_param_names" mapstructure:"template_param_names"`
+
+	// SmsType 提交模板审核时的短信类型：0 普通短信，1 营销短信
+	SmsType uint64 `yaml:"sms_type" mapstructure:"sms_type" default:"0"`
+
+	// Timeout 单次请求超时时间（秒）
+	Timeout int `yaml:"timeout" mapstructure:"timeout" default:"5"`
+}
+
+// DefaultTencentSMSConfig 返回默认腾讯云短信配置
+func DefaultTencentSMSConfig() *TencentSMSConfig {
+	return &TencentSMSConfig{
+		Endpoint:           "https://sms.tencentcloudapi.com",
+		Region:             "ap-guangzhou",
+		SecretIDEnvVar:     "TENCENTCLOUD_SECRET_ID",
+		SecretKeyEnvVar:    "TENCENTCLOUD_SECRET_KEY",
+		DefaultCountryCode: "+86",
+		Timeout:            5,
+	}
+}
+
+// GetSecretID 获取访问密钥 ID，优先从环境变量读取
+func (c *TencentSMSConfig) GetSecretID() string {
+	if c.SecretIDEnvVar != "" {
+		if v := os.Getenv(c.SecretIDEnvVar); v != "" {
+			return v
+		}
+	}
+	return c.SecretID
+}
+
+// GetSecretKey 获取访问密钥，优先从环境变量读取
+func (c *TencentSMSConfig) GetSecretKey() string {
+	if c.SecretKeyEnvVar != "" {
+		if v := os.Getenv(c.SecretKeyEnvVar); v != "" {
+			return v
+		}
+	}
+	return c.SecretKey
+}
@@ -0,0 +1,44 @@
+package config
+
+// TransactionConfig 两阶段通知配置结构
+// 预提交的通知超过 check_timeout 仍未提交或取消时，平台按 check_interval 通过 gRPC 回查调用方的事务状态，
+// 回查 max_checks 次仍无法确定时取消通知。回查地址按租户配置，租户未配置时使用 check_endpoint
+type TransactionConfig struct {
+	// CheckTimeout 预提交后首次回查前的等待时间（秒）
+	CheckTimeout int `yaml:"check_timeout" mapstructure:"check_timeout" default:"60"`
+
+	// CheckInterval 两次回查之间的间隔（秒）
+	CheckInterval int `yaml:"check_interval" mapstructure:"check_interval" default:"60"`
+
+	// MaxChecks 最多回查次数，超过后取消通知
+	MaxChecks int `yaml:"max_checks" mapstructure:"max_checks" default:"15"`
+
+	// ScanInterval 扫描到期回查计划的间隔（秒）
+	ScanInterval int `yaml:"scan_interval" mapstructure:"scan_interval" default:"5"`
+
+	// BatchSize 每次扫描最多领取的回查计划数
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size" default:"100"`
+
+	// CallTimeout 单次回查调用的超时时间（毫秒）
+	CallTimeout int `yaml:"call_timeout" mapstructure:"call_timeout" default:"3000"`
+
+	// CheckEndpoint 默认回查地址（host:port），租户未配置回查地址或未启用租户鉴权时使用；为空则只能等待回查次数用完后取消
+	CheckEndpoint string `yaml:"check_endpoint" mapstructure:"check_endpoint" default:""`
+
+	// TLS 回查调用是否使用 TLS
+	TLS bool `yaml:"tls" mapstructure:"tls" default:"false"`
+}
+
+// DefaultTransactionConfig 返回默认两阶段通知配置
+func DefaultTransactionConfig() *TransactionConfig {
+	return &TransactionConfig{
+		CheckTimeout:  60,
+		CheckInterval: 60,
+		MaxChecks:     15,
+		ScanInterval:  5,
+		BatchSize:     100,
+		CallTimeout:   3000,
+		CheckEndpoint: "",
+		TLS:           false,
+	}
+}
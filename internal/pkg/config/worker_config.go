@@ -0,0 +1,31 @@
+package config
+
+// WorkerConfig 异步发送工作池配置结构
+type WorkerConfig struct {
+	// Concurrency 并发投递的 worker 数量
+	Concurrency int `yaml:"concurrency" mapstructure:"concurrency" default:"8"`
+
+	// QueueSize 内存任务队列长度，队列满时由轮询兜底
+	QueueSize int `yaml:"queue_size" mapstructure:"queue_size" default:"1024"`
+
+	// PollInterval 轮询数据库中待发送通知的间隔（秒）
+	PollInterval int `yaml:"poll_interval" mapstructure:"poll_interval" default:"5"`
+
+	// PollBatchSize 每次轮询最多拉取的通知数量
+	PollBatchSize int `yaml:"poll_batch_size" mapstructure:"poll_batch_size" default:"100"`
+
+	// SendingLease 发送中状态的租约（秒）：通知处于发送中超过该时间仍未回写结果（如进程在发送途中退出）时，
+	// 轮询时改回待发送或重试中重新投递；须大于单条通知最长的发送耗时，避免把仍在发送的通知重复投递
+	SendingLease int `yaml:"sending_lease" mapstructure:"sending_lease" default:"300"`
+}
+
+// DefaultWorkerConfig 返回默认异步发送工作池配置
+func DefaultWorkerConfig() *WorkerConfig {
+	return &WorkerConfig{
+		Concurrency:   8,
+		QueueSize:     1024,
+		PollInterval:  5,
+		PollBatchSize: 100,
+		SendingLease:  300,
+	}
+}
@@ -0,0 +1,46 @@
+package grpcx
+
+import (
+	"context"
+	"runtime/debug"
+
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validator protoc-gen-validate 生成的消息都会实现该接口
+type validator interface {
+	Validate() error
+}
+
+// ValidateUnaryInterceptor 按 proto 中声明的 validate 规则校验请求，失败时返回 InvalidArgument
+func ValidateUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if v, ok := req.(validator); ok {
+			if err := v.Validate(); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryUnaryInterceptor 捕获处理函数中的 panic，记录日志并返回 Internal
+func RecoveryUnaryInterceptor(logger appLogger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("gRPC 处理函数 panic",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.ByteString("stack", debug.Stack()),
+				)
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,74 @@
+package grpcx
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Server gRPC 服务封装，负责监听端口与优雅退出
+type Server struct {
+	*grpc.Server
+
+	cfg    *config.GRPCConfig
+	logger appLogger.Logger
+}
+
+// NewServer 创建 gRPC 服务，默认挂载 panic 恢复与参数校验拦截器
+// 额外的拦截器通过 opts 传入 grpc.ChainUnaryInterceptor 追加
+func NewServer(cfg *config.GRPCConfig, logger appLogger.Logger, opts ...grpc.ServerOption) *Server {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			RecoveryUnaryInterceptor(logger),
+			ValidateUnaryInterceptor(),
+		),
+	}, opts...)
+	return &Server{
+		Server: grpc.NewServer(opts...),
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// Start 监听配置的端口并阻塞提供服务，直到 Stop 被调用
+func (s *Server) Start() error {
+	addr := s.cfg.Addr()
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	s.logger.Info("gRPC 服务启动", zap.String("addr", addr))
+	if err := s.Serve(lis); err != nil {
+		return fmt.Errorf("grpc serve: %w", err)
+	}
+	return nil
+}
+
+// Stop 优雅退出；超过配置的等待时间后强制关闭
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(s.cfg.GracefulStopTimeout)*time.Second)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.logger.Info("gRPC 服务已优雅退出")
+	case <-ctx.Done():
+		s.Server.Stop()
+		s.logger.Warn("gRPC 服务优雅退出超时，已强制关闭")
+	}
+}
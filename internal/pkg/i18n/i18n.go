@@ -0,0 +1,154 @@
+// Package i18n 语言区域处理：规范化语言标签、按回退链选择语言版本，以及按语言格式化数字与日期
+package i18n
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/pkg/config"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Localizer 按配置的默认语言与回退链选择语言版本
+type Localizer struct {
+	defaultLocale string
+	fallbacks     map[string][]string
+}
+
+// New 根据配置创建 Localizer，配置中的语言标签统一规范化，非法标签被忽略
+func New(cfg *config.I18nConfig) *Localizer {
+	l := &Localizer{
+		defaultLocale: Normalize(cfg.DefaultLocale),
+		fallbacks:     make(map[string][]string, len(cfg.Fallbacks)),
+	}
+	for from, tos := range cfg.Fallbacks {
+		key := Normalize(from)
+		if key == "" {
+			continue
+		}
+		for _, to := range tos {
+			if t := Normalize(to); t != "" {
+				l.fallbacks[key] = append(l.fallbacks[key], t)
+			}
+		}
+	}
+	return l
+}
+
+// DefaultLocale 模板默认内容使用的语言
+func (l *Localizer) DefaultLocale() string {
+	return l.defaultLocale
+}
+
+// Chain 返回语言的回退链：语言本身、配置的回退语言、语言的基础语种（如 zh-TW 的 zh），按顺序去重
+// locale 为空或非法时返回空，调用方直接使用默认内容
+func (l *Localizer) Chain(locale string) []string {
+	tag := Normalize(locale)
+	if tag == "" {
+		return nil
+	}
+	chain := []string{tag}
+	add := func(t string) {
+		for _, c := range chain {
+			if c == t {
+				return
+			}
+		}
+		chain = append(chain, t)
+	}
+	for _, t := range l.fallbacks[tag] {
+		add(t)
+	}
+	for _, t := range append([]string{tag}, l.fallbacks[tag]...) {
+		if base := baseOf(t); base != "" {
+			add(base)
+		}
+	}
+	return chain
+}
+
+// Normalize 规范化语言标签，如 zh_tw、ZH-tw 规范化为 zh-TW；非法标签返回空
+func Normalize(locale string) string {
+	locale = strings.TrimSpace(strings.ReplaceAll(locale, "_", "-"))
+	if locale == "" {
+		return ""
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return ""
+	}
+	return tag.String()
+}
+
+// baseOf 返回语言标签的基础语种，本身即为基础语种时返回空
+func baseOf(locale string) string {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return ""
+	}
+	base, _ := tag.Base()
+	if base.String() == locale {
+		return ""
+	}
+	return base.String()
+}
+
+// FormatNumber 按语言的数字格式（千位分隔符、小数点）格式化数字，保留原有的小数位数；无法解析时原样返回
+func FormatNumber(locale, value string) string {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return value
+	}
+	digits := 0
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		digits = len(value) - i - 1
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		tag = language.Und
+	}
+	return message.NewPrinter(tag).Sprint(number.Decimal(f,
+		number.MinFractionDigits(digits), number.MaxFractionDigits(digits)))
+}
+
+// dateLayouts 一种语言的日期与日期时间格式
+type dateLayouts struct {
+	date     string
+	dateTime string
+}
+
+// layouts 按语言标签或基础语种配置的日期格式，未配置的语言使用 ISO 8601 风格
+var layouts = map[string]dateLayouts{
+	"zh":    {date: "2006年1月2日", dateTime: "2006年1月2日 15:04"},
+	"ja":    {date: "2006年1月2日", dateTime: "2006年1月2日 15:04"},
+	"ko":    {date: "2006년 1월 2일", dateTime: "2006년 1월 2일 15:04"},
+	"en-US": {date: "Jan 2, 2006", dateTime: "Jan 2, 2006, 3:04 PM"},
+	"en":    {date: "2 Jan 2006", dateTime: "2 Jan 2006, 15:04"},
+}
+
+// defaultLayouts 未配置日期格式的语言使用的格式
+var defaultLayouts = dateLayouts{date: "2006-01-02", dateTime: "2006-01-02 15:04"}
+
+// layoutsFor 返回语言的日期格式，先按完整标签、再按基础语种查找
+func layoutsFor(locale string) dateLayouts {
+	if l, ok := layouts[locale]; ok {
+		return l
+	}
+	if l, ok := layouts[baseOf(locale)]; ok {
+		return l
+	}
+	return defaultLayouts
+}
+
+// FormatDate 按语言格式化日期
+func FormatDate(locale string, t time.Time) string {
+	return t.Format(layoutsFor(locale).date)
+}
+
+// FormatDateTime 按语言格式化日期时间，时间按 t 自身的时区显示
+func FormatDateTime(locale string, t time.Time) string {
+	return t.Format(layoutsFor(locale).dateTime)
+}
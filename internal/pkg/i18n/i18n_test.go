@@ -0,0 +1,110 @@
+package i18n_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	"github.com/dingdong-postman/internal/pkg/i18n"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "zh-TW", want: "zh-TW"},
+		{in: "zh_tw", want: "zh-TW"},
+		{in: " ZH-tw ", want: "zh-TW"},
+		{in: "en", want: "en"},
+		{in: "EN_us", want: "en-US"},
+		{in: "", want: ""},
+		{in: "not a locale", want: ""},
+	}
+	for _, tt := range tests {
+		if got := i18n.Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	l := i18n.New(&config.I18nConfig{
+		DefaultLocale: "zh_CN",
+		Fallbacks: map[string][]string{
+			"zh_TW": {"zh-CN"},
+			"zh-HK": {"zh-TW", "zh-CN"},
+			"en-GB": {"en-US"},
+			"fr-CA": {"not a locale", "fr-FR"},
+		},
+	})
+	if got := l.DefaultLocale(); got != "zh-CN" {
+		t.Errorf("DefaultLocale() = %q, want zh-CN", got)
+	}
+
+	tests := []struct {
+		locale string
+		want   []string
+	}{
+		{locale: "zh-TW", want: []string{"zh-TW", "zh-CN", "zh"}},
+		{locale: "zh_tw", want: []string{"zh-TW", "zh-CN", "zh"}},
+		{locale: "zh-HK", want: []string{"zh-HK", "zh-TW", "zh-CN", "zh"}},
+		{locale: "en-GB", want: []string{"en-GB", "en-US", "en"}},
+		// 非法的回退语言被忽略
+		{locale: "fr-CA", want: []string{"fr-CA", "fr-FR", "fr"}},
+		// 未配置回退语言时只回退到基础语种
+		{locale: "ja-JP", want: []string{"ja-JP", "ja"}},
+		{locale: "en", want: []string{"en"}},
+		{locale: "", want: nil},
+		{locale: "not a locale", want: nil},
+	}
+	for _, tt := range tests {
+		if got := l.Chain(tt.locale); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Chain(%q) = %v, want %v", tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestChainSelectsContent(t *testing.T) {
+	l := i18n.New(config.DefaultI18nConfig())
+	v := domain.TemplateVersion{
+		Body: "默认：您的验证码是 {{code}}",
+		Locales: []domain.LocalizedContent{
+			{Locale: "zh-CN", Body: "简体：您的验证码是 {{code}}"},
+			{Locale: "zh-tw", Body: "繁體：您的驗證碼是 {{code}}"},
+			{Locale: "en", Body: "Your code is {{code}}"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		locale     string
+		wantLocale string
+	}{
+		{name: "exact match", locale: "zh-TW", wantLocale: "zh-tw"},
+		{name: "zh-HK falls back to zh-TW", locale: "zh-HK", wantLocale: "zh-tw"},
+		{name: "base language without variant uses default content", locale: "zh-SG", wantLocale: ""},
+		{name: "en-GB falls back to base language", locale: "en-GB", wantLocale: "en"},
+		{name: "unknown language uses default content", locale: "ja-JP", wantLocale: ""},
+		{name: "no language uses default content", locale: "", wantLocale: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v.Content(l.Chain(tt.locale))
+			if got.Locale != tt.wantLocale {
+				t.Errorf("Content(Chain(%q)).Locale = %q, want %q", tt.locale, got.Locale, tt.wantLocale)
+			}
+		})
+	}
+
+	// zh-TW 版本缺失时沿回退链使用 zh-CN，zh-CN 也缺失时使用默认内容
+	v.Locales = v.Locales[:1]
+	if got := v.Content(l.Chain("zh-TW")); got.Locale != "zh-CN" {
+		t.Errorf("without zh-TW: Content(Chain(zh-TW)).Locale = %q, want zh-CN", got.Locale)
+	}
+	v.Locales = nil
+	if got := v.Content(l.Chain("zh-TW")); got.Locale != "" || got.Body != v.Body {
+		t.Errorf("without variants: Content(Chain(zh-TW)) = %+v, want default content", got)
+	}
+}
@@ -0,0 +1,240 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// limitScript 多规则限流：先判断全部规则，全部有余量时再统一计数，任意一条超限时不计入任何规则，
+// 避免前面的规则已计数、后面的规则拒绝时白白消耗前面规则的额度。使用 Redis 服务端时间，避免各实例时钟偏差影响计算。
+//   - 滑动窗口：以有序集合记录窗口内每次放行的时间（毫秒），先清理窗口外的记录，再判断是否还有余量
+//   - 令牌桶：以哈希记录剩余令牌数与上次补充时间，按经过的时间补充令牌后再扣减，允许不超过桶容量的突发
+//
+// KEYS[i] 第 i 条规则的计数键；ARGV[1] 本次请求在滑动窗口中的唯一成员；
+// 第 i 条规则的参数为 ARGV[4i-2..4i+1]：算法（w 滑动窗口、b 令牌桶），
+// 滑动窗口为 {w, 窗口长度（毫秒）, 窗口内最多放行次数, 0}，令牌桶为 {b, 桶容量, 每秒补充的令牌数, 本次消耗的令牌数}
+// 返回 {是否放行, 各规则剩余次数的最小值, 距全部规则可放行的毫秒数, 第一条超限规则的序号（从 1 开始，放行时为 0）}
+var limitScript = redis.NewScript(`
+redis.replicate_commands()
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local member = ARGV[1]
+
+local states = {}
+local remaining = nil
+local retry = 0
+local rejected = 0
+for i, key in ipairs(KEYS) do
+  local base = 4 * i - 2
+  local algorithm = ARGV[base]
+  local st = {algorithm = algorithm}
+  local left, wait
+  if algorithm == 'w' then
+    st.window = tonumber(ARGV[base + 1])
+    local limit = tonumber(ARGV[base + 2])
+    redis.call('ZREMRANGEBYSCORE', key, '-inf', now - st.window)
+    local count = redis.call('ZCARD', key)
+    st.ok = count < limit
+    left = limit - count - 1
+    wait = 0
+    if not st.ok then
+      left = 0
+      local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+      if oldest[2] then
+        wait = tonumber(oldest[2]) + st.window - now
+      end
+    end
+  else
+    local capacity = tonumber(ARGV[base + 1])
+    local rate = tonumber(ARGV[base + 2])
+    local cost = tonumber(ARGV[base + 3])
+    local state = redis.call('HMGET', key, 'tokens', 'ts')
+    local tokens = tonumber(state[1])
+    local ts = tonumber(state[2])
+    if tokens == nil or ts == nil then
+      tokens = capacity
+      ts = now
+    end
+    tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate / 1000)
+    st.ok = tokens >= cost
+    st.tokens = tokens - cost
+    st.ttl = math.ceil(capacity * 1000 / rate) + 1000
+    left = math.floor(st.tokens)
+    wait = 0
+    if not st.ok then
+      left = math.floor(tokens)
+      wait = math.ceil((cost - tokens) * 1000 / rate)
+    end
+  end
+  if not st.ok and rejected == 0 then
+    rejected = i
+  end
+  if wait > retry then
+    retry = wait
+  end
+  if remaining == nil or left < remaining then
+    remaining = left
+  end
+  states[i] = st
+end
+
+if rejected > 0 then
+  return {0, remaining, retry, rejected}
+end
+for i, key in ipairs(KEYS) do
+  local st = states[i]
+  if st.algorithm == 'w' then
+    redis.call('ZADD', key, now, member)
+    redis.call('PEXPIRE', key, st.window)
+  else
+    redis.call('HSET', key, 'tokens', tostring(st.tokens), 'ts', now)
+    redis.call('PEXPIRE', key, st.ttl)
+  end
+end
+return {1, remaining or 0, 0, 0}
+`)
+
+// 限流算法在脚本参数中的标识
+const (
+	algorithmSlidingWindow = "w"
+	algorithmTokenBucket   = "b"
+)
+
+// Limit 一条限流规则在一次判断中的参数，由 SlidingWindow 或 TokenBucket 创建
+type Limit struct {
+	key       string
+	algorithm string
+	args      [3]any
+}
+
+// SlidingWindow 滑动窗口规则：key 在最近 window 内最多放行 limit 次
+func SlidingWindow(key string, limit int64, window time.Duration) Limit {
+	return Limit{key: key, algorithm: algorithmSlidingWindow, args: [3]any{window.Milliseconds(), limit, 0}}
+}
+
+// TokenBucket 令牌桶规则：从 key 对应的令牌桶中取 cost 个令牌；桶容量为 capacity，每秒补充 rate 个令牌
+func TokenBucket(key string, capacity int64, rate float64, cost int64) Limit {
+	return Limit{key: key, algorithm: algorithmTokenBucket, args: [3]any{capacity, rate, cost}}
+}
+
+// LimitResult 一次限流判断的结果
+type LimitResult struct {
+	// Allowed 是否放行
+	Allowed bool
+	// Remaining 剩余可放行的次数（令牌桶为剩余令牌数）
+	Remaining int64
+	// RetryAfter 被拒绝时距下次可能放行的等待时间
+	RetryAfter time.Duration
+}
+
+// MultiLimitResult 多规则限流判断的结果
+type MultiLimitResult struct {
+	LimitResult
+	// Rejected 第一条超限规则在传入规则中的下标，放行时为 -1
+	Rejected int
+}
+
+// Limiter 基于 Lua 脚本的分布式多规则限流器，在一次脚本调用中判断全部规则，全部放行时才统一计数
+type Limiter struct {
+	client Client
+}
+
+// NewLimiter 创建多规则限流器
+func NewLimiter(client Client) *Limiter {
+	return &Limiter{
+		client: client,
+	}
+}
+
+// Allow 判断一次请求是否同时满足全部规则；放行时计入每条规则，拒绝时不计入任何规则。
+// Remaining 为各规则剩余次数的最小值，RetryAfter 为全部超限规则中最长的等待时间
+func (l *Limiter) Allow(ctx context.Context, limits ...Limit) (MultiLimitResult, error) {
+	if len(limits) == 0 {
+		return MultiLimitResult{LimitResult: LimitResult{Allowed: true}, Rejected: -1}, nil
+	}
+	member, err := uniqueMember()
+	if err != nil {
+		return MultiLimitResult{}, err
+	}
+	keys := make([]string, 0, len(limits))
+	args := make([]any, 0, 1+4*len(limits))
+	args = append(args, member)
+	for i := range limits {
+		keys = append(keys, limits[i].key)
+		args = append(args, limits[i].algorithm)
+		args = append(args, limits[i].args[:]...)
+	}
+	vals, err := limitScript.Run(ctx, l.client.Raw(), keys, args...).Int64Slice()
+	if err != nil {
+		return MultiLimitResult{}, fmt.Errorf("run limiter script: %w", err)
+	}
+	const fields = 4
+	if len(vals) != fields {
+		return MultiLimitResult{}, fmt.Errorf("unexpected limiter script result: %v", vals)
+	}
+	return MultiLimitResult{
+		LimitResult: LimitResult{
+			Allowed:    vals[0] == 1,
+			Remaining:  vals[1],
+			RetryAfter: time.Duration(vals[2]) * time.Millisecond,
+		},
+		Rejected: int(vals[3]) - 1,
+	}, nil
+}
+
+// SlidingWindowLimiter 基于 Lua 脚本的分布式滑动窗口限流器，判断与计数在 Redis 中原子完成
+type SlidingWindowLimiter struct {
+	limiter *Limiter
+}
+
+// NewSlidingWindowLimiter 创建滑动窗口限流器
+func NewSlidingWindowLimiter(client Client) *SlidingWindowLimiter {
+	return &SlidingWindowLimiter{
+		limiter: NewLimiter(client),
+	}
+}
+
+// Allow 判断 key 在最近 window 内是否还允许一次请求，放行时计入窗口
+func (l *SlidingWindowLimiter) Allow(ctx context.Context, key string, limit int64, window time.Duration) (LimitResult, error) {
+	res, err := l.limiter.Allow(ctx, SlidingWindow(key, limit, window))
+	return res.LimitResult, err
+}
+
+// TokenBucketLimiter 基于 Lua 脚本的分布式令牌桶限流器，补充与扣减在 Redis 中原子完成
+type TokenBucketLimiter struct {
+	limiter *Limiter
+}
+
+// NewTokenBucketLimiter 创建令牌桶限流器
+func NewTokenBucketLimiter(client Client) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		limiter: NewLimiter(client),
+	}
+}
+
+// Allow 从 key 对应的令牌桶中取 cost 个令牌；桶容量为 capacity，每秒补充 rate 个令牌
+func (l *TokenBucketLimiter) Allow(
+	ctx context.Context,
+	key string,
+	capacity int64,
+	rate float64,
+	cost int64,
+) (LimitResult, error) {
+	res, err := l.limiter.Allow(ctx, TokenBucket(key, capacity, rate, cost))
+	return res.LimitResult, err
+}
+
+// uniqueMember 生成滑动窗口中的唯一成员，避免同一毫秒内的多次请求相互覆盖
+func uniqueMember() (string, error) {
+	var buf [8]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", fmt.Errorf("generate limiter member: %w", err)
+	}
+	return strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + hex.EncodeToString(buf[:]), nil
+}
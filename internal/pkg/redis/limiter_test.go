@@ -0,0 +1,270 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// clock 控制 miniredis 的服务端时间（脚本中的 TIME）与键的过期
+type clock struct {
+	srv *miniredis.Miniredis
+	now time.Time
+}
+
+// advance 将服务端时间推进 d，并让键的过期时间同步流逝
+func (c *clock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+	c.srv.SetTime(c.now)
+	c.srv.FastForward(d)
+}
+
+// newServer 启动 miniredis 并固定服务端时间，测试结束时关闭
+func newServer(t *testing.T) (appRedis.Client, *clock) {
+	t.Helper()
+	srv := miniredis.RunT(t)
+	c := &clock{srv: srv, now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	srv.SetTime(c.now)
+	client := appRedis.NewClient(goredis.NewClient(&goredis.Options{Addr: srv.Addr()}))
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client, c
+}
+
+// step 一次限流判断：先推进时间，再判断并核对结果
+type step struct {
+	advance       time.Duration
+	wantAllowed   bool
+	wantRemaining int64
+	wantRetry     time.Duration
+}
+
+// checkResult 核对一次判断的结果
+func checkResult(t *testing.T, i int, got appRedis.LimitResult, want step) {
+	t.Helper()
+	if got.Allowed != want.wantAllowed || got.Remaining != want.wantRemaining || got.RetryAfter != want.wantRetry {
+		t.Errorf("step %d: got {allowed:%v remaining:%d retry:%v}, want {allowed:%v remaining:%d retry:%v}",
+			i+1, got.Allowed, got.Remaining, got.RetryAfter, want.wantAllowed, want.wantRemaining, want.wantRetry)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  int64
+		window time.Duration
+		steps  []step
+	}{
+		{
+			name:   "limit boundary and retry after",
+			limit:  3,
+			window: 10 * time.Second,
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 2},
+				{advance: time.Second, wantAllowed: true, wantRemaining: 1},
+				{advance: time.Second, wantAllowed: true, wantRemaining: 0},
+				// 最早一次在 0s 放行，10s 时移出窗口
+				{advance: time.Second, wantAllowed: false, wantRemaining: 0, wantRetry: 7 * time.Second},
+				{advance: 6500 * time.Millisecond, wantAllowed: false, wantRemaining: 0, wantRetry: 500 * time.Millisecond},
+			},
+		},
+		{
+			name:   "window expiry",
+			limit:  2,
+			window: 10 * time.Second,
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 1},
+				{advance: 4 * time.Second, wantAllowed: true, wantRemaining: 0},
+				{advance: 5 * time.Second, wantAllowed: false, wantRemaining: 0, wantRetry: time.Second},
+				// 0s 的记录移出窗口，4s 的仍在窗口内
+				{advance: time.Second, wantAllowed: true, wantRemaining: 0},
+				{wantAllowed: false, wantRemaining: 0, wantRetry: 4 * time.Second},
+				// 全部移出窗口
+				{advance: 10 * time.Second, wantAllowed: true, wantRemaining: 1},
+			},
+		},
+		{
+			name:   "rejected requests are not counted",
+			limit:  1,
+			window: time.Second,
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 0},
+				{advance: 200 * time.Millisecond, wantAllowed: false, wantRemaining: 0, wantRetry: 800 * time.Millisecond},
+				{advance: 200 * time.Millisecond, wantAllowed: false, wantRemaining: 0, wantRetry: 600 * time.Millisecond},
+				{advance: 600 * time.Millisecond, wantAllowed: true, wantRemaining: 0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, clk := newServer(t)
+			l := appRedis.NewSlidingWindowLimiter(client)
+			for i, s := range tt.steps {
+				clk.advance(s.advance)
+				res, err := l.Allow(context.Background(), "window", tt.limit, tt.window)
+				if err != nil {
+					t.Fatalf("step %d: Allow() error = %v", i+1, err)
+				}
+				checkResult(t, i, res, s)
+			}
+		})
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int64
+		rate     float64
+		cost     int64
+		steps    []step
+	}{
+		{
+			name:     "burst up to capacity then refill",
+			capacity: 2,
+			rate:     1,
+			cost:     1,
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 1},
+				{wantAllowed: true, wantRemaining: 0},
+				{wantAllowed: false, wantRemaining: 0, wantRetry: time.Second},
+				{advance: 400 * time.Millisecond, wantAllowed: false, wantRemaining: 0, wantRetry: 600 * time.Millisecond},
+				{advance: 600 * time.Millisecond, wantAllowed: true, wantRemaining: 0},
+				// 补充的令牌不超过桶容量
+				{advance: time.Minute, wantAllowed: true, wantRemaining: 1},
+			},
+		},
+		{
+			name:     "fractional rate",
+			capacity: 1,
+			rate:     0.5,
+			cost:     1,
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 0},
+				{wantAllowed: false, wantRemaining: 0, wantRetry: 2 * time.Second},
+				{advance: time.Second, wantAllowed: false, wantRemaining: 0, wantRetry: time.Second},
+				{advance: time.Second, wantAllowed: true, wantRemaining: 0},
+			},
+		},
+		{
+			name:     "cost above remaining tokens",
+			capacity: 5,
+			rate:     2,
+			cost:     3,
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 2},
+				{wantAllowed: false, wantRemaining: 2, wantRetry: 500 * time.Millisecond},
+				{advance: 500 * time.Millisecond, wantAllowed: true, wantRemaining: 0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, clk := newServer(t)
+			l := appRedis.NewTokenBucketLimiter(client)
+			for i, s := range tt.steps {
+				clk.advance(s.advance)
+				res, err := l.Allow(context.Background(), "bucket", tt.capacity, tt.rate, tt.cost)
+				if err != nil {
+					t.Fatalf("step %d: Allow() error = %v", i+1, err)
+				}
+				checkResult(t, i, res, s)
+			}
+		})
+	}
+}
+
+func TestLimiterChecksAllBeforeCounting(t *testing.T) {
+	tests := []struct {
+		name string
+		// exhaust 预先用完额度的规则下标
+		exhaust      []int
+		wantAllowed  bool
+		wantRejected int
+		wantRetry    time.Duration
+		// wantUsed 本次判断后各规则的已用次数
+		wantUsed [2]int64
+	}{
+		{
+			name:         "all rules allow",
+			wantAllowed:  true,
+			wantRejected: -1,
+			wantUsed:     [2]int64{1, 1},
+		},
+		{
+			name:         "first rule rejects, second is not counted",
+			exhaust:      []int{0},
+			wantRejected: 0,
+			wantRetry:    10 * time.Second,
+			wantUsed:     [2]int64{1, 0},
+		},
+		{
+			name:         "second rule rejects, first is not counted",
+			exhaust:      []int{1},
+			wantRejected: 1,
+			wantRetry:    time.Second,
+			wantUsed:     [2]int64{0, 1},
+		},
+		{
+			name:         "both reject, retry after the longest wait",
+			exhaust:      []int{0, 1},
+			wantRejected: 0,
+			wantRetry:    10 * time.Second,
+			wantUsed:     [2]int64{1, 1},
+		},
+	}
+
+	window := appRedis.SlidingWindow("multi:window", 1, 10*time.Second)
+	bucket := appRedis.TokenBucket("multi:bucket", 1, 1, 1)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := newServer(t)
+			l := appRedis.NewLimiter(client)
+			ctx := context.Background()
+			limits := []appRedis.Limit{window, bucket}
+			for _, i := range tt.exhaust {
+				if res, err := l.Allow(ctx, limits[i]); err != nil || !res.Allowed {
+					t.Fatalf("exhaust rule %d: allowed = %v, error = %v", i, res.Allowed, err)
+				}
+			}
+
+			res, err := l.Allow(ctx, limits...)
+			if err != nil {
+				t.Fatalf("Allow() error = %v", err)
+			}
+			if res.Allowed != tt.wantAllowed || res.Rejected != tt.wantRejected || res.RetryAfter != tt.wantRetry {
+				t.Errorf("Allow() = {allowed:%v rejected:%d retry:%v}, want {allowed:%v rejected:%d retry:%v}",
+					res.Allowed, res.Rejected, res.RetryAfter, tt.wantAllowed, tt.wantRejected, tt.wantRetry)
+			}
+
+			used := [2]int64{}
+			if n, err := client.ZCard(ctx, "multi:window"); err == nil {
+				used[0] = n
+			}
+			if tokens, err := client.HGet(ctx, "multi:bucket", "tokens"); err == nil && tokens == "0" {
+				used[1] = 1
+			}
+			if used != tt.wantUsed {
+				t.Errorf("used after Allow() = %v, want %v", used, tt.wantUsed)
+			}
+		})
+	}
+}
+
+func TestLimiterNoRules(t *testing.T) {
+	client, _ := newServer(t)
+	res, err := appRedis.NewLimiter(client).Allow(context.Background())
+	if err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if !res.Allowed || res.Rejected != -1 {
+		t.Errorf("Allow() = {allowed:%v rejected:%d}, want {allowed:true rejected:-1}", res.Allowed, res.Rejected)
+	}
+}
@@ -12,6 +12,7 @@ type Client interface {
 	// 基础操作
 	Get(ctx context.Context, key string) (string, error)
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
 	Del(ctx context.Context, keys ...string) (int64, error)
 	Exists(ctx context.Context, keys ...string) (int64, error)
 	Expire(ctx context.Context, key string, expiration time.Duration) (bool, error)
@@ -31,6 +32,7 @@ type Client interface {
 	HGetAll(ctx context.Context, key string) (map[string]string, error)
 	HDel(ctx context.Context, key string, fields ...string) (int64, error)
 	HExists(ctx context.Context, key, field string) (bool, error)
+	HIncrBy(ctx context.Context, key, field string, incr int64) (int64, error)
 
 	// 集合操作
 	SAdd(ctx context.Context, key string, members ...interface{}) (int64, error)
@@ -76,6 +78,11 @@ func (c *redisClient) Set(ctx context.Context, key string, value interface{}, ex
 	return c.client.Set(ctx, key, value, expiration).Err()
 }
 
+// SetNX 仅在键不存在时设置值，返回是否设置成功
+func (c *redisClient) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, key, value, expiration).Result()
+}
+
 // Del 删除键
 func (c *redisClient) Del(ctx context.Context, keys ...string) (int64, error) {
 	return c.client.Del(ctx, keys...).Result()
@@ -151,6 +158,11 @@ func (c *redisClient) HExists(ctx context.Context, key, field string) (bool, err
 	return c.client.HExists(ctx, key, field).Result()
 }
 
+// HIncrBy 为哈希字段增加整数值，返回增加后的值
+func (c *redisClient) HIncrBy(ctx context.Context, key, field string, incr int64) (int64, error) {
+	return c.client.HIncrBy(ctx, key, field, incr).Result()
+}
+
 // SAdd 添加集合成员
 func (c *redisClient) SAdd(ctx context.Context, key string, members ...interface{}) (int64, error) {
 	return c.client.SAdd(ctx, key, members...).Result()
@@ -0,0 +1,318 @@
+// Package aliyunsms 阿里云短信（Dysmsapi）供应商
+// 支持 SendSms 单条发送、SendBatchSms 批量发送、QuerySendDetails 投递状态查询、SmsReport 状态报告推送，
+// 以及 AddSmsTemplate / QuerySmsTemplate 模板申请与审核状态查询，
+// 访问密钥默认与阿里云日志服务共用 ALIYUN_ACCESS_KEY_ID / ALIYUN_ACCESS_KEY_SECRET
+package aliyunsms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"go.uber.org/zap"
+)
+
+// Type 供应商类型名
+const Type = "aliyun_sms"
+
+// maxBatchSize SendBatchSms 单次最多支持的号码数
+const maxBatchSize = 100
+
+// 阿里云投递状态：1 等待回执，2 发送失败，3 发送成功
+const (
+	sendStatusWaiting   = 1
+	sendStatusFailed    = 2
+	sendStatusDelivered = 3
+)
+
+// receiveDateLayout QuerySendDetails 与 SmsReport 推送中的时间格式（北京时间）
+const receiveDateLayout = "2006-01-02 15:04:05"
+
+func init() {
+	provider.RegisterFactory(Type, New)
+}
+
+// Provider 阿里云短信供应商
+type Provider struct {
+	name         string
+	signName     string
+	templateType int
+	client       *client
+	logger       appLogger.Logger
+}
+
+// New 根据配置创建阿里云短信供应商，providers[].aliyun_sms 必填，未配置的字段使用默认值
+func New(cfg config.ProviderConfig, logger appLogger.Logger) (provider.Provider, error) {
+	if cfg.AliyunSMS == nil {
+		return nil, fmt.Errorf("provider %s: aliyun_sms config is required", cfg.Name)
+	}
+	smsCfg := withDefaults(*cfg.AliyunSMS)
+	accessKeyID, accessKeySecret := smsCfg.GetAccessKeyID(), smsCfg.GetAccessKeySecret()
+	if accessKeyID == "" || accessKeySecret == "" {
+		return nil, fmt.Errorf("provider %s: aliyun access key is required (env %s / %s)",
+			cfg.Name, smsCfg.AccessKeyIDEnvVar, smsCfg.AccessKeySecretEnvVar)
+	}
+	if smsCfg.SignName == "" {
+		return nil, fmt.Errorf("provider %s: aliyun sms sign_name is required", cfg.Name)
+	}
+	templateType, ok := templateTypes[strings.ToUpper(smsCfg.TemplateType)]
+	if !ok {
+		return nil, fmt.Errorf("provider %s: unknown aliyun sms template_type %q", cfg.Name, smsCfg.TemplateType)
+	}
+
+	return &Provider{
+		name:         cfg.Name,
+		signName:     smsCfg.SignName,
+		templateType: templateType,
+		client: &client{
+			endpoint:        smsCfg.Endpoint,
+			regionID:        smsCfg.RegionID,
+			accessKeyID:     accessKeyID,
+			accessKeySecret: accessKeySecret,
+			httpClient:      &http.Client{Timeout: time.Duration(smsCfg.Timeout) * time.Second},
+		},
+		logger: logger,
+	}, nil
+}
+
+// withDefaults 用默认配置填充未设置的字段
+func withDefaults(cfg config.AliyunSMSConfig) config.AliyunSMSConfig {
+	def := config.DefaultAliyunSMSConfig()
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = def.Endpoint
+	}
+	if cfg.RegionID == "" {
+		cfg.RegionID = def.RegionID
+	}
+	if cfg.AccessKeyIDEnvVar == "" {
+		cfg.AccessKeyIDEnvVar = def.AccessKeyIDEnvVar
+	}
+	if cfg.AccessKeySecretEnvVar == "" {
+		cfg.AccessKeySecretEnvVar = def.AccessKeySecretEnvVar
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = def.Timeout
+	}
+	if cfg.TemplateType == "" {
+		cfg.TemplateType = def.TemplateType
+	}
+	return cfg
+}
+
+// Name 供应商实例名称
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// SupportedChannel 支持的通知渠道
+func (p *Provider) SupportedChannel() domain.Channel {
+	return domain.ChannelSMS
+}
+
+// sendResponse SendSms / SendBatchSms 响应
+//
+//nolint:tagliatelle // 字段名由阿里云接口定义
+type sendResponse struct {
+	response
+	BizID string `json:"BizId"`
+}
+
+// Send 调用 SendSms 发送单条短信，模板 ID 即阿里云模板 Code，返回回执 ID（BizId）
+func (p *Provider) Send(ctx context.Context, n domain.Notification) (provider.SendResult, error) {
+	params := map[string]string{
+		"PhoneNumbers":  n.Recipient,
+		"SignName":      p.sign(n),
+		"TemplateCode":  n.Template.ID,
+		"TemplateParam": "{}",
+	}
+	if len(n.Template.Params) > 0 {
+		b, err := json.Marshal(n.Template.Params)
+		if err != nil {
+			return provider.SendResult{}, fmt.Errorf("marshal template params: %w", err)
+		}
+		params["TemplateParam"] = string(b)
+	}
+	if n.ID != 0 {
+		params["OutId"] = strconv.FormatUint(n.ID, 10)
+	}
+
+	var resp sendResponse
+	if err := p.client.call(ctx, "SendSms", params, &resp); err != nil {
+		return provider.SendResult{}, p.callError(err)
+	}
+	if resp.Code != codeOK {
+		return provider.SendResult{}, p.vendorError(&resp.response)
+	}
+
+	p.logger.Debug("阿里云短信已受理",
+		zap.String("provider", p.name),
+		zap.String("biz_id", resp.BizID),
+		zap.String("request_id", resp.RequestID),
+		zap.Uint64("notification_id", n.ID),
+	)
+	return provider.SendResult{MessageID: resp.BizID}, nil
+}
+
+// SendBatch 调用 SendBatchSms 批量发送
+// 阿里云要求同一批次使用相同模板，因此按模板分组、每组每 100 个号码一次请求，同一请求内的通知共用回执 ID
+func (p *Provider) SendBatch(ctx context.Context, ns []domain.Notification) ([]provider.BatchResult, error) {
+	results := make([]provider.BatchResult, len(ns))
+
+	groups := make(map[string][]int)
+	var order []string
+	for i := range ns {
+		code := ns[i].Template.ID
+		if _, ok := groups[code]; !ok {
+			order = append(order, code)
+		}
+		groups[code] = append(groups[code], i)
+	}
+
+	for _, code := range order {
+		idx := groups[code]
+		for start := 0; start < len(idx); start += maxBatchSize {
+			end := min(start+maxBatchSize, len(idx))
+			chunk := idx[start:end]
+			bizID, err := p.sendBatch(ctx, code, ns, chunk)
+			for _, i := range chunk {
+				results[i] = provider.BatchResult{MessageID: bizID, Err: err}
+			}
+		}
+	}
+	return results, nil
+}
+
+// sign 通知指定了签名（如租户的默认签名）时使用该签名，否则使用供应商配置的签名
+func (p *Provider) sign(n domain.Notification) string {
+	if n.Sender.SignName != "" {
+		return n.Sender.SignName
+	}
+	return p.signName
+}
+
+// sendBatch 发送一批同模板的短信
+func (p *Provider) sendBatch(ctx context.Context, templateCode string, ns []domain.Notification, idx []int) (string, error) {
+	phones := make([]string, 0, len(idx))
+	signs := make([]string, 0, len(idx))
+	params := make([]map[string]string, 0, len(idx))
+	for _, i := range idx {
+		phones = append(phones, ns[i].Recipient)
+		signs = append(signs, p.sign(ns[i]))
+		tp := ns[i].Template.Params
+		if tp == nil {
+			tp = map[string]string{}
+		}
+		params = append(params, tp)
+	}
+
+	phoneJSON, err := json.Marshal(phones)
+	if err != nil {
+		return "", fmt.Errorf("marshal phone numbers: %w", err)
+	}
+	signJSON, err := json.Marshal(signs)
+	if err != nil {
+		return "", fmt.Errorf("marshal sign names: %w", err)
+	}
+	paramJSON, err := json.Marshal(params)
+	if err != nil {
+		return "", fmt.Errorf("marshal template params: %w", err)
+	}
+
+	var resp sendResponse
+	err = p.client.call(ctx, "SendBatchSms", map[string]string{
+		"PhoneNumberJson":   string(phoneJSON),
+		"SignNameJson":      string(signJSON),
+		"TemplateCode":      templateCode,
+		"TemplateParamJson": string(paramJSON),
+	}, &resp)
+	if err != nil {
+		return "", p.callError(err)
+	}
+	if resp.Code != codeOK {
+		return "", p.vendorError(&resp.response)
+	}
+
+	p.logger.Debug("阿里云批量短信已受理",
+		zap.String("provider", p.name),
+		zap.String("biz_id", resp.BizID),
+		zap.String("request_id", resp.RequestID),
+		zap.Int("count", len(idx)),
+	)
+	return resp.BizID, nil
+}
+
+// queryResponse QuerySendDetails 响应
+//
+//nolint:tagliatelle // 字段名由阿里云接口定义
+type queryResponse struct {
+	response
+	TotalCount        int `json:"TotalCount"`
+	SmsSendDetailDTOs struct {
+		SmsSendDetailDTO []sendDetail `json:"SmsSendDetailDTO"`
+	} `json:"SmsSendDetailDTOs"`
+}
+
+// sendDetail 单条短信的投递详情
+//
+//nolint:tagliatelle // 字段名由阿里云接口定义
+type sendDetail struct {
+	PhoneNum     string `json:"PhoneNum"`
+	SendStatus   int    `json:"SendStatus"`
+	ErrCode      string `json:"ErrCode"`
+	TemplateCode string `json:"TemplateCode"`
+	SendDate     string `json:"SendDate"`
+	ReceiveDate  string `json:"ReceiveDate"`
+	OutID        string `json:"OutId"`
+}
+
+// QueryStatus 调用 QuerySendDetails 查询投递状态，需要接收号码与发送日期
+func (p *Provider) QueryStatus(ctx context.Context, req provider.QueryRequest) (provider.QueryResult, error) {
+	sentAt := req.SentAt
+	if sentAt.IsZero() {
+		sentAt = time.Now()
+	}
+	var resp queryResponse
+	err := p.client.call(ctx, "QuerySendDetails", map[string]string{
+		"PhoneNumber": req.Recipient,
+		"BizId":       req.MessageID,
+		"SendDate":    sentAt.In(provider.ChinaTime).Format("20060102"),
+		"PageSize":    "10",
+		"CurrentPage": "1",
+	}, &resp)
+	if err != nil {
+		return provider.QueryResult{}, p.callError(err)
+	}
+	if resp.Code != codeOK {
+		return provider.QueryResult{}, p.vendorError(&resp.response)
+	}
+
+	details := resp.SmsSendDetailDTOs.SmsSendDetailDTO
+	if len(details) == 0 {
+		return provider.QueryResult{Status: provider.DeliveryStatusUnknown}, nil
+	}
+	d := details[0]
+	result := provider.QueryResult{
+		Status: provider.DeliveryStatusUnknown,
+		Code:   d.ErrCode,
+	}
+	switch d.SendStatus {
+	case sendStatusWaiting:
+		result.Status = provider.DeliveryStatusSent
+	case sendStatusFailed:
+		result.Status = provider.DeliveryStatusFailed
+	case sendStatusDelivered:
+		result.Status = provider.DeliveryStatusDelivered
+	}
+	if t, err := time.ParseInLocation(receiveDateLayout, d.ReceiveDate, provider.ChinaTime); err == nil {
+		result.ReportedAt = t
+	}
+	return result, nil
+}
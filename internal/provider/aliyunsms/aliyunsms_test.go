@@ -0,0 +1,258 @@
+package aliyunsms_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/provider/aliyunsms"
+	"github.com/dingdong-postman/internal/provider/aliyunsms/aliyunsmstest"
+)
+
+const (
+	testAccessKeyID     = "test-key-id"
+	testAccessKeySecret = "test-key-secret"
+)
+
+// newProvider 启动替身服务并创建指向它的阿里云短信供应商，测试结束时关闭替身
+func newProvider(t *testing.T, accessKeySecret string) (provider.Provider, *aliyunsmstest.Server) {
+	t.Helper()
+	srv := aliyunsmstest.NewServer(testAccessKeyID, testAccessKeySecret)
+	t.Cleanup(srv.Close)
+
+	p, err := aliyunsms.New(config.ProviderConfig{
+		Name: "ali",
+		AliyunSMS: &config.AliyunSMSConfig{
+			Endpoint:              srv.URL,
+			AccessKeyID:           testAccessKeyID,
+			AccessKeySecret:       accessKeySecret,
+			AccessKeyIDEnvVar:     "DINGDONG_ALIYUN_TEST_KEY_ID_UNSET",
+			AccessKeySecretEnvVar: "DINGDONG_ALIYUN_TEST_KEY_SECRET_UNSET",
+			SignName:              "叮咚",
+		},
+	}, appLogger.GetGlobal())
+	if err != nil {
+		t.Fatalf("new aliyun sms provider: %v", err)
+	}
+	return p, srv
+}
+
+func notification(phone string) domain.Notification {
+	return domain.Notification{
+		ID:        42,
+		Channel:   domain.ChannelSMS,
+		Recipient: phone,
+		Template: domain.Template{
+			ID:     "SMS_100001",
+			Params: map[string]string{"code": "1234"},
+		},
+	}
+}
+
+// TestSign 使用阿里云 RPC 签名文档中的示例（DescribeRegions）验证签名算法
+func TestSign(t *testing.T) {
+	params := url.Values{}
+	params.Set("AccessKeyId", "testid")
+	params.Set("Action", "DescribeRegions")
+	params.Set("Format", "XML")
+	params.Set("SignatureMethod", "HMAC-SHA1")
+	params.Set("SignatureNonce", "3ee8c1b8-83d3-44af-a94f-4e0ad82fd6cf")
+	params.Set("SignatureVersion", "1.0")
+	params.Set("Timestamp", "2016-02-23T12:46:24Z")
+	params.Set("Version", "2014-05-26")
+
+	const want = "OLeaidS1JvxuMvnyHOwuJ+uX5qY="
+	if got := aliyunsms.Sign("GET", params, "testsecret"); got != want {
+		t.Errorf("Sign() = %q, want %q", got, want)
+	}
+
+	// 已有的 Signature 参数不参与签名
+	params.Set("Signature", "ignored")
+	if got := aliyunsms.Sign("GET", params, "testsecret"); got != want {
+		t.Errorf("Sign() with Signature param = %q, want %q", got, want)
+	}
+}
+
+func TestSend(t *testing.T) {
+	p, srv := newProvider(t, testAccessKeySecret)
+
+	n := notification("13800138000")
+	res, err := p.Send(context.Background(), n)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if res.MessageID == "" {
+		t.Fatal("Send() returned an empty BizId")
+	}
+
+	msgs := srv.Messages()
+	if len(msgs) != 1 {
+		t.Fatalf("stand-in received %d messages, want 1", len(msgs))
+	}
+	got := msgs[0]
+	want := aliyunsmstest.Message{
+		BizID:         res.MessageID,
+		PhoneNumber:   "13800138000",
+		SignName:      "叮咚",
+		TemplateCode:  "SMS_100001",
+		TemplateParam: `{"code":"1234"}`,
+		OutID:         "42",
+	}
+	if got.BizID != want.BizID || got.PhoneNumber != want.PhoneNumber || got.SignName != want.SignName ||
+		got.TemplateCode != want.TemplateCode || got.TemplateParam != want.TemplateParam || got.OutID != want.OutID {
+		t.Errorf("stand-in received %+v, want %+v", got, want)
+	}
+}
+
+func TestSendUsesNotificationSignName(t *testing.T) {
+	p, srv := newProvider(t, testAccessKeySecret)
+
+	n := notification("13800138000")
+	n.Sender.SignName = "租户签名"
+	if _, err := p.Send(context.Background(), n); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if msgs := srv.Messages(); len(msgs) != 1 || msgs[0].SignName != "租户签名" {
+		t.Errorf("stand-in received %+v, want sign name 租户签名", msgs)
+	}
+}
+
+func TestSendBadSignature(t *testing.T) {
+	p, srv := newProvider(t, "wrong-secret")
+
+	_, err := p.Send(context.Background(), notification("13800138000"))
+	var pe *provider.Error
+	if !errors.As(err, &pe) {
+		t.Fatalf("Send() error = %v, want *provider.Error", err)
+	}
+	if pe.Code != "SignatureDoesNotMatch" || pe.Category != provider.CategoryPermanent {
+		t.Errorf("error = %s/%s, want SignatureDoesNotMatch/%s", pe.Code, pe.Category, provider.CategoryPermanent)
+	}
+	if len(srv.Messages()) != 0 {
+		t.Error("stand-in accepted a request with a bad signature")
+	}
+}
+
+func TestSendErrorCategories(t *testing.T) {
+	tests := []struct {
+		code          string
+		wantCategory  provider.ErrorCategory
+		wantRetryable bool
+	}{
+		{code: "isv.BUSINESS_LIMIT_CONTROL", wantCategory: provider.CategoryThrottled, wantRetryable: true},
+		{code: "isv.DAY_LIMIT_CONTROL", wantCategory: provider.CategoryThrottled, wantRetryable: true},
+		{code: "Throttling.User", wantCategory: provider.CategoryThrottled, wantRetryable: true},
+		{code: "Throttling.Unlisted", wantCategory: provider.CategoryThrottled, wantRetryable: true},
+		{code: "isp.SYSTEM_ERROR", wantCategory: provider.CategoryRetryable, wantRetryable: true},
+		{code: "isp.UNLISTED_ERROR", wantCategory: provider.CategoryRetryable, wantRetryable: true},
+		{code: "isv.MOBILE_NUMBER_ILLEGAL", wantCategory: provider.CategoryPermanent},
+		{code: "isv.SMS_TEMPLATE_ILLEGAL", wantCategory: provider.CategoryPermanent},
+		{code: "isv.AMOUNT_NOT_ENOUGH", wantCategory: provider.CategoryPermanent},
+		{code: "isv.UNLISTED_ERROR", wantCategory: provider.CategoryPermanent},
+	}
+	p, srv := newProvider(t, testAccessKeySecret)
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			const phone = "13900139000"
+			srv.SetError(phone, tt.code)
+			t.Cleanup(func() { srv.SetError(phone, "") })
+
+			_, err := p.Send(context.Background(), notification(phone))
+			var pe *provider.Error
+			if !errors.As(err, &pe) {
+				t.Fatalf("Send() error = %v, want *provider.Error", err)
+			}
+			if pe.Code != tt.code {
+				t.Errorf("Code = %q, want %q", pe.Code, tt.code)
+			}
+			if pe.Category != tt.wantCategory {
+				t.Errorf("Category = %s, want %s", pe.Category, tt.wantCategory)
+			}
+			if got := provider.IsRetryable(err); got != tt.wantRetryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.wantRetryable)
+			}
+		})
+	}
+}
+
+func TestSendBatchSharesBizID(t *testing.T) {
+	p, srv := newProvider(t, testAccessKeySecret)
+	batcher, ok := p.(provider.BatchSender)
+	if !ok {
+		t.Fatal("aliyun sms provider does not implement provider.BatchSender")
+	}
+
+	ns := []domain.Notification{notification("13800138001"), notification("13800138002")}
+	results, err := batcher.SendBatch(context.Background(), ns)
+	if err != nil {
+		t.Fatalf("SendBatch() error = %v", err)
+	}
+	if len(results) != 2 || results[0].Err != nil || results[1].Err != nil {
+		t.Fatalf("SendBatch() results = %+v, want two successes", results)
+	}
+	if results[0].MessageID == "" || results[0].MessageID != results[1].MessageID {
+		t.Errorf("BizIds = %q, %q, want one shared BizId", results[0].MessageID, results[1].MessageID)
+	}
+	if got := len(srv.Messages()); got != 2 {
+		t.Errorf("stand-in received %d messages, want 2", got)
+	}
+}
+
+func TestQueryStatus(t *testing.T) {
+	p, srv := newProvider(t, testAccessKeySecret)
+
+	const phone = "13800138000"
+	res, err := p.Send(context.Background(), notification(phone))
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	req := provider.QueryRequest{MessageID: res.MessageID, Recipient: phone, SentAt: time.Now()}
+
+	tests := []struct {
+		name    string
+		status  int
+		errCode string
+		want    provider.DeliveryStatus
+	}{
+		{name: "waiting", status: aliyunsmstest.StatusWaiting, want: provider.DeliveryStatusSent},
+		{name: "delivered", status: aliyunsmstest.StatusDelivered, errCode: "DELIVERED", want: provider.DeliveryStatusDelivered},
+		{name: "failed", status: aliyunsmstest.StatusFailed, errCode: "MK:0001", want: provider.DeliveryStatusFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv.SetStatus(res.MessageID, phone, tt.status, tt.errCode)
+			got, err := p.QueryStatus(context.Background(), req)
+			if err != nil {
+				t.Fatalf("QueryStatus() error = %v", err)
+			}
+			if got.Status != tt.want || got.Code != tt.errCode {
+				t.Errorf("QueryStatus() = %s/%q, want %s/%q", got.Status, got.Code, tt.want, tt.errCode)
+			}
+		})
+	}
+}
+
+func TestParseReportsUsesChinaTime(t *testing.T) {
+	p, _ := newProvider(t, testAccessKeySecret)
+	receiver := p.(provider.ReportReceiver)
+
+	reports, err := receiver.ParseReports([]byte(`[{"phone_number":"13800138000","send_time":"2024-01-02 07:59:58",` +
+		`"report_time":"2024-01-02 08:00:00","success":false,"err_code":"MK:0001","err_msg":"failed","biz_id":"biz-1","out_id":"42"}]`))
+	if err != nil {
+		t.Fatalf("ParseReports() error = %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("ParseReports() returned %d reports, want 1", len(reports))
+	}
+	// 回执时间为北京时间，与服务器所在时区无关
+	want := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got := reports[0]; !got.ReportedAt.Equal(want) || got.Status != provider.DeliveryStatusFailed || got.Code != "MK:0001" {
+		t.Errorf("ParseReports() = %+v, want failed MK:0001 at %s", got, want)
+	}
+}
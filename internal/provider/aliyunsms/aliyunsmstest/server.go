@@ -0,0 +1,305 @@
+// Package aliyunsmstest 提供本地的阿里云 Dysmsapi 替身服务，用于在不调用真实接口的情况下验证阿里云短信供应商
+// 替身校验请求签名，支持 SendSms、SendBatchSms、QuerySendDetails 以及 AddSmsTemplate、QuerySmsTemplate，
+// 可按号码注入错误码，并可设置模板的审核状态
+package aliyunsmstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/provider/aliyunsms"
+)
+
+// 投递状态：1 等待回执，2 发送失败，3 发送成功
+const (
+	StatusWaiting   = 1
+	StatusFailed    = 2
+	StatusDelivered = 3
+)
+
+// 模板审核状态：0 审核中，1 审核通过，2 审核未通过
+const (
+	TemplatePending  = 0
+	TemplateApproved = 1
+	TemplateRejected = 2
+)
+
+// Template 替身收到的一个模板申请
+type Template struct {
+	TemplateCode    string
+	TemplateType    string
+	TemplateName    string
+	TemplateContent string
+	Remark          string
+	Status          int
+	Reason          string
+}
+
+// Message 替身收到的一条短信
+type Message struct {
+	BizID         string
+	PhoneNumber   string
+	SignName      string
+	TemplateCode  string
+	TemplateParam string
+	OutID         string
+	Status        int
+	ErrCode       string
+	SentAt        time.Time
+}
+
+// Server 阿里云 Dysmsapi 替身
+type Server struct {
+	*httptest.Server
+
+	accessKeyID     string
+	accessKeySecret string
+
+	mu        sync.Mutex
+	seq       int
+	messages  []Message
+	templates []Template
+	errors    map[string]string
+}
+
+// NewServer 启动替身服务，只接受使用给定访问密钥签名的请求
+// 供应商配置中的 endpoint 设置为 Server.URL 即可
+func NewServer(accessKeyID, accessKeySecret string) *Server {
+	s := &Server{
+		accessKeyID:     accessKeyID,
+		accessKeySecret: accessKeySecret,
+		errors:          make(map[string]string),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// SetError 令发往该号码的请求返回指定错误码，code 为空时取消
+func (s *Server) SetError(phone, code string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if code == "" {
+		delete(s.errors, phone)
+		return
+	}
+	s.errors[phone] = code
+}
+
+// SetStatus 设置某条短信的投递状态，供 QuerySendDetails 返回
+func (s *Server) SetStatus(bizID, phone string, status int, errCode string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.messages {
+		if s.messages[i].BizID == bizID && s.messages[i].PhoneNumber == phone {
+			s.messages[i].Status = status
+			s.messages[i].ErrCode = errCode
+		}
+	}
+}
+
+// SetTemplateStatus 设置模板的审核状态与原因，供 QuerySmsTemplate 返回
+func (s *Server) SetTemplateStatus(templateCode string, status int, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.templates {
+		if s.templates[i].TemplateCode == templateCode {
+			s.templates[i].Status = status
+			s.templates[i].Reason = reason
+		}
+	}
+}
+
+// Templates 返回已收到的模板申请
+func (s *Server) Templates() []Template {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Template, len(s.templates))
+	copy(out, s.templates)
+	return out
+}
+
+// Messages 返回已收到的短信
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Message, len(s.messages))
+	copy(out, s.messages)
+	return out
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorBody("MissingParameter", err.Error()))
+		return
+	}
+	form := r.Form
+	if form.Get("AccessKeyId") != s.accessKeyID {
+		writeJSON(w, http.StatusNotFound, errorBody("InvalidAccessKeyId.NotFound", "Specified access key is not found."))
+		return
+	}
+	if form.Get("Signature") != aliyunsms.Sign(r.Method, form, s.accessKeySecret) {
+		writeJSON(w, http.StatusBadRequest, errorBody("SignatureDoesNotMatch", "Specified signature is not matched."))
+		return
+	}
+
+	switch form.Get("Action") {
+	case "SendSms":
+		s.sendSms(w, form.Get("PhoneNumbers"), form.Get("SignName"), form.Get("TemplateCode"),
+			form.Get("TemplateParam"), form.Get("OutId"))
+	case "SendBatchSms":
+		s.sendBatchSms(w, form.Get("PhoneNumberJson"), form.Get("SignNameJson"), form.Get("TemplateCode"),
+			form.Get("TemplateParamJson"))
+	case "QuerySendDetails":
+		s.querySendDetails(w, form.Get("PhoneNumber"), form.Get("BizId"))
+	case "AddSmsTemplate":
+		s.addSmsTemplate(w, form.Get("TemplateType"), form.Get("TemplateName"), form.Get("TemplateContent"),
+			form.Get("Remark"))
+	case "QuerySmsTemplate":
+		s.querySmsTemplate(w, form.Get("TemplateCode"))
+	default:
+		writeJSON(w, http.StatusNotFound, errorBody("InvalidAction.NotFound", "Specified api is not found."))
+	}
+}
+
+func (s *Server) sendSms(w http.ResponseWriter, phone, sign, template, param, outID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if code, ok := s.errors[phone]; ok {
+		writeJSON(w, http.StatusOK, errorBody(code, "injected error"))
+		return
+	}
+	bizID := s.nextBizID()
+	s.messages = append(s.messages, Message{
+		BizID:         bizID,
+		PhoneNumber:   phone,
+		SignName:      sign,
+		TemplateCode:  template,
+		TemplateParam: param,
+		OutID:         outID,
+		Status:        StatusWaiting,
+		SentAt:        time.Now(),
+	})
+	writeJSON(w, http.StatusOK, map[string]any{"Code": "OK", "Message": "OK", "BizId": bizID, "RequestId": bizID})
+}
+
+func (s *Server) sendBatchSms(w http.ResponseWriter, phoneJSON, signJSON, template, paramJSON string) {
+	var phones, signs []string
+	var params []json.RawMessage
+	if json.Unmarshal([]byte(phoneJSON), &phones) != nil || json.Unmarshal([]byte(signJSON), &signs) != nil ||
+		json.Unmarshal([]byte(paramJSON), &params) != nil || len(phones) != len(signs) || len(phones) != len(params) {
+		writeJSON(w, http.StatusOK, errorBody("isv.INVALID_JSON_PARAM", "invalid json param"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, phone := range phones {
+		if code, ok := s.errors[phone]; ok {
+			writeJSON(w, http.StatusOK, errorBody(code, "injected error"))
+			return
+		}
+	}
+	bizID := s.nextBizID()
+	for i := range phones {
+		s.messages = append(s.messages, Message{
+			BizID:         bizID,
+			PhoneNumber:   phones[i],
+			SignName:      signs[i],
+			TemplateCode:  template,
+			TemplateParam: string(params[i]),
+			Status:        StatusWaiting,
+			SentAt:        time.Now(),
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"Code": "OK", "Message": "OK", "BizId": bizID, "RequestId": bizID})
+}
+
+func (s *Server) querySendDetails(w http.ResponseWriter, phone, bizID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	details := make([]map[string]any, 0, 1)
+	for _, m := range s.messages {
+		if m.PhoneNumber != phone || (bizID != "" && m.BizID != bizID) {
+			continue
+		}
+		details = append(details, map[string]any{
+			"PhoneNum":     m.PhoneNumber,
+			"SendStatus":   m.Status,
+			"ErrCode":      m.ErrCode,
+			"TemplateCode": m.TemplateCode,
+			"SendDate":     m.SentAt.Format("2006-01-02 15:04:05"),
+			"ReceiveDate":  m.SentAt.Format("2006-01-02 15:04:05"),
+			"OutId":        m.OutID,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"Code":              "OK",
+		"Message":           "OK",
+		"RequestId":         "query",
+		"TotalCount":        len(details),
+		"SmsSendDetailDTOs": map[string]any{"SmsSendDetailDTO": details},
+	})
+}
+
+func (s *Server) addSmsTemplate(w http.ResponseWriter, templateType, name, content, remark string) {
+	if name == "" || content == "" {
+		writeJSON(w, http.StatusOK, errorBody("isv.INVALID_PARAMETERS", "template name and content are required"))
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	code := "SMS_" + strconv.Itoa(100000+s.seq)
+	s.templates = append(s.templates, Template{
+		TemplateCode:    code,
+		TemplateType:    templateType,
+		TemplateName:    name,
+		TemplateContent: content,
+		Remark:          remark,
+		Status:          TemplatePending,
+	})
+	writeJSON(w, http.StatusOK, map[string]any{"Code": "OK", "Message": "OK", "TemplateCode": code, "RequestId": code})
+}
+
+func (s *Server) querySmsTemplate(w http.ResponseWriter, code string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range s.templates {
+		if t.TemplateCode != code {
+			continue
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"Code":            "OK",
+			"Message":         "OK",
+			"RequestId":       "query",
+			"TemplateCode":    t.TemplateCode,
+			"TemplateName":    t.TemplateName,
+			"TemplateContent": t.TemplateContent,
+			"TemplateStatus":  t.Status,
+			"Reason":          t.Reason,
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, errorBody("isv.SMS_TEMPLATE_ILLEGAL", "template not found"))
+}
+
+// nextBizID 生成回执 ID，调用方需持有锁
+func (s *Server) nextBizID() string {
+	s.seq++
+	return fmt.Sprintf("%d^%s", time.Now().UnixNano(), strconv.Itoa(s.seq))
+}
+
+func errorBody(code, message string) map[string]any {
+	return map[string]any{"Code": code, "Message": message, "RequestId": "error"}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
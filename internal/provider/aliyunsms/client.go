@@ -0,0 +1,139 @@
+package aliyunsms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // 阿里云 RPC 签名规定使用 HMAC-SHA1
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// apiVersion Dysmsapi 接口版本
+	apiVersion = "2017-05-25"
+	// codeOK 接口调用成功时返回的 Code
+	codeOK = "OK"
+	// maxResponseSize 响应体读取上限，防止异常响应占用过多内存
+	maxResponseSize = 1 << 20
+)
+
+// response 所有接口共有的响应字段
+//
+//nolint:tagliatelle // 字段名由阿里云接口定义
+type response struct {
+	RequestID string `json:"RequestId"`
+	Code      string `json:"Code"`
+	Message   string `json:"Message"`
+}
+
+// client 阿里云 RPC 风格接口客户端，按签名机制 V1（HMAC-SHA1）签名
+type client struct {
+	endpoint        string
+	regionID        string
+	accessKeyID     string
+	accessKeySecret string
+	httpClient      *http.Client
+}
+
+// call 调用接口并将响应解析到 out；HTTP 层失败返回 *httpError
+func (c *client) call(ctx context.Context, action string, params map[string]string, out any) error {
+	nonce, err := newNonce()
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	for k, v := range params {
+		form.Set(k, v)
+	}
+	form.Set("Action", action)
+	form.Set("Version", apiVersion)
+	form.Set("Format", "JSON")
+	form.Set("RegionId", c.regionID)
+	form.Set("AccessKeyId", c.accessKeyID)
+	form.Set("SignatureMethod", "HMAC-SHA1")
+	form.Set("SignatureVersion", "1.0")
+	form.Set("SignatureNonce", nonce)
+	form.Set("Timestamp", time.Now().UTC().Format("2006-01-02T15:04:05Z"))
+	form.Set("Signature", Sign(http.MethodPost, form, c.accessKeySecret))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("build %s request: %w", action, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("call %s: %w", action, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("read %s response: %w", action, err)
+	}
+	// 业务错误（如限流、参数非法）也可能以 4xx 返回，只要是 JSON 就按业务响应解析
+	if err := json.Unmarshal(body, out); err != nil {
+		return &httpError{statusCode: resp.StatusCode, body: string(body)}
+	}
+	return nil
+}
+
+// httpError 无法解析为业务响应的 HTTP 错误
+type httpError struct {
+	statusCode int
+	body       string
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("unexpected http status %d: %s", e.statusCode, e.body)
+}
+
+// Sign 计算 RPC 接口签名：
+// StringToSign = Method + "&" + percentEncode("/") + "&" + percentEncode(按键排序的规范化查询串)
+// Signature = Base64(HMAC-SHA1(AccessKeySecret + "&", StringToSign))
+// 计算时忽略 params 中已有的 Signature 参数
+func Sign(method string, params url.Values, accessKeySecret string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k == "Signature" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, percentEncode(k)+"="+percentEncode(params.Get(k)))
+	}
+	stringToSign := method + "&" + percentEncode("/") + "&" + percentEncode(strings.Join(pairs, "&"))
+
+	mac := hmac.New(sha1.New, []byte(accessKeySecret+"&"))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// percentEncode 按 RFC 3986 编码，与阿里云签名要求一致
+func percentEncode(s string) string {
+	s = url.QueryEscape(s)
+	return strings.NewReplacer("+", "%20", "*", "%2A", "%7E", "~").Replace(s)
+}
+
+// newNonce 生成签名随机数，防止重放
+func newNonce() (string, error) {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", fmt.Errorf("generate signature nonce: %w", err)
+	}
+	return hex.EncodeToString(buf[:]), nil
+}
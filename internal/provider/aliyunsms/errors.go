@@ -0,0 +1,86 @@
+package aliyunsms
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dingdong-postman/internal/provider"
+)
+
+// errorCategories 阿里云短信错误码与平台错误分类的对应关系
+// 参考 https://help.aliyun.com/document_detail/101346.html ，未列出的错误码按前缀兜底
+var errorCategories = map[string]provider.ErrorCategory{
+	// 限流：同一号码频率超限、日/月发送量超限、接口限流
+	"isv.BUSINESS_LIMIT_CONTROL": provider.CategoryThrottled,
+	"isv.DAY_LIMIT_CONTROL":      provider.CategoryThrottled,
+	"isv.MONTH_LIMIT_CONTROL":    provider.CategoryThrottled,
+	"Throttling":                 provider.CategoryThrottled,
+	"Throttling.User":            provider.CategoryThrottled,
+	"Throttling.Api":             provider.CategoryThrottled,
+
+	// 临时错误：供应商内部错误、服务不可用、随机数重复（换新随机数重试即可）
+	"isp.SYSTEM_ERROR":   provider.CategoryRetryable,
+	"InternalError":      provider.CategoryRetryable,
+	"ServiceUnavailable": provider.CategoryRetryable,
+	"SignatureNonceUsed": provider.CategoryRetryable,
+
+	// 永久错误：号码、签名、模板、参数、账户问题，重试无意义
+	"isv.MOBILE_NUMBER_ILLEGAL":       provider.CategoryPermanent,
+	"isv.MOBILE_COUNT_OVER_LIMIT":     provider.CategoryPermanent,
+	"isv.TEMPLATE_MISSING_PARAMETERS": provider.CategoryPermanent,
+	"isv.TEMPLATE_PARAMS_ILLEGAL":     provider.CategoryPermanent,
+	"isv.SMS_TEMPLATE_ILLEGAL":        provider.CategoryPermanent,
+	"isv.SMS_SIGNATURE_ILLEGAL":       provider.CategoryPermanent,
+	"isv.INVALID_PARAMETERS":          provider.CategoryPermanent,
+	"isv.INVALID_JSON_PARAM":          provider.CategoryPermanent,
+	"isv.BLACK_KEY_CONTROL_LIMIT":     provider.CategoryPermanent,
+	"isv.PARAM_LENGTH_LIMIT":          provider.CategoryPermanent,
+	"isv.AMOUNT_NOT_ENOUGH":           provider.CategoryPermanent,
+	"isv.OUT_OF_SERVICE":              provider.CategoryPermanent,
+	"isv.ACCOUNT_NOT_EXISTS":          provider.CategoryPermanent,
+	"isv.ACCOUNT_ABNORMAL":            provider.CategoryPermanent,
+	"isp.RAM_PERMISSION_DENY":         provider.CategoryPermanent,
+	"InvalidAccessKeyId.NotFound":     provider.CategoryPermanent,
+	"SignatureDoesNotMatch":           provider.CategoryPermanent,
+	"MissingParameter":                provider.CategoryPermanent,
+}
+
+// categoryOf 返回阿里云错误码对应的平台错误分类
+func categoryOf(code string) provider.ErrorCategory {
+	if c, ok := errorCategories[code]; ok {
+		return c
+	}
+	switch {
+	case strings.HasPrefix(code, "Throttling"):
+		return provider.CategoryThrottled
+	case strings.HasPrefix(code, "isp."):
+		return provider.CategoryRetryable
+	default:
+		return provider.CategoryPermanent
+	}
+}
+
+// vendorError 将业务响应中的错误码转换为供应商错误
+func (p *Provider) vendorError(resp *response) error {
+	return provider.NewError(p.name, categoryOf(resp.Code), resp.Code, resp.Message)
+}
+
+// callError 将调用过程中的错误转换为供应商错误：5xx 可重试，429 为限流，网络错误可重试
+func (p *Provider) callError(err error) error {
+	var he *httpError
+	if errors.As(err, &he) {
+		category := provider.CategoryPermanent
+		switch {
+		case he.statusCode == http.StatusTooManyRequests:
+			category = provider.CategoryThrottled
+		case he.statusCode >= http.StatusInternalServerError:
+			category = provider.CategoryRetryable
+		}
+		pe := provider.WrapError(p.name, category, err)
+		pe.Code = "HTTP_" + strconv.Itoa(he.statusCode)
+		return pe
+	}
+	return provider.WrapError(p.name, provider.CategoryOf(err), err)
+}
@@ -0,0 +1,58 @@
+package aliyunsms
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/provider"
+)
+
+// reportAck 告知阿里云推送成功的响应体，其余响应阿里云会重新推送
+const reportAck = `{"code":0,"msg":"成功"}`
+
+// smsReport 阿里云短信发送状态报告（SmsReport）HTTP 推送中的一条回执
+//
+//nolint:tagliatelle // 字段名由阿里云接口定义
+type smsReport struct {
+	PhoneNumber string `json:"phone_number"`
+	SendTime    string `json:"send_time"`
+	ReportTime  string `json:"report_time"`
+	Success     bool   `json:"success"`
+	ErrCode     string `json:"err_code"`
+	ErrMsg      string `json:"err_msg"`
+	BizID       string `json:"biz_id"`
+	OutID       string `json:"out_id"`
+}
+
+// ParseReports 解析阿里云 SmsReport 推送，请求体为回执的 JSON 数组，biz_id 即发送时返回的回执 ID
+func (p *Provider) ParseReports(body []byte) ([]provider.Report, error) {
+	var items []smsReport
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, fmt.Errorf("unmarshal aliyun sms report: %w", err)
+	}
+	reports := make([]provider.Report, 0, len(items))
+	for i := range items {
+		item := items[i]
+		report := provider.Report{
+			MessageID: item.BizID,
+			Recipient: item.PhoneNumber,
+			Status:    provider.DeliveryStatusFailed,
+			Code:      item.ErrCode,
+			Message:   item.ErrMsg,
+		}
+		if item.Success {
+			report.Status = provider.DeliveryStatusDelivered
+		}
+		if t, err := time.ParseInLocation(receiveDateLayout, item.ReportTime, provider.ChinaTime); err == nil {
+			report.ReportedAt = t
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// ReportAck 返回阿里云要求的推送成功响应
+func (p *Provider) ReportAck() (string, []byte) {
+	return "application/json", []byte(reportAck)
+}
@@ -0,0 +1,90 @@
+package aliyunsms
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/provider"
+	"go.uber.org/zap"
+)
+
+// templateTypes 配置中的模板类型与阿里云 TemplateType 取值的对应关系
+var templateTypes = map[string]int{
+	"VERIFICATION":  0,
+	"NOTIFICATION":  1,
+	"PROMOTION":     2,
+	"INTERNATIONAL": 3,
+}
+
+// 阿里云模板审核状态：0 审核中，1 审核通过，2 审核未通过
+const (
+	templateStatusPending  = 0
+	templateStatusApproved = 1
+	templateStatusRejected = 2
+)
+
+// addTemplateResponse AddSmsTemplate 响应
+//
+//nolint:tagliatelle // 字段名由阿里云接口定义
+type addTemplateResponse struct {
+	response
+	TemplateCode string `json:"TemplateCode"`
+}
+
+// SubmitTemplate 调用 AddSmsTemplate 申请模板，占位符转换为阿里云的 ${name} 语法，返回阿里云模板 Code
+func (p *Provider) SubmitTemplate(ctx context.Context, t provider.TemplateSubmission) (string, error) {
+	content := domain.ReplacePlaceholders(t.Content, func(name string) string {
+		return "${" + name + "}"
+	})
+	var resp addTemplateResponse
+	err := p.client.call(ctx, "AddSmsTemplate", map[string]string{
+		"TemplateType":    strconv.Itoa(p.templateType),
+		"TemplateName":    t.Name,
+		"TemplateContent": content,
+		"Remark":          t.Remark,
+	}, &resp)
+	if err != nil {
+		return "", p.callError(err)
+	}
+	if resp.Code != codeOK {
+		return "", p.vendorError(&resp.response)
+	}
+
+	p.logger.Info("阿里云短信模板已提交审核",
+		zap.String("provider", p.name),
+		zap.String("template_id", t.TemplateID),
+		zap.Int("version", t.Version),
+		zap.String("template_code", resp.TemplateCode),
+	)
+	return resp.TemplateCode, nil
+}
+
+// queryTemplateResponse QuerySmsTemplate 响应
+//
+//nolint:tagliatelle // 字段名由阿里云接口定义
+type queryTemplateResponse struct {
+	response
+	TemplateStatus int    `json:"TemplateStatus"`
+	Reason         string `json:"Reason"`
+}
+
+// QueryTemplate 调用 QuerySmsTemplate 查询模板审核状态
+func (p *Provider) QueryTemplate(ctx context.Context, code string) (provider.TemplateAuditResult, error) {
+	var resp queryTemplateResponse
+	if err := p.client.call(ctx, "QuerySmsTemplate", map[string]string{"TemplateCode": code}, &resp); err != nil {
+		return provider.TemplateAuditResult{}, p.callError(err)
+	}
+	if resp.Code != codeOK {
+		return provider.TemplateAuditResult{}, p.vendorError(&resp.response)
+	}
+
+	switch resp.TemplateStatus {
+	case templateStatusApproved:
+		return provider.TemplateAuditResult{Status: provider.TemplateAuditApproved}, nil
+	case templateStatusRejected:
+		return provider.TemplateAuditResult{Status: provider.TemplateAuditRejected, Reason: resp.Reason}, nil
+	default:
+		return provider.TemplateAuditResult{Status: provider.TemplateAuditPending}, nil
+	}
+}
@@ -0,0 +1,77 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"go.uber.org/zap"
+)
+
+// Type 供应商类型名
+const Type = "console"
+
+func init() {
+	provider.RegisterFactory(Type, New)
+}
+
+// Provider 仅把通知打印到日志的供应商，用于本地开发与联调，可用于任意渠道
+type Provider struct {
+	name    string
+	channel domain.Channel
+	logger  appLogger.Logger
+	seq     atomic.Uint64
+}
+
+// New 根据配置创建 console 供应商，providers[].channel 必填
+func New(cfg config.ProviderConfig, logger appLogger.Logger) (provider.Provider, error) {
+	ch := domain.Channel(cfg.Channel)
+	if !ch.IsValid() {
+		return nil, fmt.Errorf("%w: %q", domain.ErrUnsupportedChannel, cfg.Channel)
+	}
+	return &Provider{
+		name:    cfg.Name,
+		channel: ch,
+		logger:  logger,
+	}, nil
+}
+
+// Name 供应商实例名称
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// SupportedChannel 支持的通知渠道
+func (p *Provider) SupportedChannel() domain.Channel {
+	return p.channel
+}
+
+// Send 记录一条发送日志，返回进程内自增的消息 ID
+func (p *Provider) Send(_ context.Context, n domain.Notification) (provider.SendResult, error) {
+	msgID := p.name + "-" + strconv.FormatUint(p.seq.Add(1), 10)
+	p.logger.Info("发送通知",
+		zap.String("provider", p.name),
+		zap.String("message_id", msgID),
+		zap.Uint64("notification_id", n.ID),
+		zap.String("biz_key", n.BizKey),
+		zap.String("channel", string(n.Channel)),
+		zap.String("recipient", n.Recipient),
+		zap.String("template_id", n.Template.ID),
+		zap.Any("template_params", n.Template.Params),
+	)
+	return provider.SendResult{MessageID: msgID}, nil
+}
+
+// QueryStatus 日志即视为送达
+func (p *Provider) QueryStatus(_ context.Context, _ provider.QueryRequest) (provider.QueryResult, error) {
+	return provider.QueryResult{
+		Status:     provider.DeliveryStatusDelivered,
+		ReportedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// ErrorCategory 供应商错误分类，决定失败后是否重试、是否切换供应商
+type ErrorCategory string
+
+const (
+	// CategoryRetryable 临时性错误（网络抖动、供应商内部错误），可稍后重试
+	CategoryRetryable ErrorCategory = "RETRYABLE"
+	// CategoryPermanent 永久性错误（号码非法、模板不存在、账户欠费），重试无意义
+	CategoryPermanent ErrorCategory = "PERMANENT"
+	// CategoryThrottled 触发供应商限流，应降速或稍后重试
+	CategoryThrottled ErrorCategory = "THROTTLED"
+)
+
+// Error 供应商返回的错误，携带供应商错误码与平台错误分类
+type Error struct {
+	// Provider 供应商实例名称
+	Provider string
+	// Code 供应商错误码
+	Code string
+	// Message 供应商错误描述
+	Message string
+	// Category 平台错误分类
+	Category ErrorCategory
+	// Err 底层错误，可为空
+	Err error
+}
+
+// NewError 创建供应商错误
+func NewError(providerName string, category ErrorCategory, code, message string) *Error {
+	return &Error{
+		Provider: providerName,
+		Code:     code,
+		Message:  message,
+		Category: category,
+	}
+}
+
+// WrapError 用供应商错误包装底层错误
+func WrapError(providerName string, category ErrorCategory, err error) *Error {
+	return &Error{
+		Provider: providerName,
+		Message:  err.Error(),
+		Category: category,
+		Err:      err,
+	}
+}
+
+// Error 实现 error 接口
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("provider %s: %s: %s", e.Provider, e.Category, e.Message)
+	}
+	return fmt.Sprintf("provider %s: %s: %s: %s", e.Provider, e.Category, e.Code, e.Message)
+}
+
+// Unwrap 返回底层错误
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// CategoryOf 返回错误的分类
+// 未携带分类的错误中，网络错误与超时视为可重试，其余视为永久性错误；err 为 nil 时返回空
+func CategoryOf(err error) ErrorCategory {
+	if err == nil {
+		return ""
+	}
+	var pe *Error
+	if errors.As(err, &pe) {
+		return pe.Category
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) {
+		return CategoryRetryable
+	}
+	return CategoryPermanent
+}
+
+// IsRetryable 错误是否值得稍后重试（可重试或被限流）
+func IsRetryable(err error) bool {
+	c := CategoryOf(err)
+	return c == CategoryRetryable || c == CategoryThrottled
+}
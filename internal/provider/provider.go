@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+)
+
+// Provider 渠道供应商插件，负责把通知通过某个具体渠道（如某家短信供应商、某个 SMTP 服务）发送出去
+type Provider interface {
+	// Name 供应商实例名称，对应配置中的 providers[].name，全局唯一
+	Name() string
+	// SupportedChannel 支持的通知渠道
+	SupportedChannel() domain.Channel
+	// Send 发送通知，成功时返回供应商侧的消息 ID
+	Send(ctx context.Context, n domain.Notification) (SendResult, error)
+	// QueryStatus 查询供应商侧的投递状态
+	QueryStatus(ctx context.Context, req QueryRequest) (QueryResult, error)
+}
+
+// BatchSender 支持一次请求发送多条通知的供应商可额外实现该接口
+type BatchSender interface {
+	// SendBatch 批量发送，返回与 ns 一一对应的结果；error 非空表示整批失败
+	SendBatch(ctx context.Context, ns []domain.Notification) ([]BatchResult, error)
+}
+
+// ReportReceiver 支持推送投递回执（短信状态报告、邮件退信与投诉）的供应商可额外实现该接口
+type ReportReceiver interface {
+	// ParseReports 解析供应商推送的请求体，一次推送可包含多条回执；只有已送达与投递失败的回执会更新通知
+	ParseReports(body []byte) ([]Report, error)
+	// ReportAck 返回告知供应商推送成功的响应 Content-Type 与响应体
+	ReportAck() (contentType string, body []byte)
+}
+
+// Report 供应商推送的一条投递回执
+type Report struct {
+	// MessageID 发送时供应商返回的消息 ID
+	MessageID string
+	// Recipient 接收者，手机号可能不带国家码
+	Recipient string
+	// Status 投递状态
+	Status DeliveryStatus
+	// Code 供应商返回的状态码
+	Code string
+	// Message 供应商返回的状态描述
+	Message string
+	// ReportedAt 供应商给出结果的时间，未知时为零值
+	ReportedAt time.Time
+}
+
+// BatchResult 批量发送中单条通知的结果
+type BatchResult struct {
+	// MessageID 供应商侧的消息 ID，失败时为空
+	MessageID string
+	// Err 单条发送失败的原因
+	Err error
+}
+
+// SendResult 供应商受理结果
+type SendResult struct {
+	// MessageID 供应商侧的消息 ID，用于后续查询投递状态
+	MessageID string
+}
+
+// QueryRequest 投递状态查询请求
+type QueryRequest struct {
+	// MessageID 发送时供应商返回的消息 ID
+	MessageID string
+	// Recipient 接收者，部分供应商查询时需要
+	Recipient string
+	// SentAt 发送时间，部分供应商按日期查询
+	SentAt time.Time
+}
+
+// DeliveryStatus 供应商侧的投递状态
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusUnknown 供应商尚未给出结果或不支持查询
+	DeliveryStatusUnknown DeliveryStatus = "UNKNOWN"
+	// DeliveryStatusSent 已提交给运营商或邮件服务器，尚未送达
+	DeliveryStatusSent DeliveryStatus = "SENT"
+	// DeliveryStatusDelivered 已送达
+	DeliveryStatusDelivered DeliveryStatus = "DELIVERED"
+	// DeliveryStatusFailed 投递失败
+	DeliveryStatusFailed DeliveryStatus = "FAILED"
+)
+
+// QueryResult 投递状态查询结果
+type QueryResult struct {
+	// Status 投递状态
+	Status DeliveryStatus
+	// Code 供应商返回的状态码
+	Code string
+	// Message 供应商返回的状态描述
+	Message string
+	// ReportedAt 供应商给出结果的时间
+	ReportedAt time.Time
+}
+
+// ChinaTime 国内短信供应商接口与回执中不带时区的时间均为北京时间，解析时使用该时区而不是服务器所在时区
+var ChinaTime = loadChinaTime()
+
+// loadChinaTime 加载 Asia/Shanghai 时区；运行环境缺少时区数据时使用等价的 UTC+8 固定时区（中国不实行夏令时）
+func loadChinaTime() *time.Location {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		return time.FixedZone("CST", 8*60*60)
+	}
+	return loc
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Factory 根据配置创建供应商实例
+type Factory func(cfg config.ProviderConfig, logger appLogger.Logger) (Provider, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = make(map[string]Factory)
+)
+
+// RegisterFactory 注册一种供应商类型，通常在供应商插件包的 init 中调用
+// 类型名对应配置中的 providers[].type，重复注册会 panic
+func RegisterFactory(typ string, f Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	if f == nil {
+		panic("provider: RegisterFactory factory is nil")
+	}
+	if _, dup := factories[typ]; dup {
+		panic("provider: RegisterFactory called twice for type " + typ)
+	}
+	factories[typ] = f
+}
+
+// Types 返回已注册的供应商类型，按字母序排列
+func Types() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+	types := make([]string, 0, len(factories))
+	for typ := range factories {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Registry 供应商注册表，按名称与渠道索引已启用的供应商实例
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]Provider
+	byChannel map[domain.Channel][]Provider
+}
+
+// NewRegistry 创建空的供应商注册表
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[string]Provider),
+		byChannel: make(map[domain.Channel][]Provider),
+	}
+}
+
+// NewRegistryFromConfig 根据配置创建所有已启用的供应商，按 priority 升序注册，同优先级保持配置顺序
+func NewRegistryFromConfig(cfgs []config.ProviderConfig, logger appLogger.Logger) (*Registry, error) {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	// 按优先级注册，使 ByChannel 返回的供应商即为兜底顺序
+	ordered := make([]config.ProviderConfig, 0, len(cfgs))
+	for i := range cfgs {
+		if cfgs[i].Enabled {
+			ordered = append(ordered, cfgs[i])
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Priority < ordered[j].Priority
+	})
+
+	r := NewRegistry()
+	for i := range ordered {
+		cfg := ordered[i]
+
+		factoriesMu.RLock()
+		factory, ok := factories[cfg.Type]
+		factoriesMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("provider %s: unknown type %q, registered types: %v", cfg.Name, cfg.Type, Types())
+		}
+
+		p, err := factory(cfg, logger)
+		if err != nil {
+			return nil, fmt.Errorf("provider %s: %w", cfg.Name, err)
+		}
+		if err := r.Register(p); err != nil {
+			return nil, err
+		}
+		logger.Info("渠道供应商已启用",
+			zap.String("name", p.Name()),
+			zap.String("type", cfg.Type),
+			zap.String("channel", string(p.SupportedChannel())),
+			zap.Int("priority", cfg.Priority),
+		)
+	}
+	return r, nil
+}
+
+// Register 注册一个供应商实例，名称重复时返回错误
+func (r *Registry) Register(p Provider) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, dup := r.providers[p.Name()]; dup {
+		return fmt.Errorf("provider %s already registered", p.Name())
+	}
+	r.providers[p.Name()] = p
+	r.byChannel[p.SupportedChannel()] = append(r.byChannel[p.SupportedChannel()], p)
+	return nil
+}
+
+// Get 按名称获取供应商
+func (r *Registry) Get(name string) (Provider, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+// ByChannel 获取支持指定渠道的供应商，按注册顺序排列；由配置创建的注册表即按优先级排列
+func (r *Registry) ByChannel(ch domain.Channel) []Provider {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ps := r.byChannel[ch]
+	out := make([]Provider, len(ps))
+	copy(out, ps)
+	return out
+}
+
+// Close 关闭所有实现了 io.Closer 的供应商（如持有连接池的 SMTP 供应商），返回遇到的第一个错误
+func (r *Registry) Close() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var firstErr error
+	for _, p := range r.providers {
+		closer, ok := p.(io.Closer)
+		if !ok {
+			continue
+		}
+		if err := closer.Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("close provider %s: %w", p.Name(), err)
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,70 @@
+package smtp
+
+import (
+	"errors"
+	"fmt"
+	"net/smtp"
+)
+
+// loginAuth 实现 AUTH LOGIN 认证，部分邮件服务（如 Exchange、部分国内邮箱）只支持该方式
+type loginAuth struct {
+	username string
+	password string
+	host     string
+}
+
+// LoginAuth 创建 AUTH LOGIN 认证；与 smtp.PlainAuth 一样，只在 TLS 连接或本机地址上发送凭据
+func LoginAuth(username, password, host string) smtp.Auth {
+	return &loginAuth{
+		username: username,
+		password: password,
+		host:     host,
+	}
+}
+
+// Start 开始认证
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if !server.TLS && !isLocalhost(server.Name) {
+		return "", nil, errors.New("unencrypted connection")
+	}
+	if server.Name != a.host {
+		return "", nil, errors.New("wrong host name")
+	}
+	return "LOGIN", nil, nil
+}
+
+// Next 按服务端的提示依次返回用户名与密码
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	switch string(fromServer) {
+	case "Username:", "username:", "User Name\x00":
+		return []byte(a.username), nil
+	case "Password:", "password:", "Password\x00":
+		return []byte(a.password), nil
+	default:
+		return nil, fmt.Errorf("unexpected server challenge: %q", fromServer)
+	}
+}
+
+func isLocalhost(name string) bool {
+	return name == "localhost" || name == "127.0.0.1" || name == "::1"
+}
+
+// newAuth 按配置的认证方式创建 smtp.Auth，none 或用户名为空时不认证
+func newAuth(mechanism, username, password, host string) (smtp.Auth, error) {
+	if username == "" {
+		return nil, nil
+	}
+	switch mechanism {
+	case AuthPlain, "":
+		return smtp.PlainAuth("", username, password, host), nil
+	case AuthLogin:
+		return LoginAuth(username, password, host), nil
+	case AuthNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported auth mechanism %q", mechanism)
+	}
+}
@@ -0,0 +1,244 @@
+package smtp
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+)
+
+// base64LineLen RFC 2045 规定 base64 编码每行最多 76 个字符
+const base64LineLen = 76
+
+// reservedHeaders 由平台生成、不允许通过自定义邮件头覆盖的头部
+var reservedHeaders = map[string]struct{}{
+	"From":                      {},
+	"To":                        {},
+	"Subject":                   {},
+	"Date":                      {},
+	"Message-Id":                {},
+	"Mime-Version":              {},
+	"Content-Type":              {},
+	"Content-Transfer-Encoding": {},
+}
+
+// message 一封待发送的邮件
+type message struct {
+	id      string
+	from    *mail.Address
+	to      *mail.Address
+	content *domain.EmailContent
+	date    time.Time
+}
+
+// newMessage 构造邮件，生成唯一的 Message-ID
+func newMessage(from, to *mail.Address, content *domain.EmailContent) (*message, error) {
+	id, err := newMessageID(from.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &message{
+		id:      id,
+		from:    from,
+		to:      to,
+		content: content,
+		date:    time.Now(),
+	}, nil
+}
+
+// newMessageID 生成 <随机串@发件域名> 形式的 Message-ID
+func newMessageID(from string) (string, error) {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", fmt.Errorf("generate message id: %w", err)
+	}
+	domainPart := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 && at < len(from)-1 {
+		domainPart = from[at+1:]
+	}
+	return "<" + hex.EncodeToString(buf[:]) + "@" + domainPart + ">", nil
+}
+
+// WriteTo 按 RFC 5322 / RFC 2045 输出完整的邮件内容
+// 结构：有附件时为 multipart/mixed，正文同时包含 HTML 与纯文本时为 multipart/alternative
+func (m *message) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+
+	header := make(textproto.MIMEHeader)
+	header.Set("From", m.from.String())
+	header.Set("To", m.to.String())
+	header.Set("Subject", mime.BEncoding.Encode("UTF-8", sanitizeHeaderValue(m.content.Subject)))
+	header.Set("Date", m.date.Format(time.RFC1123Z))
+	header.Set("Message-ID", m.id)
+	header.Set("MIME-Version", "1.0")
+	for k, v := range m.content.Headers {
+		key := textproto.CanonicalMIMEHeaderKey(k)
+		if _, reserved := reservedHeaders[key]; reserved {
+			continue
+		}
+		header.Set(key, mime.QEncoding.Encode("UTF-8", sanitizeHeaderValue(v)))
+	}
+
+	if len(m.content.Attachments) > 0 {
+		if err := m.writeMixed(&buf, header); err != nil {
+			return 0, err
+		}
+	} else {
+		writeBody := m.prepareBody(header)
+		writeHeader(&buf, header)
+		if err := writeBody(&buf); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := buf.WriteTo(w)
+	if err != nil {
+		return n, fmt.Errorf("write message: %w", err)
+	}
+	return n, nil
+}
+
+// writeMixed 输出 multipart/mixed：第一部分为正文，其余为附件
+func (m *message) writeMixed(buf *bytes.Buffer, header textproto.MIMEHeader) error {
+	mw := multipart.NewWriter(buf)
+	header.Set("Content-Type", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": mw.Boundary()}))
+	writeHeader(buf, header)
+
+	bodyHeader := make(textproto.MIMEHeader)
+	writeBody := m.prepareBody(bodyHeader)
+	part, err := mw.CreatePart(bodyHeader)
+	if err != nil {
+		return fmt.Errorf("create body part: %w", err)
+	}
+	if err := writeBody(part); err != nil {
+		return err
+	}
+
+	for i := range m.content.Attachments {
+		if err := writeAttachment(mw, &m.content.Attachments[i]); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+// prepareBody 将正文的 Content-Type 等头部写入 header，返回输出正文内容的函数
+// 同时存在 HTML 与纯文本时为 multipart/alternative，否则为单一 part
+func (m *message) prepareBody(header textproto.MIMEHeader) func(w io.Writer) error {
+	html, text := m.content.HTMLBody, m.content.TextBody
+	if html == "" || text == "" {
+		contentType, body := "text/plain", text
+		if html != "" {
+			contentType, body = "text/html", html
+		}
+		header.Set("Content-Type", mime.FormatMediaType(contentType, map[string]string{"charset": "UTF-8"}))
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		return func(w io.Writer) error {
+			return writeQuotedPrintable(w, body)
+		}
+	}
+
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+	header.Set("Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": boundary}))
+	return func(w io.Writer) error {
+		mw := multipart.NewWriter(w)
+		if err := mw.SetBoundary(boundary); err != nil {
+			return fmt.Errorf("set boundary: %w", err)
+		}
+		// 按 RFC 2046，越靠后的 part 越被优先展示，因此纯文本在前、HTML 在后
+		for _, alt := range []struct{ contentType, body string }{
+			{"text/plain", text},
+			{"text/html", html},
+		} {
+			part, err := mw.CreatePart(textproto.MIMEHeader{
+				"Content-Type":              {mime.FormatMediaType(alt.contentType, map[string]string{"charset": "UTF-8"})},
+				"Content-Transfer-Encoding": {"quoted-printable"},
+			})
+			if err != nil {
+				return fmt.Errorf("create %s part: %w", alt.contentType, err)
+			}
+			if err := writeQuotedPrintable(part, alt.body); err != nil {
+				return err
+			}
+		}
+		return mw.Close()
+	}
+}
+
+// writeAttachment 以 base64 输出一个附件
+func writeAttachment(mw *multipart.Writer, a *domain.Attachment) error {
+	contentType := a.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(a.Filename))
+	}
+	// 类型可能带有 charset 等参数，解析后与文件名一起重新格式化
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, params = "application/octet-stream", map[string]string{}
+	}
+	// 非 ASCII 文件名由 FormatMediaType 按 RFC 2231 编码
+	filename := sanitizeHeaderValue(a.Filename)
+	params["name"] = filename
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {mime.FormatMediaType(mediaType, params)},
+		"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": filename})},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	if err != nil {
+		return fmt.Errorf("create attachment part %s: %w", a.Filename, err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(a.Content)
+	for len(encoded) > base64LineLen {
+		if _, err := io.WriteString(part, encoded[:base64LineLen]+"\r\n"); err != nil {
+			return fmt.Errorf("write attachment %s: %w", a.Filename, err)
+		}
+		encoded = encoded[base64LineLen:]
+	}
+	if _, err := io.WriteString(part, encoded+"\r\n"); err != nil {
+		return fmt.Errorf("write attachment %s: %w", a.Filename, err)
+	}
+	return nil
+}
+
+// writeQuotedPrintable 以 quoted-printable 输出文本
+func writeQuotedPrintable(w io.Writer, body string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := io.WriteString(qp, body); err != nil {
+		return fmt.Errorf("write quoted-printable body: %w", err)
+	}
+	return qp.Close()
+}
+
+// writeHeader 按键名排序输出邮件头，并以空行结束头部
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range header[k] {
+			buf.WriteString(k + ": " + v + "\r\n")
+		}
+	}
+	buf.WriteString("\r\n")
+}
+
+// sanitizeHeaderValue 去除换行，防止邮件头注入
+func sanitizeHeaderValue(v string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(v)
+}
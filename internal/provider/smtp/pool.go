@@ -0,0 +1,226 @@
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/pkg/config"
+)
+
+// errPoolClosed 连接池已关闭
+var errPoolClosed = errors.New("smtp: connection pool closed")
+
+// conn 连接池中的一条 SMTP 连接
+type conn struct {
+	client   *smtp.Client
+	netConn  net.Conn
+	lastUsed time.Time
+}
+
+// pool SMTP 连接池
+// 最多同时持有 PoolSize 条连接；归还的连接保留在空闲列表中，
+// 超过 IdleTimeout 未使用或复用前 NOOP 探测失败的连接会被关闭重建
+type pool struct {
+	cfg      *config.SMTPConfig
+	password string
+	timeout  time.Duration
+	idleTTL  time.Duration
+
+	sem    chan struct{}
+	mu     sync.Mutex
+	idle   []*conn
+	closed bool
+}
+
+// newPool 创建连接池，连接在首次使用时建立
+func newPool(cfg *config.SMTPConfig) *pool {
+	return &pool{
+		cfg:      cfg,
+		password: cfg.GetPassword(),
+		timeout:  time.Duration(cfg.Timeout) * time.Second,
+		idleTTL:  time.Duration(cfg.IdleTimeout) * time.Second,
+		sem:      make(chan struct{}, cfg.PoolSize),
+	}
+}
+
+// get 取得一条可用连接，连接数已达上限时等待其他调用方归还
+func (p *pool) get(ctx context.Context) (*conn, error) {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	for {
+		c, err := p.popIdle()
+		if err != nil {
+			<-p.sem
+			return nil, err
+		}
+		if c == nil {
+			break
+		}
+		if time.Since(c.lastUsed) > p.idleTTL {
+			c.close()
+			continue
+		}
+		p.setDeadline(c)
+		if err := c.client.Noop(); err != nil {
+			c.close()
+			continue
+		}
+		return c, nil
+	}
+
+	c, err := p.dial(ctx)
+	if err != nil {
+		<-p.sem
+		return nil, err
+	}
+	return c, nil
+}
+
+// put 归还连接；broken 为 true 或重置会话失败时直接关闭连接
+func (p *pool) put(c *conn, broken bool) {
+	defer func() { <-p.sem }()
+
+	if !broken {
+		p.setDeadline(c)
+		broken = c.client.Reset() != nil
+	}
+	if broken {
+		c.close()
+		return
+	}
+
+	c.lastUsed = time.Now()
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		c.close()
+		return
+	}
+	p.idle = append(p.idle, c)
+	p.mu.Unlock()
+}
+
+// close 关闭连接池及所有空闲连接，正在使用的连接在归还时关闭
+func (p *pool) close() {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.closed = true
+	p.mu.Unlock()
+
+	for _, c := range idle {
+		_ = c.client.Quit()
+		c.close()
+	}
+}
+
+// popIdle 取出最近使用的空闲连接，没有空闲连接时返回 nil
+func (p *pool) popIdle() (*conn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil, errPoolClosed
+	}
+	n := len(p.idle)
+	if n == 0 {
+		return nil, nil
+	}
+	c := p.idle[n-1]
+	p.idle = p.idle[:n-1]
+	return c, nil
+}
+
+// dial 建立连接并完成 EHLO、STARTTLS 与认证
+func (p *pool) dial(ctx context.Context) (*conn, error) {
+	addr := net.JoinHostPort(p.cfg.Host, strconv.Itoa(p.cfg.Port))
+	tlsCfg := &tls.Config{
+		ServerName:         p.cfg.Host,
+		InsecureSkipVerify: p.cfg.InsecureSkipVerify, //nolint:gosec // 由配置显式开启，仅用于测试环境
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	netDialer := &net.Dialer{Timeout: p.timeout}
+	var (
+		nc  net.Conn
+		err error
+	)
+	if p.cfg.Security == SecurityTLS {
+		nc, err = (&tls.Dialer{NetDialer: netDialer, Config: tlsCfg}).DialContext(ctx, "tcp", addr)
+	} else {
+		nc, err = netDialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dial smtp server %s: %w", addr, err)
+	}
+
+	c := &conn{netConn: nc}
+	p.setDeadline(c)
+	client, err := smtp.NewClient(nc, p.cfg.Host)
+	if err != nil {
+		_ = nc.Close()
+		return nil, fmt.Errorf("smtp handshake: %w", err)
+	}
+	c.client = client
+
+	if err := p.handshake(c, tlsCfg); err != nil {
+		c.close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// handshake 发送 EHLO，按配置升级 STARTTLS 并认证
+func (p *pool) handshake(c *conn, tlsCfg *tls.Config) error {
+	if err := c.client.Hello(p.cfg.LocalName); err != nil {
+		return fmt.Errorf("smtp ehlo: %w", err)
+	}
+
+	if p.cfg.Security == SecurityStartTLS {
+		if ok, _ := c.client.Extension("STARTTLS"); !ok {
+			return errors.New("smtp server does not support STARTTLS")
+		}
+		if err := c.client.StartTLS(tlsCfg); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+
+	auth, err := newAuth(p.cfg.AuthMechanism, p.cfg.Username, p.password, p.cfg.Host)
+	if err != nil {
+		return err
+	}
+	if auth == nil {
+		return nil
+	}
+	if ok, _ := c.client.Extension("AUTH"); !ok {
+		return errors.New("smtp server does not support AUTH")
+	}
+	if err := c.client.Auth(auth); err != nil {
+		return fmt.Errorf("smtp auth: %w", err)
+	}
+	return nil
+}
+
+// setDeadline 为下一轮交互设置读写超时
+func (p *pool) setDeadline(c *conn) {
+	_ = c.netConn.SetDeadline(time.Now().Add(p.timeout))
+}
+
+// close 关闭底层连接
+func (c *conn) close() {
+	if c.client != nil {
+		_ = c.client.Close()
+		return
+	}
+	_ = c.netConn.Close()
+}
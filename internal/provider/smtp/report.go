@@ -0,0 +1,97 @@
+package smtp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/provider"
+	"go.uber.org/zap"
+)
+
+// 邮件事件类型，对应推送请求体中的 event
+const (
+	EventDelivered = "delivered"
+	EventBounce    = "bounce"
+	EventComplaint = "complaint"
+)
+
+// 退信类型，对应推送请求体中的 bounce_type；未指定时按永久退信处理
+const (
+	BouncePermanent = "permanent"
+	BounceTransient = "transient"
+)
+
+// mailEvent 邮件投递事件，由邮件服务器或退信处理程序推送
+// 请求体为事件的 JSON 数组，例如：
+//
+//	[{"message_id":"<abc@example.com>","recipient":"user@example.com","event":"bounce",
+//	  "bounce_type":"permanent","code":"5.1.1","reason":"user unknown","timestamp":"2024-01-02T15:04:05Z"}]
+//
+// message_id 为发送时生成的 Message-ID（可省略尖括号）；
+// delivered 与 complaint（收件人投诉，说明邮件已进入收件箱）视为已送达，永久退信视为投递失败，临时退信不更新通知
+type mailEvent struct {
+	MessageID  string    `json:"message_id"`
+	Recipient  string    `json:"recipient"`
+	Event      string    `json:"event"`
+	BounceType string    `json:"bounce_type"`
+	Code       string    `json:"code"`
+	Reason     string    `json:"reason"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// ParseReports 解析推送的邮件投递事件；无法识别的事件类型（如 opened、clicked）跳过并记录日志，不影响同批其他事件
+func (p *Provider) ParseReports(body []byte) ([]provider.Report, error) {
+	var events []mailEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, fmt.Errorf("unmarshal mail events: %w", err)
+	}
+	reports := make([]provider.Report, 0, len(events))
+	for i := range events {
+		e := events[i]
+		report := provider.Report{
+			MessageID:  normalizeMessageID(e.MessageID),
+			Recipient:  e.Recipient,
+			Status:     provider.DeliveryStatusUnknown,
+			Code:       e.Code,
+			Message:    e.Reason,
+			ReportedAt: e.Timestamp,
+		}
+		switch strings.ToLower(e.Event) {
+		case EventDelivered:
+			report.Status = provider.DeliveryStatusDelivered
+		case EventComplaint:
+			report.Status = provider.DeliveryStatusDelivered
+			report.Code = EventComplaint
+		case EventBounce:
+			report.Status = provider.DeliveryStatusFailed
+			if strings.EqualFold(e.BounceType, BounceTransient) {
+				report.Status = provider.DeliveryStatusSent
+			}
+		default:
+			p.logger.Warn("跳过无法识别的邮件事件",
+				zap.String("provider", p.name),
+				zap.String("event", e.Event),
+				zap.String("message_id", report.MessageID),
+			)
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// ReportAck 推送成功时返回空的 JSON 对象
+func (p *Provider) ReportAck() (string, []byte) {
+	return "application/json", []byte("{}")
+}
+
+// normalizeMessageID 为省略尖括号的 Message-ID 补全尖括号，与发送时生成的格式一致
+func normalizeMessageID(id string) string {
+	id = strings.TrimSpace(id)
+	if id == "" || strings.HasPrefix(id, "<") {
+		return id
+	}
+	return "<" + id + ">"
+}
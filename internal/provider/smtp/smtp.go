@@ -0,0 +1,230 @@
+// Package smtp 基于 SMTP 协议的邮件供应商
+// 支持 STARTTLS 与隐式 TLS、PLAIN/LOGIN 认证、HTML 与纯文本多版本正文、附件、自定义邮件头，以及连接复用；
+// SMTP 不提供投递状态查询，送达、退信与投诉事件由邮件服务器或退信处理程序推送
+package smtp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"go.uber.org/zap"
+)
+
+// Type 供应商类型名
+const Type = "smtp"
+
+// 加密方式，对应 providers[].smtp.security
+const (
+	SecurityStartTLS = "starttls"
+	SecurityTLS      = "tls"
+	SecurityNone     = "none"
+)
+
+// 认证方式，对应 providers[].smtp.auth_mechanism
+const (
+	AuthPlain = "plain"
+	AuthLogin = "login"
+	AuthNone  = "none"
+)
+
+// smtpPermanentCode 5xx 应答表示永久性失败
+const smtpPermanentCode = 500
+
+// ErrMissingEmailContent 通知未携带邮件内容
+var ErrMissingEmailContent = errors.New("smtp: notification has no email content")
+
+func init() {
+	provider.RegisterFactory(Type, New)
+}
+
+// Provider SMTP 邮件供应商
+type Provider struct {
+	name   string
+	from   *mail.Address
+	pool   *pool
+	logger appLogger.Logger
+}
+
+// New 根据配置创建 SMTP 供应商，providers[].smtp 必填，未配置的字段使用默认值
+func New(cfg config.ProviderConfig, logger appLogger.Logger) (provider.Provider, error) {
+	if cfg.SMTP == nil {
+		return nil, fmt.Errorf("provider %s: smtp config is required", cfg.Name)
+	}
+	smtpCfg := withDefaults(*cfg.SMTP)
+	if smtpCfg.Host == "" {
+		return nil, fmt.Errorf("provider %s: smtp host is required", cfg.Name)
+	}
+	switch smtpCfg.Security {
+	case SecurityStartTLS, SecurityTLS, SecurityNone:
+	default:
+		return nil, fmt.Errorf("provider %s: unsupported smtp security %q", cfg.Name, smtpCfg.Security)
+	}
+	switch smtpCfg.AuthMechanism {
+	case AuthPlain, AuthLogin, AuthNone:
+	default:
+		return nil, fmt.Errorf("provider %s: unsupported smtp auth mechanism %q", cfg.Name, smtpCfg.AuthMechanism)
+	}
+	from, err := mail.ParseAddress(smtpCfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("provider %s: invalid smtp from %q: %w", cfg.Name, smtpCfg.From, err)
+	}
+
+	return &Provider{
+		name:   cfg.Name,
+		from:   from,
+		pool:   newPool(&smtpCfg),
+		logger: logger,
+	}, nil
+}
+
+// withDefaults 用默认配置填充未设置的字段
+func withDefaults(cfg config.SMTPConfig) config.SMTPConfig {
+	def := config.DefaultSMTPConfig()
+	if cfg.Port == 0 {
+		cfg.Port = def.Port
+	}
+	if cfg.Security == "" {
+		cfg.Security = def.Security
+	}
+	if cfg.AuthMechanism == "" {
+		cfg.AuthMechanism = def.AuthMechanism
+	}
+	if cfg.PasswordEnvVar == "" {
+		cfg.PasswordEnvVar = def.PasswordEnvVar
+	}
+	if cfg.LocalName == "" {
+		cfg.LocalName = def.LocalName
+	}
+	if cfg.PoolSize <= 0 {
+		cfg.PoolSize = def.PoolSize
+	}
+	if cfg.IdleTimeout <= 0 {
+		cfg.IdleTimeout = def.IdleTimeout
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = def.Timeout
+	}
+	return cfg
+}
+
+// Name 供应商实例名称
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// SupportedChannel 支持的通知渠道
+func (p *Provider) SupportedChannel() domain.Channel {
+	return domain.ChannelEmail
+}
+
+// Send 通过 SMTP 发送邮件，返回邮件的 Message-ID
+func (p *Provider) Send(ctx context.Context, n domain.Notification) (provider.SendResult, error) {
+	if n.Email == nil {
+		return provider.SendResult{}, ErrMissingEmailContent
+	}
+	to, err := mail.ParseAddress(n.Recipient)
+	if err != nil {
+		return provider.SendResult{}, fmt.Errorf("%w: invalid email recipient %q", domain.ErrInvalidNotification, n.Recipient)
+	}
+	from, err := p.sender(n)
+	if err != nil {
+		return provider.SendResult{}, err
+	}
+	msg, err := newMessage(from, to, n.Email)
+	if err != nil {
+		return provider.SendResult{}, err
+	}
+
+	c, err := p.pool.get(ctx)
+	if err != nil {
+		return provider.SendResult{}, p.classify(err)
+	}
+	if err := p.deliver(c, msg); err != nil {
+		p.pool.put(c, true)
+		return provider.SendResult{}, p.classify(err)
+	}
+	p.pool.put(c, false)
+
+	p.logger.Debug("邮件已提交至 SMTP 服务器",
+		zap.String("provider", p.name),
+		zap.String("message_id", msg.id),
+		zap.Uint64("notification_id", n.ID),
+	)
+	return provider.SendResult{MessageID: msg.id}, nil
+}
+
+// deliver 在一条连接上完成 MAIL、RCPT 与 DATA
+func (p *Provider) deliver(c *conn, msg *message) error {
+	p.pool.setDeadline(c)
+	if err := c.client.Mail(msg.from.Address); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	if err := c.client.Rcpt(msg.to.Address); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+	w, err := c.client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err := msg.WriteTo(w); err != nil {
+		_ = w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	return nil
+}
+
+// classify 按 SMTP 应答码为错误分类：4xx 为临时错误，5xx 为永久错误，网络错误可重试
+func (p *Provider) classify(err error) error {
+	var tpErr *textproto.Error
+	if errors.As(err, &tpErr) {
+		pe := provider.WrapError(p.name, provider.CategoryPermanent, err)
+		pe.Code = strconv.Itoa(tpErr.Code)
+		pe.Message = tpErr.Msg
+		if tpErr.Code < smtpPermanentCode {
+			pe.Category = provider.CategoryRetryable
+		}
+		return pe
+	}
+	return provider.WrapError(p.name, provider.CategoryOf(err), err)
+}
+
+// QueryStatus SMTP 不提供投递状态查询，已受理的邮件视为已提交
+func (p *Provider) QueryStatus(_ context.Context, req provider.QueryRequest) (provider.QueryResult, error) {
+	if req.MessageID == "" {
+		return provider.QueryResult{Status: provider.DeliveryStatusUnknown}, nil
+	}
+	return provider.QueryResult{
+		Status:     provider.DeliveryStatusSent,
+		ReportedAt: time.Now(),
+	}, nil
+}
+
+// Close 关闭连接池
+func (p *Provider) Close() error {
+	p.pool.close()
+	return nil
+}
+
+// sender 通知指定了发件人（如租户的默认发件人）时使用该发件人，否则使用供应商配置的发件人
+func (p *Provider) sender(n domain.Notification) (*mail.Address, error) {
+	if n.Sender.EmailFrom == "" {
+		return p.from, nil
+	}
+	from, err := mail.ParseAddress(n.Sender.EmailFrom)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid email sender %q", domain.ErrInvalidNotification, n.Sender.EmailFrom)
+	}
+	return from, nil
+}
@@ -0,0 +1,259 @@
+package smtp_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/provider/smtp"
+	"github.com/dingdong-postman/internal/provider/smtp/smtptest"
+)
+
+const (
+	testUser     = "postman"
+	testPassword = "s3cret"
+)
+
+// newServer 启动测试服务端，测试结束时关闭
+func newServer(t *testing.T, mode smtptest.Mode, username, password string) *smtptest.Server {
+	t.Helper()
+	srv, err := smtptest.NewServer(mode, username, password)
+	if err != nil {
+		t.Fatalf("start smtptest server: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = srv.Close()
+	})
+	return srv
+}
+
+// newProvider 创建连接测试服务端的 SMTP 供应商，测试结束时关闭
+func newProvider(t *testing.T, srv *smtptest.Server, security, mechanism, password string) *smtp.Provider {
+	t.Helper()
+	p, err := smtp.New(config.ProviderConfig{
+		Name: "mail",
+		SMTP: &config.SMTPConfig{
+			Host:               srv.Host,
+			Port:               srv.Port,
+			Security:           security,
+			InsecureSkipVerify: true,
+			AuthMechanism:      mechanism,
+			Username:           testUser,
+			Password:           password,
+			PasswordEnvVar:     "DINGDONG_SMTP_TEST_PASSWORD_UNSET",
+			From:               "Dingdong <noreply@example.com>",
+			PoolSize:           2,
+		},
+	}, appLogger.GetGlobal())
+	if err != nil {
+		t.Fatalf("new smtp provider: %v", err)
+	}
+	sp := p.(*smtp.Provider)
+	t.Cleanup(func() {
+		_ = sp.Close()
+	})
+	return sp
+}
+
+func notification(recipient string) domain.Notification {
+	return domain.Notification{
+		ID:        1,
+		Channel:   domain.ChannelEmail,
+		Recipient: recipient,
+		Email: &domain.EmailContent{
+			Subject:  "Welcome",
+			TextBody: "hello",
+		},
+	}
+}
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      smtptest.Mode
+		security  string
+		mechanism string
+		wantTLS   bool
+	}{
+		{name: "starttls plain", mode: smtptest.ModeStartTLS, security: smtp.SecurityStartTLS, mechanism: smtp.AuthPlain, wantTLS: true},
+		{name: "implicit tls login", mode: smtptest.ModeTLS, security: smtp.SecurityTLS, mechanism: smtp.AuthLogin, wantTLS: true},
+		{name: "plaintext plain", mode: smtptest.ModePlain, security: smtp.SecurityNone, mechanism: smtp.AuthPlain},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newServer(t, tt.mode, testUser, testPassword)
+			p := newProvider(t, srv, tt.security, tt.mechanism, testPassword)
+
+			res, err := p.Send(context.Background(), notification("Alice <alice@example.org>"))
+			if err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+			if !strings.HasPrefix(res.MessageID, "<") || !strings.HasSuffix(res.MessageID, "@example.com>") {
+				t.Errorf("MessageID = %q, want <...@example.com>", res.MessageID)
+			}
+
+			msgs := srv.Messages()
+			if len(msgs) != 1 {
+				t.Fatalf("server received %d messages, want 1", len(msgs))
+			}
+			msg := msgs[0]
+			if msg.From != "noreply@example.com" {
+				t.Errorf("MAIL FROM = %q, want noreply@example.com", msg.From)
+			}
+			if len(msg.To) != 1 || msg.To[0] != "alice@example.org" {
+				t.Errorf("RCPT TO = %v, want [alice@example.org]", msg.To)
+			}
+			if msg.AuthUser != testUser {
+				t.Errorf("AuthUser = %q, want %q", msg.AuthUser, testUser)
+			}
+			if msg.TLS != tt.wantTLS {
+				t.Errorf("TLS = %v, want %v", msg.TLS, tt.wantTLS)
+			}
+			m, err := mail.ReadMessage(bytes.NewReader(msg.Data))
+			if err != nil {
+				t.Fatalf("parse DATA: %v\n%s", err, msg.Data)
+			}
+			if got := m.Header.Get("Message-ID"); got != res.MessageID {
+				t.Errorf("Message-ID header = %q, want %q", got, res.MessageID)
+			}
+			if got := m.Header.Get("Subject"); got != "Welcome" {
+				t.Errorf("Subject header = %q, want Welcome", got)
+			}
+			if body, _ := io.ReadAll(m.Body); !strings.Contains(string(body), "hello") {
+				t.Errorf("body = %q, want it to contain hello", body)
+			}
+		})
+	}
+}
+
+func TestSendUsesNotificationSender(t *testing.T) {
+	srv := newServer(t, smtptest.ModePlain, "", "")
+	p := newProvider(t, srv, smtp.SecurityNone, smtp.AuthNone, "")
+
+	n := notification("bob@example.org")
+	n.Sender.EmailFrom = "Tenant <tenant@example.net>"
+	if _, err := p.Send(context.Background(), n); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	msgs := srv.Messages()
+	if len(msgs) != 1 || msgs[0].From != "tenant@example.net" {
+		t.Fatalf("MAIL FROM = %v, want tenant@example.net", msgs)
+	}
+	if msgs[0].AuthUser != "" {
+		t.Errorf("AuthUser = %q, want no authentication", msgs[0].AuthUser)
+	}
+}
+
+func TestSendReusesConnection(t *testing.T) {
+	srv := newServer(t, smtptest.ModeStartTLS, testUser, testPassword)
+	p := newProvider(t, srv, smtp.SecurityStartTLS, smtp.AuthPlain, testPassword)
+
+	const sends = 5
+	for i := 0; i < sends; i++ {
+		if _, err := p.Send(context.Background(), notification("alice@example.org")); err != nil {
+			t.Fatalf("Send() #%d error = %v", i+1, err)
+		}
+	}
+	if got := len(srv.Messages()); got != sends {
+		t.Errorf("server received %d messages, want %d", got, sends)
+	}
+	if got := srv.Connections(); got != 1 {
+		t.Errorf("server accepted %d connections, want 1 reused connection", got)
+	}
+}
+
+func TestSendRejectedRecipient(t *testing.T) {
+	tests := []struct {
+		name         string
+		code         int
+		wantCategory provider.ErrorCategory
+	}{
+		{name: "mailbox busy", code: 450, wantCategory: provider.CategoryRetryable},
+		{name: "insufficient storage", code: 452, wantCategory: provider.CategoryRetryable},
+		{name: "mailbox unavailable", code: 550, wantCategory: provider.CategoryPermanent},
+		{name: "mailbox name not allowed", code: 553, wantCategory: provider.CategoryPermanent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newServer(t, smtptest.ModePlain, "", "")
+			srv.RcptReply = func(string) (int, string) {
+				return tt.code, "rejected"
+			}
+			p := newProvider(t, srv, smtp.SecurityNone, smtp.AuthNone, "")
+
+			_, err := p.Send(context.Background(), notification("alice@example.org"))
+			var pe *provider.Error
+			if !errors.As(err, &pe) {
+				t.Fatalf("Send() error = %v, want *provider.Error", err)
+			}
+			if pe.Category != tt.wantCategory {
+				t.Errorf("Category = %s, want %s", pe.Category, tt.wantCategory)
+			}
+			if want := strconv.Itoa(tt.code); pe.Code != want {
+				t.Errorf("Code = %q, want %q", pe.Code, want)
+			}
+			if len(srv.Messages()) != 0 {
+				t.Errorf("server accepted a message for a rejected recipient")
+			}
+		})
+	}
+}
+
+func TestSendAuthFailure(t *testing.T) {
+	srv := newServer(t, smtptest.ModeStartTLS, testUser, testPassword)
+	p := newProvider(t, srv, smtp.SecurityStartTLS, smtp.AuthPlain, "wrong")
+
+	_, err := p.Send(context.Background(), notification("alice@example.org"))
+	var pe *provider.Error
+	if !errors.As(err, &pe) {
+		t.Fatalf("Send() error = %v, want *provider.Error", err)
+	}
+	if pe.Category != provider.CategoryPermanent || pe.Code != "535" {
+		t.Errorf("error = %s/%s, want %s/535", pe.Category, pe.Code, provider.CategoryPermanent)
+	}
+}
+
+func TestSendInvalidRecipient(t *testing.T) {
+	srv := newServer(t, smtptest.ModePlain, "", "")
+	p := newProvider(t, srv, smtp.SecurityNone, smtp.AuthNone, "")
+
+	_, err := p.Send(context.Background(), notification("not an address"))
+	if !errors.Is(err, domain.ErrInvalidNotification) {
+		t.Fatalf("Send() error = %v, want ErrInvalidNotification", err)
+	}
+	if srv.Connections() != 0 {
+		t.Errorf("provider connected to the server for an invalid recipient")
+	}
+}
+
+func TestParseReportsSkipsUnknownEvents(t *testing.T) {
+	srv := newServer(t, smtptest.ModePlain, "", "")
+	p := newProvider(t, srv, smtp.SecurityNone, smtp.AuthNone, "")
+
+	reports, err := p.ParseReports([]byte(`[
+		{"message_id":"a@example.com","recipient":"a@example.org","event":"opened","timestamp":"2024-01-02T15:04:05Z"},
+		{"message_id":"b@example.com","recipient":"b@example.org","event":"bounce","code":"5.1.1","timestamp":"2024-01-02T15:04:05Z"},
+		{"message_id":"<c@example.com>","recipient":"c@example.org","event":"delivered","timestamp":"2024-01-02T15:04:05Z"}
+	]`))
+	if err != nil {
+		t.Fatalf("ParseReports() error = %v", err)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("ParseReports() returned %d reports, want 2", len(reports))
+	}
+	if got := reports[0]; got.MessageID != "<b@example.com>" || got.Status != provider.DeliveryStatusFailed {
+		t.Errorf("reports[0] = %+v, want permanent bounce of <b@example.com>", got)
+	}
+	if got := reports[1]; got.MessageID != "<c@example.com>" || got.Status != provider.DeliveryStatusDelivered {
+		t.Errorf("reports[1] = %+v, want delivery of <c@example.com>", got)
+	}
+}
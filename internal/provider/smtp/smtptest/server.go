@@ -0,0 +1,408 @@
+// Package smtptest 提供进程内的 SMTP 服务端，用于在不依赖真实邮件服务的情况下验证 SMTP 供应商
+// 用法与 net/http/httptest 类似：NewServer 启动服务，Messages 读取收到的邮件，Close 关闭服务
+package smtptest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mode 服务端的加密方式
+type Mode int
+
+const (
+	// ModePlain 明文，不支持 STARTTLS
+	ModePlain Mode = iota
+	// ModeStartTLS 明文连接，支持 STARTTLS 升级
+	ModeStartTLS
+	// ModeTLS 隐式 TLS
+	ModeTLS
+)
+
+// Message 服务端收到的一封邮件
+type Message struct {
+	From string
+	To   []string
+	Data []byte
+	// AuthUser 认证使用的用户名，未认证时为空
+	AuthUser string
+	// TLS 邮件是否通过加密连接传输
+	TLS bool
+}
+
+// Server 进程内 SMTP 服务端，监听 127.0.0.1 的随机端口
+type Server struct {
+	// Addr 监听地址 host:port
+	Addr string
+	// Host 监听主机
+	Host string
+	// Port 监听端口
+	Port int
+
+	// Username / Password 设置后要求客户端认证，支持 PLAIN 与 LOGIN
+	Username string
+	Password string
+
+	// RcptReply 设置后对每个收件人调用，返回非零应答码时以该应答拒绝 RCPT，用于模拟临时（4xx）与永久（5xx）错误；
+	// 须在客户端连接前设置
+	RcptReply func(addr string) (code int, msg string)
+
+	listener net.Listener
+	tlsCfg   *tls.Config
+	mode     Mode
+
+	mu       sync.Mutex
+	messages []Message
+	conns    int
+	active   map[net.Conn]struct{}
+	wg       sync.WaitGroup
+}
+
+// NewServer 启动服务端；username 非空时要求认证
+func NewServer(mode Mode, username, password string) (*Server, error) {
+	cert, err := selfSignedCert()
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+
+	var ln net.Listener
+	if mode == ModeTLS {
+		ln, err = tls.Listen("tcp", "127.0.0.1:0", tlsCfg)
+	} else {
+		ln, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("smtptest: listen: %w", err)
+	}
+
+	addr := ln.Addr().(*net.TCPAddr)
+	s := &Server{
+		Addr:     addr.String(),
+		Host:     addr.IP.String(),
+		Port:     addr.Port,
+		Username: username,
+		Password: password,
+		listener: ln,
+		tlsCfg:   tlsCfg,
+		mode:     mode,
+		active:   make(map[net.Conn]struct{}),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Messages 返回已收到的邮件
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Message, len(s.messages))
+	copy(out, s.messages)
+	return out
+}
+
+// Connections 返回累计建立的连接数，用于验证连接复用
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns
+}
+
+// Close 停止监听，断开所有连接并等待会话结束
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.mu.Lock()
+	for nc := range s.active {
+		_ = nc.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		nc, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns++
+		s.active[nc] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer func() {
+				_ = nc.Close()
+				s.mu.Lock()
+				delete(s.active, nc)
+				s.mu.Unlock()
+			}()
+			sess := &session{server: s, conn: nc, tls: s.mode == ModeTLS}
+			sess.run()
+		}()
+	}
+}
+
+// session 一条连接上的 SMTP 会话
+type session struct {
+	server *Server
+	conn   net.Conn
+	text   *textproto.Conn
+	tls    bool
+
+	authUser string
+	from     string
+	to       []string
+}
+
+func (sess *session) run() {
+	sess.text = textproto.NewConn(sess.conn)
+	sess.reply(220, "smtptest ESMTP ready")
+	for {
+		_ = sess.conn.SetDeadline(time.Now().Add(time.Minute))
+		line, err := sess.text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		if !sess.handle(strings.ToUpper(verb), arg) {
+			return
+		}
+	}
+}
+
+// handle 处理一条命令，返回 false 表示结束会话
+func (sess *session) handle(verb, arg string) bool {
+	switch verb {
+	case "EHLO":
+		sess.ehlo()
+	case "HELO":
+		sess.reply(250, "smtptest")
+	case "STARTTLS":
+		return sess.startTLS()
+	case "AUTH":
+		sess.auth(arg)
+	case "MAIL":
+		sess.mail(arg)
+	case "RCPT":
+		sess.rcpt(arg)
+	case "DATA":
+		return sess.data()
+	case "RSET":
+		sess.from, sess.to = "", nil
+		sess.reply(250, "OK")
+	case "NOOP":
+		sess.reply(250, "OK")
+	case "QUIT":
+		sess.reply(221, "Bye")
+		return false
+	default:
+		sess.reply(502, "command not implemented")
+	}
+	return true
+}
+
+func (sess *session) ehlo() {
+	lines := []string{"smtptest", "8BITMIME"}
+	if sess.server.mode == ModeStartTLS && !sess.tls {
+		lines = append(lines, "STARTTLS")
+	}
+	if sess.server.Username != "" {
+		lines = append(lines, "AUTH PLAIN LOGIN")
+	}
+	for i, l := range lines {
+		sep := "-"
+		if i == len(lines)-1 {
+			sep = " "
+		}
+		_ = sess.text.PrintfLine("250%s%s", sep, l)
+	}
+}
+
+func (sess *session) startTLS() bool {
+	if sess.server.mode != ModeStartTLS || sess.tls {
+		sess.reply(502, "STARTTLS not available")
+		return true
+	}
+	sess.reply(220, "Ready to start TLS")
+	tlsConn := tls.Server(sess.conn, sess.server.tlsCfg)
+	if err := tlsConn.Handshake(); err != nil {
+		return false
+	}
+	sess.conn = tlsConn
+	sess.text = textproto.NewConn(tlsConn)
+	sess.tls = true
+	sess.authUser, sess.from, sess.to = "", "", nil
+	return true
+}
+
+func (sess *session) auth(arg string) {
+	mechanism, initial, _ := strings.Cut(arg, " ")
+	var user, pass string
+	switch strings.ToUpper(mechanism) {
+	case "PLAIN":
+		resp := initial
+		if resp == "" {
+			resp = sess.challenge("")
+		}
+		decoded, err := base64.StdEncoding.DecodeString(resp)
+		if err != nil {
+			sess.reply(501, "malformed auth input")
+			return
+		}
+		parts := strings.Split(string(decoded), "\x00")
+		if len(parts) != 3 {
+			sess.reply(501, "malformed auth input")
+			return
+		}
+		user, pass = parts[1], parts[2]
+	case "LOGIN":
+		user = sess.decoded(sess.challenge("Username:"))
+		pass = sess.decoded(sess.challenge("Password:"))
+	default:
+		sess.reply(504, "unrecognized authentication type")
+		return
+	}
+	if user != sess.server.Username || pass != sess.server.Password {
+		sess.reply(535, "authentication credentials invalid")
+		return
+	}
+	sess.authUser = user
+	sess.reply(235, "Authentication successful")
+}
+
+// challenge 发送 334 质询并读取客户端的 base64 响应
+func (sess *session) challenge(prompt string) string {
+	sess.reply(334, base64.StdEncoding.EncodeToString([]byte(prompt)))
+	line, err := sess.text.ReadLine()
+	if err != nil {
+		return ""
+	}
+	return line
+}
+
+func (sess *session) decoded(s string) string {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func (sess *session) mail(arg string) {
+	if sess.server.Username != "" && sess.authUser == "" {
+		sess.reply(530, "authentication required")
+		return
+	}
+	addr, ok := parsePath(arg, "FROM:")
+	if !ok {
+		sess.reply(501, "syntax error in MAIL FROM")
+		return
+	}
+	sess.from, sess.to = addr, nil
+	sess.reply(250, "OK")
+}
+
+func (sess *session) rcpt(arg string) {
+	if sess.from == "" {
+		sess.reply(503, "need MAIL FROM first")
+		return
+	}
+	addr, ok := parsePath(arg, "TO:")
+	if !ok {
+		sess.reply(501, "syntax error in RCPT TO")
+		return
+	}
+	if sess.server.RcptReply != nil {
+		if code, msg := sess.server.RcptReply(addr); code != 0 {
+			sess.reply(code, msg)
+			return
+		}
+	}
+	sess.to = append(sess.to, addr)
+	sess.reply(250, "OK")
+}
+
+func (sess *session) data() bool {
+	if len(sess.to) == 0 {
+		sess.reply(503, "need RCPT TO first")
+		return true
+	}
+	sess.reply(354, "End data with <CR><LF>.<CR><LF>")
+	data, err := sess.text.ReadDotBytes()
+	if err != nil {
+		return false
+	}
+	sess.server.mu.Lock()
+	sess.server.messages = append(sess.server.messages, Message{
+		From:     sess.from,
+		To:       sess.to,
+		Data:     data,
+		AuthUser: sess.authUser,
+		TLS:      sess.tls,
+	})
+	sess.server.mu.Unlock()
+	sess.from, sess.to = "", nil
+	sess.reply(250, "OK: queued")
+	return true
+}
+
+func (sess *session) reply(code int, msg string) {
+	_ = sess.text.PrintfLine("%d %s", code, msg)
+}
+
+// parsePath 解析 "FROM:<addr> ..." 形式的参数
+func parsePath(arg, prefix string) (string, bool) {
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", false
+	}
+	rest := strings.TrimSpace(arg[len(prefix):])
+	if !strings.HasPrefix(rest, "<") {
+		return "", false
+	}
+	end := strings.Index(rest, ">")
+	if end < 0 {
+		return "", false
+	}
+	return rest[1:end], true
+}
+
+// selfSignedCert 生成仅用于本地 TLS 的自签名证书
+func selfSignedCert() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("smtptest: generate key: %w", err)
+	}
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "smtptest"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("smtptest: create certificate: %w", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import "context"
+
+// TemplateAuditor 需要先向供应商报备模板、审核通过后才能发送的供应商（如国内短信）可额外实现该接口
+// 平台模板的每个版本都会提交给这类供应商，审核通过前不会路由到该供应商
+type TemplateAuditor interface {
+	// SubmitTemplate 提交模板审核，返回供应商为该模板分配的模板 Code
+	SubmitTemplate(ctx context.Context, t TemplateSubmission) (string, error)
+	// QueryTemplate 按模板 Code 查询审核状态
+	QueryTemplate(ctx context.Context, code string) (TemplateAuditResult, error)
+}
+
+// TemplateSubmission 提交给供应商审核的模板
+type TemplateSubmission struct {
+	// TemplateID 平台模板 ID
+	TemplateID string
+	// Version 平台模板版本
+	Version int
+	// Name 模板名称
+	Name string
+	// Content 模板内容，占位符为平台语法 {{name}}，由供应商转换为各自的语法
+	Content string
+	// ParamNames 占位符按首次出现的顺序排列的变量名
+	ParamNames []string
+	// Remark 提交说明，供供应商审核人员参考
+	Remark string
+}
+
+// TemplateAuditStatus 供应商侧的模板审核状态
+type TemplateAuditStatus string
+
+const (
+	// TemplateAuditPending 审核中
+	TemplateAuditPending TemplateAuditStatus = "PENDING"
+	// TemplateAuditApproved 审核通过
+	TemplateAuditApproved TemplateAuditStatus = "APPROVED"
+	// TemplateAuditRejected 审核未通过
+	TemplateAuditRejected TemplateAuditStatus = "REJECTED"
+)
+
+// TemplateAuditResult 模板审核状态查询结果
+type TemplateAuditResult struct {
+	// Status 审核状态
+	Status TemplateAuditStatus
+	// Reason 审核未通过的原因
+	Reason string
+}
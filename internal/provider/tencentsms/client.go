@@ -0,0 +1,176 @@
+package tencentsms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// apiVersion 短信接口版本
+	apiVersion = "2021-01-11"
+	// service 签名中使用的服务名
+	service = "sms"
+	// algorithm 签名算法
+	algorithm = "TC3-HMAC-SHA256"
+	// contentType 请求体类型，参与签名
+	contentType = "application/json; charset=utf-8"
+	// maxResponseSize 响应体读取上限，防止异常响应占用过多内存
+	maxResponseSize = 1 << 20
+)
+
+// apiError 接口返回的错误
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type apiError struct {
+	Code    string `json:"Code"`
+	Message string `json:"Message"`
+}
+
+// envelope 腾讯云 API 3.0 响应外层结构
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type envelope struct {
+	Response json.RawMessage `json:"Response"`
+}
+
+// responseMeta 所有响应共有的字段
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type responseMeta struct {
+	RequestID string    `json:"RequestId"`
+	Error     *apiError `json:"Error"`
+}
+
+// client 腾讯云 API 3.0 客户端，按 TC3-HMAC-SHA256 签名
+type client struct {
+	endpoint   string
+	host       string
+	region     string
+	secretID   string
+	secretKey  string
+	httpClient *http.Client
+}
+
+// call 调用接口并将 Response 解析到 out；接口返回 Error 时返回 *apiError
+func (c *client) call(ctx context.Context, action string, in, out any) error {
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("marshal %s request: %w", action, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build %s request: %w", action, err)
+	}
+	now := time.Now()
+	req.Host = c.host
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-TC-Action", action)
+	req.Header.Set("X-TC-Version", apiVersion)
+	req.Header.Set("X-TC-Region", c.region)
+	req.Header.Set("X-TC-Timestamp", strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set("Authorization", Authorization(c.secretID, c.secretKey, c.host, action, payload, now))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("call %s: %w", action, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("read %s response: %w", action, err)
+	}
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil || len(env.Response) == 0 {
+		return &httpError{statusCode: resp.StatusCode, body: string(body)}
+	}
+	var meta responseMeta
+	if err := json.Unmarshal(env.Response, &meta); err != nil {
+		return fmt.Errorf("unmarshal %s response: %w", action, err)
+	}
+	if meta.Error != nil {
+		return meta.Error
+	}
+	if err := json.Unmarshal(env.Response, out); err != nil {
+		return fmt.Errorf("unmarshal %s response: %w", action, err)
+	}
+	return nil
+}
+
+func (e *apiError) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// httpError 无法解析为业务响应的 HTTP 错误
+type httpError struct {
+	statusCode int
+	body       string
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("unexpected http status %d: %s", e.statusCode, e.body)
+}
+
+// Authorization 计算 TC3-HMAC-SHA256 签名并返回 Authorization 头：
+//
+//	CanonicalRequest = POST\n/\n\n规范头\n签名头列表\nHex(SHA256(payload))
+//	StringToSign     = TC3-HMAC-SHA256\n时间戳\n日期/sms/tc3_request\nHex(SHA256(CanonicalRequest))
+//	SigningKey       = HMAC(HMAC(HMAC("TC3"+SecretKey, 日期), "sms"), "tc3_request")
+//	Signature        = Hex(HMAC(SigningKey, StringToSign))
+func Authorization(secretID, secretKey, host, action string, payload []byte, ts time.Time) string {
+	signedHeaders := "content-type;host;x-tc-action"
+	canonicalHeaders := "content-type:" + contentType + "\n" +
+		"host:" + host + "\n" +
+		"x-tc-action:" + strings.ToLower(action) + "\n"
+	scope, signature := sign(secretKey, service, canonicalHeaders, signedHeaders, payload, ts)
+	return algorithm + " Credential=" + secretID + "/" + scope +
+		", SignedHeaders=" + signedHeaders + ", Signature=" + signature
+}
+
+// sign 按 TC3-HMAC-SHA256 计算 POST / 请求的签名，返回凭证范围（日期/服务/tc3_request）与十六进制签名
+func sign(secretKey, svc, canonicalHeaders, signedHeaders string, payload []byte, ts time.Time) (string, string) {
+	date := ts.UTC().Format("2006-01-02")
+	canonicalRequest := strings.Join([]string{
+		http.MethodPost,
+		"/",
+		"",
+		canonicalHeaders,
+		signedHeaders,
+		sha256Hex(payload),
+	}, "\n")
+
+	scope := date + "/" + svc + "/tc3_request"
+	stringToSign := strings.Join([]string{
+		algorithm,
+		strconv.FormatInt(ts.Unix(), 10),
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	secretDate := hmacSHA256([]byte("TC3"+secretKey), date)
+	secretService := hmacSHA256(secretDate, svc)
+	secretSigning := hmacSHA256(secretService, "tc3_request")
+	return scope, hex.EncodeToString(hmacSHA256(secretSigning, stringToSign))
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, msg string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(msg))
+	return mac.Sum(nil)
+}
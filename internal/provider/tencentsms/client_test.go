@@ -0,0 +1,77 @@
+package tencentsms
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name             string
+		secretKey        string
+		service          string
+		canonicalHeaders string
+		signedHeaders    string
+		payload          string
+		ts               int64
+		wantScope        string
+		wantSignature    string
+	}{
+		{
+			// 腾讯云 API 3.0 签名方法 v3 文档中的示例（云服务器 DescribeInstances）
+			name:             "published example",
+			secretKey:        "Gu5t9xGARNpq86cd98joQYCN3EXAMPLE",
+			service:          "cvm",
+			canonicalHeaders: "content-type:application/json; charset=utf-8\nhost:cvm.tencentcloudapi.com\n",
+			signedHeaders:    "content-type;host",
+			payload:          `{"Limit": 1, "Filters": [{"Values": ["\u672a\u547d\u540d"], "Name": "instance-name"}]}`,
+			ts:               1551113065,
+			wantScope:        "2019-02-25/cvm/tc3_request",
+			wantSignature:    "72e494ea809ad7a8c8f7a4507b9bddcbaa8e581f516e8da2f66e2c5a96525168",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, signature := sign(tt.secretKey, tt.service, tt.canonicalHeaders, tt.signedHeaders,
+				[]byte(tt.payload), time.Unix(tt.ts, 0))
+			if scope != tt.wantScope {
+				t.Errorf("scope = %q, want %q", scope, tt.wantScope)
+			}
+			if signature != tt.wantSignature {
+				t.Errorf("signature = %q, want %q", signature, tt.wantSignature)
+			}
+		})
+	}
+}
+
+func TestAuthorization(t *testing.T) {
+	const (
+		secretID  = "AKIDz8krbsJ5yKBZQpn74WFkmLPx3EXAMPLE"
+		secretKey = "Gu5t9xGARNpq86cd98joQYCN3EXAMPLE"
+		host      = "sms.tencentcloudapi.com"
+	)
+	payload := []byte(`{"PhoneNumberSet":["+8613800138000"]}`)
+	ts := time.Unix(1551113065, 0)
+
+	got := Authorization(secretID, secretKey, host, "SendSms", payload, ts)
+	_, signature := sign(secretKey, "sms",
+		"content-type:application/json; charset=utf-8\nhost:sms.tencentcloudapi.com\nx-tc-action:sendsms\n",
+		"content-type;host;x-tc-action", payload, ts)
+	want := "TC3-HMAC-SHA256 Credential=" + secretID + "/2019-02-25/sms/tc3_request" +
+		", SignedHeaders=content-type;host;x-tc-action, Signature=" + signature
+	if got != want {
+		t.Errorf("Authorization() =\n%s\nwant\n%s", got, want)
+	}
+
+	// 请求体、接口或时间不同时签名随之变化
+	for name, other := range map[string]string{
+		"payload": Authorization(secretID, secretKey, host, "SendSms", []byte(`{}`), ts),
+		"action":  Authorization(secretID, secretKey, host, "AddSmsTemplate", payload, ts),
+		"time":    Authorization(secretID, secretKey, host, "SendSms", payload, ts.Add(time.Second)),
+	} {
+		if strings.HasSuffix(other, signature) {
+			t.Errorf("signature did not change with %s", name)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package tencentsms
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dingdong-postman/internal/provider"
+)
+
+// codeOK 单个号码发送成功时的 Code
+const codeOK = "Ok"
+
+// errorCategories 腾讯云短信错误码与平台错误分类的对应关系
+// 参考 https://cloud.tencent.com/document/api/382/55981 ，未列出的错误码按前缀兜底
+var errorCategories = map[string]provider.ErrorCategory{
+	// 临时错误：时间戳偏差、供应商超时或内部错误
+	"InternalError.RequestTimeException": provider.CategoryRetryable,
+	"InternalError.Timeout":              provider.CategoryRetryable,
+	"InternalError.OtherError":           provider.CategoryRetryable,
+
+	// 永久错误：余额不足、号码或模板非法、签名未审核、内容含敏感词等
+	"FailedOperation.ContainSensitiveWord":                     provider.CategoryPermanent,
+	"FailedOperation.InsufficientBalanceInSmsPackage":          provider.CategoryPermanent,
+	"FailedOperation.PhoneNumberInBlacklist":                   provider.CategoryPermanent,
+	"FailedOperation.PhoneNumberParseFail":                     provider.CategoryPermanent,
+	"FailedOperation.SignatureIncorrectOrUnapproved":           provider.CategoryPermanent,
+	"FailedOperation.TemplateIncorrectOrUnapproved":            provider.CategoryPermanent,
+	"FailedOperation.TemplateParamSetNotMatchApprovedTemplate": provider.CategoryPermanent,
+	"InvalidParameterValue.IncorrectPhoneNumber":               provider.CategoryPermanent,
+	"InvalidParameterValue.TemplateParameterFormatError":       provider.CategoryPermanent,
+	"UnauthorizedOperation.SmsSdkAppIdVerifyFail":              provider.CategoryPermanent,
+}
+
+// categoryOf 返回腾讯云错误码对应的平台错误分类
+func categoryOf(code string) provider.ErrorCategory {
+	if c, ok := errorCategories[code]; ok {
+		return c
+	}
+	switch {
+	case strings.HasPrefix(code, "LimitExceeded"), strings.HasPrefix(code, "RequestLimitExceeded"):
+		return provider.CategoryThrottled
+	case strings.HasPrefix(code, "InternalError"):
+		return provider.CategoryRetryable
+	default:
+		return provider.CategoryPermanent
+	}
+}
+
+// callError 将调用过程中的错误转换为供应商错误：接口错误按错误码分类，5xx 可重试，429 为限流，网络错误可重试
+func (p *Provider) callError(err error) error {
+	var ae *apiError
+	if errors.As(err, &ae) {
+		return provider.NewError(p.name, categoryOf(ae.Code), ae.Code, ae.Message)
+	}
+	var he *httpError
+	if errors.As(err, &he) {
+		category := provider.CategoryPermanent
+		switch {
+		case he.statusCode == http.StatusTooManyRequests:
+			category = provider.CategoryThrottled
+		case he.statusCode >= http.StatusInternalServerError:
+			category = provider.CategoryRetryable
+		}
+		pe := provider.WrapError(p.name, category, err)
+		pe.Code = "HTTP_" + strconv.Itoa(he.statusCode)
+		return pe
+	}
+	return provider.WrapError(p.name, provider.CategoryOf(err), err)
+}
@@ -0,0 +1,66 @@
+package tencentsms
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/provider"
+)
+
+const (
+	// reportAck 告知腾讯云推送成功的响应体
+	reportAck = `{"result":0,"errmsg":"OK"}`
+	// reportTimeLayout 状态回调中 user_receive_time 的时间格式（北京时间）
+	reportTimeLayout = "2006-01-02 15:04:05"
+	// reportStatusSuccess 状态回调中表示送达的 report_status
+	reportStatusSuccess = "SUCCESS"
+)
+
+// statusReport 腾讯云短信下发状态回调中的一条回执
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type statusReport struct {
+	UserReceiveTime string `json:"user_receive_time"`
+	NationCode      string `json:"nationcode"`
+	Mobile          string `json:"mobile"`
+	ReportStatus    string `json:"report_status"`
+	ErrMsg          string `json:"errmsg"`
+	Description     string `json:"description"`
+	SID             string `json:"sid"`
+}
+
+// ParseReports 解析腾讯云短信下发状态回调，请求体为回执的 JSON 数组，sid 即发送时返回的流水号
+func (p *Provider) ParseReports(body []byte) ([]provider.Report, error) {
+	var items []statusReport
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, fmt.Errorf("unmarshal tencent sms status report: %w", err)
+	}
+	reports := make([]provider.Report, 0, len(items))
+	for i := range items {
+		item := items[i]
+		report := provider.Report{
+			MessageID: item.SID,
+			Recipient: "+" + item.NationCode + item.Mobile,
+			Status:    provider.DeliveryStatusFailed,
+			Code:      item.ErrMsg,
+			Message:   item.Description,
+		}
+		if item.NationCode == "" {
+			report.Recipient = item.Mobile
+		}
+		if item.ReportStatus == reportStatusSuccess {
+			report.Status = provider.DeliveryStatusDelivered
+		}
+		if t, err := time.ParseInLocation(reportTimeLayout, item.UserReceiveTime, provider.ChinaTime); err == nil {
+			report.ReportedAt = t
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// ReportAck 返回腾讯云要求的推送成功响应
+func (p *Provider) ReportAck() (string, []byte) {
+	return "application/json", []byte(reportAck)
+}
@@ -0,0 +1,129 @@
+package tencentsms
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/provider"
+	"go.uber.org/zap"
+)
+
+// 腾讯云模板审核状态：0 审核通过，1 审核中，-1 审核未通过或审核失败
+const (
+	templateStatusApproved = 0
+	templateStatusPending  = 1
+	templateStatusRejected = -1
+)
+
+// addTemplateRequest AddSmsTemplate 请求
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type addTemplateRequest struct {
+	TemplateName    string `json:"TemplateName"`
+	TemplateContent string `json:"TemplateContent"`
+	SmsType         uint64 `json:"SmsType"`
+	International   uint64 `json:"International"`
+	Remark          string `json:"Remark"`
+}
+
+// addTemplateResponse AddSmsTemplate 响应
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type addTemplateResponse struct {
+	AddTemplateStatus struct {
+		TemplateID string `json:"TemplateId"`
+	} `json:"AddTemplateStatus"`
+	RequestID string `json:"RequestId"`
+}
+
+// SubmitTemplate 调用 AddSmsTemplate 申请模板，返回腾讯云模板 ID
+// 腾讯云模板变量按位置填充，占位符按 t.ParamNames 的顺序转换为 {1}、{2}...，发送时按同一顺序传参
+func (p *Provider) SubmitTemplate(ctx context.Context, t provider.TemplateSubmission) (string, error) {
+	content := domain.ReplacePlaceholders(t.Content, func(name string) string {
+		return "{" + strconv.Itoa(slices.Index(t.ParamNames, name)+1) + "}"
+	})
+	var resp addTemplateResponse
+	err := p.client.call(ctx, "AddSmsTemplate", addTemplateRequest{
+		TemplateName:    t.Name,
+		TemplateContent: content,
+		SmsType:         p.smsType,
+		International:   p.international(),
+		Remark:          t.Remark,
+	}, &resp)
+	if err != nil {
+		return "", p.callError(err)
+	}
+
+	p.logger.Info("腾讯云短信模板已提交审核",
+		zap.String("provider", p.name),
+		zap.String("template_id", t.TemplateID),
+		zap.Int("version", t.Version),
+		zap.String("vendor_template_id", resp.AddTemplateStatus.TemplateID),
+		zap.String("request_id", resp.RequestID),
+	)
+	return resp.AddTemplateStatus.TemplateID, nil
+}
+
+// describeTemplateRequest DescribeSmsTemplateList 请求
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type describeTemplateRequest struct {
+	TemplateIDSet []uint64 `json:"TemplateIdSet"`
+	International uint64   `json:"International"`
+}
+
+// describeTemplateResponse DescribeSmsTemplateList 响应
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type describeTemplateResponse struct {
+	DescribeTemplateStatusSet []struct {
+		TemplateID  uint64 `json:"TemplateId"`
+		StatusCode  int    `json:"StatusCode"`
+		ReviewReply string `json:"ReviewReply"`
+	} `json:"DescribeTemplateStatusSet"`
+	RequestID string `json:"RequestId"`
+}
+
+// QueryTemplate 调用 DescribeSmsTemplateList 查询模板审核状态
+func (p *Provider) QueryTemplate(ctx context.Context, code string) (provider.TemplateAuditResult, error) {
+	id, err := strconv.ParseUint(code, 10, 64)
+	if err != nil {
+		return provider.TemplateAuditResult{}, provider.WrapError(p.name, provider.CategoryPermanent,
+			fmt.Errorf("invalid tencent template id %q: %w", code, err))
+	}
+	var resp describeTemplateResponse
+	err = p.client.call(ctx, "DescribeSmsTemplateList", describeTemplateRequest{
+		TemplateIDSet: []uint64{id},
+		International: p.international(),
+	}, &resp)
+	if err != nil {
+		return provider.TemplateAuditResult{}, p.callError(err)
+	}
+
+	for _, st := range resp.DescribeTemplateStatusSet {
+		if st.TemplateID != id {
+			continue
+		}
+		switch st.StatusCode {
+		case templateStatusApproved:
+			return provider.TemplateAuditResult{Status: provider.TemplateAuditApproved}, nil
+		case templateStatusRejected:
+			return provider.TemplateAuditResult{Status: provider.TemplateAuditRejected, Reason: st.ReviewReply}, nil
+		case templateStatusPending:
+			return provider.TemplateAuditResult{Status: provider.TemplateAuditPending}, nil
+		}
+	}
+	return provider.TemplateAuditResult{}, provider.NewError(p.name, provider.CategoryRetryable, "MissingTemplateStatus",
+		"no template status returned for template id "+code)
+}
+
+// international 默认国家码为中国大陆时按国内短信模板申请与查询，否则按国际/港澳台短信模板
+func (p *Provider) international() uint64 {
+	if p.countryCode == "+86" {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,376 @@
+// Package tencentsms 腾讯云短信供应商
+// 请求按 TC3-HMAC-SHA256 签名；批量发送时腾讯云按号码逐一返回结果，单个号码失败不影响同批其他号码。
+// 支持通过 AddSmsTemplate / DescribeSmsTemplateList 申请模板并查询审核状态，
+// 投递回执可通过 PullSmsSendStatusByPhoneNumber 拉取，也可接收下发状态回调推送
+package tencentsms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"go.uber.org/zap"
+)
+
+// Type 供应商类型名
+const Type = "tencent_sms"
+
+// maxBatchSize SendSms 单次最多支持的号码数
+const maxBatchSize = 200
+
+// 拉取回执时的查询窗口
+const (
+	queryWindow = 24 * time.Hour
+	queryLimit  = 100
+)
+
+func init() {
+	provider.RegisterFactory(Type, New)
+}
+
+// Provider 腾讯云短信供应商
+type Provider struct {
+	name        string
+	sdkAppID    string
+	signName    string
+	countryCode string
+	smsType     uint64
+	paramNames  map[string][]string
+	client      *client
+	logger      appLogger.Logger
+}
+
+// New 根据配置创建腾讯云短信供应商，providers[].tencent_sms 必填，未配置的字段使用默认值
+func New(cfg config.ProviderConfig, logger appLogger.Logger) (provider.Provider, error) {
+	if cfg.TencentSMS == nil {
+		return nil, fmt.Errorf("provider %s: tencent_sms config is required", cfg.Name)
+	}
+	smsCfg := withDefaults(*cfg.TencentSMS)
+	secretID, secretKey := smsCfg.GetSecretID(), smsCfg.GetSecretKey()
+	if secretID == "" || secretKey == "" {
+		return nil, fmt.Errorf("provider %s: tencent cloud secret is required (env %s / %s)",
+			cfg.Name, smsCfg.SecretIDEnvVar, smsCfg.SecretKeyEnvVar)
+	}
+	if smsCfg.SdkAppID == "" || smsCfg.SignName == "" {
+		return nil, fmt.Errorf("provider %s: tencent sms sdk_app_id and sign_name are required", cfg.Name)
+	}
+	endpoint, err := url.Parse(smsCfg.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("provider %s: invalid tencent sms endpoint %q", cfg.Name, smsCfg.Endpoint)
+	}
+
+	return &Provider{
+		name:        cfg.Name,
+		sdkAppID:    smsCfg.SdkAppID,
+		signName:    smsCfg.SignName,
+		countryCode: smsCfg.DefaultCountryCode,
+		smsType:     smsCfg.SmsType,
+		paramNames:  smsCfg.TemplateParamNames,
+		client: &client{
+			endpoint:   smsCfg.Endpoint,
+			host:       endpoint.Host,
+			region:     smsCfg.Region,
+			secretID:   secretID,
+			secretKey:  secretKey,
+			httpClient: &http.Client{Timeout: time.Duration(smsCfg.Timeout) * time.Second},
+		},
+		logger: logger,
+	}, nil
+}
+
+// withDefaults 用默认配置填充未设置的字段
+func withDefaults(cfg config.TencentSMSConfig) config.TencentSMSConfig {
+	def := config.DefaultTencentSMSConfig()
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = def.Endpoint
+	}
+	if cfg.Region == "" {
+		cfg.Region = def.Region
+	}
+	if cfg.SecretIDEnvVar == "" {
+		cfg.SecretIDEnvVar = def.SecretIDEnvVar
+	}
+	if cfg.SecretKeyEnvVar == "" {
+		cfg.SecretKeyEnvVar = def.SecretKeyEnvVar
+	}
+	if cfg.DefaultCountryCode == "" {
+		cfg.DefaultCountryCode = def.DefaultCountryCode
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = def.Timeout
+	}
+	return cfg
+}
+
+// Name 供应商实例名称
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// SupportedChannel 支持的通知渠道
+func (p *Provider) SupportedChannel() domain.Channel {
+	return domain.ChannelSMS
+}
+
+// sendRequest SendSms 请求
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type sendRequest struct {
+	PhoneNumberSet   []string `json:"PhoneNumberSet"`
+	SmsSdkAppID      string   `json:"SmsSdkAppId"`
+	SignName         string   `json:"SignName"`
+	TemplateID       string   `json:"TemplateId"`
+	TemplateParamSet []string `json:"TemplateParamSet"`
+	SessionContext   string   `json:"SessionContext,omitempty"`
+}
+
+// sendResponse SendSms 响应
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type sendResponse struct {
+	SendStatusSet []sendStatus `json:"SendStatusSet"`
+	RequestID     string       `json:"RequestId"`
+}
+
+// sendStatus 单个号码的发送结果
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type sendStatus struct {
+	SerialNo       string `json:"SerialNo"`
+	PhoneNumber    string `json:"PhoneNumber"`
+	Fee            int    `json:"Fee"`
+	SessionContext string `json:"SessionContext"`
+	Code           string `json:"Code"`
+	Message        string `json:"Message"`
+}
+
+// Send 发送单条短信，模板 ID 即腾讯云模板 ID，返回流水号（SerialNo）
+func (p *Provider) Send(ctx context.Context, n domain.Notification) (provider.SendResult, error) {
+	results, err := p.SendBatch(ctx, []domain.Notification{n})
+	if err != nil {
+		return provider.SendResult{}, err
+	}
+	if results[0].Err != nil {
+		return provider.SendResult{}, results[0].Err
+	}
+	return provider.SendResult{MessageID: results[0].MessageID}, nil
+}
+
+// SendBatch 批量发送
+// 腾讯云要求同一请求内的号码使用相同签名、模板与模板参数，因此按签名、模板与参数分组，每组每 200 个号码一次请求；
+// 返回结果按号码逐一对应，单个号码失败只影响该号码
+func (p *Provider) SendBatch(ctx context.Context, ns []domain.Notification) ([]provider.BatchResult, error) {
+	results := make([]provider.BatchResult, len(ns))
+
+	groups := make(map[string][]int)
+	var order []string
+	params := make(map[string][]string)
+	for i := range ns {
+		ps, err := p.templateParams(ns[i].Template)
+		if err != nil {
+			results[i].Err = provider.WrapError(p.name, provider.CategoryPermanent, err)
+			continue
+		}
+		key := p.sign(ns[i]) + "\x00" + ns[i].Template.ID + "\x00" + strings.Join(ps, "\x00")
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+			params[key] = ps
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	for _, key := range order {
+		idx := groups[key]
+		for start := 0; start < len(idx); start += maxBatchSize {
+			end := min(start+maxBatchSize, len(idx))
+			p.sendGroup(ctx, ns, idx[start:end], params[key], results)
+		}
+	}
+	return results, nil
+}
+
+// sign 通知指定了签名（如租户的默认签名）时使用该签名，否则使用供应商配置的签名
+func (p *Provider) sign(n domain.Notification) string {
+	if n.Sender.SignName != "" {
+		return n.Sender.SignName
+	}
+	return p.signName
+}
+
+// sendGroup 发送一组同签名、同模板、同参数的短信，并按号码回填结果
+func (p *Provider) sendGroup(ctx context.Context, ns []domain.Notification, idx []int, params []string, results []provider.BatchResult) {
+	req := sendRequest{
+		PhoneNumberSet:   make([]string, 0, len(idx)),
+		SmsSdkAppID:      p.sdkAppID,
+		SignName:         p.sign(ns[idx[0]]),
+		TemplateID:       ns[idx[0]].Template.ID,
+		TemplateParamSet: params,
+	}
+	// 同一号码可能在一批中出现多次，按号码排队回填
+	byPhone := make(map[string][]int, len(idx))
+	for _, i := range idx {
+		phone := p.normalizePhone(ns[i].Recipient)
+		req.PhoneNumberSet = append(req.PhoneNumberSet, phone)
+		byPhone[phone] = append(byPhone[phone], i)
+	}
+	if len(idx) == 1 && ns[idx[0]].ID != 0 {
+		req.SessionContext = strconv.FormatUint(ns[idx[0]].ID, 10)
+	}
+
+	var resp sendResponse
+	if err := p.client.call(ctx, "SendSms", req, &resp); err != nil {
+		err = p.callError(err)
+		for _, i := range idx {
+			results[i].Err = err
+		}
+		return
+	}
+
+	for _, st := range resp.SendStatusSet {
+		queue := byPhone[st.PhoneNumber]
+		if len(queue) == 0 {
+			continue
+		}
+		i := queue[0]
+		byPhone[st.PhoneNumber] = queue[1:]
+		if st.Code != codeOK {
+			results[i].Err = provider.NewError(p.name, categoryOf(st.Code), st.Code, st.Message)
+			continue
+		}
+		results[i].MessageID = st.SerialNo
+	}
+	// 供应商未返回结果的号码按可重试失败处理
+	for _, queue := range byPhone {
+		for _, i := range queue {
+			results[i].Err = provider.NewError(p.name, provider.CategoryRetryable, "MissingSendStatus",
+				"no send status returned for phone number")
+		}
+	}
+
+	p.logger.Debug("腾讯云短信已受理",
+		zap.String("provider", p.name),
+		zap.String("request_id", resp.RequestID),
+		zap.Int("count", len(idx)),
+	)
+}
+
+// templateParams 按模板变量顺序生成 TemplateParamSet
+// 经平台模板审核提交的模板按提交时的变量顺序取值，未传的可选变量填空串；
+// 配置了 template_param_names 的模板按配置顺序取值，否则要求变量名为位置序号 "1"、"2"...
+func (p *Provider) templateParams(t domain.Template) ([]string, error) {
+	if len(t.ParamNames) > 0 {
+		out := make([]string, 0, len(t.ParamNames))
+		for _, name := range t.ParamNames {
+			out = append(out, t.Params[name])
+		}
+		return out, nil
+	}
+	if names, ok := p.paramNames[t.ID]; ok {
+		out := make([]string, 0, len(names))
+		for _, name := range names {
+			v, ok := t.Params[name]
+			if !ok {
+				return nil, fmt.Errorf("template %s: missing param %q", t.ID, name)
+			}
+			out = append(out, v)
+		}
+		return out, nil
+	}
+
+	positions := make([]int, 0, len(t.Params))
+	for k := range t.Params {
+		pos, err := strconv.Atoi(k)
+		if err != nil || pos < 1 {
+			return nil, fmt.Errorf("template %s: param %q is not a position and no template_param_names configured", t.ID, k)
+		}
+		positions = append(positions, pos)
+	}
+	sort.Ints(positions)
+	out := make([]string, 0, len(positions))
+	for i, pos := range positions {
+		if pos != i+1 {
+			return nil, fmt.Errorf("template %s: param position %d is missing", t.ID, i+1)
+		}
+		out = append(out, t.Params[strconv.Itoa(pos)])
+	}
+	return out, nil
+}
+
+// normalizePhone 为不带国家码的号码补充默认国家码（E.164）
+func (p *Provider) normalizePhone(phone string) string {
+	if strings.HasPrefix(phone, "+") {
+		return phone
+	}
+	return p.countryCode + phone
+}
+
+// pullStatusRequest PullSmsSendStatusByPhoneNumber 请求
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type pullStatusRequest struct {
+	BeginTime   uint64 `json:"BeginTime"`
+	EndTime     uint64 `json:"EndTime"`
+	Offset      uint64 `json:"Offset"`
+	Limit       uint64 `json:"Limit"`
+	PhoneNumber string `json:"PhoneNumber"`
+	SmsSdkAppID string `json:"SmsSdkAppId"`
+}
+
+// pullStatusResponse PullSmsSendStatusByPhoneNumber 响应
+//
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type pullStatusResponse struct {
+	PullSmsSendStatusSet []struct {
+		UserReceiveTime uint64 `json:"UserReceiveTime"`
+		PhoneNumber     string `json:"PhoneNumber"`
+		SerialNo        string `json:"SerialNo"`
+		ReportStatus    string `json:"ReportStatus"`
+		Description     string `json:"Description"`
+	} `json:"PullSmsSendStatusSet"`
+	RequestID string `json:"RequestId"`
+}
+
+// QueryStatus 按号码拉取发送时间起 24 小时内的回执，按流水号匹配
+func (p *Provider) QueryStatus(ctx context.Context, req provider.QueryRequest) (provider.QueryResult, error) {
+	begin := req.SentAt
+	if begin.IsZero() {
+		begin = time.Now().Add(-queryWindow)
+	}
+	var resp pullStatusResponse
+	err := p.client.call(ctx, "PullSmsSendStatusByPhoneNumber", pullStatusRequest{
+		BeginTime:   uint64(begin.Unix()),
+		EndTime:     uint64(begin.Add(queryWindow).Unix()),
+		Limit:       queryLimit,
+		PhoneNumber: p.normalizePhone(req.Recipient),
+		SmsSdkAppID: p.sdkAppID,
+	}, &resp)
+	if err != nil {
+		return provider.QueryResult{}, p.callError(err)
+	}
+
+	for _, st := range resp.PullSmsSendStatusSet {
+		if st.SerialNo != req.MessageID {
+			continue
+		}
+		result := provider.QueryResult{
+			Status:     provider.DeliveryStatusFailed,
+			Code:       st.ReportStatus,
+			Message:    st.Description,
+			ReportedAt: time.Unix(int64(st.UserReceiveTime), 0),
+		}
+		if st.ReportStatus == "SUCCESS" {
+			result.Status = provider.DeliveryStatusDelivered
+		}
+		return result, nil
+	}
+	return provider.QueryResult{Status: provider.DeliveryStatusUnknown}, nil
+}
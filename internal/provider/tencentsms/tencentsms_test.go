@@ -0,0 +1,123 @@
+package tencentsms_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/provider/tencentsms"
+	"github.com/dingdong-postman/internal/provider/tencentsms/tencentsmstest"
+)
+
+const (
+	testSecretID  = "test-secret-id"
+	testSecretKey = "test-secret-key"
+)
+
+// newProvider 启动替身服务并创建指向它的腾讯云短信供应商，测试结束时关闭替身
+func newProvider(t *testing.T, secretKey string) (provider.Provider, *tencentsmstest.Server) {
+	t.Helper()
+	srv := tencentsmstest.NewServer(testSecretID, testSecretKey)
+	t.Cleanup(srv.Close)
+
+	p, err := tencentsms.New(config.ProviderConfig{
+		Name: "tc",
+		TencentSMS: &config.TencentSMSConfig{
+			Endpoint:        srv.URL,
+			SecretID:        testSecretID,
+			SecretKey:       secretKey,
+			SecretIDEnvVar:  "DINGDONG_TENCENT_TEST_SECRET_ID_UNSET",
+			SecretKeyEnvVar: "DINGDONG_TENCENT_TEST_SECRET_KEY_UNSET",
+			SdkAppID:        "1400000000",
+			SignName:        "叮咚",
+			TemplateParamNames: map[string][]string{
+				"100001": {"code", "minutes"},
+			},
+		},
+	}, appLogger.GetGlobal())
+	if err != nil {
+		t.Fatalf("new tencent sms provider: %v", err)
+	}
+	return p, srv
+}
+
+func notification() domain.Notification {
+	return domain.Notification{
+		ID:        7,
+		Channel:   domain.ChannelSMS,
+		Recipient: "13800138000",
+		Template: domain.Template{
+			ID:     "100001",
+			Params: map[string]string{"minutes": "5", "code": "1234"},
+		},
+	}
+}
+
+func TestSend(t *testing.T) {
+	p, srv := newProvider(t, testSecretKey)
+
+	res, err := p.Send(context.Background(), notification())
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	msgs := srv.Messages()
+	if len(msgs) != 1 {
+		t.Fatalf("stand-in received %d messages, want 1", len(msgs))
+	}
+	got := msgs[0]
+	if res.MessageID == "" || res.MessageID != got.SerialNo {
+		t.Errorf("MessageID = %q, want SerialNo %q", res.MessageID, got.SerialNo)
+	}
+	if got.PhoneNumber != "+8613800138000" {
+		t.Errorf("PhoneNumber = %q, want +8613800138000", got.PhoneNumber)
+	}
+	if got.SignName != "叮咚" || got.TemplateID != "100001" {
+		t.Errorf("SignName/TemplateID = %q/%q, want 叮咚/100001", got.SignName, got.TemplateID)
+	}
+	if want := []string{"1234", "5"}; !slices.Equal(got.TemplateParamSet, want) {
+		t.Errorf("TemplateParamSet = %v, want %v", got.TemplateParamSet, want)
+	}
+}
+
+func TestSendBadSignature(t *testing.T) {
+	p, srv := newProvider(t, "wrong-secret-key")
+
+	_, err := p.Send(context.Background(), notification())
+	var pe *provider.Error
+	if !errors.As(err, &pe) {
+		t.Fatalf("Send() error = %v, want *provider.Error", err)
+	}
+	if pe.Code != "AuthFailure.SignatureFailure" || pe.Category != provider.CategoryPermanent {
+		t.Errorf("error = %s/%s, want AuthFailure.SignatureFailure/%s", pe.Code, pe.Category, provider.CategoryPermanent)
+	}
+	if len(srv.Messages()) != 0 {
+		t.Error("stand-in accepted a request with a bad signature")
+	}
+}
+
+func TestParseReportsUsesChinaTime(t *testing.T) {
+	p, _ := newProvider(t, testSecretKey)
+	receiver := p.(provider.ReportReceiver)
+
+	reports, err := receiver.ParseReports([]byte(`[{"user_receive_time":"2024-01-02 08:00:00","nationcode":"86",` +
+		`"mobile":"13800138000","report_status":"SUCCESS","errmsg":"DELIVRD","description":"用户短信送达成功","sid":"sid-1"}]`))
+	if err != nil {
+		t.Fatalf("ParseReports() error = %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("ParseReports() returned %d reports, want 1", len(reports))
+	}
+	// 回执时间为北京时间，与服务器所在时区无关
+	want := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got := reports[0]; !got.ReportedAt.Equal(want) || got.Status != provider.DeliveryStatusDelivered ||
+		got.Recipient != "+8613800138000" {
+		t.Errorf("ParseReports() = %+v, want delivered to +8613800138000 at %s", got, want)
+	}
+}
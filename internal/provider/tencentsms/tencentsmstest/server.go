@@ -0,0 +1,312 @@
+// Package tencentsmstest 提供本地的腾讯云短信接口替身，用于在不调用真实接口的情况下验证腾讯云短信供应商
+// 替身校验 TC3-HMAC-SHA256 签名，支持 SendSms、PullSmsSendStatusByPhoneNumber 以及 AddSmsTemplate、
+// DescribeSmsTemplateList，可按号码注入错误码，并可设置模板的审核状态
+package tencentsmstest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/provider/tencentsms"
+)
+
+// 模板审核状态：0 审核通过，1 审核中，-1 审核未通过
+const (
+	TemplateApproved = 0
+	TemplatePending  = 1
+	TemplateRejected = -1
+)
+
+// Template 替身收到的一个模板申请
+type Template struct {
+	TemplateID      uint64
+	TemplateName    string
+	TemplateContent string
+	SmsType         uint64
+	International   uint64
+	Remark          string
+	StatusCode      int
+	ReviewReply     string
+}
+
+// Message 替身收到的一条短信
+type Message struct {
+	SerialNo         string
+	PhoneNumber      string
+	SignName         string
+	TemplateID       string
+	TemplateParamSet []string
+	// ReportStatus 回执状态：空表示尚无回执，SUCCESS 或 FAIL
+	ReportStatus string
+	SentAt       time.Time
+}
+
+// Server 腾讯云短信接口替身
+type Server struct {
+	*httptest.Server
+
+	secretID  string
+	secretKey string
+
+	mu        sync.Mutex
+	seq       int
+	messages  []Message
+	templates []Template
+	errors    map[string]string
+}
+
+// NewServer 启动替身服务，只接受使用给定密钥签名的请求
+// 供应商配置中的 endpoint 设置为 Server.URL 即可
+func NewServer(secretID, secretKey string) *Server {
+	s := &Server{
+		secretID:  secretID,
+		secretKey: secretKey,
+		errors:    make(map[string]string),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// SetError 令发往该号码（E.164 格式）的短信返回指定错误码，code 为空时取消
+func (s *Server) SetError(phone, code string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if code == "" {
+		delete(s.errors, phone)
+		return
+	}
+	s.errors[phone] = code
+}
+
+// SetReport 设置某条短信的回执状态（SUCCESS 或 FAIL）
+func (s *Server) SetReport(serialNo, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.messages {
+		if s.messages[i].SerialNo == serialNo {
+			s.messages[i].ReportStatus = status
+		}
+	}
+}
+
+// SetTemplateStatus 设置模板的审核状态与审核回复，供 DescribeSmsTemplateList 返回
+func (s *Server) SetTemplateStatus(templateID uint64, statusCode int, reply string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.templates {
+		if s.templates[i].TemplateID == templateID {
+			s.templates[i].StatusCode = statusCode
+			s.templates[i].ReviewReply = reply
+		}
+	}
+}
+
+// Templates 返回已收到的模板申请
+func (s *Server) Templates() []Template {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Template, len(s.templates))
+	copy(out, s.templates)
+	return out
+}
+
+// Messages 返回已收到的短信
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Message, len(s.messages))
+	copy(out, s.messages)
+	return out
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, "InvalidParameter", err.Error())
+		return
+	}
+	action := r.Header.Get("X-TC-Action")
+	ts, err := strconv.ParseInt(r.Header.Get("X-TC-Timestamp"), 10, 64)
+	if err != nil {
+		writeError(w, "MissingParameter", "X-TC-Timestamp is required")
+		return
+	}
+	want := tencentsms.Authorization(s.secretID, s.secretKey, r.Host, action, payload, time.Unix(ts, 0))
+	if r.Header.Get("Authorization") != want {
+		writeError(w, "AuthFailure.SignatureFailure", "The provided credentials could not be validated.")
+		return
+	}
+
+	switch action {
+	case "SendSms":
+		s.sendSms(w, payload)
+	case "PullSmsSendStatusByPhoneNumber":
+		s.pullStatus(w, payload)
+	case "AddSmsTemplate":
+		s.addTemplate(w, payload)
+	case "DescribeSmsTemplateList":
+		s.describeTemplates(w, payload)
+	default:
+		writeError(w, "InvalidAction", "The requested action does not exist.")
+	}
+}
+
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type sendRequest struct {
+	PhoneNumberSet   []string `json:"PhoneNumberSet"`
+	SignName         string   `json:"SignName"`
+	TemplateID       string   `json:"TemplateId"`
+	TemplateParamSet []string `json:"TemplateParamSet"`
+}
+
+func (s *Server) sendSms(w http.ResponseWriter, payload []byte) {
+	var req sendRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		writeError(w, "InvalidParameter", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	set := make([]map[string]any, 0, len(req.PhoneNumberSet))
+	for _, phone := range req.PhoneNumberSet {
+		if code, ok := s.errors[phone]; ok {
+			set = append(set, map[string]any{"PhoneNumber": phone, "Code": code, "Message": "injected error"})
+			continue
+		}
+		s.seq++
+		serialNo := "2433:" + strconv.Itoa(s.seq)
+		s.messages = append(s.messages, Message{
+			SerialNo:         serialNo,
+			PhoneNumber:      phone,
+			SignName:         req.SignName,
+			TemplateID:       req.TemplateID,
+			TemplateParamSet: req.TemplateParamSet,
+			SentAt:           time.Now(),
+		})
+		set = append(set, map[string]any{
+			"SerialNo":    serialNo,
+			"PhoneNumber": phone,
+			"Fee":         1,
+			"Code":        "Ok",
+			"Message":     "send success",
+		})
+	}
+	writeResponse(w, map[string]any{"SendStatusSet": set})
+}
+
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type pullStatusRequest struct {
+	PhoneNumber string `json:"PhoneNumber"`
+}
+
+func (s *Server) pullStatus(w http.ResponseWriter, payload []byte) {
+	var req pullStatusRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		writeError(w, "InvalidParameter", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	set := make([]map[string]any, 0)
+	for _, m := range s.messages {
+		if m.PhoneNumber != req.PhoneNumber || m.ReportStatus == "" {
+			continue
+		}
+		set = append(set, map[string]any{
+			"UserReceiveTime": m.SentAt.Unix(),
+			"PhoneNumber":     m.PhoneNumber,
+			"SerialNo":        m.SerialNo,
+			"ReportStatus":    m.ReportStatus,
+			"Description":     "DELIVRD",
+		})
+	}
+	writeResponse(w, map[string]any{"PullSmsSendStatusSet": set})
+}
+
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type addTemplateRequest struct {
+	TemplateName    string `json:"TemplateName"`
+	TemplateContent string `json:"TemplateContent"`
+	SmsType         uint64 `json:"SmsType"`
+	International   uint64 `json:"International"`
+	Remark          string `json:"Remark"`
+}
+
+func (s *Server) addTemplate(w http.ResponseWriter, payload []byte) {
+	var req addTemplateRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		writeError(w, "InvalidParameter", err.Error())
+		return
+	}
+	if req.TemplateName == "" || req.TemplateContent == "" {
+		writeError(w, "MissingParameter", "TemplateName and TemplateContent are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	id := uint64(100000 + s.seq) //nolint:gosec // 序号为正数
+	s.templates = append(s.templates, Template{
+		TemplateID:      id,
+		TemplateName:    req.TemplateName,
+		TemplateContent: req.TemplateContent,
+		SmsType:         req.SmsType,
+		International:   req.International,
+		Remark:          req.Remark,
+		StatusCode:      TemplatePending,
+	})
+	writeResponse(w, map[string]any{
+		"AddTemplateStatus": map[string]any{"TemplateId": strconv.FormatUint(id, 10)},
+	})
+}
+
+//nolint:tagliatelle // 字段名由腾讯云接口定义
+type describeTemplatesRequest struct {
+	TemplateIDSet []uint64 `json:"TemplateIdSet"`
+	International uint64   `json:"International"`
+}
+
+func (s *Server) describeTemplates(w http.ResponseWriter, payload []byte) {
+	var req describeTemplatesRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		writeError(w, "InvalidParameter", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	set := make([]map[string]any, 0, len(req.TemplateIDSet))
+	for _, t := range s.templates {
+		if !slices.Contains(req.TemplateIDSet, t.TemplateID) || t.International != req.International {
+			continue
+		}
+		set = append(set, map[string]any{
+			"TemplateId":      t.TemplateID,
+			"International":   t.International,
+			"StatusCode":      t.StatusCode,
+			"ReviewReply":     t.ReviewReply,
+			"TemplateName":    t.TemplateName,
+			"TemplateContent": t.TemplateContent,
+		})
+	}
+	writeResponse(w, map[string]any{"DescribeTemplateStatusSet": set})
+}
+
+func writeResponse(w http.ResponseWriter, resp map[string]any) {
+	resp["RequestId"] = strconv.FormatInt(time.Now().UnixNano(), 10)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"Response": resp})
+}
+
+func writeError(w http.ResponseWriter, code, message string) {
+	writeResponse(w, map[string]any{"Error": map[string]any{"Code": code, "Message": message}})
+}
@@ -0,0 +1,272 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Callback notification_callback 表对应的数据库实体，通知每次进入终态时写入一行
+// 与通知状态在同一事务中写入，事务回滚时回调随之撤销；投递结束后 next_attempt_at 置空
+type Callback struct {
+	ID                 uint64     `gorm:"primaryKey;autoIncrement"`
+	NotificationID     uint64     `gorm:"not null;index:idx_notification"`
+	TenantID           string     `gorm:"type:varchar(64);not null;default:''"`
+	BizKey             string     `gorm:"type:varchar(64);not null"`
+	Channel            string     `gorm:"type:varchar(16);not null"`
+	URL                string     `gorm:"type:varchar(512);not null"`
+	NotificationStatus string     `gorm:"type:varchar(16);not null"`
+	ErrorMessage       string     `gorm:"type:varchar(512)"`
+	Provider           string     `gorm:"type:varchar(64)"`
+	ProviderMessageID  string     `gorm:"type:varchar(128)"`
+	OccurredAt         time.Time  `gorm:"not null"`
+	Status             string     `gorm:"type:varchar(16);not null"`
+	Attempts           int        `gorm:"not null;default:0"`
+	NextAttemptAt      *time.Time `gorm:"index:idx_next_attempt_at"`
+	LastError          string     `gorm:"type:varchar(512)"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// TableName 指定表名
+func (Callback) TableName() string {
+	return "notification_callback"
+}
+
+// CallbackAttempt notification_callback_attempt 表对应的数据库实体，回调的每次投递一行
+type CallbackAttempt struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	CallbackID     uint64    `gorm:"not null;index:idx_callback"`
+	NotificationID uint64    `gorm:"not null;index:idx_notification"`
+	Attempt        int       `gorm:"not null"`
+	URL            string    `gorm:"type:varchar(512);not null"`
+	StatusCode     int       `gorm:"not null;default:0"`
+	Error          string    `gorm:"type:varchar(512)"`
+	DurationMs     int64     `gorm:"not null;default:0"`
+	AttemptedAt    time.Time `gorm:"not null"`
+}
+
+// TableName 指定表名
+func (CallbackAttempt) TableName() string {
+	return "notification_callback_attempt"
+}
+
+// CallbackRepository 回调存储接口，回调由通知进入终态时写入
+type CallbackRepository interface {
+	// ClaimDue 领取最多 limit 条到期的回调：以 FOR UPDATE SKIP LOCKED 锁定到期行，
+	// 投递次数加一并将下次投递时间推迟 lease，多实例之间同一回调只会被一个实例领取
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]domain.Callback, error)
+	// Record 在同一事务中写入投递记录并更新回调：status 为等待投递时按 next 安排重试，否则结束投递
+	Record(ctx context.Context, attempt domain.CallbackAttempt, status domain.CallbackStatus, next time.Time) error
+	// ListByNotification 按回调 ID 升序查询通知的全部回调
+	ListByNotification(ctx context.Context, notificationID uint64) ([]domain.Callback, error)
+	// ListAttempts 按投递记录 ID 升序查询通知的全部投递记录
+	ListAttempts(ctx context.Context, notificationID uint64) ([]domain.CallbackAttempt, error)
+}
+
+// callbackRepository 基于 GORM 的回调存储实现
+type callbackRepository struct {
+	db *gorm.DB
+}
+
+// NewCallbackRepository 创建回调存储
+func NewCallbackRepository(db *gorm.DB) CallbackRepository {
+	return &callbackRepository{
+		db: db,
+	}
+}
+
+// ClaimDue 领取到期的回调
+func (r *callbackRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	lease time.Duration,
+) ([]domain.Callback, error) {
+	var entities []Callback
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("next_attempt_at <= ?", now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&entities).Error
+		if err != nil {
+			return fmt.Errorf("find due callbacks: %w", err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, 0, len(entities))
+		for i := range entities {
+			ids = append(ids, entities[i].ID)
+		}
+		err = tx.Model(&Callback{}).
+			Where("id IN ?", ids).
+			Updates(map[string]any{
+				"attempts":        gorm.Expr("attempts + 1"),
+				"next_attempt_at": now.Add(lease),
+				"updated_at":      now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("claim due callbacks: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	callbacks := make([]domain.Callback, 0, len(entities))
+	for i := range entities {
+		e := entities[i]
+		e.Attempts++
+		next := now.Add(lease)
+		e.NextAttemptAt = &next
+		callbacks = append(callbacks, toDomainCallback(e))
+	}
+	return callbacks, nil
+}
+
+// Record 写入投递记录并更新回调
+func (r *callbackRepository) Record(
+	ctx context.Context,
+	attempt domain.CallbackAttempt,
+	status domain.CallbackStatus,
+	next time.Time,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		entity := CallbackAttempt{
+			CallbackID:     attempt.CallbackID,
+			NotificationID: attempt.NotificationID,
+			Attempt:        attempt.Attempt,
+			URL:            attempt.URL,
+			StatusCode:     attempt.StatusCode,
+			Error:          truncate(attempt.Error, maxErrorMessageLen),
+			DurationMs:     attempt.Duration.Milliseconds(),
+			AttemptedAt:    attempt.AttemptedAt,
+		}
+		if err := tx.Create(&entity).Error; err != nil {
+			return fmt.Errorf("create callback attempt: %w", err)
+		}
+
+		updates := map[string]any{
+			"status":     string(status),
+			"last_error": entity.Error,
+			"updated_at": time.Now(),
+		}
+		if status == domain.CallbackStatusPending {
+			updates["next_attempt_at"] = next
+		} else {
+			updates["next_attempt_at"] = nil
+		}
+		if err := tx.Model(&Callback{}).Where("id = ?", attempt.CallbackID).Updates(updates).Error; err != nil {
+			return fmt.Errorf("update callback: %w", err)
+		}
+		return nil
+	})
+}
+
+// ListByNotification 查询通知的全部回调
+func (r *callbackRepository) ListByNotification(ctx context.Context, notificationID uint64) ([]domain.Callback, error) {
+	var entities []Callback
+	err := r.db.WithContext(ctx).
+		Where("notification_id = ?", notificationID).
+		Order("id ASC").
+		Find(&entities).Error
+	if err != nil {
+		return nil, fmt.Errorf("list callbacks: %w", err)
+	}
+	callbacks := make([]domain.Callback, 0, len(entities))
+	for i := range entities {
+		callbacks = append(callbacks, toDomainCallback(entities[i]))
+	}
+	return callbacks, nil
+}
+
+// ListAttempts 查询通知的全部投递记录
+func (r *callbackRepository) ListAttempts(ctx context.Context, notificationID uint64) ([]domain.CallbackAttempt, error) {
+	var entities []CallbackAttempt
+	err := r.db.WithContext(ctx).
+		Where("notification_id = ?", notificationID).
+		Order("id ASC").
+		Find(&entities).Error
+	if err != nil {
+		return nil, fmt.Errorf("list callback attempts: %w", err)
+	}
+	attempts := make([]domain.CallbackAttempt, 0, len(entities))
+	for i := range entities {
+		e := entities[i]
+		attempts = append(attempts, domain.CallbackAttempt{
+			ID:             e.ID,
+			CallbackID:     e.CallbackID,
+			NotificationID: e.NotificationID,
+			Attempt:        e.Attempt,
+			URL:            e.URL,
+			StatusCode:     e.StatusCode,
+			Error:          e.Error,
+			Duration:       time.Duration(e.DurationMs) * time.Millisecond,
+			AttemptedAt:    e.AttemptedAt,
+		})
+	}
+	return attempts, nil
+}
+
+// recordCallback 通知进入终态且设置了回调地址时写入一条立即投递的回调，tx 须为写入通知状态的事务
+func recordCallback(tx *gorm.DB, n Notification) error {
+	if n.CallbackURL == "" || !domain.SendStatus(n.Status).IsFinal() {
+		return nil
+	}
+	next := n.UpdatedAt
+	callback := Callback{
+		NotificationID:     n.ID,
+		TenantID:           n.TenantID,
+		BizKey:             n.BizKey,
+		Channel:            n.Channel,
+		URL:                n.CallbackURL,
+		NotificationStatus: n.Status,
+		ErrorMessage:       n.ErrorMessage,
+		Provider:           n.Provider,
+		ProviderMessageID:  n.ProviderMessageID,
+		OccurredAt:         n.UpdatedAt,
+		Status:             string(domain.CallbackStatusPending),
+		NextAttemptAt:      &next,
+	}
+	if err := tx.Create(&callback).Error; err != nil {
+		return fmt.Errorf("create callback: %w", err)
+	}
+	return nil
+}
+
+// toDomainCallback 将数据库实体转换为领域对象
+func toDomainCallback(entity Callback) domain.Callback {
+	var next time.Time
+	if entity.NextAttemptAt != nil {
+		next = *entity.NextAttemptAt
+	}
+	return domain.Callback{
+		ID:                 entity.ID,
+		NotificationID:     entity.NotificationID,
+		TenantID:           entity.TenantID,
+		BizKey:             entity.BizKey,
+		Channel:            domain.Channel(entity.Channel),
+		URL:                entity.URL,
+		NotificationStatus: domain.SendStatus(entity.NotificationStatus),
+		ErrorMessage:       entity.ErrorMessage,
+		Receipt: domain.Receipt{
+			Provider:  entity.Provider,
+			MessageID: entity.ProviderMessageID,
+		},
+		OccurredAt:    entity.OccurredAt,
+		Status:        domain.CallbackStatus(entity.Status),
+		Attempts:      entity.Attempts,
+		NextAttemptAt: next,
+		LastError:     entity.LastError,
+		CreatedAt:     entity.CreatedAt,
+		UpdatedAt:     entity.UpdatedAt,
+	}
+}
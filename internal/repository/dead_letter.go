@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DeadLetter notification_dead_letter 表对应的数据库实体，每条处于死信中的通知一行
+// 通知内容仍保存在 notification 表，这里只记录失败信息，便于筛选与排查
+type DeadLetter struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	NotificationID uint64    `gorm:"not null;uniqueIndex:uk_notification"`
+	BizKey         string    `gorm:"type:varchar(64);not null"`
+	Channel        string    `gorm:"type:varchar(16);not null;index:idx_channel_created,priority:1"`
+	Recipient      string    `gorm:"type:varchar(256);not null"`
+	Attempts       int       `gorm:"not null"`
+	ErrorCategory  string    `gorm:"type:varchar(16)"`
+	LastError      string    `gorm:"type:varchar(512)"`
+	Provider       string    `gorm:"type:varchar(64)"`
+	CreatedAt      time.Time `gorm:"index:idx_channel_created,priority:2"`
+	UpdatedAt      time.Time
+}
+
+// TableName 指定表名
+func (DeadLetter) TableName() string {
+	return "notification_dead_letter"
+}
+
+// DeadLetterRepository 死信存储接口
+type DeadLetterRepository interface {
+	// Bury 在同一事务中将通知标记为发送失败、删除重试计划并写入死信
+	Bury(ctx context.Context, dl domain.DeadLetter, receipt domain.Receipt) error
+	// List 按通知 ID 升序查询符合条件的死信
+	List(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error)
+	// FindByNotificationID 按通知 ID 查询死信，不存在时返回 domain.ErrDeadLetterNotFound
+	FindByNotificationID(ctx context.Context, id uint64) (domain.DeadLetter, error)
+	// UpdateContent 修改死信通知的接收者、模板与邮件内容；通知不在死信中时返回 domain.ErrDeadLetterNotFound
+	UpdateContent(ctx context.Context, n domain.Notification) (domain.DeadLetter, error)
+	// Requeue 在同一事务中删除死信并将通知重新置为待发送，返回重新入队的通知；
+	// 通知不在死信中时返回 domain.ErrDeadLetterNotFound
+	Requeue(ctx context.Context, id uint64) (domain.Notification, error)
+}
+
+// deadLetterRepository 基于 GORM 的死信存储实现
+type deadLetterRepository struct {
+	db *gorm.DB
+}
+
+// NewDeadLetterRepository 创建死信存储
+func NewDeadLetterRepository(db *gorm.DB) DeadLetterRepository {
+	return &deadLetterRepository{
+		db: db,
+	}
+}
+
+// Bury 将通知移入死信
+func (r *deadLetterRepository) Bury(ctx context.Context, dl domain.DeadLetter, receipt domain.Receipt) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateSendResult(tx, dl.NotificationID, domain.SendStatusFailed, receipt, dl.LastError); err != nil {
+			return err
+		}
+		if err := tx.Where("notification_id = ?", dl.NotificationID).Delete(&Retry{}).Error; err != nil {
+			return fmt.Errorf("delete retry: %w", err)
+		}
+		entity := DeadLetter{
+			NotificationID: dl.NotificationID,
+			BizKey:         dl.BizKey,
+			Channel:        string(dl.Channel),
+			Recipient:      dl.Recipient,
+			Attempts:       dl.Attempts,
+			ErrorCategory:  dl.ErrorCategory,
+			LastError:      truncate(dl.LastError, maxErrorMessageLen),
+			Provider:       receipt.Provider,
+		}
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "notification_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"recipient", "attempts", "error_category", "last_error", "provider", "created_at", "updated_at",
+			}),
+		}).Create(&entity).Error
+		if err != nil {
+			return fmt.Errorf("create dead letter: %w", err)
+		}
+		return nil
+	})
+}
+
+// List 按通知 ID 升序查询死信
+func (r *deadLetterRepository) List(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	query := r.db.WithContext(ctx).Where("notification_id > ?", filter.AfterID)
+	if filter.Channel != "" {
+		query = query.Where("channel = ?", string(filter.Channel))
+	}
+	if filter.ErrorCategory != "" {
+		query = query.Where("error_category = ?", filter.ErrorCategory)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		query = query.Where("created_at >= ?", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", filter.CreatedBefore)
+	}
+
+	var entities []DeadLetter
+	if err := query.Order("notification_id ASC").Limit(filter.Limit).Find(&entities).Error; err != nil {
+		return nil, fmt.Errorf("list dead letters: %w", err)
+	}
+	dls := make([]domain.DeadLetter, 0, len(entities))
+	for i := range entities {
+		dls = append(dls, toDomainDeadLetter(entities[i]))
+	}
+	return dls, nil
+}
+
+// FindByNotificationID 按通知 ID 查询死信
+func (r *deadLetterRepository) FindByNotificationID(ctx context.Context, id uint64) (domain.DeadLetter, error) {
+	var entity DeadLetter
+	err := r.db.WithContext(ctx).Where("notification_id = ?", id).First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.DeadLetter{}, domain.ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return domain.DeadLetter{}, fmt.Errorf("find dead letter: %w", err)
+	}
+	return toDomainDeadLetter(entity), nil
+}
+
+// UpdateContent 修改死信通知的内容；锁定死信行，避免与重新入队并发执行
+func (r *deadLetterRepository) UpdateContent(ctx context.Context, n domain.Notification) (domain.DeadLetter, error) {
+	content, err := toEntity(n)
+	if err != nil {
+		return domain.DeadLetter{}, err
+	}
+
+	var entity DeadLetter
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Where("notification_id = ?", n.ID).
+			First(&entity).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ErrDeadLetterNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("lock dead letter: %w", err)
+		}
+
+		now := time.Now()
+		err = tx.Model(&Notification{}).
+			Where("id = ?", n.ID).
+			Updates(map[string]any{
+				"recipient":        content.Recipient,
+				"template_id":      content.TemplateID,
+				"template_version": content.TemplateVersion,
+				"template_locale":  content.TemplateLocale,
+				"template_params":  content.TemplateParams,
+				"email_content":    content.EmailContent,
+				"updated_at":       now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("update notification content: %w", err)
+		}
+
+		entity.Recipient = n.Recipient
+		entity.UpdatedAt = now
+		err = tx.Model(&DeadLetter{}).
+			Where("id = ?", entity.ID).
+			Updates(map[string]any{
+				"recipient":  entity.Recipient,
+				"updated_at": entity.UpdatedAt,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("update dead letter: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.DeadLetter{}, err
+	}
+	return toDomainDeadLetter(entity), nil
+}
+
+// Requeue 删除死信并将通知重新置为待发送
+func (r *deadLetterRepository) Requeue(ctx context.Context, id uint64) (domain.Notification, error) {
+	var entity Notification
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("notification_id = ?", id).Delete(&DeadLetter{})
+		if res.Error != nil {
+			return fmt.Errorf("delete dead letter: %w", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return domain.ErrDeadLetterNotFound
+		}
+
+		cleared := ""
+		if _, err := changeStatus(tx, id, statusChange{to: domain.SendStatusPending, errMsg: &cleared}); err != nil {
+			return fmt.Errorf("requeue notification: %w", err)
+		}
+		if err := tx.Where("id = ?", id).First(&entity).Error; err != nil {
+			return fmt.Errorf("find notification: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return toDomain(entity)
+}
+
+// toDomainDeadLetter 将数据库实体转换为领域对象
+func toDomainDeadLetter(entity DeadLetter) domain.DeadLetter {
+	return domain.DeadLetter{
+		NotificationID: entity.NotificationID,
+		BizKey:         entity.BizKey,
+		Channel:        domain.Channel(entity.Channel),
+		Recipient:      entity.Recipient,
+		Attempts:       entity.Attempts,
+		ErrorCategory:  entity.ErrorCategory,
+		LastError:      entity.LastError,
+		Provider:       entity.Provider,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+}
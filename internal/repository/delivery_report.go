@@ -0,0 +1,298 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// maxReportMatches 按消息 ID 匹配通知时最多读取的行数，不小于供应商单次批量发送的号码数
+	maxReportMatches = 200
+	// maxReportCodeLen 与 code 列宽保持一致
+	maxReportCodeLen = 64
+)
+
+// DeliveryReport notification_delivery_report 表对应的数据库实体，供应商的每条投递回执一行
+// 尚未找到对应通知的回执 notification_id 为 0，由回执拉取器在重新匹配窗口内再次匹配
+type DeliveryReport struct {
+	ID             uint64 `gorm:"primaryKey;autoIncrement"`
+	NotificationID uint64 `gorm:"not null;default:0;index:idx_notification"`
+	Provider       string `gorm:"type:varchar(64);not null;index:idx_provider_message,priority:1"`
+	MessageID      string `gorm:"type:varchar(128);not null;index:idx_provider_message,priority:2"`
+	Recipient      string `gorm:"type:varchar(256);not null;default:''"`
+	Status         string `gorm:"type:varchar(16);not null"`
+	Code           string `gorm:"type:varchar(64)"`
+	Message        string `gorm:"type:varchar(512)"`
+	Source         string `gorm:"type:varchar(8);not null"`
+	Outcome        string `gorm:"type:varchar(16);not null;index:idx_outcome_created_at,priority:1"`
+	ReportedAt     *time.Time
+	CreatedAt      time.Time `gorm:"index:idx_outcome_created_at,priority:2"`
+	UpdatedAt      time.Time
+}
+
+// TableName 指定表名
+func (DeliveryReport) TableName() string {
+	return "notification_delivery_report"
+}
+
+// DeliveryReportRepository 投递回执存储接口
+type DeliveryReportRepository interface {
+	// Apply 记录一条回执，并按供应商与消息 ID（同一消息 ID 对应多条通知时再按接收者）匹配通知：
+	// 通知处于已成功提交状态时在同一事务中改为回执对应的状态，返回匹配到的通知 ID（未匹配时为 0）与处理结果
+	Apply(ctx context.Context, report domain.DeliveryReport) (uint64, domain.ReportOutcome, error)
+	// Rematch 按 ID 升序重新匹配最多 limit 条 since 之后收到的未匹配回执，返回本次匹配到通知的回执数
+	Rematch(ctx context.Context, since time.Time, limit int) (int, error)
+	// ClaimPollable 领取最多 limit 条等待拉取回执的通知：providers 发送、已成功提交 interval 以上且不超过 maxAge、
+	// 距上次拉取超过 interval 的通知，以 FOR UPDATE SKIP LOCKED 锁定后记录拉取时间，多实例之间同一通知只会被一个实例领取
+	ClaimPollable(
+		ctx context.Context,
+		now time.Time,
+		providers []string,
+		limit int,
+		interval, maxAge time.Duration,
+	) ([]domain.Notification, error)
+}
+
+// deliveryReportRepository 基于 GORM 的投递回执存储实现
+type deliveryReportRepository struct {
+	db *gorm.DB
+}
+
+// NewDeliveryReportRepository 创建投递回执存储
+func NewDeliveryReportRepository(db *gorm.DB) DeliveryReportRepository {
+	return &deliveryReportRepository{
+		db: db,
+	}
+}
+
+// Apply 记录回执并更新对应的通知
+func (r *deliveryReportRepository) Apply(
+	ctx context.Context,
+	report domain.DeliveryReport,
+) (uint64, domain.ReportOutcome, error) {
+	entity := DeliveryReport{
+		Provider:  report.Provider,
+		MessageID: report.MessageID,
+		Recipient: report.Recipient,
+		Status:    string(report.Status),
+		Code:      truncate(report.Code, maxReportCodeLen),
+		Message:   truncate(report.Message, maxErrorMessageLen),
+		Source:    string(report.Source),
+	}
+	if !report.ReportedAt.IsZero() {
+		reportedAt := report.ReportedAt
+		entity.ReportedAt = &reportedAt
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := applyReport(tx, &entity); err != nil {
+			return err
+		}
+		if err := tx.Create(&entity).Error; err != nil {
+			return fmt.Errorf("create delivery report: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, "", err
+	}
+	return entity.NotificationID, domain.ReportOutcome(entity.Outcome), nil
+}
+
+// Rematch 重新匹配未匹配的回执
+func (r *deliveryReportRepository) Rematch(ctx context.Context, since time.Time, limit int) (int, error) {
+	var ids []uint64
+	err := r.db.WithContext(ctx).Model(&DeliveryReport{}).
+		Where("outcome = ? AND created_at >= ?", string(domain.ReportOutcomeUnmatched), since).
+		Order("id ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, fmt.Errorf("find unmatched delivery reports: %w", err)
+	}
+
+	matched := 0
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return matched, err
+		}
+		ok, err := r.rematch(ctx, id)
+		if err != nil {
+			return matched, err
+		}
+		if ok {
+			matched++
+		}
+	}
+	return matched, nil
+}
+
+// rematch 重新匹配一条未匹配的回执，返回是否匹配到通知；回执已被其他实例锁定或已匹配时返回 false
+func (r *deliveryReportRepository) rematch(ctx context.Context, id uint64) (bool, error) {
+	var matched bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var entity DeliveryReport
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("id = ? AND outcome = ?", id, string(domain.ReportOutcomeUnmatched)).
+			Take(&entity).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("lock delivery report: %w", err)
+		}
+
+		if err := applyReport(tx, &entity); err != nil {
+			return err
+		}
+		if entity.NotificationID == 0 {
+			return nil
+		}
+		err = tx.Model(&DeliveryReport{}).Where("id = ?", id).Updates(map[string]any{
+			"notification_id": entity.NotificationID,
+			"outcome":         entity.Outcome,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("update delivery report: %w", err)
+		}
+		matched = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return matched, nil
+}
+
+// ClaimPollable 领取等待拉取回执的通知
+func (r *deliveryReportRepository) ClaimPollable(
+	ctx context.Context,
+	now time.Time,
+	providers []string,
+	limit int,
+	interval, maxAge time.Duration,
+) ([]domain.Notification, error) {
+	if len(providers) == 0 {
+		return nil, nil
+	}
+	var entities []Notification
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		polledBefore := now.Add(-interval)
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("status = ?", string(domain.SendStatusSucceeded)).
+			Where("updated_at BETWEEN ? AND ?", now.Add(-maxAge), polledBefore).
+			Where("provider IN ? AND provider_message_id <> ''", providers).
+			Where("report_polled_at IS NULL OR report_polled_at <= ?", polledBefore).
+			Order("updated_at ASC").
+			Limit(limit).
+			Find(&entities).Error
+		if err != nil {
+			return fmt.Errorf("find pollable notifications: %w", err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, 0, len(entities))
+		for i := range entities {
+			ids = append(ids, entities[i].ID)
+		}
+		// 不更新 updated_at：已成功提交的通知以 updated_at 作为提交给供应商的时间
+		err = tx.Model(&Notification{}).
+			Where("id IN ?", ids).
+			UpdateColumns(map[string]any{
+				"report_polls":     gorm.Expr("report_polls + 1"),
+				"report_polled_at": now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("claim pollable notifications: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ns := make([]domain.Notification, 0, len(entities))
+	for i := range entities {
+		n, err := toDomain(entities[i])
+		if err != nil {
+			return nil, err
+		}
+		ns = append(ns, n)
+	}
+	return ns, nil
+}
+
+// applyReport 按回执匹配通知并在通知处于已成功提交状态时更新状态，将匹配结果写回 report，tx 须为事务
+func applyReport(tx *gorm.DB, report *DeliveryReport) error {
+	var candidates []Notification
+	err := tx.Select("id", "recipient").
+		Where("provider = ? AND provider_message_id = ?", report.Provider, report.MessageID).
+		Order("id ASC").
+		Limit(maxReportMatches).
+		Find(&candidates).Error
+	if err != nil {
+		return fmt.Errorf("find notifications by delivery report: %w", err)
+	}
+	id, ok := matchReport(candidates, report.Recipient)
+	if !ok {
+		report.Outcome = string(domain.ReportOutcomeUnmatched)
+		return nil
+	}
+
+	change := statusChange{
+		from: domain.SendStatusSucceeded,
+		to:   domain.SendStatus(report.Status),
+	}
+	if change.to == domain.SendStatusFailed {
+		errMsg := reportError(report)
+		change.errMsg = &errMsg
+	}
+	changed, err := changeStatus(tx, id, change)
+	if err != nil {
+		return err
+	}
+	report.NotificationID = id
+	report.Outcome = string(domain.ReportOutcomeIgnored)
+	if changed {
+		report.Outcome = string(domain.ReportOutcomeApplied)
+	}
+	return nil
+}
+
+// matchReport 从消息 ID 相同的通知中选出回执对应的一条：只有一条时直接使用，多条时按接收者区分；
+// 供应商回执中的手机号可能不带国家码，接收者以一方为另一方的后缀即视为相同
+func matchReport(candidates []Notification, recipient string) (uint64, bool) {
+	if len(candidates) == 1 {
+		return candidates[0].ID, true
+	}
+	if recipient == "" {
+		return 0, false
+	}
+	for i := range candidates {
+		c := candidates[i].Recipient
+		if strings.EqualFold(c, recipient) || strings.HasSuffix(c, recipient) || strings.HasSuffix(recipient, c) {
+			return candidates[i].ID, true
+		}
+	}
+	return 0, false
+}
+
+// reportError 将未送达回执的状态码与描述作为通知的失败原因
+func reportError(report *DeliveryReport) string {
+	msg := "delivery report: undelivered"
+	if report.Code != "" {
+		msg += " (" + report.Code + ")"
+	}
+	if report.Message != "" {
+		msg += ": " + report.Message
+	}
+	return msg
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// legacyBizKeyIndex 业务键改为租户内唯一之前的全局唯一索引
+	legacyBizKeyIndex = "uk_biz_key"
+	// legacyApprovalIndex 审核记录按语言版本区分之前的唯一索引
+	legacyApprovalIndex = "uk_template_version_provider"
+)
+
+// InitTables 自动迁移通知平台使用的数据表
+func InitTables(db *gorm.DB) error {
+	err := db.AutoMigrate(
+		&Notification{},
+		&SendAttempt{},
+		&Retry{},
+		&DeadLetter{},
+		&Tenant{},
+		&TenantAPIKey{},
+		&TenantUsage{},
+		&MessageTemplate{},
+		&MessageTemplateVersion{},
+		&TemplateApproval{},
+		&RecipientProfile{},
+		&StatusEvent{},
+		&Prepared{},
+		&Callback{},
+		&CallbackAttempt{},
+		&DeliveryReport{},
+	)
+	if err != nil {
+		return err
+	}
+	// AutoMigrate 不会删除索引，旧的全局唯一索引会让不同租户无法使用相同的业务键，
+	// 旧的审核记录唯一索引会让同一模板版本的多个语言版本无法在同一供应商处各有一条记录
+	if err := dropLegacyIndex(db, &Notification{}, legacyBizKeyIndex); err != nil {
+		return err
+	}
+	return dropLegacyIndex(db, &TemplateApproval{}, legacyApprovalIndex)
+}
+
+// dropLegacyIndex 删除已废弃的索引，索引不存在时不做任何事
+func dropLegacyIndex(db *gorm.DB, model any, name string) error {
+	if m := db.Migrator(); m.HasIndex(model, name) {
+		if err := m.DropIndex(model, name); err != nil {
+			return fmt.Errorf("drop legacy index %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,408 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Notification notification 表对应的数据库实体
+type Notification struct {
+	ID                uint64     `gorm:"primaryKey;autoIncrement"`
+	TenantID          string     `gorm:"type:varchar(64);not null;default:'';uniqueIndex:uk_tenant_biz_key,priority:1"`
+	BizKey            string     `gorm:"type:varchar(64);not null;uniqueIndex:uk_tenant_biz_key,priority:2"`
+	Channel           string     `gorm:"type:varchar(16);not null"`
+	Recipient         string     `gorm:"type:varchar(256);not null"`
+	TemplateID        string     `gorm:"type:varchar(64);not null"`
+	TemplateParams    string     `gorm:"type:text"`
+	TemplateVersion   int        `gorm:"not null;default:0"`
+	TemplateLocale    string     `gorm:"type:varchar(35);not null;default:''"`
+	Locale            string     `gorm:"type:varchar(35);not null;default:''"`
+	EmailContent      string     `gorm:"type:mediumtext"`
+	SignName          string     `gorm:"type:varchar(64)"`
+	EmailFrom         string     `gorm:"type:varchar(320)"`
+	Status            string     `gorm:"type:varchar(16);not null;index:idx_status;index:idx_status_scheduled_at,priority:1;index:idx_status_updated_at,priority:1;index:idx_status_sending_since,priority:1"`
+	ErrorMessage      string     `gorm:"type:varchar(512)"`
+	Provider          string     `gorm:"type:varchar(64)"`
+	ProviderMessageID string     `gorm:"type:varchar(128);index:idx_provider_message"`
+	ScheduledAt       *time.Time `gorm:"index:idx_status_scheduled_at,priority:2"`
+	SendingSince      *time.Time `gorm:"index:idx_status_sending_since,priority:2"`
+	Transactional     bool       `gorm:"not null;default:false"`
+	CallbackURL       string     `gorm:"type:varchar(512);not null;default:''"`
+	ReportPolls       int        `gorm:"not null;default:0"`
+	ReportPolledAt    *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time `gorm:"index:idx_status_updated_at,priority:2"`
+}
+
+// TableName 指定表名
+func (Notification) TableName() string {
+	return "notification"
+}
+
+// maxErrorMessageLen 与 error_message 列宽保持一致
+const maxErrorMessageLen = 512
+
+// NotificationRepository 通知存储接口
+type NotificationRepository interface {
+	// Create 保存一条通知，返回带 ID 的通知；同一租户下业务键已存在时返回 domain.ErrDuplicateBizKey
+	Create(ctx context.Context, n domain.Notification) (domain.Notification, error)
+	// UpdateSendResult 回写发送结果：状态、供应商回执与失败原因
+	UpdateSendResult(ctx context.Context, id uint64, status domain.SendStatus, receipt domain.Receipt, errMsg string) error
+	// FindByID 按通知 ID 查询
+	FindByID(ctx context.Context, id uint64) (domain.Notification, error)
+	// FindByBizKey 按租户与业务键查询
+	FindByBizKey(ctx context.Context, tenantID, bizKey string) (domain.Notification, error)
+	// FindPending 按 ID 升序查询最多 limit 条待发送的通知
+	FindPending(ctx context.Context, limit int) ([]domain.Notification, error)
+	// ClaimPending 将待发送的通知抢占为发送中，返回是否抢占成功
+	ClaimPending(ctx context.Context, id uint64) (bool, error)
+	// ClaimRetrying 将重试中的通知抢占为发送中，返回是否抢占成功
+	ClaimRetrying(ctx context.Context, id uint64) (bool, error)
+	// ReclaimSending 将进入发送中早于 before 仍未回写结果的通知（如进程在发送途中退出）改回待发送，
+	// 存在重试计划的改回重试中；最多处理 limit 条，返回改回的数量
+	ReclaimSending(ctx context.Context, before time.Time, limit int) (int, error)
+	// FindDueScheduled 按发送时间升序查询最多 limit 条发送时间不晚于 now 的定时通知
+	FindDueScheduled(ctx context.Context, now time.Time, limit int) ([]domain.Notification, error)
+	// ReleaseScheduled 将定时通知改为待发送，返回是否成功；已取消或已被其他实例释放时返回 false
+	ReleaseScheduled(ctx context.Context, id uint64) (bool, error)
+	// CancelScheduled 将定时通知改为已取消，返回是否成功；已释放发送或已取消时返回 false
+	CancelScheduled(ctx context.Context, id uint64) (bool, error)
+}
+
+// notificationRepository 基于 GORM 的通知存储实现
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository 创建通知存储
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
+
+// Create 保存一条通知
+func (r *notificationRepository) Create(ctx context.Context, n domain.Notification) (domain.Notification, error) {
+	entity, err := toEntity(n)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createNotification(tx, &entity)
+	})
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return toDomain(entity)
+}
+
+// createNotification 保存通知并记录受理时的状态变更事件（以终态受理时一并写入回调），tx 须为事务
+func createNotification(tx *gorm.DB, entity *Notification) error {
+	if err := tx.Create(entity).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return domain.ErrDuplicateBizKey
+		}
+		return fmt.Errorf("create notification: %w", err)
+	}
+	if err := recordStatusEvent(tx, *entity, ""); err != nil {
+		return err
+	}
+	return recordCallback(tx, *entity)
+}
+
+// UpdateSendResult 回写发送结果
+func (r *notificationRepository) UpdateSendResult(
+	ctx context.Context,
+	id uint64,
+	status domain.SendStatus,
+	receipt domain.Receipt,
+	errMsg string,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return updateSendResult(tx, id, status, receipt, errMsg)
+	})
+}
+
+// updateSendResult 回写发送结果并记录状态变更事件，tx 须为事务
+func updateSendResult(tx *gorm.DB, id uint64, status domain.SendStatus, receipt domain.Receipt, errMsg string) error {
+	_, err := changeStatus(tx, id, statusChange{to: status, errMsg: &errMsg, receipt: &receipt})
+	if err != nil {
+		return fmt.Errorf("update notification send result: %w", err)
+	}
+	return nil
+}
+
+// FindByID 按通知 ID 查询
+func (r *notificationRepository) FindByID(ctx context.Context, id uint64) (domain.Notification, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+// FindByBizKey 按租户与业务键查询
+func (r *notificationRepository) FindByBizKey(ctx context.Context, tenantID, bizKey string) (domain.Notification, error) {
+	return r.findOne(ctx, "tenant_id = ? AND biz_key = ?", tenantID, bizKey)
+}
+
+// FindPending 按 ID 升序查询最多 limit 条待发送的通知
+func (r *notificationRepository) FindPending(ctx context.Context, limit int) ([]domain.Notification, error) {
+	var entities []Notification
+	err := r.db.WithContext(ctx).
+		Where("status = ?", string(domain.SendStatusPending)).
+		Order("id ASC").
+		Limit(limit).
+		Find(&entities).Error
+	if err != nil {
+		return nil, fmt.Errorf("find pending notifications: %w", err)
+	}
+	ns := make([]domain.Notification, 0, len(entities))
+	for i := range entities {
+		n, err := toDomain(entities[i])
+		if err != nil {
+			return nil, err
+		}
+		ns = append(ns, n)
+	}
+	return ns, nil
+}
+
+// ClaimPending 通过条件更新将通知从待发送改为发送中，多个 worker 或实例之间只有一个能成功
+func (r *notificationRepository) ClaimPending(ctx context.Context, id uint64) (bool, error) {
+	return r.transit(ctx, id, domain.SendStatusPending, domain.SendStatusSending)
+}
+
+// ClaimRetrying 通过条件更新将通知从重试中改为发送中，重试计划的租约过期后被重复领取时只有一个实例能成功
+func (r *notificationRepository) ClaimRetrying(ctx context.Context, id uint64) (bool, error) {
+	return r.transit(ctx, id, domain.SendStatusRetrying, domain.SendStatusSending)
+}
+
+// ReclaimSending 以 FOR UPDATE SKIP LOCKED 锁定超时的发送中通知并逐条改回，多实例之间同一通知只会被一个实例改回
+func (r *notificationRepository) ReclaimSending(ctx context.Context, before time.Time, limit int) (int, error) {
+	var reclaimed int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uint64
+		err := tx.Model(&Notification{}).
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("status = ? AND sending_since < ?", string(domain.SendStatusSending), before).
+			Order("sending_since ASC").
+			Limit(limit).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return fmt.Errorf("find stale sending notifications: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		var retrying []uint64
+		if err := tx.Model(&Retry{}).Where("notification_id IN ?", ids).Pluck("notification_id", &retrying).Error; err != nil {
+			return fmt.Errorf("find retries of stale sending notifications: %w", err)
+		}
+		scheduled := make(map[uint64]struct{}, len(retrying))
+		for _, id := range retrying {
+			scheduled[id] = struct{}{}
+		}
+		for _, id := range ids {
+			to := domain.SendStatusPending
+			if _, ok := scheduled[id]; ok {
+				to = domain.SendStatusRetrying
+			}
+			changed, err := changeStatus(tx, id, statusChange{from: domain.SendStatusSending, to: to})
+			if err != nil {
+				return err
+			}
+			if changed {
+				reclaimed++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reclaimed, nil
+}
+
+// FindDueScheduled 按发送时间升序查询最多 limit 条到期的定时通知
+func (r *notificationRepository) FindDueScheduled(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]domain.Notification, error) {
+	var entities []Notification
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND scheduled_at <= ?", string(domain.SendStatusScheduled), now).
+		Order("scheduled_at ASC").
+		Limit(limit).
+		Find(&entities).Error
+	if err != nil {
+		return nil, fmt.Errorf("find due scheduled notifications: %w", err)
+	}
+	ns := make([]domain.Notification, 0, len(entities))
+	for i := range entities {
+		n, err := toDomain(entities[i])
+		if err != nil {
+			return nil, err
+		}
+		ns = append(ns, n)
+	}
+	return ns, nil
+}
+
+// ReleaseScheduled 通过条件更新将定时通知改为待发送，与取消互斥，多个实例之间只有一个能成功
+func (r *notificationRepository) ReleaseScheduled(ctx context.Context, id uint64) (bool, error) {
+	return r.transit(ctx, id, domain.SendStatusScheduled, domain.SendStatusPending)
+}
+
+// CancelScheduled 通过条件更新将定时通知改为已取消，与释放发送互斥
+func (r *notificationRepository) CancelScheduled(ctx context.Context, id uint64) (bool, error) {
+	return r.transit(ctx, id, domain.SendStatusScheduled, domain.SendStatusCanceled)
+}
+
+// transit 仅当通知处于 from 状态时改为 to 状态并记录状态变更事件，返回是否更新成功
+func (r *notificationRepository) transit(ctx context.Context, id uint64, from, to domain.SendStatus) (bool, error) {
+	var changed bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		changed, err = changeStatus(tx, id, statusChange{from: from, to: to})
+		return err
+	})
+	if err != nil {
+		return false, fmt.Errorf("update notification status from %s to %s: %w", from, to, err)
+	}
+	return changed, nil
+}
+
+func (r *notificationRepository) findOne(ctx context.Context, query string, args ...any) (domain.Notification, error) {
+	var entity Notification
+	err := r.db.WithContext(ctx).Where(query, args...).First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Notification{}, domain.ErrNotificationNotFound
+	}
+	if err != nil {
+		return domain.Notification{}, fmt.Errorf("find notification: %w", err)
+	}
+	return toDomain(entity)
+}
+
+// toEntity 将领域对象转换为数据库实体
+func toEntity(n domain.Notification) (Notification, error) {
+	params, err := json.Marshal(n.Template.Params)
+	if err != nil {
+		return Notification{}, fmt.Errorf("marshal template params: %w", err)
+	}
+	var email []byte
+	if n.Email != nil {
+		if email, err = json.Marshal(n.Email); err != nil {
+			return Notification{}, fmt.Errorf("marshal email content: %w", err)
+		}
+	}
+	var scheduledAt *time.Time
+	if !n.ScheduledAt.IsZero() {
+		scheduledAt = &n.ScheduledAt
+	}
+	var sendingSince *time.Time
+	if n.Status == domain.SendStatusSending {
+		now := time.Now()
+		sendingSince = &now
+	}
+	return Notification{
+		ID:                n.ID,
+		TenantID:          n.TenantID,
+		BizKey:            n.BizKey,
+		Channel:           string(n.Channel),
+		Recipient:         n.Recipient,
+		TemplateID:        n.Template.ID,
+		TemplateParams:    string(params),
+		TemplateVersion:   n.Template.Version,
+		TemplateLocale:    n.Template.Locale,
+		Locale:            n.Locale,
+		EmailContent:      string(email),
+		SignName:          n.Sender.SignName,
+		EmailFrom:         n.Sender.EmailFrom,
+		Status:            string(n.Status),
+		ErrorMessage:      truncate(n.ErrorMessage, maxErrorMessageLen),
+		Provider:          n.Receipt.Provider,
+		ProviderMessageID: n.Receipt.MessageID,
+		ScheduledAt:       scheduledAt,
+		SendingSince:      sendingSince,
+		Transactional:     n.Transactional,
+		CallbackURL:       n.CallbackURL,
+		CreatedAt:         n.CreatedAt,
+		UpdatedAt:         n.UpdatedAt,
+	}, nil
+}
+
+// toDomain 将数据库实体转换为领域对象
+func toDomain(entity Notification) (domain.Notification, error) {
+	var params map[string]string
+	if entity.TemplateParams != "" {
+		if err := json.Unmarshal([]byte(entity.TemplateParams), &params); err != nil {
+			return domain.Notification{}, fmt.Errorf("unmarshal template params: %w", err)
+		}
+	}
+	var email *domain.EmailContent
+	if entity.EmailContent != "" {
+		email = &domain.EmailContent{}
+		if err := json.Unmarshal([]byte(entity.EmailContent), email); err != nil {
+			return domain.Notification{}, fmt.Errorf("unmarshal email content: %w", err)
+		}
+	}
+	var scheduledAt time.Time
+	if entity.ScheduledAt != nil {
+		scheduledAt = *entity.ScheduledAt
+	}
+	return domain.Notification{
+		ID:        entity.ID,
+		TenantID:  entity.TenantID,
+		BizKey:    entity.BizKey,
+		Channel:   domain.Channel(entity.Channel),
+		Recipient: entity.Recipient,
+		Template: domain.Template{
+			ID:      entity.TemplateID,
+			Params:  params,
+			Version: entity.TemplateVersion,
+			Locale:  entity.TemplateLocale,
+		},
+		Locale: entity.Locale,
+		Email:  email,
+		Sender: domain.Sender{
+			SignName:  entity.SignName,
+			EmailFrom: entity.EmailFrom,
+		},
+		Status:       domain.SendStatus(entity.Status),
+		ErrorMessage: entity.ErrorMessage,
+		Receipt: domain.Receipt{
+			Provider:  entity.Provider,
+			MessageID: entity.ProviderMessageID,
+		},
+		ScheduledAt:   scheduledAt,
+		Transactional: entity.Transactional,
+		CallbackURL:   entity.CallbackURL,
+		CreatedAt:     entity.CreatedAt,
+		UpdatedAt:     entity.UpdatedAt,
+	}, nil
+}
+
+// mysqlErrDuplicateEntry MySQL 唯一键冲突的错误码
+const mysqlErrDuplicateEntry = 1062
+
+// isDuplicateKeyError 判断是否为唯一键冲突
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysqlDriver.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
+
+// truncate 按字符截断字符串，避免超出列宽
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Prepared notification_prepared 表对应的数据库实体，每条预提交的两阶段通知一行，记录回查进度
+// 通知提交或取消时在同一事务中删除
+type Prepared struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	NotificationID uint64    `gorm:"not null;uniqueIndex:uk_notification"`
+	TenantID       string    `gorm:"type:varchar(64);not null;default:''"`
+	BizKey         string    `gorm:"type:varchar(64);not null"`
+	Checks         int       `gorm:"not null;default:0"`
+	NextCheckAt    time.Time `gorm:"not null;index:idx_next_check_at"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// TableName 指定表名
+func (Prepared) TableName() string {
+	return "notification_prepared"
+}
+
+// PreparedRepository 两阶段通知存储接口
+type PreparedRepository interface {
+	// Prepare 在同一事务中保存预提交状态的通知与回查计划，首次回查时间为 checkAt；
+	// 同一租户下业务键已存在时返回 domain.ErrDuplicateBizKey
+	Prepare(ctx context.Context, n domain.Notification, checkAt time.Time) (domain.Notification, error)
+	// Commit 在同一事务中将预提交的通知改为 to 状态（待发送或定时）并删除回查计划，返回是否成功；
+	// 通知不处于预提交状态时返回 false
+	Commit(ctx context.Context, id uint64, to domain.SendStatus) (bool, error)
+	// Cancel 在同一事务中将预提交的通知改为已取消并删除回查计划，返回是否成功；通知不处于预提交状态时返回 false
+	Cancel(ctx context.Context, id uint64) (bool, error)
+	// ClaimDue 领取最多 limit 条到期的回查计划：以 FOR UPDATE SKIP LOCKED 锁定到期行，
+	// 回查次数加一并将下次回查时间推迟 interval，多实例之间同一计划只会被一个实例领取
+	ClaimDue(ctx context.Context, now time.Time, limit int, interval time.Duration) ([]domain.PreparedCheck, error)
+}
+
+// preparedRepository 基于 GORM 的两阶段通知存储实现
+type preparedRepository struct {
+	db *gorm.DB
+}
+
+// NewPreparedRepository 创建两阶段通知存储
+func NewPreparedRepository(db *gorm.DB) PreparedRepository {
+	return &preparedRepository{
+		db: db,
+	}
+}
+
+// Prepare 保存预提交的通知与回查计划
+func (r *preparedRepository) Prepare(
+	ctx context.Context,
+	n domain.Notification,
+	checkAt time.Time,
+) (domain.Notification, error) {
+	entity, err := toEntity(n)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	entity.Status = string(domain.SendStatusPrepared)
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := createNotification(tx, &entity); err != nil {
+			return err
+		}
+		prepared := Prepared{
+			NotificationID: entity.ID,
+			TenantID:       entity.TenantID,
+			BizKey:         entity.BizKey,
+			NextCheckAt:    checkAt,
+		}
+		if err := tx.Create(&prepared).Error; err != nil {
+			return fmt.Errorf("create prepared: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return toDomain(entity)
+}
+
+// Commit 提交预提交的通知
+func (r *preparedRepository) Commit(ctx context.Context, id uint64, to domain.SendStatus) (bool, error) {
+	return r.finish(ctx, id, to)
+}
+
+// Cancel 取消预提交的通知
+func (r *preparedRepository) Cancel(ctx context.Context, id uint64) (bool, error) {
+	return r.finish(ctx, id, domain.SendStatusCanceled)
+}
+
+// finish 仅当通知处于预提交状态时改为 to 状态，并删除回查计划；提交与取消之间只有一个能成功
+func (r *preparedRepository) finish(ctx context.Context, id uint64, to domain.SendStatus) (bool, error) {
+	var finished bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		changed, err := changeStatus(tx, id, statusChange{from: domain.SendStatusPrepared, to: to})
+		if err != nil || !changed {
+			return err
+		}
+		if err := tx.Where("notification_id = ?", id).Delete(&Prepared{}).Error; err != nil {
+			return fmt.Errorf("delete prepared: %w", err)
+		}
+		finished = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("finish prepared notification as %s: %w", to, err)
+	}
+	return finished, nil
+}
+
+// ClaimDue 领取到期的回查计划
+func (r *preparedRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	interval time.Duration,
+) ([]domain.PreparedCheck, error) {
+	var entities []Prepared
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("next_check_at <= ?", now).
+			Order("next_check_at ASC").
+			Limit(limit).
+			Find(&entities).Error
+		if err != nil {
+			return fmt.Errorf("find due prepared: %w", err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, 0, len(entities))
+		for i := range entities {
+			ids = append(ids, entities[i].ID)
+		}
+		err = tx.Model(&Prepared{}).
+			Where("id IN ?", ids).
+			Updates(map[string]any{
+				"checks":        gorm.Expr("checks + 1"),
+				"next_check_at": now.Add(interval),
+				"updated_at":    now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("claim due prepared: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]domain.PreparedCheck, 0, len(entities))
+	for i := range entities {
+		checks = append(checks, domain.PreparedCheck{
+			NotificationID: entities[i].NotificationID,
+			TenantID:       entities[i].TenantID,
+			BizKey:         entities[i].BizKey,
+			Checks:         entities[i].Checks + 1,
+			PreparedAt:     entities[i].CreatedAt,
+		})
+	}
+	return checks, nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RecipientProfile recipient_profile 表对应的数据库实体，每个租户的每个接收者一行
+type RecipientProfile struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	TenantID  string `gorm:"type:varchar(64);not null;default:'';uniqueIndex:uk_tenant_recipient,priority:1"`
+	Recipient string `gorm:"type:varchar(256);not null;uniqueIndex:uk_tenant_recipient,priority:2"`
+	Locale    string `gorm:"type:varchar(35);not null;default:''"`
+	Timezone  string `gorm:"type:varchar(64);not null;default:''"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName 指定表名
+func (RecipientProfile) TableName() string {
+	return "recipient_profile"
+}
+
+// RecipientProfileRepository 接收者资料存储接口，按租户隔离
+type RecipientProfileRepository interface {
+	// Upsert 写入接收者资料，已存在时覆盖
+	Upsert(ctx context.Context, p domain.RecipientProfile) (domain.RecipientProfile, error)
+	// Find 查询接收者资料，不存在时返回 domain.ErrRecipientProfileNotFound
+	Find(ctx context.Context, tenantID, recipient string) (domain.RecipientProfile, error)
+	// Delete 删除接收者资料，不存在时返回 domain.ErrRecipientProfileNotFound
+	Delete(ctx context.Context, tenantID, recipient string) error
+}
+
+// recipientProfileRepository 基于 GORM 的接收者资料存储实现
+type recipientProfileRepository struct {
+	db *gorm.DB
+}
+
+// NewRecipientProfileRepository 创建接收者资料存储
+func NewRecipientProfileRepository(db *gorm.DB) RecipientProfileRepository {
+	return &recipientProfileRepository{
+		db: db,
+	}
+}
+
+// Upsert 以唯一索引冲突更新的方式写入，并发写入同一接收者时以最后一次为准
+func (r *recipientProfileRepository) Upsert(ctx context.Context, p domain.RecipientProfile) (domain.RecipientProfile, error) {
+	entity := RecipientProfile{
+		TenantID:  p.TenantID,
+		Recipient: p.Recipient,
+		Locale:    p.Locale,
+		Timezone:  p.Timezone,
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "recipient"}},
+		DoUpdates: clause.AssignmentColumns([]string{"locale", "timezone", "updated_at"}),
+	}).Create(&entity).Error
+	if err != nil {
+		return domain.RecipientProfile{}, fmt.Errorf("upsert recipient profile: %w", err)
+	}
+	return r.Find(ctx, p.TenantID, p.Recipient)
+}
+
+// Find 查询接收者资料
+func (r *recipientProfileRepository) Find(ctx context.Context, tenantID, recipient string) (domain.RecipientProfile, error) {
+	var entity RecipientProfile
+	err := r.db.WithContext(ctx).Where("tenant_id = ? AND recipient = ?", tenantID, recipient).First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.RecipientProfile{}, domain.ErrRecipientProfileNotFound
+	}
+	if err != nil {
+		return domain.RecipientProfile{}, fmt.Errorf("find recipient profile: %w", err)
+	}
+	return toDomainRecipientProfile(entity), nil
+}
+
+// Delete 删除接收者资料
+func (r *recipientProfileRepository) Delete(ctx context.Context, tenantID, recipient string) error {
+	res := r.db.WithContext(ctx).Where("tenant_id = ? AND recipient = ?", tenantID, recipient).Delete(&RecipientProfile{})
+	if res.Error != nil {
+		return fmt.Errorf("delete recipient profile: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrRecipientProfileNotFound
+	}
+	return nil
+}
+
+// toDomainRecipientProfile 将接收者资料数据库实体转换为领域对象
+func toDomainRecipientProfile(entity RecipientProfile) domain.RecipientProfile {
+	return domain.RecipientProfile{
+		TenantID:  entity.TenantID,
+		Recipient: entity.Recipient,
+		Locale:    entity.Locale,
+		Timezone:  entity.Timezone,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Retry notification_retry 表对应的数据库实体，每条等待重试的通知一行
+type Retry struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	NotificationID uint64    `gorm:"not null;uniqueIndex:uk_notification"`
+	Channel        string    `gorm:"type:varchar(16);not null"`
+	Attempts       int       `gorm:"not null"`
+	NextRetryAt    time.Time `gorm:"not null;index:idx_next_retry_at"`
+	LastError      string    `gorm:"type:varchar(512)"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// TableName 指定表名
+func (Retry) TableName() string {
+	return "notification_retry"
+}
+
+// RetryRepository 重试计划存储接口
+type RetryRepository interface {
+	// Schedule 在同一事务中将通知置为重试中，并写入或更新重试计划
+	Schedule(ctx context.Context, task domain.RetryTask, receipt domain.Receipt) error
+	// Finish 在同一事务中回写最终发送结果，并删除重试计划
+	Finish(ctx context.Context, id uint64, status domain.SendStatus, receipt domain.Receipt, errMsg string) error
+	// Remove 删除重试计划，用于通知已不处于重试中的情况
+	Remove(ctx context.Context, id uint64) error
+	// ClaimDue 领取最多 limit 条到期的重试计划：以 FOR UPDATE SKIP LOCKED 锁定到期行，
+	// 发送次数加一并将下次重试时间推迟 lease，多实例之间同一计划只会被一个实例领取
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]domain.RetryTask, error)
+}
+
+// retryRepository 基于 GORM 的重试计划存储实现
+type retryRepository struct {
+	db *gorm.DB
+}
+
+// NewRetryRepository 创建重试计划存储
+func NewRetryRepository(db *gorm.DB) RetryRepository {
+	return &retryRepository{
+		db: db,
+	}
+}
+
+// Schedule 将通知置为重试中并写入重试计划
+func (r *retryRepository) Schedule(ctx context.Context, task domain.RetryTask, receipt domain.Receipt) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateSendResult(tx, task.NotificationID, domain.SendStatusRetrying, receipt, task.LastError); err != nil {
+			return err
+		}
+		entity := Retry{
+			NotificationID: task.NotificationID,
+			Channel:        string(task.Channel),
+			Attempts:       task.Attempts,
+			NextRetryAt:    task.NextRetryAt,
+			LastError:      truncate(task.LastError, maxErrorMessageLen),
+		}
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "notification_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"attempts", "next_retry_at", "last_error", "updated_at"}),
+		}).Create(&entity).Error
+		if err != nil {
+			return fmt.Errorf("schedule retry: %w", err)
+		}
+		return nil
+	})
+}
+
+// Finish 回写最终发送结果并删除重试计划
+func (r *retryRepository) Finish(
+	ctx context.Context,
+	id uint64,
+	status domain.SendStatus,
+	receipt domain.Receipt,
+	errMsg string,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateSendResult(tx, id, status, receipt, errMsg); err != nil {
+			return err
+		}
+		if err := tx.Where("notification_id = ?", id).Delete(&Retry{}).Error; err != nil {
+			return fmt.Errorf("delete retry: %w", err)
+		}
+		return nil
+	})
+}
+
+// Remove 删除重试计划
+func (r *retryRepository) Remove(ctx context.Context, id uint64) error {
+	if err := r.db.WithContext(ctx).Where("notification_id = ?", id).Delete(&Retry{}).Error; err != nil {
+		return fmt.Errorf("delete retry: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue 领取到期的重试计划
+func (r *retryRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	lease time.Duration,
+) ([]domain.RetryTask, error) {
+	var entities []Retry
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("next_retry_at <= ?", now).
+			Order("next_retry_at ASC").
+			Limit(limit).
+			Find(&entities).Error
+		if err != nil {
+			return fmt.Errorf("find due retries: %w", err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, 0, len(entities))
+		for i := range entities {
+			ids = append(ids, entities[i].ID)
+		}
+		err = tx.Model(&Retry{}).
+			Where("id IN ?", ids).
+			Updates(map[string]any{
+				"attempts":      gorm.Expr("attempts + 1"),
+				"next_retry_at": now.Add(lease),
+				"updated_at":    now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("claim due retries: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]domain.RetryTask, 0, len(entities))
+	for i := range entities {
+		e := entities[i]
+		tasks = append(tasks, domain.RetryTask{
+			NotificationID: e.NotificationID,
+			Channel:        domain.Channel(e.Channel),
+			Attempts:       e.Attempts + 1,
+			NextRetryAt:    now.Add(lease),
+			LastError:      e.LastError,
+		})
+	}
+	return tasks, nil
+}
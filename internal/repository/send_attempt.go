@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SendAttempt notification_send_attempt 表对应的数据库实体
+type SendAttempt struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	NotificationID uint64    `gorm:"not null;index:idx_notification"`
+	Seq            int       `gorm:"not null"`
+	Provider       string    `gorm:"type:varchar(64);not null;index:idx_provider_created,priority:1"`
+	Succeeded      bool      `gorm:"not null"`
+	MessageID      string    `gorm:"type:varchar(128)"`
+	ErrorCategory  string    `gorm:"type:varchar(16)"`
+	ErrorCode      string    `gorm:"type:varchar(128)"`
+	ErrorMessage   string    `gorm:"type:varchar(512)"`
+	DurationMs     int64     `gorm:"not null"`
+	CreatedAt      time.Time `gorm:"index:idx_provider_created,priority:2"`
+}
+
+// TableName 指定表名
+func (SendAttempt) TableName() string {
+	return "notification_send_attempt"
+}
+
+// maxErrorCodeLen 与 error_code 列宽保持一致
+const maxErrorCodeLen = 128
+
+// SendAttemptRepository 发送尝试记录存储接口
+type SendAttemptRepository interface {
+	// Create 保存一次发送尝试，忽略 a.Seq，按通知已有尝试的最大序号加 1 分配序号
+	Create(ctx context.Context, a domain.SendAttempt) error
+	// FindByNotificationID 按尝试时间顺序查询通知的所有发送尝试
+	FindByNotificationID(ctx context.Context, notificationID uint64) ([]domain.SendAttempt, error)
+}
+
+// sendAttemptRepository 基于 GORM 的发送尝试记录存储实现
+type sendAttemptRepository struct {
+	db *gorm.DB
+}
+
+// NewSendAttemptRepository 创建发送尝试记录存储
+func NewSendAttemptRepository(db *gorm.DB) SendAttemptRepository {
+	return &sendAttemptRepository{
+		db: db,
+	}
+}
+
+// Create 保存一次发送尝试；锁定通知已有的尝试记录后分配序号，并发写入同一通知的尝试时序号不重复
+func (r *sendAttemptRepository) Create(ctx context.Context, a domain.SendAttempt) error {
+	entity := SendAttempt{
+		NotificationID: a.NotificationID,
+		Provider:       a.Provider,
+		Succeeded:      a.Succeeded,
+		MessageID:      a.MessageID,
+		ErrorCategory:  a.ErrorCategory,
+		ErrorCode:      truncate(a.ErrorCode, maxErrorCodeLen),
+		ErrorMessage:   truncate(a.ErrorMessage, maxErrorMessageLen),
+		DurationMs:     a.Duration.Milliseconds(),
+		CreatedAt:      a.CreatedAt,
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var last int
+		err := tx.Model(&SendAttempt{}).
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Select("COALESCE(MAX(seq), 0)").
+			Where("notification_id = ?", a.NotificationID).
+			Scan(&last).Error
+		if err != nil {
+			return fmt.Errorf("find last send attempt seq: %w", err)
+		}
+		entity.Seq = last + 1
+		if err := tx.Create(&entity).Error; err != nil {
+			return fmt.Errorf("create send attempt: %w", err)
+		}
+		return nil
+	})
+}
+
+// FindByNotificationID 按尝试时间顺序查询通知的所有发送尝试
+func (r *sendAttemptRepository) FindByNotificationID(ctx context.Context, notificationID uint64) ([]domain.SendAttempt, error) {
+	var entities []SendAttempt
+	err := r.db.WithContext(ctx).
+		Where("notification_id = ?", notificationID).
+		Order("id ASC").
+		Find(&entities).Error
+	if err != nil {
+		return nil, fmt.Errorf("find send attempts: %w", err)
+	}
+	attempts := make([]domain.SendAttempt, 0, len(entities))
+	for i := range entities {
+		e := entities[i]
+		attempts = append(attempts, domain.SendAttempt{
+			ID:             e.ID,
+			NotificationID: e.NotificationID,
+			Seq:            e.Seq,
+			Provider:       e.Provider,
+			Succeeded:      e.Succeeded,
+			MessageID:      e.MessageID,
+			ErrorCategory:  e.ErrorCategory,
+			ErrorCode:      e.ErrorCode,
+			ErrorMessage:   e.ErrorMessage,
+			Duration:       time.Duration(e.DurationMs) * time.Millisecond,
+			CreatedAt:      e.CreatedAt,
+		})
+	}
+	return attempts, nil
+}
@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StatusEvent notification_status_event 表对应的数据库实体，即状态变更事件的发件箱
+// 通知状态的每次变更都在同一事务中写入一行，事务回滚时事件随之撤销；事务提交后由发布器按 ID 顺序领取并发布，
+// 领取时记录租约到期时间，发布器崩溃未标记的事件在租约到期后被重新领取
+type StatusEvent struct {
+	ID                uint64     `gorm:"primaryKey;autoIncrement"`
+	NotificationID    uint64     `gorm:"not null"`
+	TenantID          string     `gorm:"type:varchar(64);not null;default:''"`
+	BizKey            string     `gorm:"type:varchar(64);not null"`
+	Channel           string     `gorm:"type:varchar(16);not null"`
+	PreviousStatus    string     `gorm:"type:varchar(16);not null;default:''"`
+	Status            string     `gorm:"type:varchar(16);not null"`
+	ErrorMessage      string     `gorm:"type:varchar(512)"`
+	Provider          string     `gorm:"type:varchar(64)"`
+	ProviderMessageID string     `gorm:"type:varchar(128)"`
+	OccurredAt        time.Time  `gorm:"not null;index:idx_occurred_at"`
+	PublishedAt       *time.Time `gorm:"index:idx_published_at"`
+	ClaimedUntil      *time.Time
+}
+
+// TableName 指定表名
+func (StatusEvent) TableName() string {
+	return "notification_status_event"
+}
+
+// StatusEventRepository 状态变更事件发件箱存储接口，事件由通知状态变更时写入
+type StatusEventRepository interface {
+	// Publish 按 ID 升序领取最多 limit 条未发布的事件并记录租约（lease），在事务外交给 publish，
+	// publish 成功后标记为已发布，返回发布的事件数；publish 失败时释放租约，事件保持未发布。
+	// 只有领取到最早的未发布事件时才发布，其他实例持有的租约到期前不会越过它发布后面的事件，保证同一通知的事件按发生顺序发布
+	Publish(
+		ctx context.Context,
+		limit int,
+		lease time.Duration,
+		publish func(ctx context.Context, events []domain.StatusEvent) error,
+	) (int, error)
+	// Purge 删除最多 limit 条过期的事件，返回删除的事件数：
+	// publishedOnly 为 true 时删除发布时间早于 before 的事件，否则删除发生时间早于 before 的事件（含未发布的）
+	Purge(ctx context.Context, before time.Time, publishedOnly bool, limit int) (int64, error)
+}
+
+// statusEventRepository 基于 GORM 的状态变更事件存储实现
+type statusEventRepository struct {
+	db *gorm.DB
+}
+
+// NewStatusEventRepository 创建状态变更事件存储
+func NewStatusEventRepository(db *gorm.DB) StatusEventRepository {
+	return &statusEventRepository{
+		db: db,
+	}
+}
+
+// Publish 发布未发布的事件；发布成功但标记失败时事件在租约到期后被再次发布，消费方按事件 ID 去重
+func (r *statusEventRepository) Publish(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+	publish func(ctx context.Context, events []domain.StatusEvent) error,
+) (int, error) {
+	entities, err := r.claim(ctx, limit, lease)
+	if err != nil || len(entities) == 0 {
+		return 0, err
+	}
+
+	events := make([]domain.StatusEvent, 0, len(entities))
+	ids := make([]uint64, 0, len(entities))
+	for i := range entities {
+		events = append(events, toDomainStatusEvent(entities[i]))
+		ids = append(ids, entities[i].ID)
+	}
+	if err := publish(ctx, events); err != nil {
+		// 释放失败时等待租约到期
+		_ = r.db.WithContext(ctx).Model(&StatusEvent{}).Where("id IN ?", ids).Update("claimed_until", nil).Error
+		return 0, err
+	}
+	if err := r.db.WithContext(ctx).Model(&StatusEvent{}).Where("id IN ?", ids).Updates(map[string]any{
+		"published_at":  time.Now(),
+		"claimed_until": nil,
+	}).Error; err != nil {
+		return 0, fmt.Errorf("mark status events published: %w", err)
+	}
+	return len(events), nil
+}
+
+// claim 领取最多 limit 条最早的未发布事件并记录租约，最早的未发布事件已被其他实例领取时不领取
+// 先以一致性读找出候选事件，再按主键 FOR UPDATE SKIP LOCKED 锁定，不持有范围锁，不阻塞状态变更写入事件
+func (r *statusEventRepository) claim(ctx context.Context, limit int, lease time.Duration) ([]StatusEvent, error) {
+	var entities []StatusEvent
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		var ids []uint64
+		err := tx.Model(&StatusEvent{}).
+			Where("published_at IS NULL AND (claimed_until IS NULL OR claimed_until < ?)", now).
+			Order("id ASC").
+			Limit(limit).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return fmt.Errorf("find unpublished status events: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		var earlier int64
+		err = tx.Model(&StatusEvent{}).
+			Where("published_at IS NULL AND id < ?", ids[0]).
+			Limit(1).
+			Count(&earlier).Error
+		if err != nil {
+			return fmt.Errorf("find earlier unpublished status events: %w", err)
+		}
+		if earlier > 0 {
+			return nil
+		}
+
+		var locked []StatusEvent
+		err = tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("id IN ?", ids).
+			Where("published_at IS NULL AND (claimed_until IS NULL OR claimed_until < ?)", now).
+			Order("id ASC").
+			Find(&locked).Error
+		if err != nil {
+			return fmt.Errorf("lock unpublished status events: %w", err)
+		}
+		// 只保留与候选事件连续的前缀，其他实例正在领取的事件之后的事件留到下次发布
+		n := 0
+		for n < len(locked) && locked[n].ID == ids[n] {
+			n++
+		}
+		if n == 0 {
+			return nil
+		}
+		locked = locked[:n]
+
+		claimed := make([]uint64, 0, n)
+		for i := range locked {
+			claimed = append(claimed, locked[i].ID)
+		}
+		if err := tx.Model(&StatusEvent{}).Where("id IN ?", claimed).Update("claimed_until", now.Add(lease)).Error; err != nil {
+			return fmt.Errorf("claim status events: %w", err)
+		}
+		entities = locked
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// Purge 删除过期的事件
+func (r *statusEventRepository) Purge(ctx context.Context, before time.Time, publishedOnly bool, limit int) (int64, error) {
+	query := r.db.WithContext(ctx)
+	if publishedOnly {
+		query = query.Where("published_at < ?", before)
+	} else {
+		query = query.Where("occurred_at < ?", before)
+	}
+	res := query.Limit(limit).Delete(&StatusEvent{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("purge status events: %w", res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+// statusChange 通知的一次状态变更
+type statusChange struct {
+	// from 变更前须处于的状态，为空表示不限
+	from domain.SendStatus
+	// to 变更后的状态
+	to domain.SendStatus
+	// errMsg 非 nil 时同时回写失败原因
+	errMsg *string
+	// receipt 非 nil 时同时回写供应商回执
+	receipt *domain.Receipt
+}
+
+// changeStatus 变更通知状态，状态确有变化时在同一事务中写入状态变更事件（进入终态时一并写入回调），返回是否更新成功；
+// 通知不存在或不处于 change.from 状态时返回 false。tx 须为事务，锁定通知行直至事务结束
+func changeStatus(tx *gorm.DB, id uint64, change statusChange) (bool, error) {
+	var current Notification
+	query := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Select(
+			"id", "tenant_id", "biz_key", "channel", "status", "error_message", "provider", "provider_message_id",
+			"callback_url",
+		).
+		Where("id = ?", id)
+	if change.from != "" {
+		query = query.Where("status = ?", string(change.from))
+	}
+	err := query.Take(&current).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("lock notification: %w", err)
+	}
+
+	now := time.Now()
+	updates := map[string]any{
+		"status":     string(change.to),
+		"updated_at": now,
+		// 进入发送中时记录开始发送的时间，超时仍未回写结果的通知据此改回重新投递
+		"sending_since": nil,
+	}
+	if change.to == domain.SendStatusSending {
+		updates["sending_since"] = now
+	}
+	if change.errMsg != nil {
+		current.ErrorMessage = truncate(*change.errMsg, maxErrorMessageLen)
+		updates["error_message"] = current.ErrorMessage
+	}
+	if change.receipt != nil {
+		current.Provider, current.ProviderMessageID = change.receipt.Provider, change.receipt.MessageID
+		updates["provider"] = current.Provider
+		updates["provider_message_id"] = current.ProviderMessageID
+	}
+	if err := tx.Model(&Notification{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return false, fmt.Errorf("update notification status to %s: %w", change.to, err)
+	}
+
+	previous := current.Status
+	if previous == string(change.to) {
+		return true, nil
+	}
+	current.Status = string(change.to)
+	current.UpdatedAt = now
+	if err := recordStatusEvent(tx, current, previous); err != nil {
+		return false, err
+	}
+	if err := recordCallback(tx, current); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// recordStatusEvent 按变更后的通知写入一条状态变更事件，tx 须为写入通知状态的事务
+func recordStatusEvent(tx *gorm.DB, n Notification, previous string) error {
+	event := StatusEvent{
+		NotificationID:    n.ID,
+		TenantID:          n.TenantID,
+		BizKey:            n.BizKey,
+		Channel:           n.Channel,
+		PreviousStatus:    previous,
+		Status:            n.Status,
+		ErrorMessage:      n.ErrorMessage,
+		Provider:          n.Provider,
+		ProviderMessageID: n.ProviderMessageID,
+		OccurredAt:        n.UpdatedAt,
+	}
+	if err := tx.Create(&event).Error; err != nil {
+		return fmt.Errorf("create status event: %w", err)
+	}
+	return nil
+}
+
+// toDomainStatusEvent 将数据库实体转换为领域对象
+func toDomainStatusEvent(entity StatusEvent) domain.StatusEvent {
+	return domain.StatusEvent{
+		ID:             entity.ID,
+		NotificationID: entity.NotificationID,
+		TenantID:       entity.TenantID,
+		BizKey:         entity.BizKey,
+		Channel:        domain.Channel(entity.Channel),
+		PreviousStatus: domain.SendStatus(entity.PreviousStatus),
+		Status:         domain.SendStatus(entity.Status),
+		ErrorMessage:   entity.ErrorMessage,
+		Receipt: domain.Receipt{
+			Provider:  entity.Provider,
+			MessageID: entity.ProviderMessageID,
+		},
+		OccurredAt: entity.OccurredAt,
+	}
+}
@@ -0,0 +1,392 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MessageTemplate message_template 表对应的数据库实体，每个模板一行
+type MessageTemplate struct {
+	ID            uint64 `gorm:"primaryKey;autoIncrement"`
+	TenantID      string `gorm:"type:varchar(64);not null;default:'';uniqueIndex:uk_tenant_template,priority:1"`
+	TemplateID    string `gorm:"type:varchar(64);not null;uniqueIndex:uk_tenant_template,priority:2"`
+	Name          string `gorm:"type:varchar(128);not null"`
+	Channel       string `gorm:"type:varchar(16);not null"`
+	Description   string `gorm:"type:varchar(512)"`
+	ActiveVersion int    `gorm:"not null"`
+	LatestVersion int    `gorm:"not null"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// TableName 指定表名
+func (MessageTemplate) TableName() string {
+	return "message_template"
+}
+
+// MessageTemplateVersion message_template_version 表对应的数据库实体，每个模板版本一行，创建后不再修改
+type MessageTemplateVersion struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"`
+	TenantID   string `gorm:"type:varchar(64);not null;default:'';uniqueIndex:uk_tenant_template_version,priority:1"`
+	TemplateID string `gorm:"type:varchar(64);not null;uniqueIndex:uk_tenant_template_version,priority:2"`
+	Version    int    `gorm:"not null;uniqueIndex:uk_tenant_template_version,priority:3"`
+	Subject    string `gorm:"type:varchar(998)"`
+	Body       string `gorm:"type:mediumtext"`
+	HTMLBody   string `gorm:"column:html_body;type:mediumtext"`
+	Locales    string `gorm:"type:mediumtext"`
+	Variables  string `gorm:"type:text"`
+	CreatedAt  time.Time
+}
+
+// TableName 指定表名
+func (MessageTemplateVersion) TableName() string {
+	return "message_template_version"
+}
+
+// TemplateRepository 模板存储接口，模板与版本均按租户隔离
+type TemplateRepository interface {
+	// Create 在同一事务中创建模板及其第一个版本，第一个版本即生效版本；
+	// 模板 ID 已存在时返回 domain.ErrDuplicateTemplate
+	Create(ctx context.Context, t domain.MessageTemplate, v domain.TemplateVersion) (domain.MessageTemplate, domain.TemplateVersion, error)
+	// Update 更新模板名称与说明
+	Update(ctx context.Context, t domain.MessageTemplate) (domain.MessageTemplate, error)
+	// Delete 在同一事务中删除模板及其全部版本与供应商审核记录
+	Delete(ctx context.Context, tenantID, id string) error
+	// Find 查询模板，不存在时返回 domain.ErrTemplateNotFound
+	Find(ctx context.Context, tenantID, id string) (domain.MessageTemplate, error)
+	// List 按模板 ID 升序查询符合条件的模板
+	List(ctx context.Context, filter domain.TemplateFilter) ([]domain.MessageTemplate, error)
+	// CreateVersion 为模板追加一个版本，版本号为最新版本号加一；activate 为 true 时同时设为生效版本
+	CreateVersion(ctx context.Context, v domain.TemplateVersion, activate bool) (domain.TemplateVersion, error)
+	// FindVersion 查询模板的指定版本，不存在时返回 domain.ErrTemplateVersionNotFound
+	FindVersion(ctx context.Context, tenantID, id string, version int) (domain.TemplateVersion, error)
+	// ListVersions 按版本号升序查询模板的全部版本
+	ListVersions(ctx context.Context, tenantID, id string) ([]domain.TemplateVersion, error)
+	// Activate 将模板的生效版本切换为指定版本
+	Activate(ctx context.Context, tenantID, id string, version int) (domain.MessageTemplate, error)
+}
+
+// templateRepository 基于 GORM 的模板存储实现
+type templateRepository struct {
+	db *gorm.DB
+}
+
+// NewTemplateRepository 创建模板存储
+func NewTemplateRepository(db *gorm.DB) TemplateRepository {
+	return &templateRepository{
+		db: db,
+	}
+}
+
+// Create 创建模板及其第一个版本
+func (r *templateRepository) Create(
+	ctx context.Context,
+	t domain.MessageTemplate,
+	v domain.TemplateVersion,
+) (domain.MessageTemplate, domain.TemplateVersion, error) {
+	t.ActiveVersion, t.LatestVersion = 1, 1
+	v.TenantID, v.TemplateID, v.Version = t.TenantID, t.ID, 1
+
+	entity := toTemplateEntity(t)
+	versionEntity, err := toTemplateVersionEntity(v)
+	if err != nil {
+		return domain.MessageTemplate{}, domain.TemplateVersion{}, err
+	}
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&entity).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return domain.ErrDuplicateTemplate
+			}
+			return fmt.Errorf("create template: %w", err)
+		}
+		if err := tx.Create(&versionEntity).Error; err != nil {
+			return fmt.Errorf("create template version: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.MessageTemplate{}, domain.TemplateVersion{}, err
+	}
+	created, err := toDomainTemplateVersion(versionEntity)
+	if err != nil {
+		return domain.MessageTemplate{}, domain.TemplateVersion{}, err
+	}
+	return toDomainTemplate(entity), created, nil
+}
+
+// Update 更新模板名称与说明
+func (r *templateRepository) Update(ctx context.Context, t domain.MessageTemplate) (domain.MessageTemplate, error) {
+	err := r.db.WithContext(ctx).Model(&MessageTemplate{}).
+		Where("tenant_id = ? AND template_id = ?", t.TenantID, t.ID).
+		Updates(map[string]any{
+			"name":        t.Name,
+			"description": t.Description,
+			"updated_at":  time.Now(),
+		}).Error
+	if err != nil {
+		return domain.MessageTemplate{}, fmt.Errorf("update template: %w", err)
+	}
+	// 内容未变化时 RowsAffected 也为 0，因此以查询结果判断模板是否存在
+	return r.Find(ctx, t.TenantID, t.ID)
+}
+
+// Delete 删除模板及其全部版本与供应商审核记录
+func (r *templateRepository) Delete(ctx context.Context, tenantID, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("tenant_id = ? AND template_id = ?", tenantID, id).Delete(&MessageTemplate{})
+		if res.Error != nil {
+			return fmt.Errorf("delete template: %w", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return domain.ErrTemplateNotFound
+		}
+		err := tx.Where("tenant_id = ? AND template_id = ?", tenantID, id).Delete(&MessageTemplateVersion{}).Error
+		if err != nil {
+			return fmt.Errorf("delete template versions: %w", err)
+		}
+		err = tx.Where("tenant_id = ? AND template_id = ?", tenantID, id).Delete(&TemplateApproval{}).Error
+		if err != nil {
+			return fmt.Errorf("delete template approvals: %w", err)
+		}
+		return nil
+	})
+}
+
+// Find 查询模板
+func (r *templateRepository) Find(ctx context.Context, tenantID, id string) (domain.MessageTemplate, error) {
+	var entity MessageTemplate
+	err := r.db.WithContext(ctx).Where("tenant_id = ? AND template_id = ?", tenantID, id).First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.MessageTemplate{}, domain.ErrTemplateNotFound
+	}
+	if err != nil {
+		return domain.MessageTemplate{}, fmt.Errorf("find template: %w", err)
+	}
+	return toDomainTemplate(entity), nil
+}
+
+// List 按模板 ID 升序查询模板
+func (r *templateRepository) List(ctx context.Context, filter domain.TemplateFilter) ([]domain.MessageTemplate, error) {
+	q := r.db.WithContext(ctx).Where("tenant_id = ? AND template_id > ?", filter.TenantID, filter.AfterID)
+	if filter.Channel != "" {
+		q = q.Where("channel = ?", string(filter.Channel))
+	}
+	var entities []MessageTemplate
+	if err := q.Order("template_id ASC").Limit(filter.Limit).Find(&entities).Error; err != nil {
+		return nil, fmt.Errorf("list templates: %w", err)
+	}
+	ts := make([]domain.MessageTemplate, 0, len(entities))
+	for i := range entities {
+		ts = append(ts, toDomainTemplate(entities[i]))
+	}
+	return ts, nil
+}
+
+// CreateVersion 锁定模板行后追加版本，保证并发创建时版本号不冲突
+func (r *templateRepository) CreateVersion(
+	ctx context.Context,
+	v domain.TemplateVersion,
+	activate bool,
+) (domain.TemplateVersion, error) {
+	var versionEntity MessageTemplateVersion
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		entity, err := lockTemplate(tx, v.TenantID, v.TemplateID)
+		if err != nil {
+			return err
+		}
+		v.Version = entity.LatestVersion + 1
+		versionEntity, err = toTemplateVersionEntity(v)
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(&versionEntity).Error; err != nil {
+			return fmt.Errorf("create template version: %w", err)
+		}
+		updates := map[string]any{
+			"latest_version": v.Version,
+			"updated_at":     time.Now(),
+		}
+		if activate {
+			updates["active_version"] = v.Version
+		}
+		if err := tx.Model(&MessageTemplate{}).Where("id = ?", entity.ID).Updates(updates).Error; err != nil {
+			return fmt.Errorf("update template: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.TemplateVersion{}, err
+	}
+	return toDomainTemplateVersion(versionEntity)
+}
+
+// FindVersion 查询模板的指定版本
+func (r *templateRepository) FindVersion(ctx context.Context, tenantID, id string, version int) (domain.TemplateVersion, error) {
+	var entity MessageTemplateVersion
+	err := r.db.WithContext(ctx).
+		Where("tenant_id = ? AND template_id = ? AND version = ?", tenantID, id, version).
+		First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.TemplateVersion{}, domain.ErrTemplateVersionNotFound
+	}
+	if err != nil {
+		return domain.TemplateVersion{}, fmt.Errorf("find template version: %w", err)
+	}
+	return toDomainTemplateVersion(entity)
+}
+
+// ListVersions 按版本号升序查询模板的全部版本
+func (r *templateRepository) ListVersions(ctx context.Context, tenantID, id string) ([]domain.TemplateVersion, error) {
+	var entities []MessageTemplateVersion
+	err := r.db.WithContext(ctx).
+		Where("tenant_id = ? AND template_id = ?", tenantID, id).
+		Order("version ASC").
+		Find(&entities).Error
+	if err != nil {
+		return nil, fmt.Errorf("list template versions: %w", err)
+	}
+	vs := make([]domain.TemplateVersion, 0, len(entities))
+	for i := range entities {
+		v, err := toDomainTemplateVersion(entities[i])
+		if err != nil {
+			return nil, err
+		}
+		vs = append(vs, v)
+	}
+	return vs, nil
+}
+
+// Activate 切换模板的生效版本
+func (r *templateRepository) Activate(ctx context.Context, tenantID, id string, version int) (domain.MessageTemplate, error) {
+	var entity MessageTemplate
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		entity, err = lockTemplate(tx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if version < 1 || version > entity.LatestVersion {
+			return domain.ErrTemplateVersionNotFound
+		}
+		entity.ActiveVersion = version
+		entity.UpdatedAt = time.Now()
+		err = tx.Model(&MessageTemplate{}).
+			Where("id = ?", entity.ID).
+			Updates(map[string]any{
+				"active_version": entity.ActiveVersion,
+				"updated_at":     entity.UpdatedAt,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("activate template version: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.MessageTemplate{}, err
+	}
+	return toDomainTemplate(entity), nil
+}
+
+// lockTemplate 在事务中锁定模板行
+func lockTemplate(tx *gorm.DB, tenantID, id string) (MessageTemplate, error) {
+	var entity MessageTemplate
+	err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Where("tenant_id = ? AND template_id = ?", tenantID, id).
+		First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return MessageTemplate{}, domain.ErrTemplateNotFound
+	}
+	if err != nil {
+		return MessageTemplate{}, fmt.Errorf("lock template: %w", err)
+	}
+	return entity, nil
+}
+
+// toTemplateEntity 将模板领域对象转换为数据库实体
+func toTemplateEntity(t domain.MessageTemplate) MessageTemplate {
+	return MessageTemplate{
+		TenantID:      t.TenantID,
+		TemplateID:    t.ID,
+		Name:          t.Name,
+		Channel:       string(t.Channel),
+		Description:   t.Description,
+		ActiveVersion: t.ActiveVersion,
+		LatestVersion: t.LatestVersion,
+		CreatedAt:     t.CreatedAt,
+		UpdatedAt:     t.UpdatedAt,
+	}
+}
+
+// toDomainTemplate 将模板数据库实体转换为领域对象
+func toDomainTemplate(entity MessageTemplate) domain.MessageTemplate {
+	return domain.MessageTemplate{
+		TenantID:      entity.TenantID,
+		ID:            entity.TemplateID,
+		Name:          entity.Name,
+		Channel:       domain.Channel(entity.Channel),
+		Description:   entity.Description,
+		ActiveVersion: entity.ActiveVersion,
+		LatestVersion: entity.LatestVersion,
+		CreatedAt:     entity.CreatedAt,
+		UpdatedAt:     entity.UpdatedAt,
+	}
+}
+
+// toTemplateVersionEntity 将模板版本领域对象转换为数据库实体
+func toTemplateVersionEntity(v domain.TemplateVersion) (MessageTemplateVersion, error) {
+	variables, err := json.Marshal(v.Variables)
+	if err != nil {
+		return MessageTemplateVersion{}, fmt.Errorf("marshal template variables: %w", err)
+	}
+	var locales []byte
+	if len(v.Locales) > 0 {
+		if locales, err = json.Marshal(v.Locales); err != nil {
+			return MessageTemplateVersion{}, fmt.Errorf("marshal template locales: %w", err)
+		}
+	}
+	return MessageTemplateVersion{
+		TenantID:   v.TenantID,
+		TemplateID: v.TemplateID,
+		Version:    v.Version,
+		Subject:    v.Subject,
+		Body:       v.Body,
+		HTMLBody:   v.HTMLBody,
+		Locales:    string(locales),
+		Variables:  string(variables),
+		CreatedAt:  v.CreatedAt,
+	}, nil
+}
+
+// toDomainTemplateVersion 将模板版本数据库实体转换为领域对象
+func toDomainTemplateVersion(entity MessageTemplateVersion) (domain.TemplateVersion, error) {
+	var variables []domain.TemplateVariable
+	if entity.Variables != "" {
+		if err := json.Unmarshal([]byte(entity.Variables), &variables); err != nil {
+			return domain.TemplateVersion{}, fmt.Errorf("unmarshal template variables: %w", err)
+		}
+	}
+	var locales []domain.LocalizedContent
+	if entity.Locales != "" {
+		if err := json.Unmarshal([]byte(entity.Locales), &locales); err != nil {
+			return domain.TemplateVersion{}, fmt.Errorf("unmarshal template locales: %w", err)
+		}
+	}
+	return domain.TemplateVersion{
+		TenantID:   entity.TenantID,
+		TemplateID: entity.TemplateID,
+		Version:    entity.Version,
+		Subject:    entity.Subject,
+		Body:       entity.Body,
+		HTMLBody:   entity.HTMLBody,
+		Locales:    locales,
+		Variables:  variables,
+		CreatedAt:  entity.CreatedAt,
+	}, nil
+}
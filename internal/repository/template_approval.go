@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxApprovalReasonLen 审核原因的最大长度，与 reason 列的长度一致
+const maxApprovalReasonLen = 512
+
+// TemplateApproval template_approval 表对应的数据库实体，每个模板版本的每个语言版本在每个供应商处一行
+type TemplateApproval struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	TenantID    string    `gorm:"type:varchar(64);not null;default:'';uniqueIndex:uk_template_version_locale_provider,priority:1"`
+	TemplateID  string    `gorm:"type:varchar(64);not null;uniqueIndex:uk_template_version_locale_provider,priority:2"`
+	Version     int       `gorm:"not null;uniqueIndex:uk_template_version_locale_provider,priority:3"`
+	Locale      string    `gorm:"type:varchar(35);not null;default:'';uniqueIndex:uk_template_version_locale_provider,priority:4"`
+	Provider    string    `gorm:"type:varchar(64);not null;uniqueIndex:uk_template_version_locale_provider,priority:5"`
+	VendorCode  string    `gorm:"type:varchar(64)"`
+	ParamNames  string    `gorm:"type:varchar(1024)"`
+	Status      string    `gorm:"type:varchar(16);not null;index:idx_status_next_check_at,priority:1"`
+	Reason      string    `gorm:"type:varchar(512)"`
+	Attempts    int       `gorm:"not null"`
+	NextCheckAt time.Time `gorm:"not null;index:idx_status_next_check_at,priority:2"`
+	SubmittedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TableName 指定表名
+func (TemplateApproval) TableName() string {
+	return "template_approval"
+}
+
+// TemplateApprovalRepository 模板供应商审核记录存储接口
+type TemplateApprovalRepository interface {
+	// Create 写入审核记录，同一模板版本的同一语言版本在同一供应商处已有记录时保留原记录
+	Create(ctx context.Context, as []domain.TemplateApproval) error
+	// Update 回写提交或查询的结果
+	Update(ctx context.Context, a domain.TemplateApproval) error
+	// Find 查询模板版本的某个语言版本在某个供应商处的审核记录，locale 为空表示默认内容；
+	// 不存在时返回 domain.ErrTemplateApprovalNotFound
+	Find(ctx context.Context, tenantID, templateID string, version int, locale, provider string) (domain.TemplateApproval, error)
+	// List 查询模板的审核记录，version 为 0 时返回全部版本，按版本号、语言、供应商排列
+	List(ctx context.Context, tenantID, templateID string, version int) ([]domain.TemplateApproval, error)
+	// ClaimDue 领取最多 limit 条到期待提交或审核中的记录：以 FOR UPDATE SKIP LOCKED 锁定到期行，
+	// 次数加一并将下次检查时间推迟 lease，多实例之间同一记录只会被一个实例领取
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]domain.TemplateApproval, error)
+}
+
+// templateApprovalRepository 基于 GORM 的模板审核记录存储实现
+type templateApprovalRepository struct {
+	db *gorm.DB
+}
+
+// NewTemplateApprovalRepository 创建模板审核记录存储
+func NewTemplateApprovalRepository(db *gorm.DB) TemplateApprovalRepository {
+	return &templateApprovalRepository{
+		db: db,
+	}
+}
+
+// Create 写入审核记录
+func (r *templateApprovalRepository) Create(ctx context.Context, as []domain.TemplateApproval) error {
+	if len(as) == 0 {
+		return nil
+	}
+	entities := make([]TemplateApproval, 0, len(as))
+	for i := range as {
+		entities = append(entities, toTemplateApprovalEntity(as[i]))
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&entities).Error; err != nil {
+		return fmt.Errorf("create template approvals: %w", err)
+	}
+	return nil
+}
+
+// Update 回写审核记录
+func (r *templateApprovalRepository) Update(ctx context.Context, a domain.TemplateApproval) error {
+	updates := map[string]any{
+		"vendor_code":   a.VendorCode,
+		"param_names":   strings.Join(a.ParamNames, ","),
+		"status":        string(a.Status),
+		"reason":        truncate(a.Reason, maxApprovalReasonLen),
+		"attempts":      a.Attempts,
+		"next_check_at": a.NextCheckAt,
+		"submitted_at":  nullableTime(a.SubmittedAt),
+		"updated_at":    time.Now(),
+	}
+	res := r.db.WithContext(ctx).Model(&TemplateApproval{}).
+		Where("tenant_id = ? AND template_id = ? AND version = ? AND locale = ? AND provider = ?",
+			a.TenantID, a.TemplateID, a.Version, a.Locale, a.Provider).
+		Updates(updates)
+	if res.Error != nil {
+		return fmt.Errorf("update template approval: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrTemplateApprovalNotFound
+	}
+	return nil
+}
+
+// Find 查询模板版本的某个语言版本在某个供应商处的审核记录
+func (r *templateApprovalRepository) Find(
+	ctx context.Context,
+	tenantID, templateID string,
+	version int,
+	locale, provider string,
+) (domain.TemplateApproval, error) {
+	var entity TemplateApproval
+	err := r.db.WithContext(ctx).
+		Where("tenant_id = ? AND template_id = ? AND version = ? AND locale = ? AND provider = ?",
+			tenantID, templateID, version, locale, provider).
+		First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.TemplateApproval{}, domain.ErrTemplateApprovalNotFound
+	}
+	if err != nil {
+		return domain.TemplateApproval{}, fmt.Errorf("find template approval: %w", err)
+	}
+	return toDomainTemplateApproval(entity), nil
+}
+
+// List 查询模板的审核记录
+func (r *templateApprovalRepository) List(
+	ctx context.Context,
+	tenantID, templateID string,
+	version int,
+) ([]domain.TemplateApproval, error) {
+	q := r.db.WithContext(ctx).Where("tenant_id = ? AND template_id = ?", tenantID, templateID)
+	if version > 0 {
+		q = q.Where("version = ?", version)
+	}
+	var entities []TemplateApproval
+	if err := q.Order("version ASC, locale ASC, provider ASC").Find(&entities).Error; err != nil {
+		return nil, fmt.Errorf("list template approvals: %w", err)
+	}
+	as := make([]domain.TemplateApproval, 0, len(entities))
+	for i := range entities {
+		as = append(as, toDomainTemplateApproval(entities[i]))
+	}
+	return as, nil
+}
+
+// ClaimDue 领取到期的审核记录
+func (r *templateApprovalRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	lease time.Duration,
+) ([]domain.TemplateApproval, error) {
+	var entities []TemplateApproval
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("status IN ? AND next_check_at <= ?",
+				[]string{string(domain.ApprovalStatusSubmitting), string(domain.ApprovalStatusPending)}, now).
+			Order("next_check_at ASC").
+			Limit(limit).
+			Find(&entities).Error
+		if err != nil {
+			return fmt.Errorf("find due template approvals: %w", err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, 0, len(entities))
+		for i := range entities {
+			ids = append(ids, entities[i].ID)
+		}
+		err = tx.Model(&TemplateApproval{}).
+			Where("id IN ?", ids).
+			Updates(map[string]any{
+				"attempts":      gorm.Expr("attempts + 1"),
+				"next_check_at": now.Add(lease),
+				"updated_at":    now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("claim due template approvals: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	as := make([]domain.TemplateApproval, 0, len(entities))
+	for i := range entities {
+		a := toDomainTemplateApproval(entities[i])
+		a.Attempts++
+		a.NextCheckAt = now.Add(lease)
+		as = append(as, a)
+	}
+	return as, nil
+}
+
+// nullableTime 零值时间写为 NULL
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// toTemplateApprovalEntity 将审核记录领域对象转换为数据库实体
+func toTemplateApprovalEntity(a domain.TemplateApproval) TemplateApproval {
+	return TemplateApproval{
+		TenantID:    a.TenantID,
+		TemplateID:  a.TemplateID,
+		Version:     a.Version,
+		Locale:      a.Locale,
+		Provider:    a.Provider,
+		VendorCode:  a.VendorCode,
+		ParamNames:  strings.Join(a.ParamNames, ","),
+		Status:      string(a.Status),
+		Reason:      truncate(a.Reason, maxApprovalReasonLen),
+		Attempts:    a.Attempts,
+		NextCheckAt: a.NextCheckAt,
+		SubmittedAt: nullableTime(a.SubmittedAt),
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+	}
+}
+
+// toDomainTemplateApproval 将审核记录数据库实体转换为领域对象
+func toDomainTemplateApproval(entity TemplateApproval) domain.TemplateApproval {
+	a := domain.TemplateApproval{
+		TenantID:    entity.TenantID,
+		TemplateID:  entity.TemplateID,
+		Version:     entity.Version,
+		Locale:      entity.Locale,
+		Provider:    entity.Provider,
+		VendorCode:  entity.VendorCode,
+		Status:      domain.ApprovalStatus(entity.Status),
+		Reason:      entity.Reason,
+		Attempts:    entity.Attempts,
+		NextCheckAt: entity.NextCheckAt,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}
+	if entity.ParamNames != "" {
+		a.ParamNames = strings.Split(entity.ParamNames, ",")
+	}
+	if entity.SubmittedAt != nil {
+		a.SubmittedAt = *entity.SubmittedAt
+	}
+	return a
+}
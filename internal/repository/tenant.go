@@ -0,0 +1,329 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Tenant tenant 表对应的数据库实体
+type Tenant struct {
+	ID              string `gorm:"primaryKey;type:varchar(64)"`
+	Name            string `gorm:"type:varchar(128);not null"`
+	Enabled         bool   `gorm:"not null"`
+	AllowedChannels string `gorm:"type:varchar(128);not null"`
+	DailyQuota      int64  `gorm:"not null"`
+	MonthlyQuota    int64  `gorm:"not null"`
+	SignName        string `gorm:"type:varchar(64)"`
+	EmailFrom       string `gorm:"type:varchar(320)"`
+	DeliveryWindows string `gorm:"type:text"`
+	Timezone        string `gorm:"type:varchar(64);not null;default:''"`
+	CheckEndpoint   string `gorm:"type:varchar(256);not null;default:''"`
+	CallbackURL     string `gorm:"type:varchar(512);not null;default:''"`
+	CallbackSecret  string `gorm:"type:varchar(128);not null;default:''"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// TableName 指定表名
+func (Tenant) TableName() string {
+	return "tenant"
+}
+
+// TenantAPIKey tenant_api_key 表对应的数据库实体，只保存 API Key 的哈希
+type TenantAPIKey struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	TenantID  string `gorm:"type:varchar(64);not null;index:idx_tenant"`
+	Name      string `gorm:"type:varchar(128)"`
+	Prefix    string `gorm:"type:varchar(16);not null"`
+	KeyHash   string `gorm:"type:char(64);not null;uniqueIndex:uk_key_hash"`
+	Revoked   bool   `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName 指定表名
+func (TenantAPIKey) TableName() string {
+	return "tenant_api_key"
+}
+
+// TenantRepository 租户存储接口
+type TenantRepository interface {
+	// Create 创建租户；租户 ID 已存在时返回 domain.ErrDuplicateTenant
+	Create(ctx context.Context, t domain.Tenant) (domain.Tenant, error)
+	// Update 更新租户，不修改回调签名密钥；租户不存在时返回 domain.ErrTenantNotFound
+	Update(ctx context.Context, t domain.Tenant) (domain.Tenant, error)
+	// SetCallbackSecret 设置租户的回调签名密钥；租户不存在时返回 domain.ErrTenantNotFound
+	SetCallbackSecret(ctx context.Context, id, secret string) (domain.Tenant, error)
+	// FindByID 按租户 ID 查询
+	FindByID(ctx context.Context, id string) (domain.Tenant, error)
+	// List 按租户 ID 升序查询
+	List(ctx context.Context) ([]domain.Tenant, error)
+	// CreateAPIKey 保存 API Key
+	CreateAPIKey(ctx context.Context, key domain.APIKey) (domain.APIKey, error)
+	// FindAPIKeyByHash 按哈希查询未吊销的 API Key
+	FindAPIKeyByHash(ctx context.Context, hash string) (domain.APIKey, error)
+	// ListAPIKeys 查询租户的全部 API Key
+	ListAPIKeys(ctx context.Context, tenantID string) ([]domain.APIKey, error)
+	// RevokeAPIKey 吊销 API Key，返回被吊销的 API Key
+	RevokeAPIKey(ctx context.Context, tenantID string, id uint64) (domain.APIKey, error)
+}
+
+// tenantRepository 基于 GORM 的租户存储实现
+type tenantRepository struct {
+	db *gorm.DB
+}
+
+// NewTenantRepository 创建租户存储
+func NewTenantRepository(db *gorm.DB) TenantRepository {
+	return &tenantRepository{
+		db: db,
+	}
+}
+
+// Create 创建租户
+func (r *tenantRepository) Create(ctx context.Context, t domain.Tenant) (domain.Tenant, error) {
+	entity, err := toTenantEntity(t)
+	if err != nil {
+		return domain.Tenant{}, err
+	}
+	if err := r.db.WithContext(ctx).Create(&entity).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return domain.Tenant{}, domain.ErrDuplicateTenant
+		}
+		return domain.Tenant{}, fmt.Errorf("create tenant: %w", err)
+	}
+	return toDomainTenant(entity)
+}
+
+// Update 更新租户
+func (r *tenantRepository) Update(ctx context.Context, t domain.Tenant) (domain.Tenant, error) {
+	entity, err := toTenantEntity(t)
+	if err != nil {
+		return domain.Tenant{}, err
+	}
+	res := r.db.WithContext(ctx).Model(&Tenant{}).
+		Where("id = ?", t.ID).
+		Updates(map[string]any{
+			"name":             entity.Name,
+			"enabled":          entity.Enabled,
+			"allowed_channels": entity.AllowedChannels,
+			"daily_quota":      entity.DailyQuota,
+			"monthly_quota":    entity.MonthlyQuota,
+			"sign_name":        entity.SignName,
+			"email_from":       entity.EmailFrom,
+			"delivery_windows": entity.DeliveryWindows,
+			"timezone":         entity.Timezone,
+			"check_endpoint":   entity.CheckEndpoint,
+			"callback_url":     entity.CallbackURL,
+			"updated_at":       time.Now(),
+		})
+	if res.Error != nil {
+		return domain.Tenant{}, fmt.Errorf("update tenant: %w", res.Error)
+	}
+	// 内容未变化时 RowsAffected 也为 0，因此以查询结果判断租户是否存在
+	return r.FindByID(ctx, t.ID)
+}
+
+// SetCallbackSecret 设置回调签名密钥
+func (r *tenantRepository) SetCallbackSecret(ctx context.Context, id, secret string) (domain.Tenant, error) {
+	res := r.db.WithContext(ctx).Model(&Tenant{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"callback_secret": secret,
+			"updated_at":      time.Now(),
+		})
+	if res.Error != nil {
+		return domain.Tenant{}, fmt.Errorf("set tenant callback secret: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return domain.Tenant{}, domain.ErrTenantNotFound
+	}
+	return r.FindByID(ctx, id)
+}
+
+// FindByID 按租户 ID 查询
+func (r *tenantRepository) FindByID(ctx context.Context, id string) (domain.Tenant, error) {
+	var entity Tenant
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Tenant{}, domain.ErrTenantNotFound
+	}
+	if err != nil {
+		return domain.Tenant{}, fmt.Errorf("find tenant: %w", err)
+	}
+	return toDomainTenant(entity)
+}
+
+// List 按租户 ID 升序查询
+func (r *tenantRepository) List(ctx context.Context) ([]domain.Tenant, error) {
+	var entities []Tenant
+	if err := r.db.WithContext(ctx).Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, fmt.Errorf("list tenants: %w", err)
+	}
+	ts := make([]domain.Tenant, 0, len(entities))
+	for i := range entities {
+		t, err := toDomainTenant(entities[i])
+		if err != nil {
+			return nil, err
+		}
+		ts = append(ts, t)
+	}
+	return ts, nil
+}
+
+// CreateAPIKey 保存 API Key
+func (r *tenantRepository) CreateAPIKey(ctx context.Context, key domain.APIKey) (domain.APIKey, error) {
+	entity := TenantAPIKey{
+		TenantID: key.TenantID,
+		Name:     key.Name,
+		Prefix:   key.Prefix,
+		KeyHash:  key.Hash,
+	}
+	if err := r.db.WithContext(ctx).Create(&entity).Error; err != nil {
+		return domain.APIKey{}, fmt.Errorf("create api key: %w", err)
+	}
+	return toDomainAPIKey(entity), nil
+}
+
+// FindAPIKeyByHash 按哈希查询未吊销的 API Key
+func (r *tenantRepository) FindAPIKeyByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	var entity TenantAPIKey
+	err := r.db.WithContext(ctx).Where("key_hash = ? AND revoked = ?", hash, false).First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.APIKey{}, domain.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("find api key: %w", err)
+	}
+	return toDomainAPIKey(entity), nil
+}
+
+// ListAPIKeys 查询租户的全部 API Key
+func (r *tenantRepository) ListAPIKeys(ctx context.Context, tenantID string) ([]domain.APIKey, error) {
+	var entities []TenantAPIKey
+	if err := r.db.WithContext(ctx).Where("tenant_id = ?", tenantID).Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+	keys := make([]domain.APIKey, 0, len(entities))
+	for i := range entities {
+		keys = append(keys, toDomainAPIKey(entities[i]))
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey 吊销 API Key
+func (r *tenantRepository) RevokeAPIKey(ctx context.Context, tenantID string, id uint64) (domain.APIKey, error) {
+	var entity TenantAPIKey
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ? AND tenant_id = ?", id, tenantID).First(&entity).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ErrAPIKeyNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("find api key: %w", err)
+		}
+		entity.Revoked = true
+		entity.UpdatedAt = time.Now()
+		err = tx.Model(&TenantAPIKey{}).
+			Where("id = ?", id).
+			Updates(map[string]any{
+				"revoked":    true,
+				"updated_at": entity.UpdatedAt,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("revoke api key: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.APIKey{}, err
+	}
+	return toDomainAPIKey(entity), nil
+}
+
+// toTenantEntity 将租户领域对象转换为数据库实体
+func toTenantEntity(t domain.Tenant) (Tenant, error) {
+	channels := make([]string, 0, len(t.AllowedChannels))
+	for _, c := range t.AllowedChannels {
+		channels = append(channels, string(c))
+	}
+	var windows []byte
+	if len(t.DeliveryWindows) > 0 {
+		var err error
+		if windows, err = json.Marshal(t.DeliveryWindows); err != nil {
+			return Tenant{}, fmt.Errorf("marshal delivery windows: %w", err)
+		}
+	}
+	return Tenant{
+		ID:              t.ID,
+		Name:            t.Name,
+		Enabled:         t.Enabled,
+		AllowedChannels: strings.Join(channels, ","),
+		DailyQuota:      t.DailyQuota,
+		MonthlyQuota:    t.MonthlyQuota,
+		SignName:        t.Sender.SignName,
+		EmailFrom:       t.Sender.EmailFrom,
+		DeliveryWindows: string(windows),
+		Timezone:        t.Timezone,
+		CheckEndpoint:   t.CheckEndpoint,
+		CallbackURL:     t.CallbackURL,
+		CallbackSecret:  t.CallbackSecret,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}, nil
+}
+
+// toDomainTenant 将租户数据库实体转换为领域对象
+func toDomainTenant(entity Tenant) (domain.Tenant, error) {
+	var channels []domain.Channel
+	for _, c := range strings.Split(entity.AllowedChannels, ",") {
+		if c != "" {
+			channels = append(channels, domain.Channel(c))
+		}
+	}
+	var windows []domain.DeliveryWindow
+	if entity.DeliveryWindows != "" {
+		if err := json.Unmarshal([]byte(entity.DeliveryWindows), &windows); err != nil {
+			return domain.Tenant{}, fmt.Errorf("unmarshal delivery windows: %w", err)
+		}
+	}
+	return domain.Tenant{
+		ID:              entity.ID,
+		Name:            entity.Name,
+		Enabled:         entity.Enabled,
+		AllowedChannels: channels,
+		DailyQuota:      entity.DailyQuota,
+		MonthlyQuota:    entity.MonthlyQuota,
+		Sender: domain.Sender{
+			SignName:  entity.SignName,
+			EmailFrom: entity.EmailFrom,
+		},
+		DeliveryWindows: windows,
+		Timezone:        entity.Timezone,
+		CheckEndpoint:   entity.CheckEndpoint,
+		CallbackURL:     entity.CallbackURL,
+		CallbackSecret:  entity.CallbackSecret,
+		CreatedAt:       entity.CreatedAt,
+		UpdatedAt:       entity.UpdatedAt,
+	}, nil
+}
+
+// toDomainAPIKey 将 API Key 数据库实体转换为领域对象
+func toDomainAPIKey(entity TenantAPIKey) domain.APIKey {
+	return domain.APIKey{
+		ID:        entity.ID,
+		TenantID:  entity.TenantID,
+		Name:      entity.Name,
+		Prefix:    entity.Prefix,
+		Hash:      entity.KeyHash,
+		Revoked:   entity.Revoked,
+		CreatedAt: entity.CreatedAt,
+	}
+}
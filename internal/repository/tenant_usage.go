@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TenantUsage tenant_usage 表对应的数据库实体，记录租户在每个配额周期内已受理的通知数
+type TenantUsage struct {
+	ID       uint64 `gorm:"primaryKey;autoIncrement"`
+	TenantID string `gorm:"type:varchar(64);not null;uniqueIndex:uk_tenant_period,priority:1"`
+	// Period 配额周期，日配额形如 D20261017，月配额形如 M202610
+	Period    string `gorm:"type:varchar(16);not null;uniqueIndex:uk_tenant_period,priority:2"`
+	Used      int64  `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName 指定表名
+func (TenantUsage) TableName() string {
+	return "tenant_usage"
+}
+
+// TenantUsageRepository 租户配额用量存储接口
+type TenantUsageRepository interface {
+	// Consume 在 at 所在的日、月周期内各占用一个配额，任一周期已用完时不占用并返回 domain.ErrQuotaExceeded
+	Consume(ctx context.Context, t domain.Tenant, at time.Time) error
+	// Refund 归还 Consume 占用的配额，at 须与 Consume 时一致
+	Refund(ctx context.Context, t domain.Tenant, at time.Time) error
+}
+
+// tenantUsageRepository 基于 GORM 的配额用量存储实现
+type tenantUsageRepository struct {
+	db *gorm.DB
+}
+
+// NewTenantUsageRepository 创建配额用量存储
+func NewTenantUsageRepository(db *gorm.DB) TenantUsageRepository {
+	return &tenantUsageRepository{
+		db: db,
+	}
+}
+
+// quotaPeriod 一个配额周期及其上限
+type quotaPeriod struct {
+	period string
+	quota  int64
+}
+
+// quotaPeriods 返回 at 所在的、设置了上限的配额周期
+func quotaPeriods(t domain.Tenant, at time.Time) []quotaPeriod {
+	var periods []quotaPeriod
+	if t.DailyQuota > 0 {
+		periods = append(periods, quotaPeriod{period: "D" + at.Format("20060102"), quota: t.DailyQuota})
+	}
+	if t.MonthlyQuota > 0 {
+		periods = append(periods, quotaPeriod{period: "M" + at.Format("200601"), quota: t.MonthlyQuota})
+	}
+	return periods
+}
+
+// Consume 在同一事务中用条件更新占用日、月配额，并发受理时不会超出上限
+func (r *tenantUsageRepository) Consume(ctx context.Context, t domain.Tenant, at time.Time) error {
+	periods := quotaPeriods(t, at)
+	if len(periods) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range periods {
+			err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&TenantUsage{TenantID: t.ID, Period: p.period}).Error
+			if err != nil {
+				return fmt.Errorf("init tenant usage: %w", err)
+			}
+			res := tx.Model(&TenantUsage{}).
+				Where("tenant_id = ? AND period = ? AND used < ?", t.ID, p.period, p.quota).
+				Updates(map[string]any{
+					"used":       gorm.Expr("used + 1"),
+					"updated_at": time.Now(),
+				})
+			if res.Error != nil {
+				return fmt.Errorf("consume tenant usage: %w", res.Error)
+			}
+			if res.RowsAffected == 0 {
+				return domain.ErrQuotaExceeded
+			}
+		}
+		return nil
+	})
+}
+
+// Refund 归还日、月配额
+func (r *tenantUsageRepository) Refund(ctx context.Context, t domain.Tenant, at time.Time) error {
+	periods := quotaPeriods(t, at)
+	if len(periods) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(periods))
+	for _, p := range periods {
+		names = append(names, p.period)
+	}
+	err := r.db.WithContext(ctx).Model(&TenantUsage{}).
+		Where("tenant_id = ? AND period IN ? AND used > 0", t.ID, names).
+		Updates(map[string]any{
+			"used":       gorm.Expr("used - 1"),
+			"updated_at": time.Now(),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("refund tenant usage: %w", err)
+	}
+	return nil
+}
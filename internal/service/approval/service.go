@@ -0,0 +1,172 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/provider"
+	"go.uber.org/zap"
+)
+
+// Submit 为短信模板版本的默认内容与每个语言版本在每个需要报备模板的供应商处创建待提交的审核记录，
+// 并唤醒扫描器尽快提交；邮件模板无需报备，直接忽略
+func (s *Syncer) Submit(ctx context.Context, t domain.MessageTemplate, v domain.TemplateVersion) error {
+	if t.Channel != domain.ChannelSMS {
+		return nil
+	}
+	auditors := s.auditors()
+	now := time.Now()
+	as := make([]domain.TemplateApproval, 0, len(auditors)*(len(v.Locales)+1))
+	for _, c := range v.Contents() {
+		for _, p := range auditors {
+			as = append(as, domain.TemplateApproval{
+				TenantID:    t.TenantID,
+				TemplateID:  t.ID,
+				Version:     v.Version,
+				Locale:      c.Locale,
+				Provider:    p.Name(),
+				Status:      domain.ApprovalStatusSubmitting,
+				NextCheckAt: now,
+			})
+		}
+	}
+	if err := s.approvals.Create(ctx, as); err != nil {
+		return err
+	}
+	s.wake()
+	return nil
+}
+
+// List 查询模板在各供应商处的审核记录，version 为 0 时返回全部版本；模板归属于 context 中已鉴权的租户
+func (s *Syncer) List(ctx context.Context, templateID string, version int) ([]domain.TemplateApproval, error) {
+	tid := tenantID(ctx)
+	if _, err := s.templates.Find(ctx, tid, templateID); err != nil {
+		return nil, err
+	}
+	return s.approvals.List(ctx, tid, templateID, version)
+}
+
+// Resubmit 将模板版本的默认内容与全部语言版本重新提交给供应商审核，providerName 为空时提交给所有需要报备模板的供应商
+// 尚无审核记录的供应商（如启用审核同步之前创建的版本、新接入的供应商）创建待提交记录；
+// 审核未通过的记录重置为待提交；审核中与已通过的记录保持不变
+func (s *Syncer) Resubmit(
+	ctx context.Context,
+	templateID string,
+	version int,
+	providerName string,
+) ([]domain.TemplateApproval, error) {
+	tid := tenantID(ctx)
+	t, err := s.templates.Find(ctx, tid, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if t.Channel != domain.ChannelSMS {
+		return nil, fmt.Errorf("%w: 仅短信模板需要供应商审核", domain.ErrInvalidTemplate)
+	}
+	v, err := s.templates.FindVersion(ctx, tid, templateID, version)
+	if err != nil {
+		return nil, err
+	}
+	targets := s.auditors()
+	if providerName != "" {
+		p, ok := s.registry.Get(providerName)
+		if _, audited := p.(provider.TemplateAuditor); !ok || !audited || p.SupportedChannel() != domain.ChannelSMS {
+			return nil, fmt.Errorf("%w: 供应商 %s 未启用或无需模板审核", domain.ErrInvalidTemplate, providerName)
+		}
+		targets = []provider.Provider{p}
+	}
+
+	for _, c := range v.Contents() {
+		for _, p := range targets {
+			if err := s.resubmit(ctx, tid, templateID, version, c.Locale, p.Name()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	s.logger.Info("重新提交模板审核",
+		zap.String("tenant_id", tid),
+		zap.String("template_id", templateID),
+		zap.Int("version", version),
+		zap.Strings("providers", providerNames(targets)),
+	)
+	s.wake()
+	return s.approvals.List(ctx, tid, templateID, version)
+}
+
+// resubmit 为模板版本的一个语言版本在一个供应商处创建待提交记录，或将审核未通过的记录重置为待提交
+func (s *Syncer) resubmit(ctx context.Context, tid, templateID string, version int, locale, providerName string) error {
+	now := time.Now()
+	a, err := s.approvals.Find(ctx, tid, templateID, version, locale, providerName)
+	switch {
+	case errors.Is(err, domain.ErrTemplateApprovalNotFound):
+		return s.approvals.Create(ctx, []domain.TemplateApproval{{
+			TenantID:    tid,
+			TemplateID:  templateID,
+			Version:     version,
+			Locale:      locale,
+			Provider:    providerName,
+			Status:      domain.ApprovalStatusSubmitting,
+			NextCheckAt: now,
+		}})
+	case err == nil && a.Status == domain.ApprovalStatusRejected:
+		a.Status = domain.ApprovalStatusSubmitting
+		a.VendorCode, a.Reason, a.ParamNames = "", "", nil
+		a.SubmittedAt, a.NextCheckAt = time.Time{}, now
+		return s.approvals.Update(ctx, a)
+	}
+	return err
+}
+
+// ForProvider 返回发往该供应商时使用的通知：模板 ID 换成供应商的模板 Code，并带上变量的位置顺序
+// 使用受理时选中的语言版本的审核记录；无需报备模板的供应商与未绑定平台模板版本的通知原样返回，
+// 模板版本在该供应商处尚未审核通过时返回 domain.ErrTemplateNotApproved
+func (s *Syncer) ForProvider(ctx context.Context, n domain.Notification, p provider.Provider) (domain.Notification, error) {
+	if n.Channel != domain.ChannelSMS || n.Template.Version == 0 {
+		return n, nil
+	}
+	if _, ok := p.(provider.TemplateAuditor); !ok {
+		return n, nil
+	}
+
+	key := approvalKey(n.TenantID, n.Template.ID, n.Template.Version, n.Template.Locale, p.Name())
+	cached, ok := s.approved.Load(key)
+	a, _ := cached.(domain.TemplateApproval)
+	if !ok {
+		var err error
+		a, err = s.approvals.Find(ctx, n.TenantID, n.Template.ID, n.Template.Version, n.Template.Locale, p.Name())
+		if errors.Is(err, domain.ErrTemplateApprovalNotFound) {
+			return n, fmt.Errorf("%w: 模板 %s 第 %d 版%s未提交给供应商 %s",
+				domain.ErrTemplateNotApproved, n.Template.ID, n.Template.Version, localeLabel(n.Template.Locale), p.Name())
+		}
+		if err != nil {
+			return n, err
+		}
+		if a.Status != domain.ApprovalStatusApproved {
+			return n, fmt.Errorf("%w: 模板 %s 第 %d 版%s在供应商 %s 处的审核状态为 %s",
+				domain.ErrTemplateNotApproved, n.Template.ID, n.Template.Version, localeLabel(n.Template.Locale),
+				p.Name(), a.Status)
+		}
+		s.approved.Store(key, a)
+	}
+
+	n.Template.ID = a.VendorCode
+	n.Template.ParamNames = a.ParamNames
+	return n, nil
+}
+
+// localeLabel 日志与错误信息中的语言版本说明，默认内容为空
+func localeLabel(locale string) string {
+	if locale == "" {
+		return ""
+	}
+	return "（" + locale + "）"
+}
+
+// tenantID 返回 context 中已鉴权的租户 ID，未启用租户鉴权时为空
+func tenantID(ctx context.Context) string {
+	t, _ := domain.TenantFromContext(ctx)
+	return t.ID
+}
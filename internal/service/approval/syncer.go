@@ -0,0 +1,309 @@
+// Package approval 供应商模板审核同步
+// 国内短信供应商要求模板先报备审核，审核通过后才能以供应商分配的模板 Code 发送。
+// 平台短信模板每创建一个版本，就为每个需要报备的供应商写入一条待提交的审核记录；
+// 后台同步器以行锁领取到期记录，提交模板或轮询审核状态并回写 MySQL，多实例部署时同一记录只会被一个实例处理。
+// 发送前按审核记录过滤供应商，并把平台模板 ID 换成该供应商的模板 Code
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+)
+
+// maxTemplateNameLen 提交给供应商的模板名称长度上限（按字符计），取各供应商限制中较小者
+const maxTemplateNameLen = 30
+
+// Syncer 模板审核同步器
+type Syncer struct {
+	cfg       *config.TemplateApprovalConfig
+	approvals repository.TemplateApprovalRepository
+	templates repository.TemplateRepository
+	registry  *provider.Registry
+	logger    appLogger.Logger
+
+	// approved 已审核通过的记录，审核通过后不再变化，可在进程内长期缓存
+	approved sync.Map
+
+	// kick 有新记录待提交时唤醒扫描器，不必等到下一个扫描周期
+	kick   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewSyncer 创建模板审核同步器
+func NewSyncer(
+	cfg *config.TemplateApprovalConfig,
+	approvals repository.TemplateApprovalRepository,
+	templates repository.TemplateRepository,
+	registry *provider.Registry,
+	logger appLogger.Logger,
+) *Syncer {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &Syncer{
+		cfg:       cfg,
+		approvals: approvals,
+		templates: templates,
+		registry:  registry,
+		logger:    logger,
+		kick:      make(chan struct{}, 1),
+	}
+}
+
+// Start 启动审核同步扫描器
+func (s *Syncer) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.scan(ctx)
+
+	s.logger.Info("模板审核同步器启动",
+		zap.Int("scan_interval", s.cfg.ScanInterval),
+		zap.Int("poll_interval", s.cfg.PollInterval),
+		zap.Strings("providers", providerNames(s.auditors())),
+	)
+}
+
+// Stop 停止扫描器并等待正在处理的记录完成；未完成的记录在租约到期后会被重新领取
+func (s *Syncer) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	s.logger.Info("模板审核同步器已停止")
+}
+
+// scan 定时领取到期的审核记录并处理
+func (s *Syncer) scan(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(time.Duration(s.cfg.ScanInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.kick:
+		}
+		s.scanOnce(ctx)
+	}
+}
+
+// wake 唤醒扫描器，扫描器正忙时本次唤醒合并到下一次扫描
+func (s *Syncer) wake() {
+	select {
+	case s.kick <- struct{}{}:
+	default:
+	}
+}
+
+// scanOnce 领取一批到期的审核记录，并发处理
+// 每条记录的处理时间受 call_timeout 限制，小于租约时长，因此整批都能在租约内完成
+func (s *Syncer) scanOnce(ctx context.Context) {
+	lease := time.Duration(s.cfg.Lease) * time.Second
+	as, err := s.approvals.ClaimDue(ctx, time.Now(), s.cfg.ScanBatchSize, lease)
+	if err != nil {
+		s.logger.Error("领取到期模板审核记录失败", zap.Error(err))
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, a := range as {
+		wg.Add(1)
+		go func(a domain.TemplateApproval) {
+			defer wg.Done()
+			// 处理过程不受扫描器停止影响，避免提交成功却未回写模板 Code
+			s.sync(context.WithoutCancel(ctx), a)
+		}(a)
+	}
+	wg.Wait()
+}
+
+// sync 按记录状态提交模板或查询审核状态，并回写结果
+func (s *Syncer) sync(ctx context.Context, a domain.TemplateApproval) {
+	before := a.Status
+	a.NextCheckAt = time.Now().Add(time.Duration(s.cfg.PollInterval) * time.Second)
+
+	auditor, ok := s.auditor(a.Provider)
+	if !ok {
+		// 供应商已下线或不再需要报备，保留记录，供应商重新启用后继续处理
+		a.Reason = "provider not enabled"
+		s.logger.Warn("模板审核记录的供应商未启用，稍后重试",
+			zap.String("tenant_id", a.TenantID),
+			zap.String("template_id", a.TemplateID),
+			zap.Int("version", a.Version),
+			zap.String("locale", a.Locale),
+			zap.String("provider", a.Provider),
+		)
+	} else {
+		callCtx, cancel := context.WithTimeout(ctx, time.Duration(s.cfg.CallTimeout)*time.Second)
+		if a.Status == domain.ApprovalStatusSubmitting {
+			s.submit(callCtx, auditor, &a)
+		} else {
+			s.poll(callCtx, auditor, &a)
+		}
+		cancel()
+	}
+
+	if err := s.approvals.Update(ctx, a); err != nil {
+		s.logger.Error("回写模板审核记录失败",
+			zap.String("tenant_id", a.TenantID),
+			zap.String("template_id", a.TemplateID),
+			zap.Int("version", a.Version),
+			zap.String("locale", a.Locale),
+			zap.String("provider", a.Provider),
+			zap.String("vendor_code", a.VendorCode),
+			zap.Error(err),
+		)
+		return
+	}
+	if a.Status == domain.ApprovalStatusApproved {
+		s.approved.Store(approvalKey(a.TenantID, a.TemplateID, a.Version, a.Locale, a.Provider), a)
+	}
+	if a.Status != before {
+		s.logger.Info("模板审核状态变更",
+			zap.String("tenant_id", a.TenantID),
+			zap.String("template_id", a.TemplateID),
+			zap.Int("version", a.Version),
+			zap.String("locale", a.Locale),
+			zap.String("provider", a.Provider),
+			zap.String("vendor_code", a.VendorCode),
+			zap.String("from", string(before)),
+			zap.String("to", string(a.Status)),
+			zap.String("reason", a.Reason),
+		)
+	}
+}
+
+// submit 提交模板版本；可重试的失败保持待提交状态，永久性失败（如内容不合规）视为审核未通过
+func (s *Syncer) submit(ctx context.Context, auditor provider.TemplateAuditor, a *domain.TemplateApproval) {
+	t, err := s.templates.Find(ctx, a.TenantID, a.TemplateID)
+	if err == nil {
+		var v domain.TemplateVersion
+		v, err = s.templates.FindVersion(ctx, a.TenantID, a.TemplateID, a.Version)
+		if err == nil {
+			sub := submission(t, v, a.Locale)
+			a.ParamNames = sub.ParamNames
+			a.VendorCode, err = auditor.SubmitTemplate(ctx, sub)
+		}
+	}
+	if err != nil {
+		a.Reason = err.Error()
+		if errors.Is(err, domain.ErrTemplateNotFound) || errors.Is(err, domain.ErrTemplateVersionNotFound) ||
+			!provider.IsRetryable(err) {
+			a.Status = domain.ApprovalStatusRejected
+		}
+		s.logger.Warn("提交模板审核失败",
+			zap.String("tenant_id", a.TenantID),
+			zap.String("template_id", a.TemplateID),
+			zap.Int("version", a.Version),
+			zap.String("locale", a.Locale),
+			zap.String("provider", a.Provider),
+			zap.Int("attempts", a.Attempts),
+			zap.Error(err),
+		)
+		return
+	}
+	a.Status = domain.ApprovalStatusPending
+	a.Reason = ""
+	a.SubmittedAt = time.Now()
+}
+
+// poll 查询审核状态，查询失败时保持审核中，下个周期再查
+func (s *Syncer) poll(ctx context.Context, auditor provider.TemplateAuditor, a *domain.TemplateApproval) {
+	res, err := auditor.QueryTemplate(ctx, a.VendorCode)
+	if err != nil {
+		s.logger.Warn("查询模板审核状态失败",
+			zap.String("tenant_id", a.TenantID),
+			zap.String("template_id", a.TemplateID),
+			zap.Int("version", a.Version),
+			zap.String("locale", a.Locale),
+			zap.String("provider", a.Provider),
+			zap.String("vendor_code", a.VendorCode),
+			zap.Error(err),
+		)
+		return
+	}
+	switch res.Status {
+	case provider.TemplateAuditApproved:
+		a.Status = domain.ApprovalStatusApproved
+		a.Reason = ""
+	case provider.TemplateAuditRejected:
+		a.Status = domain.ApprovalStatusRejected
+		a.Reason = res.Reason
+	case provider.TemplateAuditPending:
+	}
+}
+
+// submission 由平台模板版本的一个语言版本生成提交给供应商的模板，locale 为空表示默认内容
+func submission(t domain.MessageTemplate, v domain.TemplateVersion, locale string) provider.TemplateSubmission {
+	content := v.Content([]string{locale})
+	suffix := fmt.Sprintf(" v%d", v.Version)
+	if content.Locale != "" {
+		suffix += " " + content.Locale
+	}
+	name := []rune(t.Name)
+	if limit := maxTemplateNameLen - len(suffix); len(name) > limit {
+		name = name[:limit]
+	}
+	remark := t.Description
+	if remark == "" {
+		remark = fmt.Sprintf("模板 %s 第 %d 版%s", t.ID, v.Version, localeLabel(content.Locale))
+	}
+	return provider.TemplateSubmission{
+		TemplateID: t.ID,
+		Version:    v.Version,
+		Name:       string(name) + suffix,
+		Content:    content.Body,
+		ParamNames: content.Placeholders(),
+		Remark:     remark,
+	}
+}
+
+// auditor 按名称获取需要报备模板的供应商
+func (s *Syncer) auditor(name string) (provider.TemplateAuditor, bool) {
+	p, ok := s.registry.Get(name)
+	if !ok {
+		return nil, false
+	}
+	auditor, ok := p.(provider.TemplateAuditor)
+	return auditor, ok
+}
+
+// auditors 返回已启用的、需要报备模板的短信供应商
+func (s *Syncer) auditors() []provider.Provider {
+	var ps []provider.Provider
+	for _, p := range s.registry.ByChannel(domain.ChannelSMS) {
+		if _, ok := p.(provider.TemplateAuditor); ok {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// providerNames 返回供应商名称列表
+func providerNames(ps []provider.Provider) []string {
+	names := make([]string, 0, len(ps))
+	for _, p := range ps {
+		names = append(names, p.Name())
+	}
+	return names
+}
+
+// approvalKey 审核记录在进程内缓存中的键
+func approvalKey(tenantID, templateID string, version int, locale, providerName string) string {
+	return fmt.Sprintf("%s\x00%s\x00%d\x00%s\x00%s", tenantID, templateID, version, locale, providerName)
+}
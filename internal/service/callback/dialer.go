@@ -0,0 +1,77 @@
+package callback
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// dialTimeout 建立连接的超时时间，整体超时由 http.Client 控制
+const dialTimeout = 5 * time.Second
+
+// errForbiddenAddress 回调地址解析到不允许访问的内网地址
+var errForbiddenAddress = errors.New("回调地址不允许访问内网地址")
+
+// nonPublicPrefixes 除回环、私有、链路本地等标准库可识别的地址外，其他不允许回调访问的保留网段
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // 本网络
+	netip.MustParsePrefix("100.64.0.0/10"),  // 运营商级 NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF 协议分配
+	netip.MustParsePrefix("198.18.0.0/15"),  // 网络基准测试
+	netip.MustParsePrefix("240.0.0.0/4"),    // 保留地址与广播地址
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64，可映射到内网 IPv4 地址
+	netip.MustParsePrefix("64:ff9b:1::/48"), // 本地 NAT64
+	netip.MustParsePrefix("2002::/16"),      // 6to4，可内嵌内网 IPv4 地址
+}
+
+// newTransport 创建投递回调的 Transport：不使用代理，allowPrivate 为 false 时在建立连接前校验解析后的地址，
+// 拒绝连接内网地址；在连接时而不是按回调地址字符串校验，避免 DNS 重绑定绕过
+func newTransport(allowPrivate bool) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	if !allowPrivate {
+		dialer.Control = denyPrivate
+	}
+	return &http.Transport{
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+}
+
+// denyPrivate net.Dialer 的 Control 钩子，address 为解析后的 IP 与端口
+func denyPrivate(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errForbiddenAddress, address)
+	}
+	if !isPublic(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errForbiddenAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+// isPublic 判断地址是否为可回调的公网地址
+func isPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
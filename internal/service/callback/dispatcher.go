@@ -0,0 +1,302 @@
+// Package callback 通知结果回调
+// 通知进入终态且设置了回调地址时，回调与通知状态在同一事务中写入 MySQL，事务回滚时回调随之撤销；
+// 投递器以行锁领取到期的回调，以 POST 发送签名的 JSON 结果（格式见 pkg/callback），业务方返回 2xx 即投递成功，
+// 否则按重试策略退避后再次投递，每次投递都写入投递记录。多实例部署时同一回调同一时刻只会被一个实例投递，
+// 实例在投递中途退出时租约到期后重新投递，业务方按回调 ID 去重
+package callback
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/repository"
+	"github.com/dingdong-postman/internal/service/retry"
+	"github.com/dingdong-postman/pkg/callback"
+	"go.uber.org/zap"
+)
+
+const (
+	// userAgent 回调请求的 User-Agent
+	userAgent = "dingdong-postman-callback/1"
+	// maxErrorBodyLen 投递失败时写入日志的响应体最大长度
+	maxErrorBodyLen = 256
+)
+
+// errNoSecret 回调所属租户与平台均未配置签名密钥
+var errNoSecret = errors.New("未配置回调签名密钥")
+
+// statusError 业务方返回非 2xx 响应
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("业务方返回 HTTP %d: %s", e.code, e.body)
+}
+
+// Tenants 查询租户的回调签名密钥，由租户服务实现
+type Tenants interface {
+	// Get 查询租户；须从 MySQL 读取，租户缓存中不包含回调签名密钥
+	Get(ctx context.Context, id string) (domain.Tenant, error)
+}
+
+// Dispatcher 回调投递器
+type Dispatcher struct {
+	cfg     *config.CallbackConfig
+	repo    repository.CallbackRepository
+	tenants Tenants
+	policy  retry.Policy
+	client  *http.Client
+	logger  appLogger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDispatcher 创建回调投递器
+// tenants 为 nil 时（如未启用租户鉴权）统一使用平台的默认签名密钥
+func NewDispatcher(
+	cfg *config.CallbackConfig,
+	repo repository.CallbackRepository,
+	tenants Tenants,
+	logger appLogger.Logger,
+) *Dispatcher {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &Dispatcher{
+		cfg:     cfg,
+		repo:    repo,
+		tenants: tenants,
+		policy: retry.Policy{
+			MaxAttempts: cfg.Retry.MaxAttempts,
+			BaseDelay:   time.Duration(cfg.Retry.BaseDelay) * time.Second,
+			MaxDelay:    time.Duration(cfg.Retry.MaxDelay) * time.Second,
+			Jitter:      cfg.Retry.Jitter,
+		},
+		client: &http.Client{
+			Timeout:   time.Duration(cfg.Timeout) * time.Millisecond,
+			Transport: newTransport(cfg.AllowPrivateNetworks),
+			// 重定向按投递失败处理，避免 POST 被改写为 GET 后误判为成功，也避免经重定向访问内网地址
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		logger: logger,
+	}
+}
+
+// Start 启动回调扫描器
+func (d *Dispatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+
+	d.wg.Add(1)
+	go d.scan(ctx)
+
+	d.logger.Info("回调投递器启动",
+		zap.Int("scan_interval", d.cfg.ScanInterval),
+		zap.Int("concurrency", d.cfg.Concurrency),
+		zap.Int("max_attempts", d.policy.MaxAttempts),
+		zap.Bool("default_secret", d.cfg.GetSecret() != ""),
+		zap.Bool("allow_private_networks", d.cfg.AllowPrivateNetworks),
+	)
+}
+
+// Stop 停止扫描器并等待正在进行的投递完成；未完成的回调在租约到期后会被重新领取
+func (d *Dispatcher) Stop() {
+	if d.cancel != nil {
+		d.cancel()
+	}
+	d.wg.Wait()
+	d.logger.Info("回调投递器已停止")
+}
+
+// scan 定时领取到期的回调并投递
+func (d *Dispatcher) scan(ctx context.Context) {
+	defer d.wg.Done()
+	ticker := time.NewTicker(time.Duration(d.cfg.ScanInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.scanOnce(ctx)
+		}
+	}
+}
+
+// scanOnce 领取一批到期的回调，并发投递
+func (d *Dispatcher) scanOnce(ctx context.Context) {
+	lease := time.Duration(d.cfg.Lease) * time.Second
+	callbacks, err := d.repo.ClaimDue(ctx, time.Now(), d.cfg.BatchSize, lease)
+	if err != nil {
+		d.logger.Error("领取到期回调失败", zap.Error(err))
+		return
+	}
+	if len(callbacks) == 0 {
+		return
+	}
+
+	sem := make(chan struct{}, d.cfg.Concurrency)
+	var wg sync.WaitGroup
+	for _, cb := range callbacks {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(cb domain.Callback) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			// 投递过程不受扫描器停止影响，避免业务方已收到而未记录
+			d.dispatch(context.WithoutCancel(ctx), cb)
+		}(cb)
+	}
+	wg.Wait()
+}
+
+// dispatch 投递一次回调并记录结果：成功即结束，失败时未用完投递次数的按退避时间安排重试
+func (d *Dispatcher) dispatch(ctx context.Context, cb domain.Callback) {
+	fields := []zap.Field{
+		zap.Uint64("callback_id", cb.ID),
+		zap.Uint64("notification_id", cb.NotificationID),
+		zap.String("tenant_id", cb.TenantID),
+		zap.String("url", cb.URL),
+		zap.Int("attempt", cb.Attempts),
+	}
+
+	start := time.Now()
+	statusCode, err := d.post(ctx, cb)
+	attempt := domain.CallbackAttempt{
+		CallbackID:     cb.ID,
+		NotificationID: cb.NotificationID,
+		Attempt:        cb.Attempts,
+		URL:            cb.URL,
+		StatusCode:     statusCode,
+		Duration:       time.Since(start),
+		AttemptedAt:    start,
+	}
+
+	status, next := domain.CallbackStatusSucceeded, time.Time{}
+	switch {
+	case err == nil:
+		d.logger.Info("回调投递成功", append(fields, zap.Int("status_code", statusCode))...)
+	case errors.Is(err, errNoSecret) || errors.Is(err, errForbiddenAddress) || cb.Attempts >= d.policy.MaxAttempts:
+		attempt.Error = attemptError(statusCode, err)
+		status = domain.CallbackStatusFailed
+		d.logger.Error("回调投递失败，不再重试", append(fields, zap.Int("status_code", statusCode), zap.Error(err))...)
+	default:
+		attempt.Error = attemptError(statusCode, err)
+		status = domain.CallbackStatusPending
+		delay := d.policy.Delay(cb.Attempts)
+		next = time.Now().Add(delay)
+		d.logger.Warn("回调投递失败，等待重试",
+			append(fields, zap.Int("status_code", statusCode), zap.Duration("delay", delay), zap.Error(err))...)
+	}
+
+	if err := d.repo.Record(ctx, attempt, status, next); err != nil {
+		// 记录失败时回调保持领取时的租约，到期后重新投递
+		d.logger.Error("记录回调投递结果失败", append(fields, zap.Error(err))...)
+	}
+}
+
+// post 签名并发送回调请求，返回业务方的 HTTP 状态码；非 2xx 响应返回错误
+func (d *Dispatcher) post(ctx context.Context, cb domain.Callback) (int, error) {
+	secret, err := d.secret(ctx, cb.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	body, err := json.Marshal(callback.Payload{
+		CallbackID:        cb.ID,
+		NotificationID:    cb.NotificationID,
+		TenantID:          cb.TenantID,
+		BizKey:            cb.BizKey,
+		Channel:           string(cb.Channel),
+		Status:            string(cb.NotificationStatus),
+		ErrorMessage:      cb.ErrorMessage,
+		Provider:          cb.Receipt.Provider,
+		ProviderMessageID: cb.Receipt.MessageID,
+		OccurredAt:        cb.OccurredAt,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("marshal callback payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cb.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("build callback request: %w", err)
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(callback.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(callback.HeaderSignature, callback.Sign(secret, timestamp, body))
+	req.Header.Set(callback.HeaderCallbackID, strconv.FormatUint(cb.ID, 10))
+	req.Header.Set(callback.HeaderAttempt, strconv.Itoa(cb.Attempts))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		// 读完响应体以便复用连接
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+		return resp.StatusCode, nil
+	}
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLen))
+	return resp.StatusCode, &statusError{code: resp.StatusCode, body: string(bytes.TrimSpace(snippet))}
+}
+
+// attemptError 返回写入投递记录的失败原因；投递记录可由租户查询，只包含状态码与概括的原因，
+// 不包含响应体与连接错误的细节，避免回调被用于探测内网，详细错误只写入日志
+func attemptError(statusCode int, err error) string {
+	var se *statusError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, errNoSecret):
+		return errNoSecret.Error()
+	case errors.Is(err, errForbiddenAddress):
+		return errForbiddenAddress.Error()
+	case errors.As(err, &se):
+		return fmt.Sprintf("业务方返回 HTTP %d", statusCode)
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "投递超时"
+	default:
+		return "连接业务方失败"
+	}
+}
+
+// secret 返回回调的签名密钥：租户已生成密钥时使用租户的密钥，否则使用平台的默认密钥
+func (d *Dispatcher) secret(ctx context.Context, tenantID string) (string, error) {
+	if tenantID != "" && d.tenants != nil {
+		t, err := d.tenants.Get(ctx, tenantID)
+		if err != nil {
+			return "", fmt.Errorf("查询回调所属租户失败: %w", err)
+		}
+		if t.CallbackSecret != "" {
+			return t.CallbackSecret, nil
+		}
+	}
+	if secret := d.cfg.GetSecret(); secret != "" {
+		return secret, nil
+	}
+	return "", errNoSecret
+}
@@ -0,0 +1,85 @@
+package callback
+
+import (
+	"context"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/repository"
+)
+
+// History 一次回调及其投递记录
+type History struct {
+	// Callback 回调
+	Callback domain.Callback
+	// Attempts 按投递顺序排列的投递记录
+	Attempts []domain.CallbackAttempt
+}
+
+// Notifications 按租户查询通知，由通知服务实现
+type Notifications interface {
+	// FindByID 按通知 ID 查询；启用租户鉴权时只能查询本租户的通知
+	FindByID(ctx context.Context, id uint64) (domain.Notification, error)
+	// FindByBizKey 按业务键查询本租户的通知
+	FindByBizKey(ctx context.Context, bizKey string) (domain.Notification, error)
+}
+
+// Service 回调历史查询服务接口
+type Service interface {
+	// ListByID 按通知 ID 查询回调历史，返回通知 ID 与按创建顺序排列的回调
+	ListByID(ctx context.Context, id uint64) (uint64, []History, error)
+	// ListByBizKey 按业务键查询本租户通知的回调历史
+	ListByBizKey(ctx context.Context, bizKey string) (uint64, []History, error)
+}
+
+// service 回调历史查询服务实现
+type service struct {
+	notifications Notifications
+	repo          repository.CallbackRepository
+}
+
+// NewService 创建回调历史查询服务，通知的租户归属由 notifications 校验
+func NewService(notifications Notifications, repo repository.CallbackRepository) Service {
+	return &service{
+		notifications: notifications,
+		repo:          repo,
+	}
+}
+
+// ListByID 按通知 ID 查询回调历史
+func (s *service) ListByID(ctx context.Context, id uint64) (uint64, []History, error) {
+	n, err := s.notifications.FindByID(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.list(ctx, n.ID)
+}
+
+// ListByBizKey 按业务键查询回调历史
+func (s *service) ListByBizKey(ctx context.Context, bizKey string) (uint64, []History, error) {
+	n, err := s.notifications.FindByBizKey(ctx, bizKey)
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.list(ctx, n.ID)
+}
+
+// list 查询通知的回调与投递记录，并按回调归组
+func (s *service) list(ctx context.Context, notificationID uint64) (uint64, []History, error) {
+	callbacks, err := s.repo.ListByNotification(ctx, notificationID)
+	if err != nil {
+		return 0, nil, err
+	}
+	attempts, err := s.repo.ListAttempts(ctx, notificationID)
+	if err != nil {
+		return 0, nil, err
+	}
+	byCallback := make(map[uint64][]domain.CallbackAttempt, len(callbacks))
+	for _, a := range attempts {
+		byCallback[a.CallbackID] = append(byCallback[a.CallbackID], a)
+	}
+	histories := make([]History, 0, len(callbacks))
+	for _, cb := range callbacks {
+		histories = append(histories, History{Callback: cb, Attempts: byCallback[cb.ID]})
+	}
+	return notificationID, histories, nil
+}
@@ -0,0 +1,172 @@
+// Package deadletter 死信管理
+// 重试耗尽或遇到永久性错误的通知由重试引擎移入死信；运维人员可以查询、查看、修正通知内容，
+// 并批量重新入队，重新入队的通知回到待发送状态，由异步工作池按首次发送的流程投递
+package deadletter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dingdong-postman/internal/domain"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+)
+
+// DefaultPageSize 未指定分页大小时每页返回的条数
+const DefaultPageSize = 50
+
+// Service 死信管理服务接口
+type Service interface {
+	// List 按通知 ID 升序查询符合条件的死信
+	List(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error)
+	// Get 查看死信及通知的完整内容
+	Get(ctx context.Context, id uint64) (domain.DeadLetter, domain.Notification, error)
+	// Edit 修改死信通知的内容，修改后的通知必须仍然可以发送
+	Edit(ctx context.Context, id uint64, patch domain.NotificationPatch) (domain.DeadLetter, domain.Notification, error)
+	// Requeue 批量将死信重新入队，单条失败不影响其余通知，结果顺序与入参一致
+	Requeue(ctx context.Context, ids []uint64) []RequeueResult
+}
+
+// RequeueResult 单条死信的重新入队结果
+type RequeueResult struct {
+	// NotificationID 通知 ID
+	NotificationID uint64
+	// Err 入队失败的原因，成功时为 nil
+	Err error
+}
+
+// Queue 异步发送队列，由后台工作池实现
+type Queue interface {
+	// Submit 提交一条已落库的待发送通知，返回是否成功入队
+	Submit(n domain.Notification) bool
+}
+
+// TemplateResolver 按平台模板校验通知，由模板管理服务实现
+type TemplateResolver interface {
+	// Apply 校验模板参数并记录使用的模板版本，邮件未指定内容时按模板渲染邮件内容
+	Apply(ctx context.Context, n *domain.Notification) error
+}
+
+// service 死信管理服务实现
+type service struct {
+	deadLetters   repository.DeadLetterRepository
+	notifications repository.NotificationRepository
+	queue         Queue
+	templates     TemplateResolver
+	logger        appLogger.Logger
+}
+
+// NewService 创建死信管理服务
+// templates 为 nil 时修改后的模板 ID 原样交给供应商
+func NewService(
+	deadLetters repository.DeadLetterRepository,
+	notifications repository.NotificationRepository,
+	queue Queue,
+	templates TemplateResolver,
+	logger appLogger.Logger,
+) Service {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &service{
+		deadLetters:   deadLetters,
+		notifications: notifications,
+		queue:         queue,
+		templates:     templates,
+		logger:        logger,
+	}
+}
+
+// List 查询死信
+func (s *service) List(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultPageSize
+	}
+	return s.deadLetters.List(ctx, filter)
+}
+
+// Get 查看死信及通知内容
+func (s *service) Get(ctx context.Context, id uint64) (domain.DeadLetter, domain.Notification, error) {
+	dl, err := s.deadLetters.FindByNotificationID(ctx, id)
+	if err != nil {
+		return domain.DeadLetter{}, domain.Notification{}, err
+	}
+	n, err := s.notifications.FindByID(ctx, id)
+	if err != nil {
+		return domain.DeadLetter{}, domain.Notification{}, err
+	}
+	return dl, n, nil
+}
+
+// Edit 修改死信通知的内容
+func (s *service) Edit(
+	ctx context.Context,
+	id uint64,
+	patch domain.NotificationPatch,
+) (domain.DeadLetter, domain.Notification, error) {
+	if patch.IsEmpty() {
+		return domain.DeadLetter{}, domain.Notification{}, fmt.Errorf("%w: 未指定要修改的内容", domain.ErrInvalidNotification)
+	}
+	if _, err := s.deadLetters.FindByNotificationID(ctx, id); err != nil {
+		return domain.DeadLetter{}, domain.Notification{}, err
+	}
+	n, err := s.notifications.FindByID(ctx, id)
+	if err != nil {
+		return domain.DeadLetter{}, domain.Notification{}, err
+	}
+
+	before := n.Recipient
+	patch.Apply(&n)
+	// 修改模板或模板参数后按与受理时相同的规则重新校验参数、确定模板版本与语言
+	if patch.TemplateChanged() && s.templates != nil {
+		if err := s.templates.Apply(ctx, &n); err != nil {
+			return domain.DeadLetter{}, domain.Notification{}, err
+		}
+	}
+	if err := n.Validate(); err != nil {
+		return domain.DeadLetter{}, domain.Notification{}, err
+	}
+	dl, err := s.deadLetters.UpdateContent(ctx, n)
+	if err != nil {
+		return domain.DeadLetter{}, domain.Notification{}, err
+	}
+
+	s.logger.Info("死信通知内容已修改",
+		zap.Uint64("notification_id", id),
+		zap.String("biz_key", n.BizKey),
+		zap.String("recipient_before", before),
+		zap.String("recipient", n.Recipient),
+		zap.Bool("template_changed", patch.TemplateChanged()),
+		zap.Int("template_version", n.Template.Version),
+		zap.Bool("email_changed", patch.Email != nil),
+	)
+	return dl, n, nil
+}
+
+// Requeue 批量将死信重新入队
+func (s *service) Requeue(ctx context.Context, ids []uint64) []RequeueResult {
+	results := make([]RequeueResult, 0, len(ids))
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			results = append(results, RequeueResult{NotificationID: id, Err: err})
+			continue
+		}
+		n, err := s.deadLetters.Requeue(ctx, id)
+		if err != nil {
+			s.logger.Warn("死信重新入队失败", zap.Uint64("notification_id", id), zap.Error(err))
+			results = append(results, RequeueResult{NotificationID: id, Err: err})
+			continue
+		}
+		// 入队失败也无妨，通知已是待发送状态，工作池会轮询数据库兜底
+		queued := s.queue.Submit(n)
+		s.logger.Info("死信已重新入队",
+			zap.Uint64("notification_id", id),
+			zap.String("biz_key", n.BizKey),
+			zap.String("channel", string(n.Channel)),
+			zap.Bool("queued", queued),
+		)
+		results = append(results, RequeueResult{NotificationID: id})
+	}
+	return results
+}
@@ -0,0 +1,175 @@
+package dlr
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+)
+
+// Poller 投递回执拉取器：定期重新匹配未匹配的回执，并向只支持查询的供应商拉取已成功提交的通知的回执
+// 多实例部署时同一通知同一时刻只会被一个实例拉取
+type Poller struct {
+	cfg       *config.DeliveryReportConfig
+	repo      repository.DeliveryReportRepository
+	providers Providers
+	receiver  *Receiver
+	logger    appLogger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPoller 创建投递回执拉取器，拉取到的回执交给 receiver 记录
+func NewPoller(
+	cfg *config.DeliveryReportConfig,
+	repo repository.DeliveryReportRepository,
+	providers Providers,
+	receiver *Receiver,
+	logger appLogger.Logger,
+) *Poller {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &Poller{
+		cfg:       cfg,
+		repo:      repo,
+		providers: providers,
+		receiver:  receiver,
+		logger:    logger,
+	}
+}
+
+// Start 启动拉取器
+func (p *Poller) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	p.wg.Add(1)
+	go p.scan(ctx)
+
+	p.logger.Info("投递回执拉取器启动",
+		zap.Strings("providers", p.cfg.Poll.Providers),
+		zap.Int("scan_interval", p.cfg.Poll.ScanInterval),
+		zap.Int("interval", p.cfg.Poll.Interval),
+		zap.Int("max_age", p.cfg.Poll.MaxAge),
+	)
+}
+
+// Stop 停止拉取器并等待正在进行的查询完成
+func (p *Poller) Stop() {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.wg.Wait()
+	p.logger.Info("投递回执拉取器已停止")
+}
+
+// scan 定时重新匹配回执并拉取回执
+func (p *Poller) scan(ctx context.Context) {
+	defer p.wg.Done()
+	ticker := time.NewTicker(time.Duration(p.cfg.Poll.ScanInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.rematch(ctx)
+			p.pollOnce(ctx)
+		}
+	}
+}
+
+// rematch 重新匹配窗口内未匹配的回执
+func (p *Poller) rematch(ctx context.Context) {
+	since := time.Now().Add(-time.Duration(p.cfg.RematchWindow) * time.Second)
+	matched, err := p.repo.Rematch(ctx, since, p.cfg.Poll.BatchSize)
+	if err != nil {
+		p.logger.Error("重新匹配投递回执失败", zap.Error(err))
+		return
+	}
+	if matched > 0 {
+		p.logger.Info("已重新匹配投递回执", zap.Int("matched", matched))
+	}
+}
+
+// pollOnce 领取一批等待拉取回执的通知，并发查询
+func (p *Poller) pollOnce(ctx context.Context) {
+	poll := p.cfg.Poll
+	if len(poll.Providers) == 0 {
+		return
+	}
+	ns, err := p.repo.ClaimPollable(
+		ctx, time.Now(), poll.Providers, poll.BatchSize,
+		time.Duration(poll.Interval)*time.Second, time.Duration(poll.MaxAge)*time.Second,
+	)
+	if err != nil {
+		p.logger.Error("领取等待拉取回执的通知失败", zap.Error(err))
+		return
+	}
+
+	sem := make(chan struct{}, poll.Concurrency)
+	var wg sync.WaitGroup
+	for _, n := range ns {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(n domain.Notification) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			// 查询过程不受拉取器停止影响，避免拿到回执而未记录
+			p.poll(context.WithoutCancel(ctx), n)
+		}(n)
+	}
+	wg.Wait()
+}
+
+// poll 向发送通知的供应商查询回执，拿到已送达或投递失败的结果时更新通知，否则等待下次拉取
+func (p *Poller) poll(ctx context.Context, n domain.Notification) {
+	fields := []zap.Field{
+		zap.Uint64("notification_id", n.ID),
+		zap.String("provider", n.Receipt.Provider),
+		zap.String("message_id", n.Receipt.MessageID),
+	}
+	prov, ok := p.providers.Get(n.Receipt.Provider)
+	if !ok {
+		p.logger.Warn("拉取回执的供应商未启用", fields...)
+		return
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, time.Duration(p.cfg.Poll.Timeout)*time.Millisecond)
+	defer cancel()
+	result, err := prov.QueryStatus(queryCtx, provider.QueryRequest{
+		MessageID: n.Receipt.MessageID,
+		Recipient: n.Recipient,
+		SentAt:    n.UpdatedAt,
+	})
+	if err != nil {
+		p.logger.Warn("拉取投递回执失败，等待下次拉取", append(fields, zap.Error(err))...)
+		return
+	}
+
+	report, ok := toDomainReport(n.Receipt.Provider, provider.Report{
+		MessageID:  n.Receipt.MessageID,
+		Recipient:  n.Recipient,
+		Status:     result.Status,
+		Code:       result.Code,
+		Message:    result.Message,
+		ReportedAt: result.ReportedAt,
+	}, domain.ReportSourcePoll)
+	if !ok {
+		p.logger.Debug("供应商尚未给出投递结果", append(fields, zap.String("delivery_status", string(result.Status)))...)
+		return
+	}
+	// 记录失败已由接收器输出日志，通知下次拉取时重试
+	_ = p.receiver.Apply(ctx, report)
+}
@@ -0,0 +1,135 @@
+// Package dlr 供应商投递回执（DLR）
+// 通知提交给供应商后状态为已成功提交，供应商给出的回执决定通知最终已送达还是发送失败：
+// 支持推送的供应商（短信状态报告、邮件退信与投诉）由接收器解析推送的请求体，只支持查询的供应商由拉取器定期查询。
+// 回执按供应商与消息 ID 匹配通知，与状态变更在同一事务中写入 MySQL；回执先于发送结果落库到达时暂存，
+// 由拉取器在重新匹配窗口内再次匹配
+package dlr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dingdong-postman/internal/domain"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrUnknownProvider 推送地址中的供应商未配置或未启用
+	ErrUnknownProvider = errors.New("unknown provider")
+	// ErrPushNotSupported 供应商不支持推送回执
+	ErrPushNotSupported = errors.New("provider does not support delivery report push")
+	// ErrInvalidReport 推送的请求体无法解析
+	ErrInvalidReport = errors.New("invalid delivery report")
+)
+
+// Providers 按名称查找供应商，由供应商注册表实现
+type Providers interface {
+	// Get 按名称获取供应商
+	Get(name string) (provider.Provider, bool)
+}
+
+// Receiver 投递回执接收器
+type Receiver struct {
+	repo      repository.DeliveryReportRepository
+	providers Providers
+	logger    appLogger.Logger
+}
+
+// NewReceiver 创建投递回执接收器
+func NewReceiver(repo repository.DeliveryReportRepository, providers Providers, logger appLogger.Logger) *Receiver {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &Receiver{
+		repo:      repo,
+		providers: providers,
+		logger:    logger,
+	}
+}
+
+// Receive 处理供应商推送的回执，返回告知供应商推送成功的响应 Content-Type 与响应体
+// 每条回执都会处理，任一条失败时返回汇总的错误，供应商收不到成功响应会重新推送；重复推送的回执不会再次更新通知
+func (r *Receiver) Receive(ctx context.Context, providerName string, body []byte) (string, []byte, error) {
+	p, ok := r.providers.Get(providerName)
+	if !ok {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnknownProvider, providerName)
+	}
+	receiver, ok := p.(provider.ReportReceiver)
+	if !ok {
+		return "", nil, fmt.Errorf("%w: %s", ErrPushNotSupported, providerName)
+	}
+	reports, err := receiver.ParseReports(body)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %w", ErrInvalidReport, err)
+	}
+
+	// 一次推送包含多条回执时逐条处理，某条失败不影响其余回执，失败的回执随供应商重新推送再次处理
+	var errs []error
+	for i := range reports {
+		report, ok := toDomainReport(providerName, reports[i], domain.ReportSourcePush)
+		if !ok {
+			continue
+		}
+		if err := r.Apply(ctx, report); err != nil {
+			errs = append(errs, fmt.Errorf("message %s: %w", report.MessageID, err))
+		}
+	}
+	if len(errs) > 0 {
+		return "", nil, errors.Join(errs...)
+	}
+	contentType, ack := receiver.ReportAck()
+	return contentType, ack, nil
+}
+
+// Apply 记录一条回执并更新对应的通知
+func (r *Receiver) Apply(ctx context.Context, report domain.DeliveryReport) error {
+	fields := []zap.Field{
+		zap.String("provider", report.Provider),
+		zap.String("message_id", report.MessageID),
+		zap.String("status", string(report.Status)),
+		zap.String("code", report.Code),
+		zap.String("source", string(report.Source)),
+	}
+	id, outcome, err := r.repo.Apply(ctx, report)
+	if err != nil {
+		r.logger.Error("记录投递回执失败", append(fields, zap.Error(err))...)
+		return err
+	}
+
+	fields = append(fields, zap.Uint64("notification_id", id))
+	switch outcome {
+	case domain.ReportOutcomeApplied:
+		r.logger.Info("已按投递回执更新通知", fields...)
+	case domain.ReportOutcomeUnmatched:
+		r.logger.Info("未找到投递回执对应的通知，等待重新匹配", fields...)
+	default:
+		r.logger.Debug("通知已不处于已成功提交状态，投递回执仅记录", fields...)
+	}
+	return nil
+}
+
+// toDomainReport 将供应商的回执转换为领域对象；只有已送达与投递失败的回执需要处理，其余返回 false
+func toDomainReport(providerName string, r provider.Report, source domain.ReportSource) (domain.DeliveryReport, bool) {
+	report := domain.DeliveryReport{
+		Provider:   providerName,
+		MessageID:  r.MessageID,
+		Recipient:  r.Recipient,
+		Code:       r.Code,
+		Message:    r.Message,
+		Source:     source,
+		ReportedAt: r.ReportedAt,
+	}
+	switch r.Status {
+	case provider.DeliveryStatusDelivered:
+		report.Status = domain.SendStatusDelivered
+	case provider.DeliveryStatusFailed:
+		report.Status = domain.SendStatusFailed
+	default:
+		return domain.DeliveryReport{}, false
+	}
+	return report, r.MessageID != ""
+}
@@ -0,0 +1,132 @@
+// Package health 统计渠道供应商的健康度
+// 健康度保存在 Redis 中，所有实例共享同一份统计，保证各实例的兜底决策一致
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dingdong-postman/internal/pkg/config"
+	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+	"github.com/redis/go-redis/v9"
+)
+
+// 统计桶中的字段
+const (
+	fieldTotal  = "total"
+	fieldErrors = "errors"
+)
+
+// Stats 统计窗口内的请求数与错误数
+type Stats struct {
+	Total  int64
+	Errors int64
+}
+
+// ErrorRate 错误率，没有请求时为 0
+func (s Stats) ErrorRate() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Total)
+}
+
+// Tracker 供应商健康度统计
+type Tracker interface {
+	// Record 记录一次发送结果，failed 表示供应商侧故障（可重试错误、限流或超时）
+	Record(ctx context.Context, providerName string, failed bool) error
+	// Stats 返回统计窗口内的请求数与错误数
+	Stats(ctx context.Context, providerName string) (Stats, error)
+	// IsHealthy 判断供应商是否健康：窗口内请求数不足 min_requests 或错误率低于阈值时视为健康
+	IsHealthy(ctx context.Context, providerName string) (bool, Stats, error)
+}
+
+// redisTracker Tracker 的 Redis 实现
+// 按 health_bucket 秒分桶，每个桶是一个哈希，记录 total 与 errors，桶在窗口结束后自动过期
+type redisTracker struct {
+	client        appRedis.Client
+	bucket        time.Duration
+	buckets       int
+	minRequests   int64
+	unhealthyRate float64
+	keyPrefix     string
+}
+
+// NewRedisTracker 创建基于 Redis 的健康度统计
+func NewRedisTracker(client appRedis.Client, cfg *config.FailoverConfig) Tracker {
+	return &redisTracker{
+		client:        client,
+		bucket:        time.Duration(cfg.HealthBucket) * time.Second,
+		buckets:       cfg.HealthWindow / cfg.HealthBucket,
+		minRequests:   int64(cfg.MinRequests),
+		unhealthyRate: cfg.UnhealthyErrorRate,
+		keyPrefix:     cfg.KeyPrefix,
+	}
+}
+
+// Record 在当前时间桶中累加请求数与错误数
+func (t *redisTracker) Record(ctx context.Context, providerName string, failed bool) error {
+	key := t.key(providerName, t.bucketIndex(time.Now()))
+	if _, err := t.client.HIncrBy(ctx, key, fieldTotal, 1); err != nil {
+		return fmt.Errorf("record provider health: %w", err)
+	}
+	if failed {
+		if _, err := t.client.HIncrBy(ctx, key, fieldErrors, 1); err != nil {
+			return fmt.Errorf("record provider health: %w", err)
+		}
+	}
+	// 多保留一个桶，保证窗口边缘的桶在统计时仍然存在
+	if _, err := t.client.Expire(ctx, key, t.bucket*time.Duration(t.buckets+1)); err != nil {
+		return fmt.Errorf("expire provider health: %w", err)
+	}
+	return nil
+}
+
+// Stats 汇总窗口内所有时间桶
+func (t *redisTracker) Stats(ctx context.Context, providerName string) (Stats, error) {
+	var s Stats
+	current := t.bucketIndex(time.Now())
+	for i := int64(0); i < int64(t.buckets); i++ {
+		fields, err := t.client.HGetAll(ctx, t.key(providerName, current-i))
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return Stats{}, fmt.Errorf("get provider health: %w", err)
+		}
+		s.Total += parseCount(fields[fieldTotal])
+		s.Errors += parseCount(fields[fieldErrors])
+	}
+	return s, nil
+}
+
+// IsHealthy 判断供应商是否健康
+func (t *redisTracker) IsHealthy(ctx context.Context, providerName string) (bool, Stats, error) {
+	s, err := t.Stats(ctx, providerName)
+	if err != nil {
+		return true, Stats{}, err
+	}
+	if s.Total < t.minRequests {
+		return true, s, nil
+	}
+	return s.ErrorRate() < t.unhealthyRate, s, nil
+}
+
+func (t *redisTracker) bucketIndex(now time.Time) int64 {
+	return now.Unix() / int64(t.bucket/time.Second)
+}
+
+func (t *redisTracker) key(providerName string, bucket int64) string {
+	return t.keyPrefix + providerName + ":" + strconv.FormatInt(bucket, 10)
+}
+
+func parseCount(v string) int64 {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,93 @@
+package idempotent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dingdong-postman/internal/pkg/config"
+	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+	"github.com/redis/go-redis/v9"
+)
+
+// placeholder 业务键已被占用但通知尚未落库时的占位值
+const placeholder = "0"
+
+// Checker 基于 Redis SET NX 的业务键去重快速通道
+// Redis 只负责在去重窗口内快速拦截重复请求，真正的幂等由 MySQL 唯一索引保证；
+// 业务键在租户内唯一，不同租户可以使用相同的业务键
+type Checker interface {
+	// Acquire 尝试占用业务键
+	// 返回 acquired=true 表示首次请求；acquired=false 表示窗口内的重复请求，
+	// 此时 id 为首次请求的通知 ID，首次请求尚未落库时 id 为 0
+	Acquire(ctx context.Context, tenantID, bizKey string) (acquired bool, id uint64, err error)
+	// Bind 将业务键绑定到已落库的通知 ID
+	Bind(ctx context.Context, tenantID, bizKey string, id uint64) error
+	// Release 释放业务键，用于落库失败后允许调用方重试
+	Release(ctx context.Context, tenantID, bizKey string) error
+}
+
+// redisChecker Checker 的 Redis 实现
+type redisChecker struct {
+	client    appRedis.Client
+	window    time.Duration
+	keyPrefix string
+}
+
+// NewChecker 创建基于 Redis 的业务键去重器
+func NewChecker(client appRedis.Client, cfg *config.IdempotencyConfig) Checker {
+	return &redisChecker{
+		client:    client,
+		window:    time.Duration(cfg.Window) * time.Second,
+		keyPrefix: cfg.KeyPrefix,
+	}
+}
+
+// Acquire 使用 SET NX 占用业务键
+func (c *redisChecker) Acquire(ctx context.Context, tenantID, bizKey string) (bool, uint64, error) {
+	key := c.key(tenantID, bizKey)
+	ok, err := c.client.SetNX(ctx, key, placeholder, c.window)
+	if err != nil {
+		return false, 0, fmt.Errorf("acquire biz key: %w", err)
+	}
+	if ok {
+		return true, 0, nil
+	}
+
+	val, err := c.client.Get(ctx, key)
+	if errors.Is(err, redis.Nil) {
+		// 刚好过期，视为首次请求，交给 MySQL 唯一索引兜底
+		return true, 0, nil
+	}
+	if err != nil {
+		return false, 0, fmt.Errorf("get biz key: %w", err)
+	}
+	id, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		return false, 0, fmt.Errorf("parse biz key value %q: %w", val, err)
+	}
+	return false, id, nil
+}
+
+// Bind 将业务键绑定到通知 ID，保留原有的去重窗口
+func (c *redisChecker) Bind(ctx context.Context, tenantID, bizKey string, id uint64) error {
+	if err := c.client.Set(ctx, c.key(tenantID, bizKey), strconv.FormatUint(id, 10), redis.KeepTTL); err != nil {
+		return fmt.Errorf("bind biz key: %w", err)
+	}
+	return nil
+}
+
+// Release 删除业务键
+func (c *redisChecker) Release(ctx context.Context, tenantID, bizKey string) error {
+	if _, err := c.client.Del(ctx, c.key(tenantID, bizKey)); err != nil {
+		return fmt.Errorf("release biz key: %w", err)
+	}
+	return nil
+}
+
+// key 租户 ID 与业务键拼接出的 Redis 键；未启用租户鉴权时租户 ID 为空
+func (c *redisChecker) key(tenantID, bizKey string) string {
+	return c.keyPrefix + tenantID + ":" + bizKey
+}
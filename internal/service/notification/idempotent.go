@@ -0,0 +1,118 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dingdong-postman/internal/domain"
+	"go.uber.org/zap"
+)
+
+// create 按业务键幂等地落库通知
+// 先走 Redis 快速通道拦截窗口内的重复请求，再按业务键查询 MySQL，最后由 MySQL 唯一索引兜底并发请求；
+// 重复请求返回首次请求的通知，duplicated 为 true
+func (s *service) create(ctx context.Context, n domain.Notification) (created domain.Notification, duplicated bool, err error) {
+	return s.createWith(ctx, n, s.repo.Create)
+}
+
+// createWith 与 create 相同，由 insert 落库，insert 在业务键已存在时须返回 domain.ErrDuplicateBizKey
+func (s *service) createWith(
+	ctx context.Context,
+	n domain.Notification,
+	insert func(ctx context.Context, n domain.Notification) (domain.Notification, error),
+) (created domain.Notification, duplicated bool, err error) {
+	acquired := false
+	if s.checker != nil {
+		var id uint64
+		acquired, id, err = s.checker.Acquire(ctx, n.TenantID, n.BizKey)
+		switch {
+		case err != nil:
+			// Redis 不可用时退化为仅依赖 MySQL 唯一索引
+			s.logger.Warn("业务键去重快速检查失败", zap.String("biz_key", n.BizKey), zap.Error(err))
+		case !acquired && id != 0:
+			if existing, findErr := s.repo.FindByID(ctx, id); findErr == nil {
+				return existing, true, nil
+			}
+		}
+	}
+
+	// 快速通道未能确认重复（如超出去重窗口、Redis 不可用）时按业务键查询 MySQL，
+	// 限流在去重之后判断，重复请求不占用限流额度，也不会因被限流而拿不到首次请求的通知
+	existing, err := s.repo.FindByBizKey(ctx, n.TenantID, n.BizKey)
+	switch {
+	case err == nil:
+		if acquired {
+			s.bindBizKey(ctx, n, existing.ID)
+		}
+		return existing, true, nil
+	case !errors.Is(err, domain.ErrNotificationNotFound):
+		if acquired {
+			s.releaseBizKey(ctx, n)
+		}
+		return domain.Notification{}, false, err
+	}
+
+	if s.limiter != nil {
+		if err := s.limiter.Admit(ctx, n); err != nil {
+			if acquired {
+				s.releaseBizKey(ctx, n)
+			}
+			return domain.Notification{}, false, err
+		}
+	}
+
+	// 租户配额只统计真正受理的通知，重复请求与落库失败都会归还
+	refund, err := s.consumeQuota(ctx)
+	if err != nil {
+		if acquired {
+			s.releaseBizKey(ctx, n)
+		}
+		return domain.Notification{}, false, err
+	}
+
+	created, err = insert(ctx, n)
+	if errors.Is(err, domain.ErrDuplicateBizKey) {
+		refund()
+		existing, findErr := s.repo.FindByBizKey(ctx, n.TenantID, n.BizKey)
+		if findErr != nil {
+			return domain.Notification{}, false, findErr
+		}
+		return existing, true, nil
+	}
+	if err != nil {
+		refund()
+		if acquired {
+			s.releaseBizKey(ctx, n)
+		}
+		return domain.Notification{}, false, err
+	}
+
+	if s.checker != nil {
+		s.bindBizKey(ctx, n, created.ID)
+	}
+	return created, false, nil
+}
+
+// bindBizKey 将占用的业务键绑定到通知 ID，窗口内的重复请求由快速通道直接返回该通知
+func (s *service) bindBizKey(ctx context.Context, n domain.Notification, id uint64) {
+	if err := s.checker.Bind(ctx, n.TenantID, n.BizKey, id); err != nil {
+		s.logger.Warn("绑定业务键失败", zap.String("biz_key", n.BizKey), zap.Error(err))
+	}
+}
+
+// releaseBizKey 落库失败时释放业务键，允许调用方重试
+func (s *service) releaseBizKey(ctx context.Context, n domain.Notification) {
+	if err := s.checker.Release(ctx, n.TenantID, n.BizKey); err != nil {
+		s.logger.Warn("释放业务键失败", zap.String("biz_key", n.BizKey), zap.Error(err))
+	}
+}
+
+// duplicatedResult 重复请求的发送结果：首次请求的通知 ID 与当前状态
+func duplicatedResult(n domain.Notification) domain.SendResult {
+	return domain.SendResult{
+		NotificationID: n.ID,
+		Status:         n.Status,
+		ErrorMessage:   n.ErrorMessage,
+		Duplicated:     true,
+	}
+}
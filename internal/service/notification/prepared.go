@@ -0,0 +1,175 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"go.uber.org/zap"
+)
+
+// Prepare 预提交两阶段通知：与异步发送一样校验、去重、限流并占用租户配额，落库为预提交状态，提交前不会发送
+// 定时发送时间与按发送时段推迟的时间在预提交时确定，提交时已到期的通知立即发送，否则转为定时通知
+func (s *service) Prepare(ctx context.Context, n domain.Notification) (domain.SendResult, error) {
+	if s.txs == nil {
+		return domain.SendResult{}, fmt.Errorf("%w: 未启用两阶段通知", domain.ErrInvalidNotification)
+	}
+	if n.ScheduledAt.After(time.Now()) {
+		if err := s.checkSchedule(n.ScheduledAt); err != nil {
+			return domain.SendResult{}, err
+		}
+	}
+	if err := s.validate(ctx, &n); err != nil {
+		return domain.SendResult{}, err
+	}
+	if err := s.deferToWindow(ctx, &n); err != nil {
+		return domain.SendResult{}, err
+	}
+
+	n.Status = domain.SendStatusPrepared
+	created, duplicated, err := s.createWith(ctx, n, s.txs.Prepare)
+	if err != nil {
+		return domain.SendResult{}, err
+	}
+	if duplicated {
+		return duplicatedResult(created), nil
+	}
+	return domain.SendResult{
+		NotificationID: created.ID,
+		Status:         domain.SendStatusPrepared,
+	}, nil
+}
+
+// Commit 按通知 ID 提交两阶段通知
+func (s *service) Commit(ctx context.Context, id uint64) (domain.Notification, error) {
+	n, err := s.FindByID(ctx, id)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return s.commit(ctx, n)
+}
+
+// CommitByBizKey 按业务键提交两阶段通知
+func (s *service) CommitByBizKey(ctx context.Context, bizKey string) (domain.Notification, error) {
+	n, err := s.FindByBizKey(ctx, bizKey)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return s.commit(ctx, n)
+}
+
+// CancelPrepared 按通知 ID 取消两阶段通知
+func (s *service) CancelPrepared(ctx context.Context, id uint64) (domain.Notification, error) {
+	n, err := s.FindByID(ctx, id)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return s.cancelPrepared(ctx, n)
+}
+
+// CancelPreparedByBizKey 按业务键取消两阶段通知
+func (s *service) CancelPreparedByBizKey(ctx context.Context, bizKey string) (domain.Notification, error) {
+	n, err := s.FindByBizKey(ctx, bizKey)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return s.cancelPrepared(ctx, n)
+}
+
+// commit 通过条件更新提交两阶段通知，与取消（含回查器的取消）互斥
+// 发送时间已到的通知改为待发送并交给工作池，否则改为定时并登记到调度器
+func (s *service) commit(ctx context.Context, n domain.Notification) (domain.Notification, error) {
+	if n.Status != domain.SendStatusPrepared {
+		return committed(n)
+	}
+
+	to := domain.SendStatusPending
+	if n.ScheduledAt.After(time.Now()) {
+		to = domain.SendStatusScheduled
+	}
+	ok, err := s.txs.Commit(ctx, n.ID, to)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	if !ok {
+		return s.reload(ctx, n.ID, committed)
+	}
+
+	n.Status = to
+	n.UpdatedAt = time.Now()
+	if to == domain.SendStatusScheduled {
+		// 登记失败也无妨，调度器会扫描数据库兜底
+		if err := s.scheduler.Schedule(ctx, n); err != nil {
+			s.logger.Warn("登记定时通知失败，等待数据库扫描释放",
+				zap.Uint64("notification_id", n.ID),
+				zap.Time("scheduled_at", n.ScheduledAt),
+				zap.Error(err),
+			)
+		}
+	} else {
+		// 入队失败也无妨，工作池会轮询数据库兜底
+		s.queue.Submit(n)
+	}
+	s.logger.Info("两阶段通知已提交",
+		zap.Uint64("notification_id", n.ID),
+		zap.String("tenant_id", n.TenantID),
+		zap.String("biz_key", n.BizKey),
+		zap.String("status", string(to)),
+	)
+	return n, nil
+}
+
+// cancelPrepared 通过条件更新取消两阶段通知，与提交互斥；取消成功后归还预提交时占用的租户配额
+func (s *service) cancelPrepared(ctx context.Context, n domain.Notification) (domain.Notification, error) {
+	if n.Status != domain.SendStatusPrepared {
+		return canceled(n)
+	}
+
+	ok, err := s.txs.Cancel(ctx, n.ID)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	if !ok {
+		return s.reload(ctx, n.ID, canceled)
+	}
+	s.refundQuota(ctx, n.CreatedAt)
+
+	n.Status = domain.SendStatusCanceled
+	n.UpdatedAt = time.Now()
+	s.logger.Info("两阶段通知已取消",
+		zap.Uint64("notification_id", n.ID),
+		zap.String("tenant_id", n.TenantID),
+		zap.String("biz_key", n.BizKey),
+	)
+	return n, nil
+}
+
+// committed 处理不处于预提交状态的通知的提交：已取消时返回 domain.ErrNotPrepared，否则视为重复提交，返回当前状态
+func committed(n domain.Notification) (domain.Notification, error) {
+	if n.Status == domain.SendStatusCanceled {
+		return domain.Notification{}, fmt.Errorf("%w: 已取消", domain.ErrNotPrepared)
+	}
+	return n, nil
+}
+
+// canceled 处理不处于预提交状态的通知的取消：已取消时视为重复取消，返回当前状态，否则返回 domain.ErrNotPrepared
+func canceled(n domain.Notification) (domain.Notification, error) {
+	if n.Status == domain.SendStatusCanceled {
+		return n, nil
+	}
+	return domain.Notification{}, fmt.Errorf("%w: 已提交，当前状态为 %s", domain.ErrNotPrepared, n.Status)
+}
+
+// reload 条件更新失败（查询后已被提交或取消）时重新查询通知，交给 resolve 按最新状态判断
+func (s *service) reload(
+	ctx context.Context,
+	id uint64,
+	resolve func(n domain.Notification) (domain.Notification, error),
+) (domain.Notification, error) {
+	n, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return resolve(n)
+}
@@ -0,0 +1,122 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"go.uber.org/zap"
+)
+
+// checkSchedule 校验定时发送时间；未配置调度器时不支持定时发送
+func (s *service) checkSchedule(at time.Time) error {
+	if s.scheduler == nil {
+		return fmt.Errorf("%w: 未启用定时发送", domain.ErrInvalidNotification)
+	}
+	return s.scheduler.Check(at)
+}
+
+// deferToWindow 按租户的发送时段规则推迟通知：发送时间（未定时为当前时间）不允许发送时，
+// 将定时发送时间改为下一个允许发送的时间；推迟不受最长定时时间限制
+func (s *service) deferToWindow(ctx context.Context, n *domain.Notification) error {
+	if s.windows == nil || s.scheduler == nil {
+		return nil
+	}
+	at := time.Now()
+	if n.ScheduledAt.After(at) {
+		at = n.ScheduledAt
+	}
+	next, err := s.windows.Plan(ctx, *n, at)
+	if err != nil {
+		return err
+	}
+	if !next.After(at) {
+		return nil
+	}
+	s.logger.Info("通知落在免打扰时间内，推迟发送",
+		zap.String("tenant_id", n.TenantID),
+		zap.String("biz_key", n.BizKey),
+		zap.String("channel", string(n.Channel)),
+		zap.Time("requested_at", at),
+		zap.Time("scheduled_at", next),
+	)
+	n.ScheduledAt = next
+	return nil
+}
+
+// schedule 落库为定时状态并登记到调度器，立即返回通知 ID
+func (s *service) schedule(ctx context.Context, n domain.Notification) (domain.SendResult, error) {
+	n.Status = domain.SendStatusScheduled
+	created, duplicated, err := s.create(ctx, n)
+	if err != nil {
+		return domain.SendResult{}, err
+	}
+	if duplicated {
+		return duplicatedResult(created), nil
+	}
+	// 登记失败也无妨，调度器会扫描数据库兜底
+	if err := s.scheduler.Schedule(ctx, created); err != nil {
+		s.logger.Warn("登记定时通知失败，等待数据库扫描释放",
+			zap.Uint64("notification_id", created.ID),
+			zap.Time("scheduled_at", created.ScheduledAt),
+			zap.Error(err),
+		)
+	}
+
+	return domain.SendResult{
+		NotificationID: created.ID,
+		Status:         domain.SendStatusScheduled,
+	}, nil
+}
+
+// Cancel 按通知 ID 取消定时通知
+func (s *service) Cancel(ctx context.Context, id uint64) (domain.Notification, error) {
+	n, err := s.FindByID(ctx, id)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return s.cancel(ctx, n)
+}
+
+// CancelByBizKey 按业务键取消定时通知
+func (s *service) CancelByBizKey(ctx context.Context, bizKey string) (domain.Notification, error) {
+	n, err := s.FindByBizKey(ctx, bizKey)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return s.cancel(ctx, n)
+}
+
+// cancel 通过条件更新取消定时通知，与调度器的释放互斥：调度器释放前取消成功的通知不会再发送
+// 取消成功后移除调度器中的登记，并归还受理时占用的租户配额
+func (s *service) cancel(ctx context.Context, n domain.Notification) (domain.Notification, error) {
+	if n.Status != domain.SendStatusScheduled {
+		return domain.Notification{}, fmt.Errorf("%w: 当前状态为 %s", domain.ErrNotCancelable, n.Status)
+	}
+	canceled, err := s.repo.CancelScheduled(ctx, n.ID)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	if !canceled {
+		// 查询后、取消前已被调度器释放发送
+		return domain.Notification{}, fmt.Errorf("%w: 已开始发送", domain.ErrNotCancelable)
+	}
+
+	if s.scheduler != nil {
+		if err := s.scheduler.Unschedule(ctx, n.ID); err != nil {
+			s.logger.Warn("移除定时通知登记失败", zap.Uint64("notification_id", n.ID), zap.Error(err))
+		}
+	}
+	s.refundQuota(ctx, n.CreatedAt)
+
+	n.Status = domain.SendStatusCanceled
+	n.UpdatedAt = time.Now()
+	s.logger.Info("定时通知已取消",
+		zap.Uint64("notification_id", n.ID),
+		zap.String("tenant_id", n.TenantID),
+		zap.String("biz_key", n.BizKey),
+		zap.Time("scheduled_at", n.ScheduledAt),
+	)
+	return n, nil
+}
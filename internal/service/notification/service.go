@@ -0,0 +1,270 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/repository"
+	"github.com/dingdong-postman/internal/service/idempotent"
+	"github.com/dingdong-postman/internal/service/ratelimit"
+)
+
+// Service 通知服务接口
+type Service interface {
+	// Send 同步发送单条通知
+	Send(ctx context.Context, n domain.Notification) (domain.SendResult, error)
+	// BatchSend 同步批量发送通知，结果顺序与入参一致
+	BatchSend(ctx context.Context, ns []domain.Notification) ([]domain.SendResult, error)
+	// SendAsync 异步发送单条通知，落库后立即返回
+	SendAsync(ctx context.Context, n domain.Notification) (domain.SendResult, error)
+	// BatchSendAsync 异步批量发送通知，结果顺序与入参一致
+	BatchSendAsync(ctx context.Context, ns []domain.Notification) ([]domain.SendResult, error)
+	// FindByID 按通知 ID 查询；启用租户鉴权时只能查询本租户的通知
+	FindByID(ctx context.Context, id uint64) (domain.Notification, error)
+	// FindByBizKey 按业务键查询本租户的通知
+	FindByBizKey(ctx context.Context, bizKey string) (domain.Notification, error)
+	// Cancel 按通知 ID 取消尚未发送的定时通知，通知已开始发送或已取消时返回 domain.ErrNotCancelable
+	Cancel(ctx context.Context, id uint64) (domain.Notification, error)
+	// CancelByBizKey 按业务键取消本租户尚未发送的定时通知
+	CancelByBizKey(ctx context.Context, bizKey string) (domain.Notification, error)
+	// Prepare 预提交两阶段通知：校验并落库，提交前不会发送
+	Prepare(ctx context.Context, n domain.Notification) (domain.SendResult, error)
+	// Commit 按通知 ID 提交两阶段通知；重复提交返回当前状态，通知已取消时返回 domain.ErrNotPrepared
+	Commit(ctx context.Context, id uint64) (domain.Notification, error)
+	// CommitByBizKey 按业务键提交本租户的两阶段通知
+	CommitByBizKey(ctx context.Context, bizKey string) (domain.Notification, error)
+	// CancelPrepared 按通知 ID 取消两阶段通知；重复取消返回当前状态，通知已提交时返回 domain.ErrNotPrepared
+	CancelPrepared(ctx context.Context, id uint64) (domain.Notification, error)
+	// CancelPreparedByBizKey 按业务键取消本租户的两阶段通知
+	CancelPreparedByBizKey(ctx context.Context, bizKey string) (domain.Notification, error)
+}
+
+// AsyncQueue 异步发送队列，由后台工作池实现
+type AsyncQueue interface {
+	// Submit 提交一条已落库的待发送通知，返回是否成功入队
+	Submit(n domain.Notification) bool
+}
+
+// Scheduler 定时通知调度器
+type Scheduler interface {
+	// Check 校验定时发送时间是否在允许的范围内
+	Check(at time.Time) error
+	// Schedule 登记已落库的定时通知，到期后释放发送
+	Schedule(ctx context.Context, n domain.Notification) error
+	// Unschedule 移除已取消的定时通知的登记
+	Unschedule(ctx context.Context, id uint64) error
+}
+
+// Transactions 两阶段通知存储，由两阶段通知存储实现
+type Transactions interface {
+	// Prepare 落库预提交的通知并登记回查，同一租户下业务键已存在时返回 domain.ErrDuplicateBizKey
+	Prepare(ctx context.Context, n domain.Notification) (domain.Notification, error)
+	// Commit 将预提交的通知改为 to 状态，返回是否成功；通知不处于预提交状态时返回 false
+	Commit(ctx context.Context, id uint64, to domain.SendStatus) (bool, error)
+	// Cancel 取消预提交的通知，返回是否成功；通知不处于预提交状态时返回 false
+	Cancel(ctx context.Context, id uint64) (bool, error)
+}
+
+// WindowPlanner 按租户的发送时段规则计算通知的最早发送时间，由发送时段规划器实现
+type WindowPlanner interface {
+	// Plan 返回不早于 at 的最早允许发送时间
+	Plan(ctx context.Context, n domain.Notification, at time.Time) (time.Time, error)
+}
+
+// TemplateResolver 按平台模板校验通知，由模板管理服务实现
+type TemplateResolver interface {
+	// Apply 校验模板参数并记录使用的模板版本，邮件未指定内容时按模板渲染邮件内容
+	Apply(ctx context.Context, n *domain.Notification) error
+}
+
+// Deliverer 投递已落库的通知并回写结果，由重试引擎实现
+type Deliverer interface {
+	// Deliver 发送通知并回写结果，attempt 为本次发送的序号（首次发送为 1）
+	Deliver(ctx context.Context, n domain.Notification, attempt int) (domain.SendResult, error)
+}
+
+// service 通知服务实现
+type service struct {
+	repo      repository.NotificationRepository
+	deliverer Deliverer
+	queue     AsyncQueue
+	scheduler Scheduler
+	windows   WindowPlanner
+	txs       Transactions
+	checker   idempotent.Checker
+	limiter   ratelimit.Limiter
+	quota     Quota
+	templates TemplateResolver
+	logger    appLogger.Logger
+}
+
+// NewService 创建通知服务
+// checker 为 nil 时（如未启用 Redis）仅依赖 MySQL 唯一索引去重；limiter 为 nil 时不限流；
+// quota 为 nil 时（如未启用租户鉴权）不限制租户配额；templates 为 nil 时模板 ID 原样交给供应商；
+// scheduler 为 nil 时不支持定时发送；windows 为 nil 或 scheduler 为 nil 时不按发送时段推迟通知；
+// txs 为 nil 时不支持两阶段通知
+func NewService(
+	repo repository.NotificationRepository,
+	deliverer Deliverer,
+	queue AsyncQueue,
+	scheduler Scheduler,
+	windows WindowPlanner,
+	txs Transactions,
+	checker idempotent.Checker,
+	limiter ratelimit.Limiter,
+	quota Quota,
+	templates TemplateResolver,
+	logger appLogger.Logger,
+) Service {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &service{
+		repo:      repo,
+		deliverer: deliverer,
+		queue:     queue,
+		scheduler: scheduler,
+		windows:   windows,
+		txs:       txs,
+		checker:   checker,
+		limiter:   limiter,
+		quota:     quota,
+		templates: templates,
+		logger:    logger,
+	}
+}
+
+// Send 同步发送单条通知：先落库，再调用发送器，最后回写发送结果
+// 可重试的失败返回重试中状态，由重试引擎在后台继续发送
+// 重复的业务键不会再次发送，直接返回首次请求的通知 ID 与状态
+// 落在租户免打扰时间内的非事务类通知不会同步发送，推迟到下一个允许发送的时间并返回定时状态
+func (s *service) Send(ctx context.Context, n domain.Notification) (domain.SendResult, error) {
+	if n.ScheduledAt.After(time.Now()) {
+		return domain.SendResult{}, fmt.Errorf("%w: 同步发送不支持定时，请使用异步发送", domain.ErrInvalidNotification)
+	}
+	if err := s.validate(ctx, &n); err != nil {
+		return domain.SendResult{}, err
+	}
+	if err := s.deferToWindow(ctx, &n); err != nil {
+		return domain.SendResult{}, err
+	}
+	if n.ScheduledAt.After(time.Now()) {
+		return s.schedule(ctx, n)
+	}
+
+	n.Status = domain.SendStatusSending
+	created, duplicated, err := s.create(ctx, n)
+	if err != nil {
+		return domain.SendResult{}, err
+	}
+	if duplicated {
+		return duplicatedResult(created), nil
+	}
+
+	return s.deliverer.Deliver(ctx, created, 1)
+}
+
+// BatchSend 同步批量发送通知，单条失败不影响其余通知
+func (s *service) BatchSend(ctx context.Context, ns []domain.Notification) ([]domain.SendResult, error) {
+	return s.batch(ctx, ns, s.Send)
+}
+
+// SendAsync 异步发送单条通知：落库为待发送状态后交给工作池，立即返回通知 ID
+// 发送时间晚于受理时刻的定时通知（含按发送时段推迟的通知）落库为定时状态，到期后由调度器交给工作池
+func (s *service) SendAsync(ctx context.Context, n domain.Notification) (domain.SendResult, error) {
+	if n.ScheduledAt.After(time.Now()) {
+		if err := s.checkSchedule(n.ScheduledAt); err != nil {
+			return domain.SendResult{}, err
+		}
+	}
+	if err := s.validate(ctx, &n); err != nil {
+		return domain.SendResult{}, err
+	}
+	if err := s.deferToWindow(ctx, &n); err != nil {
+		return domain.SendResult{}, err
+	}
+	if n.ScheduledAt.After(time.Now()) {
+		return s.schedule(ctx, n)
+	}
+
+	n.Status = domain.SendStatusPending
+	created, duplicated, err := s.create(ctx, n)
+	if err != nil {
+		return domain.SendResult{}, err
+	}
+	if duplicated {
+		return duplicatedResult(created), nil
+	}
+	// 入队失败也无妨，工作池会轮询数据库兜底
+	s.queue.Submit(created)
+
+	return domain.SendResult{
+		NotificationID: created.ID,
+		Status:         domain.SendStatusPending,
+	}, nil
+}
+
+// BatchSendAsync 异步批量发送通知，单条受理失败不影响其余通知
+func (s *service) BatchSendAsync(ctx context.Context, ns []domain.Notification) ([]domain.SendResult, error) {
+	return s.batch(ctx, ns, s.SendAsync)
+}
+
+// validate 受理前的校验：通知内容、租户的渠道权限与默认发送方、模板参数
+func (s *service) validate(ctx context.Context, n *domain.Notification) error {
+	if err := n.Validate(); err != nil {
+		return err
+	}
+	if err := bindTenant(ctx, n); err != nil {
+		return err
+	}
+	if s.templates != nil {
+		return s.templates.Apply(ctx, n)
+	}
+	return nil
+}
+
+// batch 逐条执行 fn，将单条失败转换为失败结果
+func (s *service) batch(
+	ctx context.Context,
+	ns []domain.Notification,
+	fn func(ctx context.Context, n domain.Notification) (domain.SendResult, error),
+) ([]domain.SendResult, error) {
+	results := make([]domain.SendResult, 0, len(ns))
+	for i := range ns {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := fn(ctx, ns[i])
+		if err != nil {
+			result = domain.SendResult{
+				NotificationID: result.NotificationID,
+				Status:         domain.SendStatusFailed,
+				ErrorMessage:   err.Error(),
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// FindByID 按通知 ID 查询
+func (s *service) FindByID(ctx context.Context, id uint64) (domain.Notification, error) {
+	n, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	// 其他租户的通知按不存在处理，不暴露通知 ID 是否存在
+	if t, ok := domain.TenantFromContext(ctx); ok && n.TenantID != t.ID {
+		return domain.Notification{}, domain.ErrNotificationNotFound
+	}
+	return n, nil
+}
+
+// FindByBizKey 按业务键查询
+func (s *service) FindByBizKey(ctx context.Context, bizKey string) (domain.Notification, error) {
+	t, _ := domain.TenantFromContext(ctx)
+	return s.repo.FindByBizKey(ctx, t.ID, bizKey)
+}
@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"go.uber.org/zap"
+)
+
+// Quota 租户配额，由配额用量存储实现
+type Quota interface {
+	// Consume 在 at 所在的日、月周期内各占用一个配额，已用完时返回 domain.ErrQuotaExceeded
+	Consume(ctx context.Context, t domain.Tenant, at time.Time) error
+	// Refund 归还 Consume 占用的配额
+	Refund(ctx context.Context, t domain.Tenant, at time.Time) error
+}
+
+// bindTenant 将通知归属到已鉴权的租户：校验渠道是否开通，并以租户的默认发送方与回调地址补全未指定的字段
+// 未启用租户鉴权时 context 中没有租户，通知不归属任何租户
+func bindTenant(ctx context.Context, n *domain.Notification) error {
+	t, ok := domain.TenantFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if !t.AllowsChannel(n.Channel) {
+		return fmt.Errorf("%w: %s", domain.ErrChannelNotAllowed, n.Channel)
+	}
+	n.TenantID = t.ID
+	if n.Sender.SignName == "" {
+		n.Sender.SignName = t.Sender.SignName
+	}
+	if n.Sender.EmailFrom == "" {
+		n.Sender.EmailFrom = t.Sender.EmailFrom
+	}
+	if n.CallbackURL == "" {
+		n.CallbackURL = t.CallbackURL
+	}
+	return nil
+}
+
+// consumeQuota 占用租户配额，返回归还配额的函数；未启用租户鉴权或未配置配额存储时不做限制
+func (s *service) consumeQuota(ctx context.Context) (refund func(), err error) {
+	t, ok := domain.TenantFromContext(ctx)
+	if !ok || s.quota == nil {
+		return func() {}, nil
+	}
+	at := time.Now()
+	if err := s.quota.Consume(ctx, t, at); err != nil {
+		return nil, err
+	}
+	return func() {
+		if err := s.quota.Refund(context.WithoutCancel(ctx), t, at); err != nil {
+			s.logger.Warn("归还租户配额失败", zap.String("tenant_id", t.ID), zap.Error(err))
+		}
+	}, nil
+}
+
+// refundQuota 归还 at 时占用的租户配额，用于受理后取消的通知；未启用租户鉴权或未配置配额存储时不做处理
+func (s *service) refundQuota(ctx context.Context, at time.Time) {
+	t, ok := domain.TenantFromContext(ctx)
+	if !ok || s.quota == nil {
+		return
+	}
+	if err := s.quota.Refund(context.WithoutCancel(ctx), t, at); err != nil {
+		s.logger.Warn("归还租户配额失败", zap.String("tenant_id", t.ID), zap.Error(err))
+	}
+}
@@ -0,0 +1,144 @@
+// Package outbox 发布通知状态变更事件
+// 通知状态的每次变更都与状态在同一事务中写入事件发件箱，事务回滚时事件随之撤销，不会发布未生效的变更；
+// 发布器按事件 ID 顺序领取未发布的事件并在事务外交给 Kafka，全部确认后才标记为已发布，进程崩溃或 Kafka 不可用时事件留在发件箱中下次继续发布。
+// 发布成功但标记失败时事件会被再次发布，消费方按事件 ID 去重。发件箱中的事件保留一段时间后删除
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+)
+
+// purgeInterval 删除过期事件的间隔
+const purgeInterval = 10 * time.Minute
+
+// Publisher 状态变更事件发布器，由 Kafka 实现
+type Publisher interface {
+	// Publish 按顺序发布一批事件，返回 nil 表示全部发布成功
+	Publish(ctx context.Context, events []domain.StatusEvent) error
+}
+
+// Relay 将发件箱中的状态变更事件发布出去，并删除过期的事件
+type Relay struct {
+	cfg       *config.StatusEventConfig
+	repo      repository.StatusEventRepository
+	publisher Publisher
+	logger    appLogger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRelay 创建状态变更事件发布器
+// publisher 为 nil 时（如未启用 Kafka）不发布事件，只删除超出保留时间的事件，避免发件箱无限增长
+func NewRelay(
+	cfg *config.StatusEventConfig,
+	repo repository.StatusEventRepository,
+	publisher Publisher,
+	logger appLogger.Logger,
+) *Relay {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &Relay{
+		cfg:       cfg,
+		repo:      repo,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Start 启动发布与清理协程
+func (r *Relay) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	pollInterval := time.Duration(r.cfg.PollInterval) * time.Millisecond
+	if r.publisher != nil {
+		r.wg.Add(1)
+		go r.loop(ctx, pollInterval, r.publishOnce)
+	}
+	r.wg.Add(1)
+	go r.loop(ctx, purgeInterval, r.purgeOnce)
+
+	r.logger.Info("状态变更事件发布器启动",
+		zap.Bool("publish", r.publisher != nil),
+		zap.Duration("poll_interval", pollInterval),
+		zap.Int("retention_hours", r.cfg.Retention),
+	)
+}
+
+// Stop 停止发布器并等待正在发布的批次完成
+func (r *Relay) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	r.logger.Info("状态变更事件发布器已停止")
+}
+
+// loop 按 interval 定时执行 fn
+func (r *Relay) loop(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	defer r.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
+
+// publishOnce 发布未发布的事件，取满一批时继续发布下一批，直到发件箱中没有积压
+func (r *Relay) publishOnce(ctx context.Context) {
+	timeout := time.Duration(r.cfg.PublishTimeout) * time.Millisecond
+	for ctx.Err() == nil {
+		// 发布过程不受发布器停止影响，避免事件已发布而未标记；Kafka 不可用时超时返回，不阻塞停止
+		n, err := r.repo.Publish(context.WithoutCancel(ctx), r.cfg.BatchSize, 2*timeout, r.publish)
+		if err != nil {
+			r.logger.Error("发布状态变更事件失败", zap.Error(err))
+			return
+		}
+		if n < r.cfg.BatchSize {
+			return
+		}
+	}
+}
+
+// publish 在超时时间内发布一批事件
+func (r *Relay) publish(ctx context.Context, events []domain.StatusEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(r.cfg.PublishTimeout)*time.Millisecond)
+	defer cancel()
+	return r.publisher.Publish(ctx, events)
+}
+
+// purgeOnce 分批删除超出保留时间的事件；发布时只删除已发布的事件，未发布的事件一直保留到发布成功
+func (r *Relay) purgeOnce(ctx context.Context) {
+	before := time.Now().Add(-time.Duration(r.cfg.Retention) * time.Hour)
+	var total int64
+	for ctx.Err() == nil {
+		n, err := r.repo.Purge(ctx, before, r.publisher != nil, r.cfg.BatchSize)
+		if err != nil {
+			r.logger.Error("删除过期状态变更事件失败", zap.Error(err))
+			break
+		}
+		total += n
+		if n < int64(r.cfg.BatchSize) {
+			break
+		}
+	}
+	if total > 0 {
+		r.logger.Info("已删除过期状态变更事件", zap.Int64("count", total), zap.Time("before", before))
+	}
+}
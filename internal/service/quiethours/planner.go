@@ -0,0 +1,92 @@
+// Package quiethours 按租户的发送时段规则推迟非事务类通知
+// 租户可为每个渠道设置允许发送的时间段与免打扰时间段，规则按接收者所在时区判断：
+// 优先取接收者资料中的时区，其次取租户的默认时区，均未设置时使用服务器所在时区。
+// 落在不允许发送时间内的非事务类通知推迟到下一个允许发送的时间，由定时发送调度器到期释放
+package quiethours
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+)
+
+// Planner 按发送时段规则计算通知的最早发送时间
+type Planner struct {
+	profiles repository.RecipientProfileRepository
+	logger   appLogger.Logger
+
+	// locations 已加载的时区，时区数据加载后不再变化
+	locations sync.Map
+}
+
+// NewPlanner 创建发送时段规划器
+// profiles 为 nil 时不查询接收者资料，按租户的默认时区判断
+func NewPlanner(profiles repository.RecipientProfileRepository, logger appLogger.Logger) *Planner {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &Planner{
+		profiles: profiles,
+		logger:   logger,
+	}
+}
+
+// Plan 返回不早于 at 的最早允许发送时间
+// 事务类通知、未启用租户鉴权或租户未为该渠道设置规则时原样返回 at
+func (p *Planner) Plan(ctx context.Context, n domain.Notification, at time.Time) (time.Time, error) {
+	if n.Transactional {
+		return at, nil
+	}
+	t, ok := domain.TenantFromContext(ctx)
+	if !ok {
+		return at, nil
+	}
+	w, ok := t.DeliveryWindow(n.Channel)
+	if !ok {
+		return at, nil
+	}
+	loc, err := p.location(ctx, t, n.Recipient)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return w.NextAllowed(at.In(loc)), nil
+}
+
+// location 确定接收者所在时区：接收者资料、租户默认时区、服务器所在时区依次回退
+func (p *Planner) location(ctx context.Context, t domain.Tenant, recipient string) (*time.Location, error) {
+	name := t.Timezone
+	if p.profiles != nil {
+		profile, err := p.profiles.Find(ctx, t.ID, recipient)
+		if err != nil && !errors.Is(err, domain.ErrRecipientProfileNotFound) {
+			return nil, err
+		}
+		if profile.Timezone != "" {
+			name = profile.Timezone
+		}
+	}
+	if name == "" {
+		return time.Local, nil
+	}
+	if cached, ok := p.locations.Load(name); ok {
+		loc, _ := cached.(*time.Location)
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		// 时区已在写入时校验，加载失败多为运行环境缺少时区数据
+		p.logger.Warn("加载时区失败，使用服务器所在时区",
+			zap.String("tenant_id", t.ID),
+			zap.String("timezone", name),
+			zap.Error(err),
+		)
+		return time.Local, nil
+	}
+	p.locations.Store(name, loc)
+	return loc, nil
+}
@@ -0,0 +1,198 @@
+// Package ratelimit 通知发送限流
+// 限流规则按租户、渠道、供应商、接收者、模板组合计数，计数保存在 Redis 中由各实例共享：
+//   - 与供应商无关的规则在受理通知时判断，超限的请求直接拒绝，不落库
+//   - 与供应商相关的规则在选定供应商后、调用供应商前判断，超限时切换到下一个供应商
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// emptyKeyPart 计数维度没有取值时在键中的占位符
+const emptyKeyPart = "-"
+
+// Limiter 通知发送限流器
+type Limiter interface {
+	// Admit 按与供应商无关的规则判断是否受理通知，超限时返回 *domain.RateLimitError
+	Admit(ctx context.Context, n domain.Notification) error
+	// AllowProvider 按与该供应商相关的规则判断是否可以调用供应商，超限时返回 *domain.RateLimitError
+	AllowProvider(ctx context.Context, n domain.Notification, providerName string) error
+}
+
+// subject 参与限流判断的请求属性
+type subject struct {
+	tenant    string
+	channel   string
+	provider  string
+	recipient string
+	template  string
+}
+
+// subjectOf 提取通知的限流属性
+func subjectOf(n domain.Notification, providerName string) subject {
+	return subject{
+		tenant:    n.TenantID,
+		channel:   string(n.Channel),
+		provider:  providerName,
+		recipient: strings.ToLower(strings.TrimSpace(n.Recipient)),
+		template:  n.Template.ID,
+	}
+}
+
+// value 返回计数维度的取值
+func (s subject) value(dimension string) string {
+	switch dimension {
+	case config.RateLimitKeyTenant:
+		return s.tenant
+	case config.RateLimitKeyChannel:
+		return s.channel
+	case config.RateLimitKeyProvider:
+		return s.provider
+	case config.RateLimitKeyRecipient:
+		return s.recipient
+	case config.RateLimitKeyTemplate:
+		return s.template
+	default:
+		return ""
+	}
+}
+
+// rule 已规范化的限流规则
+type rule struct {
+	config.RateLimitRule
+}
+
+// matches 判断规则是否适用于请求
+func (r *rule) matches(s subject) bool {
+	m := r.Match
+	return (m.Tenant == "" || m.Tenant == s.tenant) &&
+		(m.Channel == "" || m.Channel == s.channel) &&
+		(m.Provider == "" || m.Provider == s.provider) &&
+		(m.TemplateID == "" || m.TemplateID == s.template)
+}
+
+// redisLimiter 基于 Redis Lua 脚本的限流器
+type redisLimiter struct {
+	limiter   *appRedis.Limiter
+	keyPrefix string
+	failOpen  bool
+	admission []rule
+	scoped    []rule
+	logger    appLogger.Logger
+}
+
+// New 创建基于 Redis 的限流器
+func New(client appRedis.Client, cfg *config.RateLimitConfig, logger appLogger.Logger) Limiter {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	l := &redisLimiter{
+		limiter:   appRedis.NewLimiter(client),
+		keyPrefix: cfg.KeyPrefix,
+		failOpen:  cfg.FailOpen,
+		logger:    logger,
+	}
+	for _, c := range cfg.Rules {
+		r := rule{RateLimitRule: c}
+		r.Match.Channel = strings.ToUpper(r.Match.Channel)
+		if r.IsProviderScoped() {
+			l.scoped = append(l.scoped, r)
+		} else {
+			l.admission = append(l.admission, r)
+		}
+	}
+	return l
+}
+
+// Admit 受理通知时判断
+func (l *redisLimiter) Admit(ctx context.Context, n domain.Notification) error {
+	return l.check(ctx, l.admission, subjectOf(n, ""))
+}
+
+// AllowProvider 调用供应商前判断
+func (l *redisLimiter) AllowProvider(ctx context.Context, n domain.Notification, providerName string) error {
+	return l.check(ctx, l.scoped, subjectOf(n, providerName))
+}
+
+// check 在一次 Redis 脚本调用中判断全部命中的规则，任意一条超限即拒绝；拒绝时不计入任何规则
+func (l *redisLimiter) check(ctx context.Context, rules []rule, s subject) error {
+	var (
+		matched []*rule
+		limits  []appRedis.Limit
+	)
+	for i := range rules {
+		r := &rules[i]
+		if !r.matches(s) {
+			continue
+		}
+		matched = append(matched, r)
+		limits = append(limits, l.limit(r, l.key(r, s)))
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	res, err := l.limiter.Allow(ctx, limits...)
+	if err != nil {
+		l.logger.Warn("限流判断失败",
+			zap.Strings("rules", ruleNames(matched)),
+			zap.Bool("fail_open", l.failOpen),
+			zap.Error(err),
+		)
+		if l.failOpen {
+			return nil
+		}
+		return &domain.RateLimitError{Rule: matched[0].Name}
+	}
+	if !res.Allowed {
+		r := matched[res.Rejected]
+		l.logger.Info("请求被限流",
+			zap.String("rule", r.Name),
+			zap.String("key", l.key(r, s)),
+			zap.Duration("retry_after", res.RetryAfter),
+		)
+		return &domain.RateLimitError{Rule: r.Name, RetryAfter: res.RetryAfter}
+	}
+	return nil
+}
+
+// limit 按规则的算法生成一次判断的参数
+func (l *redisLimiter) limit(r *rule, key string) appRedis.Limit {
+	if r.Algorithm == config.RateLimitTokenBucket {
+		return appRedis.TokenBucket(key, r.Capacity, r.Rate, 1)
+	}
+	return appRedis.SlidingWindow(key, r.Limit, time.Duration(r.Window)*time.Second)
+}
+
+// ruleNames 返回规则名称
+func ruleNames(rules []*rule) []string {
+	names := make([]string, 0, len(rules))
+	for _, r := range rules {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+// key 生成计数键：前缀 + 规则名 + 各计数维度的取值
+func (l *redisLimiter) key(r *rule, s subject) string {
+	var b strings.Builder
+	b.WriteString(l.keyPrefix)
+	b.WriteString(r.Name)
+	for _, dimension := range r.KeyBy {
+		v := s.value(dimension)
+		if v == "" {
+			v = emptyKeyPart
+		}
+		b.WriteString(":")
+		b.WriteString(v)
+	}
+	return b.String()
+}
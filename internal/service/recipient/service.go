@@ -0,0 +1,88 @@
+// Package recipient 接收者资料管理
+// 租户为接收者登记语言、时区等偏好，受理通知时请求未指定的语言从接收者资料中补全，
+// 并按接收者的时区判断租户的发送时段规则
+package recipient
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/i18n"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+)
+
+// Service 接收者资料管理服务接口，接收者资料归属于 context 中已鉴权的租户
+type Service interface {
+	// Put 写入接收者资料，已存在时覆盖
+	Put(ctx context.Context, p domain.RecipientProfile) (domain.RecipientProfile, error)
+	// Get 查询接收者资料
+	Get(ctx context.Context, recipient string) (domain.RecipientProfile, error)
+	// Delete 删除接收者资料
+	Delete(ctx context.Context, recipient string) error
+}
+
+// service 接收者资料管理服务实现
+type service struct {
+	repo   repository.RecipientProfileRepository
+	logger appLogger.Logger
+}
+
+// NewService 创建接收者资料管理服务
+func NewService(repo repository.RecipientProfileRepository, logger appLogger.Logger) Service {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Put 规范化语言标签后写入接收者资料
+func (s *service) Put(ctx context.Context, p domain.RecipientProfile) (domain.RecipientProfile, error) {
+	p.TenantID = tenantID(ctx)
+	p.Recipient = strings.TrimSpace(p.Recipient)
+	p.Timezone = strings.TrimSpace(p.Timezone)
+	if locale := i18n.Normalize(p.Locale); locale != "" {
+		p.Locale = locale
+	}
+	if err := p.Validate(); err != nil {
+		return domain.RecipientProfile{}, err
+	}
+	saved, err := s.repo.Upsert(ctx, p)
+	if err != nil {
+		return domain.RecipientProfile{}, err
+	}
+	s.logger.Info("写入接收者资料",
+		zap.String("tenant_id", saved.TenantID),
+		zap.String("recipient", saved.Recipient),
+		zap.String("locale", saved.Locale),
+		zap.String("timezone", saved.Timezone),
+	)
+	return saved, nil
+}
+
+// Get 查询接收者资料
+func (s *service) Get(ctx context.Context, recipient string) (domain.RecipientProfile, error) {
+	return s.repo.Find(ctx, tenantID(ctx), strings.TrimSpace(recipient))
+}
+
+// Delete 删除接收者资料
+func (s *service) Delete(ctx context.Context, recipient string) error {
+	tid := tenantID(ctx)
+	recipient = strings.TrimSpace(recipient)
+	if err := s.repo.Delete(ctx, tid, recipient); err != nil {
+		return err
+	}
+	s.logger.Info("删除接收者资料", zap.String("tenant_id", tid), zap.String("recipient", recipient))
+	return nil
+}
+
+// tenantID 取出已鉴权的租户 ID；未启用租户鉴权时为空
+func tenantID(ctx context.Context) string {
+	t, _ := domain.TenantFromContext(ctx)
+	return t.ID
+}
@@ -0,0 +1,261 @@
+// Package retry 失败重试
+// 发送失败且错误可重试时，按渠道的重试策略以指数退避计算下次重试时间，重试计划保存在 MySQL 中，
+// 进程重启后仍会继续；后台扫描器以行锁领取到期的重试计划，多实例部署时同一通知不会被重复发送。
+// 重试耗尽或遇到永久性错误的通知移入死信，由运维人员修正后重新入队
+package retry
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/repository"
+	"github.com/dingdong-postman/internal/service/sender"
+	"go.uber.org/zap"
+)
+
+// Engine 投递与重试引擎
+// 所有发送路径（同步发送、异步工作池、重试扫描）都通过 Deliver 发送并回写结果，保证失败处理一致
+type Engine struct {
+	cfg           *config.RetryConfig
+	def           Policy
+	policies      map[domain.Channel]Policy
+	notifications repository.NotificationRepository
+	retries       repository.RetryRepository
+	deadLetters   repository.DeadLetterRepository
+	sender        sender.Sender
+	logger        appLogger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewEngine 创建投递与重试引擎
+func NewEngine(
+	cfg *config.RetryConfig,
+	notifications repository.NotificationRepository,
+	retries repository.RetryRepository,
+	deadLetters repository.DeadLetterRepository,
+	s sender.Sender,
+	logger appLogger.Logger,
+) *Engine {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	def, policies := policiesFromConfig(cfg)
+	return &Engine{
+		cfg:           cfg,
+		def:           def,
+		policies:      policies,
+		notifications: notifications,
+		retries:       retries,
+		deadLetters:   deadLetters,
+		sender:        s,
+		logger:        logger,
+	}
+}
+
+// Deliver 发送一条已落库的通知并回写结果，attempt 为本次发送的序号（首次发送为 1）
+// 失败且错误可重试、未达到最大发送次数时写入重试计划，返回的状态为重试中；否则标记为失败并移入死信
+func (e *Engine) Deliver(ctx context.Context, n domain.Notification, attempt int) (domain.SendResult, error) {
+	result := domain.SendResult{
+		NotificationID: n.ID,
+		Status:         domain.SendStatusSucceeded,
+	}
+	receipt, sendErr := e.sender.Send(ctx, n)
+	if sendErr == nil {
+		return result, e.succeed(ctx, n.ID, attempt, receipt)
+	}
+
+	result.ErrorMessage = sendErr.Error()
+	policy := e.policyFor(n.Channel)
+	if !provider.IsRetryable(sendErr) || attempt >= policy.MaxAttempts {
+		result.Status = domain.SendStatusFailed
+		return result, e.bury(ctx, n, attempt, receipt, sendErr)
+	}
+
+	delay := policy.Delay(attempt)
+	task := domain.RetryTask{
+		NotificationID: n.ID,
+		Channel:        n.Channel,
+		Attempts:       attempt,
+		NextRetryAt:    time.Now().Add(delay),
+		LastError:      result.ErrorMessage,
+	}
+	if err := e.retries.Schedule(ctx, task, receipt); err != nil {
+		return domain.SendResult{}, err
+	}
+	e.logger.Warn("通知发送失败，等待重试",
+		zap.Uint64("notification_id", n.ID),
+		zap.String("biz_key", n.BizKey),
+		zap.Int("attempt", attempt),
+		zap.Int("max_attempts", policy.MaxAttempts),
+		zap.Duration("delay", delay),
+		zap.Error(sendErr),
+	)
+	result.Status = domain.SendStatusRetrying
+	return result, nil
+}
+
+// succeed 回写发送成功的结果；重试发送时一并删除重试计划
+func (e *Engine) succeed(ctx context.Context, id uint64, attempt int, receipt domain.Receipt) error {
+	if attempt > 1 {
+		return e.retries.Finish(ctx, id, domain.SendStatusSucceeded, receipt, "")
+	}
+	return e.notifications.UpdateSendResult(ctx, id, domain.SendStatusSucceeded, receipt, "")
+}
+
+// bury 将最终发送失败的通知标记为失败并移入死信
+func (e *Engine) bury(
+	ctx context.Context,
+	n domain.Notification,
+	attempt int,
+	receipt domain.Receipt,
+	sendErr error,
+) error {
+	dl := domain.DeadLetter{
+		NotificationID: n.ID,
+		BizKey:         n.BizKey,
+		Channel:        n.Channel,
+		Recipient:      n.Recipient,
+		Attempts:       attempt,
+		ErrorCategory:  string(provider.CategoryOf(sendErr)),
+		LastError:      sendErr.Error(),
+	}
+	if err := e.deadLetters.Bury(ctx, dl, receipt); err != nil {
+		return err
+	}
+	e.logger.Warn("通知发送失败，已移入死信",
+		zap.Uint64("notification_id", n.ID),
+		zap.String("biz_key", n.BizKey),
+		zap.String("channel", string(n.Channel)),
+		zap.Int("attempts", attempt),
+		zap.String("category", dl.ErrorCategory),
+		zap.String("provider", receipt.Provider),
+		zap.Error(sendErr),
+	)
+	return nil
+}
+
+// policyFor 返回渠道的重试策略
+func (e *Engine) policyFor(ch domain.Channel) Policy {
+	if p, ok := e.policies[ch]; ok {
+		return p
+	}
+	return e.def
+}
+
+// Start 启动重试扫描器
+func (e *Engine) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+
+	e.wg.Add(1)
+	go e.scan(ctx)
+
+	e.logger.Info("重试扫描器启动",
+		zap.Int("scan_interval", e.cfg.ScanInterval),
+		zap.Int("concurrency", e.cfg.Concurrency),
+		zap.Int("default_max_attempts", e.def.MaxAttempts),
+	)
+}
+
+// Stop 停止扫描器并等待正在执行的重试完成；未完成的计划在租约到期后会被重新领取
+func (e *Engine) Stop() {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.wg.Wait()
+	e.logger.Info("重试扫描器已停止")
+}
+
+// scan 定时领取到期的重试计划并发送
+func (e *Engine) scan(ctx context.Context) {
+	defer e.wg.Done()
+	ticker := time.NewTicker(time.Duration(e.cfg.ScanInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.scanOnce(ctx)
+		}
+	}
+}
+
+// scanOnce 领取一批到期的重试计划，并发执行
+func (e *Engine) scanOnce(ctx context.Context) {
+	lease := time.Duration(e.cfg.Lease) * time.Second
+	tasks, err := e.retries.ClaimDue(ctx, time.Now(), e.cfg.ScanBatchSize, lease)
+	if err != nil {
+		e.logger.Error("领取到期重试任务失败", zap.Error(err))
+		return
+	}
+	if len(tasks) == 0 {
+		return
+	}
+
+	sem := make(chan struct{}, e.cfg.Concurrency)
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(task domain.RetryTask) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			// 重试过程不受扫描器停止影响，避免发送到一半被取消
+			e.retry(context.WithoutCancel(ctx), task)
+		}(task)
+	}
+	wg.Wait()
+}
+
+// retry 执行一次重试
+func (e *Engine) retry(ctx context.Context, task domain.RetryTask) {
+	n, err := e.notifications.FindByID(ctx, task.NotificationID)
+	if err != nil {
+		e.logger.Error("查询待重试通知失败", zap.Uint64("notification_id", task.NotificationID), zap.Error(err))
+		return
+	}
+	switch n.Status {
+	case domain.SendStatusRetrying:
+	case domain.SendStatusSending:
+		// 其他实例仍在发送（重试计划的租约已过期被再次领取），由其回写结果；发送途中退出的由发送中回收改回重试中
+		return
+	default:
+		// 通知已被其他途径处理（如人工干预），清理残留的重试计划
+		if err := e.retries.Remove(ctx, n.ID); err != nil {
+			e.logger.Error("清理重试计划失败", zap.Uint64("notification_id", n.ID), zap.Error(err))
+		}
+		return
+	}
+
+	// 先以条件更新抢占为发送中再发送，租约过期后被多个实例领取的同一计划只会被发送一次
+	claimed, err := e.notifications.ClaimRetrying(ctx, n.ID)
+	if err != nil {
+		e.logger.Error("抢占待重试通知失败", zap.Uint64("notification_id", n.ID), zap.Error(err))
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	result, err := e.Deliver(ctx, n, task.Attempts)
+	if err != nil {
+		e.logger.Error("回写重试结果失败", zap.Uint64("notification_id", n.ID), zap.Error(err))
+		return
+	}
+	e.logger.Info("通知重试完成",
+		zap.Uint64("notification_id", n.ID),
+		zap.Int("attempt", task.Attempts),
+		zap.String("status", string(result.Status)),
+	)
+}
@@ -0,0 +1,68 @@
+package retry
+
+import (
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+)
+
+// Policy 重试策略
+type Policy struct {
+	// MaxAttempts 最多发送次数（含首次发送）
+	MaxAttempts int
+	// BaseDelay 首次重试前的等待时间
+	BaseDelay time.Duration
+	// MaxDelay 等待时间上限
+	MaxDelay time.Duration
+	// Jitter 等待时间的随机浮动比例
+	Jitter float64
+}
+
+// Delay 返回第 attempts 次发送失败后、下一次重试前的等待时间：
+// BaseDelay * 2^(attempts-1)，不超过 MaxDelay，再按 Jitter 随机浮动
+func (p Policy) Delay(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		//nolint:gosec // 退避抖动不需要密码学安全的随机数
+		factor := 1 - p.Jitter + rand.Float64()*2*p.Jitter
+		delay = time.Duration(float64(delay) * factor)
+	}
+	return delay
+}
+
+// policyFromConfig 将配置转换为重试策略，未设置的字段沿用 def
+func policyFromConfig(c config.RetryPolicyConfig, def Policy) Policy {
+	p := def
+	if c.MaxAttempts > 0 {
+		p.MaxAttempts = c.MaxAttempts
+	}
+	if c.BaseDelay > 0 {
+		p.BaseDelay = time.Duration(c.BaseDelay) * time.Second
+	}
+	if c.MaxDelay > 0 {
+		p.MaxDelay = time.Duration(c.MaxDelay) * time.Second
+	}
+	if c.Jitter > 0 {
+		p.Jitter = c.Jitter
+	}
+	return p
+}
+
+// policiesFromConfig 按渠道构建重试策略
+func policiesFromConfig(cfg *config.RetryConfig) (Policy, map[domain.Channel]Policy) {
+	def := policyFromConfig(cfg.Default, Policy{MaxAttempts: 1})
+	policies := make(map[domain.Channel]Policy, len(cfg.Channels))
+	for ch, c := range cfg.Channels {
+		policies[domain.Channel(strings.ToUpper(ch))] = policyFromConfig(c, def)
+	}
+	return def, policies
+}
@@ -0,0 +1,112 @@
+package retry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dingdong-postman/internal/service/retry"
+)
+
+func TestPolicyDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   retry.Policy
+		attempts int
+		want     time.Duration
+	}{
+		{
+			name:     "first retry waits base delay",
+			policy:   retry.Policy{BaseDelay: 10 * time.Second, MaxDelay: 10 * time.Minute},
+			attempts: 1,
+			want:     10 * time.Second,
+		},
+		{
+			name:     "doubles per attempt",
+			policy:   retry.Policy{BaseDelay: 10 * time.Second, MaxDelay: 10 * time.Minute},
+			attempts: 2,
+			want:     20 * time.Second,
+		},
+		{
+			name:     "exponential growth",
+			policy:   retry.Policy{BaseDelay: 10 * time.Second, MaxDelay: 10 * time.Minute},
+			attempts: 5,
+			want:     160 * time.Second,
+		},
+		{
+			name:     "capped at max delay",
+			policy:   retry.Policy{BaseDelay: 10 * time.Second, MaxDelay: 10 * time.Minute},
+			attempts: 7,
+			want:     10 * time.Minute,
+		},
+		{
+			name:     "large attempts do not overflow",
+			policy:   retry.Policy{BaseDelay: 10 * time.Second, MaxDelay: 10 * time.Minute},
+			attempts: 200,
+			want:     10 * time.Minute,
+		},
+		{
+			name:     "base delay above max delay is capped",
+			policy:   retry.Policy{BaseDelay: time.Hour, MaxDelay: 10 * time.Minute},
+			attempts: 1,
+			want:     10 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Delay(tt.attempts); got != tt.want {
+				t.Errorf("Delay(%d) = %v, want %v", tt.attempts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyDelayJitter(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   retry.Policy
+		attempts int
+		min, max time.Duration
+	}{
+		{
+			name:     "jitter around backoff",
+			policy:   retry.Policy{BaseDelay: 10 * time.Second, MaxDelay: 10 * time.Minute, Jitter: 0.2},
+			attempts: 3,
+			min:      32 * time.Second,
+			max:      48 * time.Second,
+		},
+		{
+			name:     "jitter applied after cap",
+			policy:   retry.Policy{BaseDelay: 10 * time.Second, MaxDelay: 100 * time.Second, Jitter: 0.5},
+			attempts: 10,
+			min:      50 * time.Second,
+			max:      150 * time.Second,
+		},
+		{
+			name:     "full jitter",
+			policy:   retry.Policy{BaseDelay: 10 * time.Second, MaxDelay: 10 * time.Minute, Jitter: 1},
+			attempts: 1,
+			min:      0,
+			max:      20 * time.Second,
+		},
+	}
+
+	const rounds = 1000
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi := tt.max, tt.min
+			for range rounds {
+				got := tt.policy.Delay(tt.attempts)
+				if got < tt.min || got > tt.max {
+					t.Fatalf("Delay(%d) = %v, want within [%v, %v]", tt.attempts, got, tt.min, tt.max)
+				}
+				lo, hi = min(lo, got), max(hi, got)
+			}
+			// 抖动须真正生效：多次取值应分布在区间两侧，而不是固定值
+			mid := (tt.min + tt.max) / 2
+			if lo >= mid || hi <= mid {
+				t.Errorf("Delay(%d) ranged [%v, %v] over %d rounds, want spread around %v", tt.attempts, lo, hi, rounds, mid)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package router
+
+import "strings"
+
+// dialCodes 国际电话区号与国家/地区的对应关系，按最长前缀匹配
+// 北美编号计划（+1）统一视为 US
+var dialCodes = map[string]string{
+	"1":   "US",
+	"7":   "RU",
+	"33":  "FR",
+	"34":  "ES",
+	"39":  "IT",
+	"44":  "GB",
+	"49":  "DE",
+	"55":  "BR",
+	"60":  "MY",
+	"61":  "AU",
+	"62":  "ID",
+	"63":  "PH",
+	"64":  "NZ",
+	"65":  "SG",
+	"66":  "TH",
+	"81":  "JP",
+	"82":  "KR",
+	"84":  "VN",
+	"86":  "CN",
+	"91":  "IN",
+	"852": "HK",
+	"853": "MO",
+	"855": "KH",
+	"886": "TW",
+	"971": "AE",
+}
+
+// maxDialCodeLen 国际电话区号最长位数
+const maxDialCodeLen = 3
+
+// CountryOf 根据 E.164 号码推断国家/地区；号码不带 "+" 时返回 defaultCountry，无法识别时返回空
+func CountryOf(phone, defaultCountry string) string {
+	phone = strings.TrimSpace(phone)
+	if !strings.HasPrefix(phone, "+") {
+		if strings.HasPrefix(phone, "00") {
+			phone = "+" + phone[2:]
+		} else {
+			return defaultCountry
+		}
+	}
+	digits := phone[1:]
+	for n := min(maxDialCodeLen, len(digits)); n > 0; n-- {
+		if country, ok := dialCodes[digits[:n]]; ok {
+			return country
+		}
+	}
+	return ""
+}
@@ -0,0 +1,210 @@
+// Package router 供应商路由
+// 路由策略决定同一渠道下各供应商的尝试顺序，按渠道在 routing 配置中选择策略；
+// 每次决策都会记录日志，包含选中的供应商与选择依据
+package router
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"go.uber.org/zap"
+)
+
+// defaultPriceKey prices 中表示默认价格的键
+const defaultPriceKey = "DEFAULT"
+
+// Candidate 参与路由的供应商及其路由属性
+type Candidate struct {
+	Provider provider.Provider
+	// Priority 优先级，数值越小越优先
+	Priority int
+	// Weight 权重，0 表示不参与加权随机
+	Weight int
+	// Prices 单条消息价格，键为大写的国家/地区代码或 DEFAULT
+	Prices map[string]float64
+}
+
+// Price 返回发往指定国家/地区的单价，未配置价格时 ok 为 false
+func (c *Candidate) Price(country string) (price float64, ok bool) {
+	if p, ok := c.Prices[country]; ok {
+		return p, true
+	}
+	p, ok := c.Prices[defaultPriceKey]
+	return p, ok
+}
+
+// Request 路由请求
+type Request struct {
+	// Notification 待发送的通知
+	Notification domain.Notification
+	// Country 目的地国家/地区，无法判断时为空
+	Country string
+}
+
+// Strategy 路由策略
+type Strategy interface {
+	// Name 策略名称，对应 routing 配置中的取值
+	Name() string
+	// Select 对候选供应商排序：首位为首选供应商，其余为兜底顺序；reason 说明选择依据
+	// candidates 已按优先级排列，实现不得修改传入的切片
+	Select(ctx context.Context, req Request, candidates []Candidate) (ordered []Candidate, reason string)
+}
+
+// Decision 路由决策
+type Decision struct {
+	// Strategy 使用的策略名称
+	Strategy string
+	// Providers 尝试顺序
+	Providers []provider.Provider
+	// Reason 选择依据
+	Reason string
+}
+
+// Router 按渠道选择路由策略，对渠道下的供应商排序
+type Router struct {
+	registry       *provider.Registry
+	candidates     map[string]Candidate
+	strategies     map[string]Strategy
+	byChannel      map[domain.Channel]string
+	defaultName    string
+	defaultCountry string
+	logger         appLogger.Logger
+
+	mu sync.RWMutex
+}
+
+// New 创建路由器，providers 提供各供应商的优先级、权重与价格
+func New(
+	cfg *config.RoutingConfig,
+	providers []config.ProviderConfig,
+	registry *provider.Registry,
+	logger appLogger.Logger,
+) (*Router, error) {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	r := &Router{
+		registry:       registry,
+		candidates:     make(map[string]Candidate, len(providers)),
+		strategies:     make(map[string]Strategy),
+		byChannel:      make(map[domain.Channel]string, len(cfg.Strategies)),
+		defaultName:    cfg.DefaultStrategy,
+		defaultCountry: strings.ToUpper(cfg.DefaultCountry),
+		logger:         logger,
+	}
+	for _, s := range []Strategy{NewPriority(), NewWeighted(), NewLowestCost(), NewRoundRobin()} {
+		r.strategies[s.Name()] = s
+	}
+
+	for i := range providers {
+		pc := providers[i]
+		prices := make(map[string]float64, len(pc.Prices))
+		for country, price := range pc.Prices {
+			prices[strings.ToUpper(country)] = price
+		}
+		weight := 1
+		if pc.Weight != nil {
+			weight = *pc.Weight
+		}
+		r.candidates[pc.Name] = Candidate{
+			Priority: pc.Priority,
+			Weight:   weight,
+			Prices:   prices,
+		}
+	}
+
+	if r.defaultName == "" {
+		r.defaultName = StrategyPriority
+	}
+	if _, ok := r.strategies[r.defaultName]; !ok {
+		return nil, fmt.Errorf("routing.default_strategy: unknown strategy %q", r.defaultName)
+	}
+	for ch, name := range cfg.Strategies {
+		channel := domain.Channel(strings.ToUpper(ch))
+		if !channel.IsValid() {
+			return nil, fmt.Errorf("routing.strategies: %w: %q", domain.ErrUnsupportedChannel, ch)
+		}
+		if _, ok := r.strategies[name]; !ok {
+			return nil, fmt.Errorf("routing.strategies.%s: unknown strategy %q", ch, name)
+		}
+		r.byChannel[channel] = name
+	}
+	return r, nil
+}
+
+// Register 注册自定义路由策略，同名策略会被覆盖
+func (r *Router) Register(s Strategy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.strategies[s.Name()] = s
+}
+
+// Route 对渠道下的供应商排序并记录决策
+func (r *Router) Route(ctx context.Context, n domain.Notification) Decision {
+	ps := r.registry.ByChannel(n.Channel)
+	strategy := r.strategyFor(n.Channel)
+	if len(ps) == 0 {
+		return Decision{Strategy: strategy.Name()}
+	}
+
+	candidates := make([]Candidate, 0, len(ps))
+	for _, p := range ps {
+		c := r.candidates[p.Name()]
+		c.Provider = p
+		candidates = append(candidates, c)
+	}
+	req := Request{Notification: n, Country: r.country(n)}
+
+	ordered, reason := strategy.Select(ctx, req, candidates)
+	d := Decision{
+		Strategy:  strategy.Name(),
+		Providers: make([]provider.Provider, 0, len(ordered)),
+		Reason:    reason,
+	}
+	for i := range ordered {
+		d.Providers = append(d.Providers, ordered[i].Provider)
+	}
+
+	r.logger.Info("供应商路由决策",
+		zap.Uint64("notification_id", n.ID),
+		zap.String("channel", string(n.Channel)),
+		zap.String("country", req.Country),
+		zap.String("strategy", d.Strategy),
+		zap.String("provider", d.Providers[0].Name()),
+		zap.Strings("fallbacks", providerNames(d.Providers[1:])),
+		zap.String("reason", d.Reason),
+	)
+	return d
+}
+
+func (r *Router) strategyFor(ch domain.Channel) Strategy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	name, ok := r.byChannel[ch]
+	if !ok {
+		name = r.defaultName
+	}
+	return r.strategies[name]
+}
+
+// country 推断目的地国家/地区：短信按号码国家码，其余渠道无法判断
+func (r *Router) country(n domain.Notification) string {
+	if n.Channel != domain.ChannelSMS {
+		return ""
+	}
+	return CountryOf(n.Recipient, r.defaultCountry)
+}
+
+func providerNames(ps []provider.Provider) []string {
+	names := make([]string, 0, len(ps))
+	for _, p := range ps {
+		names = append(names, p.Name())
+	}
+	return names
+}
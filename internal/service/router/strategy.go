@@ -0,0 +1,144 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// 内置路由策略名称
+const (
+	StrategyPriority   = "priority"
+	StrategyWeighted   = "weighted"
+	StrategyLowestCost = "lowest_cost"
+	StrategyRoundRobin = "round_robin"
+)
+
+// priorityStrategy 按优先级排序
+type priorityStrategy struct{}
+
+// NewPriority 创建优先级策略：始终选择优先级最高的供应商
+func NewPriority() Strategy {
+	return priorityStrategy{}
+}
+
+// Name 策略名称
+func (priorityStrategy) Name() string {
+	return StrategyPriority
+}
+
+// Select 保持优先级顺序
+func (priorityStrategy) Select(_ context.Context, _ Request, candidates []Candidate) ([]Candidate, string) {
+	out := append([]Candidate(nil), candidates...)
+	return out, fmt.Sprintf("highest priority (%d)", out[0].Priority)
+}
+
+// weightedStrategy 按权重随机
+type weightedStrategy struct{}
+
+// NewWeighted 创建加权随机策略：按权重比例随机选择首选供应商，其余按优先级兜底
+func NewWeighted() Strategy {
+	return weightedStrategy{}
+}
+
+// Name 策略名称
+func (weightedStrategy) Name() string {
+	return StrategyWeighted
+}
+
+// Select 按权重随机选出首选供应商；权重为 0 的供应商不参与随机，只按优先级兜底
+func (weightedStrategy) Select(_ context.Context, _ Request, candidates []Candidate) ([]Candidate, string) {
+	total := 0
+	for i := range candidates {
+		total += candidates[i].Weight
+	}
+	if total == 0 {
+		out := append([]Candidate(nil), candidates...)
+		return out, "all weights are 0, fell back to priority"
+	}
+	//nolint:gosec // 流量分配不需要密码学安全的随机数
+	n := rand.IntN(total)
+	chosen := 0
+	for i := range candidates {
+		if candidates[i].Weight == 0 {
+			continue
+		}
+		n -= candidates[i].Weight
+		if n < 0 {
+			chosen = i
+			break
+		}
+	}
+	return moveToFront(candidates, chosen),
+		fmt.Sprintf("weighted random, weight %d of %d", candidates[chosen].Weight, total)
+}
+
+// lowestCostStrategy 按目的地价格排序
+type lowestCostStrategy struct{}
+
+// NewLowestCost 创建最低成本策略：按发往目的地的单价从低到高排序，未配置价格的供应商排在最后
+func NewLowestCost() Strategy {
+	return lowestCostStrategy{}
+}
+
+// Name 策略名称
+func (lowestCostStrategy) Name() string {
+	return StrategyLowestCost
+}
+
+// Select 按单价升序排序，同价按优先级
+func (lowestCostStrategy) Select(_ context.Context, req Request, candidates []Candidate) ([]Candidate, string) {
+	out := append([]Candidate(nil), candidates...)
+	sort.SliceStable(out, func(i, j int) bool {
+		pi, oki := out[i].Price(req.Country)
+		pj, okj := out[j].Price(req.Country)
+		if oki != okj {
+			return oki
+		}
+		return oki && pi < pj
+	})
+	price, ok := out[0].Price(req.Country)
+	if !ok {
+		return out, fmt.Sprintf("no price configured for %q, fell back to priority", req.Country)
+	}
+	return out, fmt.Sprintf("lowest price %.4f for %q", price, req.Country)
+}
+
+// roundRobinStrategy 按渠道轮询
+type roundRobinStrategy struct {
+	counters sync.Map // domain.Channel -> *atomic.Uint64
+}
+
+// NewRoundRobin 创建轮询策略：同一渠道的请求依次轮流使用各供应商，计数在进程内维护
+func NewRoundRobin() Strategy {
+	return &roundRobinStrategy{}
+}
+
+// Name 策略名称
+func (*roundRobinStrategy) Name() string {
+	return StrategyRoundRobin
+}
+
+// Select 以轮询位置为起点依次排列
+func (s *roundRobinStrategy) Select(_ context.Context, req Request, candidates []Candidate) ([]Candidate, string) {
+	v, _ := s.counters.LoadOrStore(req.Notification.Channel, new(atomic.Uint64))
+	counter, _ := v.(*atomic.Uint64)
+	start := int((counter.Add(1) - 1) % uint64(len(candidates)))
+
+	out := make([]Candidate, 0, len(candidates))
+	out = append(out, candidates[start:]...)
+	out = append(out, candidates[:start]...)
+	return out, fmt.Sprintf("round robin position %d of %d", start+1, len(candidates))
+}
+
+// moveToFront 将第 i 个候选移到首位，其余保持原有顺序
+func moveToFront(candidates []Candidate, i int) []Candidate {
+	out := make([]Candidate, 0, len(candidates))
+	out = append(out, candidates[i])
+	out = append(out, candidates[:i]...)
+	out = append(out, candidates[i+1:]...)
+	return out
+}
@@ -0,0 +1,245 @@
+package router_test
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/service/router"
+)
+
+// namedProvider 只提供名称的供应商，策略排序不会调用其他方法
+type namedProvider struct {
+	provider.Provider
+	name string
+}
+
+func (p namedProvider) Name() string {
+	return p.name
+}
+
+// candidate 创建候选供应商
+func candidate(name string, priority, weight int, prices map[string]float64) router.Candidate {
+	return router.Candidate{
+		Provider: namedProvider{name: name},
+		Priority: priority,
+		Weight:   weight,
+		Prices:   prices,
+	}
+}
+
+// names 返回候选供应商的名称
+func names(cs []router.Candidate) []string {
+	out := make([]string, 0, len(cs))
+	for i := range cs {
+		out = append(out, cs[i].Provider.Name())
+	}
+	return out
+}
+
+func TestWeightedDistribution(t *testing.T) {
+	const rounds = 20000
+	tests := []struct {
+		name       string
+		candidates []router.Candidate
+		// wantShare 各供应商被选为首选的期望比例
+		wantShare map[string]float64
+	}{
+		{
+			name: "share follows weights",
+			candidates: []router.Candidate{
+				candidate("a", 1, 3, nil),
+				candidate("b", 2, 1, nil),
+			},
+			wantShare: map[string]float64{"a": 0.75, "b": 0.25},
+		},
+		{
+			name: "weight 0 is drained",
+			candidates: []router.Candidate{
+				candidate("a", 1, 0, nil),
+				candidate("b", 2, 1, nil),
+				candidate("c", 3, 1, nil),
+			},
+			wantShare: map[string]float64{"a": 0, "b": 0.5, "c": 0.5},
+		},
+	}
+
+	s := router.NewWeighted()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := make(map[string]int)
+			for range rounds {
+				out, _ := s.Select(context.Background(), router.Request{}, tt.candidates)
+				if len(out) != len(tt.candidates) {
+					t.Fatalf("Select() returned %d candidates, want %d", len(out), len(tt.candidates))
+				}
+				counts[out[0].Provider.Name()]++
+			}
+			for name, want := range tt.wantShare {
+				got := float64(counts[name]) / rounds
+				if want == 0 && counts[name] != 0 {
+					t.Errorf("%s chosen %d times, want never", name, counts[name])
+				}
+				if math.Abs(got-want) > 0.03 {
+					t.Errorf("%s share = %.3f, want %.2f±0.03", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWeightedOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []router.Candidate
+		want       []string
+		wantReason string
+	}{
+		{
+			name: "weight 0 stays as fallback in priority order",
+			candidates: []router.Candidate{
+				candidate("a", 1, 0, nil),
+				candidate("b", 2, 1, nil),
+				candidate("c", 3, 0, nil),
+			},
+			want:       []string{"b", "a", "c"},
+			wantReason: "weighted random, weight 1 of 1",
+		},
+		{
+			name: "all weights 0 falls back to priority",
+			candidates: []router.Candidate{
+				candidate("a", 1, 0, nil),
+				candidate("b", 2, 0, nil),
+			},
+			want:       []string{"a", "b"},
+			wantReason: "all weights are 0, fell back to priority",
+		},
+	}
+
+	s := router.NewWeighted()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, reason := s.Select(context.Background(), router.Request{}, tt.candidates)
+			if got := names(out); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Select() order = %v, want %v", got, tt.want)
+			}
+			if reason != tt.wantReason {
+				t.Errorf("Select() reason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestLowestCost(t *testing.T) {
+	tests := []struct {
+		name       string
+		country    string
+		candidates []router.Candidate
+		want       []string
+		wantReason string
+	}{
+		{
+			name:    "country price wins over DEFAULT",
+			country: "CN",
+			candidates: []router.Candidate{
+				candidate("a", 1, 1, map[string]float64{"DEFAULT": 0.05}),
+				candidate("b", 2, 1, map[string]float64{"CN": 0.03, "DEFAULT": 0.08}),
+				candidate("c", 3, 1, map[string]float64{"US": 0.01}),
+			},
+			want:       []string{"b", "a", "c"},
+			wantReason: `lowest price 0.0300 for "CN"`,
+		},
+		{
+			name:    "DEFAULT price used for unlisted country",
+			country: "SG",
+			candidates: []router.Candidate{
+				candidate("a", 1, 1, map[string]float64{"CN": 0.01, "DEFAULT": 0.09}),
+				candidate("b", 2, 1, map[string]float64{"DEFAULT": 0.06}),
+			},
+			want:       []string{"b", "a"},
+			wantReason: `lowest price 0.0600 for "SG"`,
+		},
+		{
+			name:    "same price keeps priority order",
+			country: "CN",
+			candidates: []router.Candidate{
+				candidate("a", 1, 1, map[string]float64{"DEFAULT": 0.04}),
+				candidate("b", 2, 1, map[string]float64{"CN": 0.04}),
+			},
+			want:       []string{"a", "b"},
+			wantReason: `lowest price 0.0400 for "CN"`,
+		},
+		{
+			name:    "no price falls back to priority",
+			country: "JP",
+			candidates: []router.Candidate{
+				candidate("a", 1, 1, map[string]float64{"CN": 0.03}),
+				candidate("b", 2, 1, nil),
+			},
+			want:       []string{"a", "b"},
+			wantReason: `no price configured for "JP", fell back to priority`,
+		},
+		{
+			name:    "unknown country uses DEFAULT",
+			country: "",
+			candidates: []router.Candidate{
+				candidate("a", 1, 1, nil),
+				candidate("b", 2, 1, map[string]float64{"DEFAULT": 0.07}),
+			},
+			want:       []string{"b", "a"},
+			wantReason: `lowest price 0.0700 for ""`,
+		},
+	}
+
+	s := router.NewLowestCost()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, reason := s.Select(context.Background(), router.Request{Country: tt.country}, tt.candidates)
+			if got := names(out); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Select() order = %v, want %v", got, tt.want)
+			}
+			if reason != tt.wantReason {
+				t.Errorf("Select() reason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestRoundRobinPerChannel(t *testing.T) {
+	sms := []router.Candidate{
+		candidate("sms-a", 1, 1, nil),
+		candidate("sms-b", 2, 1, nil),
+		candidate("sms-c", 3, 1, nil),
+	}
+	email := []router.Candidate{
+		candidate("mail-a", 1, 1, nil),
+		candidate("mail-b", 2, 1, nil),
+	}
+
+	tests := []struct {
+		channel    domain.Channel
+		candidates []router.Candidate
+		want       string
+	}{
+		{channel: domain.ChannelSMS, candidates: sms, want: "sms-a,sms-b,sms-c"},
+		{channel: domain.ChannelEmail, candidates: email, want: "mail-a,mail-b"},
+		{channel: domain.ChannelSMS, candidates: sms, want: "sms-b,sms-c,sms-a"},
+		{channel: domain.ChannelSMS, candidates: sms, want: "sms-c,sms-a,sms-b"},
+		{channel: domain.ChannelEmail, candidates: email, want: "mail-b,mail-a"},
+		{channel: domain.ChannelSMS, candidates: sms, want: "sms-a,sms-b,sms-c"},
+		{channel: domain.ChannelEmail, candidates: email, want: "mail-a,mail-b"},
+	}
+
+	s := router.NewRoundRobin()
+	for i, tt := range tests {
+		req := router.Request{Notification: domain.Notification{Channel: tt.channel}}
+		out, _ := s.Select(context.Background(), req, tt.candidates)
+		if got := strings.Join(names(out), ","); got != tt.want {
+			t.Errorf("call %d (%s): order = %s, want %s", i+1, tt.channel, got, tt.want)
+		}
+	}
+}
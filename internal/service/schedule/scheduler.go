@@ -0,0 +1,239 @@
+// Package schedule 定时发送
+// 定时通知以 SCHEDULED 状态落库作为持久记录，同时登记到 Redis 有序集合，分值为发送时间（毫秒时间戳）。
+// 调度器定时取出到期的成员并逐个 ZREM，只有删除成功的实例才算领取到该通知；
+// 领取后通过条件更新把通知从 SCHEDULED 改为 PENDING 再交给异步发送工作池，与取消操作互斥。
+// Redis 丢失数据、登记失败或未启用 Redis 时，由数据库扫描兜底释放到期的定时通知
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+	"github.com/dingdong-postman/internal/repository"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// timerKey 定时通知有序集合的键名（不含前缀）
+const timerKey = "notifications"
+
+// recoverGrace 启用 Redis 时数据库扫描只处理到期超过该时长的通知，正常到期的通知留给 Redis 领取
+const recoverGrace = 5 * time.Second
+
+// Queue 异步发送队列，由后台工作池实现
+type Queue interface {
+	// Submit 提交一条已落库的待发送通知，返回是否成功入队
+	Submit(n domain.Notification) bool
+}
+
+// Scheduler 定时通知调度器
+type Scheduler struct {
+	cfg    *config.ScheduleConfig
+	repo   repository.NotificationRepository
+	client appRedis.Client
+	queue  Queue
+	logger appLogger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler 创建定时通知调度器
+// client 为 nil 时（如未启用 Redis）仅依赖数据库扫描，扫描间隔取 poll_interval
+func NewScheduler(
+	cfg *config.ScheduleConfig,
+	repo repository.NotificationRepository,
+	client appRedis.Client,
+	queue Queue,
+	logger appLogger.Logger,
+) *Scheduler {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &Scheduler{
+		cfg:    cfg,
+		repo:   repo,
+		client: client,
+		queue:  queue,
+		logger: logger,
+	}
+}
+
+// Start 启动到期通知的领取与数据库扫描协程
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	pollInterval := time.Duration(s.cfg.PollInterval) * time.Millisecond
+	recoverInterval := time.Duration(s.cfg.RecoverInterval) * time.Second
+	if s.client != nil {
+		s.wg.Add(1)
+		go s.loop(ctx, pollInterval, s.pollOnce)
+	} else {
+		recoverInterval = pollInterval
+	}
+	s.wg.Add(1)
+	go s.loop(ctx, recoverInterval, s.recoverOnce)
+
+	s.logger.Info("定时通知调度器启动",
+		zap.Bool("redis", s.client != nil),
+		zap.Duration("poll_interval", pollInterval),
+		zap.Duration("recover_interval", recoverInterval),
+	)
+}
+
+// Stop 停止调度器并等待正在释放的通知完成；已领取但未释放的通知由数据库扫描兜底
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	s.logger.Info("定时通知调度器已停止")
+}
+
+// Check 校验定时发送时间是否超出允许的最长定时时间
+func (s *Scheduler) Check(at time.Time) error {
+	limit := time.Duration(s.cfg.MaxDelay) * time.Second
+	if time.Until(at) > limit {
+		return fmt.Errorf("%w: 定时发送时间不能晚于受理后 %s", domain.ErrInvalidNotification, limit)
+	}
+	return nil
+}
+
+// Schedule 将已落库的定时通知登记到 Redis；未启用 Redis 时不做处理
+func (s *Scheduler) Schedule(ctx context.Context, n domain.Notification) error {
+	if s.client == nil {
+		return nil
+	}
+	_, err := s.client.ZAdd(ctx, s.key(), redis.Z{
+		Score:  float64(n.ScheduledAt.UnixMilli()),
+		Member: strconv.FormatUint(n.ID, 10),
+	})
+	if err != nil {
+		return fmt.Errorf("schedule notification: %w", err)
+	}
+	return nil
+}
+
+// Unschedule 从 Redis 中移除定时通知；未启用 Redis 时不做处理
+func (s *Scheduler) Unschedule(ctx context.Context, id uint64) error {
+	if s.client == nil {
+		return nil
+	}
+	if _, err := s.client.ZRem(ctx, s.key(), strconv.FormatUint(id, 10)); err != nil {
+		return fmt.Errorf("unschedule notification: %w", err)
+	}
+	return nil
+}
+
+// loop 按 interval 定时执行 fn
+func (s *Scheduler) loop(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
+
+// pollOnce 领取 Redis 中到期的定时通知并释放发送
+// 多个实例可能取到相同的成员，ZREM 是原子的，只有删除成功的实例继续处理
+func (s *Scheduler) pollOnce(ctx context.Context) {
+	members, err := s.client.ZRangeByScore(ctx, s.key(), &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(time.Now().UnixMilli(), 10),
+		Count: int64(s.cfg.BatchSize),
+	})
+	if err != nil {
+		s.logger.Error("查询到期定时通知失败", zap.Error(err))
+		return
+	}
+
+	for _, member := range members {
+		removed, err := s.client.ZRem(ctx, s.key(), member)
+		if err != nil {
+			s.logger.Error("领取到期定时通知失败", zap.String("member", member), zap.Error(err))
+			return
+		}
+		if removed == 0 {
+			// 已被其他实例领取或已取消
+			continue
+		}
+		id, err := strconv.ParseUint(member, 10, 64)
+		if err != nil {
+			s.logger.Warn("忽略无法解析的定时通知成员", zap.String("member", member))
+			continue
+		}
+		// 释放过程不受调度器停止影响，避免已领取的通知只能等待数据库扫描
+		s.release(context.WithoutCancel(ctx), id)
+	}
+}
+
+// recoverOnce 扫描数据库中已到期但仍未释放的定时通知并释放发送
+func (s *Scheduler) recoverOnce(ctx context.Context) {
+	due := time.Now()
+	if s.client != nil {
+		due = due.Add(-recoverGrace)
+	}
+	ns, err := s.repo.FindDueScheduled(ctx, due, s.cfg.BatchSize)
+	if err != nil {
+		s.logger.Error("扫描到期定时通知失败", zap.Error(err))
+		return
+	}
+	for i := range ns {
+		if s.client != nil {
+			s.logger.Warn("定时通知到期未从 Redis 领取，由数据库扫描释放",
+				zap.Uint64("notification_id", ns[i].ID),
+				zap.Time("scheduled_at", ns[i].ScheduledAt),
+			)
+		}
+		if !s.release(context.WithoutCancel(ctx), ns[i].ID) || s.client == nil {
+			continue
+		}
+		// 清理 Redis 中可能残留的成员，失败不影响发送：成员到期被领取时释放会因状态不符而跳过
+		if err := s.Unschedule(ctx, ns[i].ID); err != nil {
+			s.logger.Warn("清理定时通知登记失败", zap.Uint64("notification_id", ns[i].ID), zap.Error(err))
+		}
+	}
+}
+
+// release 将定时通知改为待发送并交给异步发送工作池，返回是否释放成功；
+// 通知已取消或已被其他实例释放时跳过
+func (s *Scheduler) release(ctx context.Context, id uint64) bool {
+	released, err := s.repo.ReleaseScheduled(ctx, id)
+	if err != nil {
+		s.logger.Error("释放定时通知失败", zap.Uint64("notification_id", id), zap.Error(err))
+		return false
+	}
+	if !released {
+		return false
+	}
+
+	n, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		// 已是待发送状态，由工作池轮询兜底
+		s.logger.Error("查询已释放的定时通知失败", zap.Uint64("notification_id", id), zap.Error(err))
+		return true
+	}
+	// 入队失败也无妨，工作池会轮询数据库兜底
+	s.queue.Submit(n)
+	return true
+}
+
+// key 定时通知有序集合的 Redis 键
+func (s *Scheduler) key() string {
+	return s.cfg.KeyPrefix + timerKey
+}
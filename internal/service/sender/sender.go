@@ -0,0 +1,313 @@
+package sender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/repository"
+	"github.com/dingdong-postman/internal/service/health"
+	"github.com/dingdong-postman/internal/service/ratelimit"
+	"github.com/dingdong-postman/internal/service/router"
+	"github.com/dingdong-postman/internal/service/shaper"
+	"go.uber.org/zap"
+)
+
+// ErrNoProvider 渠道没有已启用的供应商
+var ErrNoProvider = errors.New("no provider enabled for channel")
+
+// TemplateGate 按供应商的模板审核结果放行通知，由模板审核同步器实现
+type TemplateGate interface {
+	// ForProvider 返回发往该供应商时使用的通知（如换成供应商的模板 Code），
+	// 模板尚未在该供应商处审核通过时返回 domain.ErrTemplateNotApproved
+	ForProvider(ctx context.Context, n domain.Notification, p provider.Provider) (domain.Notification, error)
+}
+
+// Sender 负责把一条通知真正发送出去
+type Sender interface {
+	// Send 发送通知，返回 nil 表示已成功提交给渠道；
+	// 无论成功与否，回执中都会带上实际使用的（最后一次尝试的）供应商
+	Send(ctx context.Context, n domain.Notification) (domain.Receipt, error)
+}
+
+// providerSender 基于供应商路由的发送器，支持渠道兜底
+// 按路由策略给出的顺序依次尝试渠道下的供应商，健康的供应商优先；
+// 供应商返回可重试错误、被限流或超时时切换到下一个供应商，返回永久性错误时直接失败。
+// 每次尝试都记录到 MySQL，并计入 Redis 中的供应商健康度。
+// 供应商被限流规则拦截时不调用供应商，按被限流处理并切换到下一个供应商；
+// 调用供应商前按供应商的 QPS 配额排队，排队超时同样按被限流处理。
+// 模板尚未在供应商处审核通过时不路由到该供应商
+type providerSender struct {
+	router   *router.Router
+	attempts repository.SendAttemptRepository
+	tracker  health.Tracker
+	limiter  ratelimit.Limiter
+	shaper   *shaper.Shaper
+	gate     TemplateGate
+	timeout  time.Duration
+	logger   appLogger.Logger
+}
+
+// NewProviderSender 创建基于供应商路由的发送器
+// tracker 为 nil 时不统计健康度，所有供应商视为健康；limiter 为 nil 时不限流；shaper 为 nil 时不限速；
+// gate 为 nil 时不检查模板审核结果
+func NewProviderSender(
+	r *router.Router,
+	attempts repository.SendAttemptRepository,
+	tracker health.Tracker,
+	limiter ratelimit.Limiter,
+	sh *shaper.Shaper,
+	gate TemplateGate,
+	cfg *config.FailoverConfig,
+	logger appLogger.Logger,
+) Sender {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &providerSender{
+		router:   r,
+		attempts: attempts,
+		tracker:  tracker,
+		limiter:  limiter,
+		shaper:   sh,
+		gate:     gate,
+		timeout:  time.Duration(cfg.AttemptTimeout) * time.Second,
+		logger:   logger,
+	}
+}
+
+// Send 按兜底顺序依次尝试供应商，直到成功或遇到永久性错误
+func (s *providerSender) Send(ctx context.Context, n domain.Notification) (domain.Receipt, error) {
+	ps := s.candidates(ctx, n)
+	if len(ps) == 0 {
+		return domain.Receipt{}, fmt.Errorf("%w: %s", ErrNoProvider, n.Channel)
+	}
+	ps, ns, err := s.approved(ctx, n, ps)
+	if err != nil {
+		return domain.Receipt{}, err
+	}
+
+	var (
+		receipt domain.Receipt
+		lastErr error
+	)
+	for i, p := range ps {
+		var res provider.SendResult
+		err := s.allow(ctx, n, p)
+		if err == nil {
+			err = s.pace(ctx, p)
+		}
+		if err == nil {
+			res, err = s.attempt(ctx, ns[i], p)
+		}
+		receipt = domain.Receipt{Provider: p.Name(), MessageID: res.MessageID}
+		if err == nil {
+			s.logger.Debug("通知已提交给供应商",
+				zap.Uint64("notification_id", n.ID),
+				zap.String("provider", receipt.Provider),
+				zap.String("message_id", receipt.MessageID),
+				zap.Int("attempt", i+1),
+			)
+			return receipt, nil
+		}
+
+		lastErr = err
+		if !provider.IsRetryable(err) || ctx.Err() != nil || i == len(ps)-1 {
+			break
+		}
+		s.logger.Warn("供应商发送失败，切换到下一个供应商",
+			zap.Uint64("notification_id", n.ID),
+			zap.String("provider", p.Name()),
+			zap.String("next_provider", ps[i+1].Name()),
+			zap.String("category", string(provider.CategoryOf(err))),
+			zap.Error(err),
+		)
+	}
+	return receipt, lastErr
+}
+
+// approved 按模板审核结果过滤供应商，返回放行的供应商及发往各供应商时使用的通知
+// 没有任何供应商放行时返回可重试错误，等待审核通过后由重试引擎重发
+func (s *providerSender) approved(
+	ctx context.Context,
+	n domain.Notification,
+	ps []provider.Provider,
+) ([]provider.Provider, []domain.Notification, error) {
+	ns := make([]domain.Notification, 0, len(ps))
+	if s.gate == nil {
+		for range ps {
+			ns = append(ns, n)
+		}
+		return ps, ns, nil
+	}
+
+	allowed := make([]provider.Provider, 0, len(ps))
+	var lastErr error
+	for _, p := range ps {
+		pn, err := s.gate.ForProvider(ctx, n, p)
+		if err != nil {
+			lastErr = err
+			if !errors.Is(err, domain.ErrTemplateNotApproved) {
+				s.logger.Warn("查询模板审核结果失败，跳过供应商",
+					zap.Uint64("notification_id", n.ID),
+					zap.String("provider", p.Name()),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+		allowed = append(allowed, p)
+		ns = append(ns, pn)
+	}
+	if len(allowed) == 0 {
+		pe := provider.WrapError(ps[0].Name(), provider.CategoryRetryable, lastErr)
+		pe.Code = "TEMPLATE_NOT_APPROVED"
+		return nil, nil, pe
+	}
+	return allowed, ns, nil
+}
+
+// allow 按与供应商相关的限流规则判断是否可以调用供应商，超限时返回被限流类别的错误
+func (s *providerSender) allow(ctx context.Context, n domain.Notification, p provider.Provider) error {
+	if s.limiter == nil {
+		return nil
+	}
+	if err := s.limiter.AllowProvider(ctx, n, p.Name()); err != nil {
+		pe := provider.WrapError(p.Name(), provider.CategoryThrottled, err)
+		pe.Code = "RATE_LIMITED"
+		return pe
+	}
+	return nil
+}
+
+// pace 按供应商的 QPS 配额排队等待，排队超时返回被限流类别的错误
+func (s *providerSender) pace(ctx context.Context, p provider.Provider) error {
+	if s.shaper == nil {
+		return nil
+	}
+	err := s.shaper.Wait(ctx, p.Name())
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, shaper.ErrQueueTimeout):
+		pe := provider.WrapError(p.Name(), provider.CategoryThrottled, err)
+		pe.Code = "SHAPING_TIMEOUT"
+		return pe
+	default:
+		return provider.WrapError(p.Name(), provider.CategoryRetryable, err)
+	}
+}
+
+// attempt 在单次超时内向一个供应商发送，记录尝试与健康度
+func (s *providerSender) attempt(
+	ctx context.Context,
+	n domain.Notification,
+	p provider.Provider,
+) (provider.SendResult, error) {
+	attemptCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	start := time.Now()
+	res, err := p.Send(attemptCtx, n)
+	duration := time.Since(start)
+	if err != nil {
+		err = s.normalizeError(ctx, attemptCtx, p, err)
+	}
+
+	// 记录不受调用方取消影响，保证每次尝试都有据可查
+	recordCtx := context.WithoutCancel(ctx)
+	s.recordAttempt(recordCtx, n, p, res, err, start, duration)
+	if s.tracker != nil {
+		// 永久性错误（如号码非法）与供应商本身无关，不计入错误率
+		if rerr := s.tracker.Record(recordCtx, p.Name(), err != nil && provider.IsRetryable(err)); rerr != nil {
+			s.logger.Warn("记录供应商健康度失败", zap.String("provider", p.Name()), zap.Error(rerr))
+		}
+	}
+	return res, err
+}
+
+// normalizeError 统一错误：单次尝试超时视为可重试错误，未分类的错误补充供应商名称
+func (s *providerSender) normalizeError(ctx, attemptCtx context.Context, p provider.Provider, err error) error {
+	if ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		pe := provider.WrapError(p.Name(), provider.CategoryRetryable, err)
+		pe.Code = "TIMEOUT"
+		return pe
+	}
+	var pe *provider.Error
+	if errors.As(err, &pe) {
+		return err
+	}
+	return provider.WrapError(p.Name(), provider.CategoryOf(err), err)
+}
+
+// recordAttempt 将一次尝试写入 MySQL，尝试序号由存储按通知已有的尝试递增分配；失败只记录日志，不影响发送结果
+func (s *providerSender) recordAttempt(
+	ctx context.Context,
+	n domain.Notification,
+	p provider.Provider,
+	res provider.SendResult,
+	err error,
+	start time.Time,
+	duration time.Duration,
+) {
+	if s.attempts == nil {
+		return
+	}
+	a := domain.SendAttempt{
+		NotificationID: n.ID,
+		Provider:       p.Name(),
+		Succeeded:      err == nil,
+		MessageID:      res.MessageID,
+		Duration:       duration,
+		CreatedAt:      start,
+	}
+	if err != nil {
+		a.ErrorCategory = string(provider.CategoryOf(err))
+		a.ErrorMessage = err.Error()
+		var pe *provider.Error
+		if errors.As(err, &pe) {
+			a.ErrorCode = pe.Code
+		}
+	}
+	if rerr := s.attempts.Create(ctx, a); rerr != nil {
+		s.logger.Error("记录发送尝试失败",
+			zap.Uint64("notification_id", n.ID),
+			zap.String("provider", p.Name()),
+			zap.Error(rerr),
+		)
+	}
+}
+
+// candidates 返回兜底顺序：健康的供应商按路由顺序在前，不健康的供应商按路由顺序排在最后
+func (s *providerSender) candidates(ctx context.Context, n domain.Notification) []provider.Provider {
+	ps := s.router.Route(ctx, n).Providers
+	if s.tracker == nil || len(ps) < 2 {
+		return ps
+	}
+
+	healthy := make([]provider.Provider, 0, len(ps))
+	var unhealthy []provider.Provider
+	for _, p := range ps {
+		ok, stats, err := s.tracker.IsHealthy(ctx, p.Name())
+		if err != nil {
+			s.logger.Warn("查询供应商健康度失败，视为健康", zap.String("provider", p.Name()), zap.Error(err))
+		}
+		if ok {
+			healthy = append(healthy, p)
+			continue
+		}
+		s.logger.Warn("供应商不健康，降为最后尝试",
+			zap.String("provider", p.Name()),
+			zap.Int64("total", stats.Total),
+			zap.Int64("errors", stats.Errors),
+			zap.Float64("error_rate", stats.ErrorRate()),
+		)
+		unhealthy = append(unhealthy, p)
+	}
+	return append(healthy, unhealthy...)
+}
@@ -0,0 +1,247 @@
+package sender_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/service/health"
+	"github.com/dingdong-postman/internal/service/router"
+	"github.com/dingdong-postman/internal/service/sender"
+)
+
+// fakeType 测试用供应商类型
+const fakeType = "sender_test_fake"
+
+func init() {
+	provider.RegisterFactory(fakeType, func(cfg config.ProviderConfig, _ appLogger.Logger) (provider.Provider, error) {
+		return &fakeProvider{name: cfg.Name}, nil
+	})
+}
+
+// fakeProvider 返回预设结果的短信供应商
+type fakeProvider struct {
+	name string
+	err  error
+}
+
+func (p *fakeProvider) Name() string {
+	return p.name
+}
+
+func (p *fakeProvider) SupportedChannel() domain.Channel {
+	return domain.ChannelSMS
+}
+
+func (p *fakeProvider) Send(_ context.Context, _ domain.Notification) (provider.SendResult, error) {
+	if p.err != nil {
+		return provider.SendResult{}, p.err
+	}
+	return provider.SendResult{MessageID: p.name + "-msg"}, nil
+}
+
+func (p *fakeProvider) QueryStatus(_ context.Context, _ provider.QueryRequest) (provider.QueryResult, error) {
+	return provider.QueryResult{Status: provider.DeliveryStatusUnknown}, nil
+}
+
+// attemptStore 记录写入的发送尝试
+type attemptStore struct {
+	mu       sync.Mutex
+	attempts []domain.SendAttempt
+}
+
+func (s *attemptStore) Create(_ context.Context, a domain.SendAttempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a.Seq = len(s.attempts) + 1
+	s.attempts = append(s.attempts, a)
+	return nil
+}
+
+func (s *attemptStore) FindByNotificationID(_ context.Context, id uint64) ([]domain.SendAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []domain.SendAttempt
+	for _, a := range s.attempts {
+		if a.NotificationID == id {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// unhealthyTracker 把指定供应商视为不健康，其余视为健康
+type unhealthyTracker struct {
+	unhealthy map[string]bool
+}
+
+func (t unhealthyTracker) Record(_ context.Context, _ string, _ bool) error {
+	return nil
+}
+
+func (t unhealthyTracker) Stats(_ context.Context, _ string) (health.Stats, error) {
+	return health.Stats{}, nil
+}
+
+func (t unhealthyTracker) IsHealthy(_ context.Context, name string) (bool, health.Stats, error) {
+	return !t.unhealthy[name], health.Stats{}, nil
+}
+
+// wantAttempt 期望写入的一次发送尝试
+type wantAttempt struct {
+	provider  string
+	succeeded bool
+	category  string
+	code      string
+}
+
+func TestSendFailover(t *testing.T) {
+	retryable := provider.NewError("", provider.CategoryRetryable, "isp.SYSTEM_ERROR", "system busy")
+	throttled := provider.NewError("", provider.CategoryThrottled, "isv.BUSINESS_LIMIT_CONTROL", "too many requests")
+	permanent := provider.NewError("", provider.CategoryPermanent, "isv.MOBILE_NUMBER_ILLEGAL", "invalid number")
+
+	tests := []struct {
+		name         string
+		errs         map[string]error
+		unhealthy    map[string]bool
+		wantErr      provider.ErrorCategory
+		wantProvider string
+		wantAttempts []wantAttempt
+	}{
+		{
+			name:         "first provider succeeds",
+			wantProvider: "primary",
+			wantAttempts: []wantAttempt{
+				{provider: "primary", succeeded: true},
+			},
+		},
+		{
+			name:         "retryable and throttled errors fail over in priority order",
+			errs:         map[string]error{"primary": retryable, "secondary": throttled},
+			wantProvider: "tertiary",
+			wantAttempts: []wantAttempt{
+				{provider: "primary", category: "RETRYABLE", code: "isp.SYSTEM_ERROR"},
+				{provider: "secondary", category: "THROTTLED", code: "isv.BUSINESS_LIMIT_CONTROL"},
+				{provider: "tertiary", succeeded: true},
+			},
+		},
+		{
+			name:         "permanent error stops failover",
+			errs:         map[string]error{"primary": retryable, "secondary": permanent},
+			wantErr:      provider.CategoryPermanent,
+			wantProvider: "secondary",
+			wantAttempts: []wantAttempt{
+				{provider: "primary", category: "RETRYABLE", code: "isp.SYSTEM_ERROR"},
+				{provider: "secondary", category: "PERMANENT", code: "isv.MOBILE_NUMBER_ILLEGAL"},
+			},
+		},
+		{
+			name:         "unclassified error is permanent",
+			errs:         map[string]error{"primary": errors.New("unexpected response")},
+			wantErr:      provider.CategoryPermanent,
+			wantProvider: "primary",
+			wantAttempts: []wantAttempt{
+				{provider: "primary", category: "PERMANENT"},
+			},
+		},
+		{
+			name:         "all providers fail returns last error",
+			errs:         map[string]error{"primary": retryable, "secondary": retryable, "tertiary": throttled},
+			wantErr:      provider.CategoryThrottled,
+			wantProvider: "tertiary",
+			wantAttempts: []wantAttempt{
+				{provider: "primary", category: "RETRYABLE", code: "isp.SYSTEM_ERROR"},
+				{provider: "secondary", category: "RETRYABLE", code: "isp.SYSTEM_ERROR"},
+				{provider: "tertiary", category: "THROTTLED", code: "isv.BUSINESS_LIMIT_CONTROL"},
+			},
+		},
+		{
+			name:         "unhealthy provider is tried last",
+			errs:         map[string]error{"secondary": retryable, "tertiary": retryable},
+			unhealthy:    map[string]bool{"primary": true},
+			wantProvider: "primary",
+			wantAttempts: []wantAttempt{
+				{provider: "secondary", category: "RETRYABLE", code: "isp.SYSTEM_ERROR"},
+				{provider: "tertiary", category: "RETRYABLE", code: "isp.SYSTEM_ERROR"},
+				{provider: "primary", succeeded: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, store := newSender(t, tt.errs, tt.unhealthy)
+			n := domain.Notification{ID: 42, Channel: domain.ChannelSMS, Recipient: "+8613800138000"}
+
+			receipt, err := s.Send(context.Background(), n)
+			if got := provider.CategoryOf(err); got != tt.wantErr {
+				t.Fatalf("Send() error = %v, category %q, want %q", err, got, tt.wantErr)
+			}
+			if receipt.Provider != tt.wantProvider {
+				t.Errorf("receipt provider = %q, want %q", receipt.Provider, tt.wantProvider)
+			}
+			if err == nil && receipt.MessageID != tt.wantProvider+"-msg" {
+				t.Errorf("receipt message id = %q, want %q", receipt.MessageID, tt.wantProvider+"-msg")
+			}
+
+			got, _ := store.FindByNotificationID(context.Background(), n.ID)
+			if len(got) != len(tt.wantAttempts) {
+				t.Fatalf("recorded %d attempts, want %d: %+v", len(got), len(tt.wantAttempts), got)
+			}
+			for i, want := range tt.wantAttempts {
+				a := got[i]
+				if a.Provider != want.provider || a.Succeeded != want.succeeded ||
+					a.ErrorCategory != want.category || a.ErrorCode != want.code {
+					t.Errorf("attempt %d = {provider:%s succeeded:%v category:%s code:%s}, want %+v",
+						i+1, a.Provider, a.Succeeded, a.ErrorCategory, a.ErrorCode, want)
+				}
+				if !want.succeeded && a.ErrorMessage == "" {
+					t.Errorf("attempt %d has no error message", i+1)
+				}
+				if want.succeeded && a.MessageID != want.provider+"-msg" {
+					t.Errorf("attempt %d message id = %q, want %q", i+1, a.MessageID, want.provider+"-msg")
+				}
+			}
+		})
+	}
+}
+
+// newSender 创建按优先级兜底的发送器：primary、secondary、tertiary 依次兜底，
+// 配置顺序与优先级不同，确认按优先级而不是配置顺序尝试
+func newSender(t *testing.T, errs map[string]error, unhealthy map[string]bool) (sender.Sender, *attemptStore) {
+	t.Helper()
+	cfgs := []config.ProviderConfig{
+		{Name: "tertiary", Type: fakeType, Enabled: true, Priority: 3},
+		{Name: "primary", Type: fakeType, Enabled: true, Priority: 1},
+		{Name: "secondary", Type: fakeType, Enabled: true, Priority: 2},
+		{Name: "disabled", Type: fakeType, Enabled: false, Priority: 0},
+	}
+	registry, err := provider.NewRegistryFromConfig(cfgs, nil)
+	if err != nil {
+		t.Fatalf("new registry: %v", err)
+	}
+	for name, perr := range errs {
+		p, ok := registry.Get(name)
+		if !ok {
+			t.Fatalf("provider %s not registered", name)
+		}
+		p.(*fakeProvider).err = perr
+	}
+	r, err := router.New(config.DefaultRoutingConfig(), cfgs, registry, nil)
+	if err != nil {
+		t.Fatalf("new router: %v", err)
+	}
+
+	var tracker health.Tracker
+	if unhealthy != nil {
+		tracker = unhealthyTracker{unhealthy: unhealthy}
+	}
+	store := &attemptStore{}
+	s := sender.NewProviderSender(r, store, tracker, nil, nil, nil, &config.FailoverConfig{AttemptTimeout: 5}, nil)
+	return s, store
+}
@@ -0,0 +1,66 @@
+package shaper
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+)
+
+// bucket 令牌桶，Take 取一个令牌
+type bucket interface {
+	Take(ctx context.Context, key string, capacity int64, rate float64) (appRedis.LimitResult, error)
+}
+
+// redisBucket 基于 Redis Lua 脚本的令牌桶，各实例共享
+type redisBucket struct {
+	limiter *appRedis.TokenBucketLimiter
+}
+
+// Take 从 Redis 令牌桶中取一个令牌
+func (b *redisBucket) Take(ctx context.Context, key string, capacity int64, rate float64) (appRedis.LimitResult, error) {
+	return b.limiter.Allow(ctx, key, capacity, rate, 1)
+}
+
+// localState 进程内令牌桶的状态
+type localState struct {
+	tokens float64
+	last   time.Time
+}
+
+// localBucket 进程内令牌桶，未启用 Redis 时使用，各实例分别限速
+type localBucket struct {
+	mu     sync.Mutex
+	states map[string]*localState
+}
+
+// newLocalBucket 创建进程内令牌桶
+func newLocalBucket() *localBucket {
+	return &localBucket{
+		states: make(map[string]*localState),
+	}
+}
+
+// Take 从进程内令牌桶中取一个令牌，算法与 Redis 脚本一致
+func (b *localBucket) Take(_ context.Context, key string, capacity int64, rate float64) (appRedis.LimitResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	st, ok := b.states[key]
+	if !ok {
+		st = &localState{tokens: float64(capacity), last: now}
+		b.states[key] = st
+	}
+	st.tokens = math.Min(float64(capacity), st.tokens+now.Sub(st.last).Seconds()*rate)
+	st.last = now
+
+	if st.tokens >= 1 {
+		st.tokens--
+		return appRedis.LimitResult{Allowed: true, Remaining: int64(st.tokens)}, nil
+	}
+	wait := time.Duration(math.Ceil((1 - st.tokens) / rate * float64(time.Second)))
+	return appRedis.LimitResult{RetryAfter: wait}, nil
+}
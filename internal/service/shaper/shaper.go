@@ -0,0 +1,273 @@
+// Package shaper 供应商调用限速
+// 短信供应商对调用 QPS 有配额，超出后会被供应商限流并白白消耗重试次数。
+// Shaper 按各供应商配置的 qps 与 burst 以令牌桶控制调用速率，令牌不足时调用排队等待，
+// 而不是直接失败；Redis 可用时令牌桶保存在 Redis 中，各实例共享同一配额
+package shaper
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// ErrQueueTimeout 排队时间超过上限
+var ErrQueueTimeout = errors.New("provider shaping queue timeout")
+
+// minPollInterval 两次取令牌之间的最短间隔，避免令牌桶刚好耗尽时频繁访问 Redis
+const minPollInterval = 5 * time.Millisecond
+
+// limit 单个供应商的速率配置
+type limit struct {
+	qps   float64
+	burst int64
+}
+
+// stats 单个供应商的排队统计，depth 为实时排队数，其余字段在每次输出统计日志后清零
+type stats struct {
+	depth atomic.Int64
+
+	mu       sync.Mutex
+	calls    int64
+	queued   int64
+	timeouts int64
+	maxDepth int64
+	total    time.Duration
+	maxWait  time.Duration
+}
+
+// enter 进入排队，返回进入后的排队数
+func (st *stats) enter() int64 {
+	depth := st.depth.Add(1)
+	st.mu.Lock()
+	if depth > st.maxDepth {
+		st.maxDepth = depth
+	}
+	st.mu.Unlock()
+	return depth
+}
+
+// leave 离开排队，记录本次等待时间
+func (st *stats) leave(wait time.Duration, timeout bool) {
+	st.depth.Add(-1)
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.calls++
+	if wait >= minPollInterval {
+		st.queued++
+	}
+	if timeout {
+		st.timeouts++
+	}
+	st.total += wait
+	if wait > st.maxWait {
+		st.maxWait = wait
+	}
+}
+
+// Shaper 供应商调用限速器
+type Shaper struct {
+	bucket    bucket
+	keyPrefix string
+	maxWait   time.Duration
+	interval  time.Duration
+	limits    map[string]limit
+	stats     map[string]*stats
+	logger    appLogger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New 创建供应商调用限速器，只对配置了 qps 的供应商限速
+// client 为 nil 时使用进程内令牌桶，各实例分别按配置的速率限速
+func New(
+	cfg *config.ShapingConfig,
+	providers []config.ProviderConfig,
+	client appRedis.Client,
+	logger appLogger.Logger,
+) *Shaper {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	s := &Shaper{
+		keyPrefix: cfg.KeyPrefix,
+		maxWait:   time.Duration(cfg.MaxWait) * time.Second,
+		interval:  time.Duration(cfg.ReportInterval) * time.Second,
+		limits:    make(map[string]limit),
+		stats:     make(map[string]*stats),
+		logger:    logger,
+	}
+	if client != nil {
+		s.bucket = &redisBucket{limiter: appRedis.NewTokenBucketLimiter(client)}
+	} else {
+		s.bucket = newLocalBucket()
+	}
+	for _, p := range providers {
+		if !p.Enabled || p.QPS <= 0 {
+			continue
+		}
+		burst := p.Burst
+		if burst <= 0 {
+			burst = int64(math.Ceil(p.QPS))
+		}
+		s.limits[p.Name] = limit{qps: p.QPS, burst: burst}
+		s.stats[p.Name] = &stats{}
+	}
+	return s
+}
+
+// Wait 排队等待供应商的调用配额；未限速的供应商立即返回
+// 排队超过 MaxWait 时返回 ErrQueueTimeout，ctx 结束时返回 ctx 的错误；Redis 异常时放行
+func (s *Shaper) Wait(ctx context.Context, name string) error {
+	l, ok := s.limits[name]
+	if !ok {
+		return nil
+	}
+	st := s.stats[name]
+	depth := st.enter()
+	start := time.Now()
+
+	err := s.wait(ctx, name, l)
+	wait := time.Since(start)
+	st.leave(wait, errors.Is(err, ErrQueueTimeout))
+
+	switch {
+	case errors.Is(err, ErrQueueTimeout):
+		s.logger.Warn("供应商限速排队超时",
+			zap.String("provider", name),
+			zap.Int64("queue_depth", depth),
+			zap.Duration("wait", wait),
+		)
+	case err == nil && wait >= minPollInterval:
+		s.logger.Debug("供应商限速排队完成",
+			zap.String("provider", name),
+			zap.Int64("queue_depth", depth),
+			zap.Duration("wait", wait),
+		)
+	}
+	return err
+}
+
+// wait 循环取令牌，令牌不足时按令牌桶给出的等待时间休眠后重试
+func (s *Shaper) wait(ctx context.Context, name string, l limit) error {
+	deadline := time.Now().Add(s.maxWait)
+	for {
+		res, err := s.bucket.Take(ctx, s.keyPrefix+name, l.burst, l.qps)
+		if err != nil {
+			s.logger.Warn("供应商限速令牌获取失败，直接放行", zap.String("provider", name), zap.Error(err))
+			return nil
+		}
+		if res.Allowed {
+			return nil
+		}
+
+		delay := res.RetryAfter
+		if delay < minPollInterval {
+			delay = minPollInterval
+		}
+		// 加入少量随机抖动，避免大量排队的调用在同一时刻争抢令牌
+		//nolint:gosec // 抖动不需要密码学安全的随机数
+		delay += time.Duration(rand.Int64N(int64(delay)/5 + 1))
+		if time.Until(deadline) < delay {
+			return ErrQueueTimeout
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Start 启动排队统计日志的定时输出
+func (s *Shaper) Start() {
+	if len(s.limits) == 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.report(ctx)
+
+	for name, l := range s.limits {
+		s.logger.Info("供应商限速已启用",
+			zap.String("provider", name),
+			zap.Float64("qps", l.qps),
+			zap.Int64("burst", l.burst),
+			zap.Bool("shared", s.isShared()),
+		)
+	}
+}
+
+// Stop 停止排队统计日志的输出
+func (s *Shaper) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+// isShared 令牌桶是否在各实例间共享
+func (s *Shaper) isShared() bool {
+	_, ok := s.bucket.(*redisBucket)
+	return ok
+}
+
+// report 定时输出各供应商的排队深度与等待时间
+func (s *Shaper) report(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for name, st := range s.stats {
+				s.reportOne(name, st)
+			}
+		}
+	}
+}
+
+// reportOne 输出一个供应商的排队统计并清零
+func (s *Shaper) reportOne(name string, st *stats) {
+	depth := st.depth.Load()
+	st.mu.Lock()
+	calls, queued, timeouts, maxDepth, total, maxWait := st.calls, st.queued, st.timeouts, st.maxDepth, st.total, st.maxWait
+	st.calls, st.queued, st.timeouts, st.total, st.maxWait = 0, 0, 0, 0, 0
+	st.maxDepth = depth
+	st.mu.Unlock()
+
+	if calls == 0 && depth == 0 {
+		return
+	}
+	var avgWait time.Duration
+	if calls > 0 {
+		avgWait = total / time.Duration(calls)
+	}
+	s.logger.Info("供应商限速排队统计",
+		zap.String("provider", name),
+		zap.Int64("queue_depth", depth),
+		zap.Int64("max_queue_depth", maxDepth),
+		zap.Int64("calls", calls),
+		zap.Int64("queued", queued),
+		zap.Int64("timeouts", timeouts),
+		zap.Duration("avg_wait", avgWait),
+		zap.Duration("max_wait", maxWait),
+	)
+}
@@ -0,0 +1,85 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/i18n"
+)
+
+// localize 确定通知的语言，按回退链选择模板的语言版本，并按该语言版本的语言格式化参数
+// 请求未指定语言时取接收者资料中的语言，均未设置时使用默认内容
+func (s *service) localize(
+	ctx context.Context,
+	n *domain.Notification,
+	v domain.TemplateVersion,
+) (domain.LocalizedContent, map[string]string, error) {
+	n.Locale = i18n.Normalize(n.Locale)
+	if n.Locale == "" && s.profiles != nil {
+		p, err := s.profiles.Find(ctx, n.TenantID, n.Recipient)
+		if err != nil && !errors.Is(err, domain.ErrRecipientProfileNotFound) {
+			return domain.LocalizedContent{}, nil, err
+		}
+		n.Locale = p.Locale
+	}
+
+	content, params := s.localizeContent(v, n.Locale, n.Template.Params)
+	return content, params, nil
+}
+
+// localizeContent 按语言的回退链选择模板的语言版本，并按该语言版本的语言格式化参数；
+// 使用默认内容时按默认语言格式化
+func (s *service) localizeContent(
+	v domain.TemplateVersion,
+	locale string,
+	params map[string]string,
+) (domain.LocalizedContent, map[string]string) {
+	content := v.Content(s.localizer.Chain(locale))
+	formatLocale := content.Locale
+	if formatLocale == "" {
+		formatLocale = s.localizer.DefaultLocale()
+	}
+	return content, formatParams(v.Variables, params, formatLocale)
+}
+
+// formatParams 按语言格式化数字、日期与日期时间类型的参数，其余参数原样保留；参数已通过类型校验
+func formatParams(variables []domain.TemplateVariable, params map[string]string, locale string) map[string]string {
+	if len(params) == 0 {
+		return params
+	}
+	formatted := make(map[string]string, len(params))
+	for name, value := range params {
+		formatted[name] = value
+	}
+	for _, variable := range variables {
+		value, ok := params[variable.Name]
+		if !ok || value == "" {
+			continue
+		}
+		switch variable.Type {
+		case domain.VariableTypeNumber:
+			formatted[variable.Name] = i18n.FormatNumber(locale, value)
+		case domain.VariableTypeDate:
+			if t, err := time.Parse(time.DateOnly, value); err == nil {
+				formatted[variable.Name] = i18n.FormatDate(locale, t)
+			}
+		case domain.VariableTypeDateTime:
+			if t, err := time.Parse(time.RFC3339, value); err == nil {
+				formatted[variable.Name] = i18n.FormatDateTime(locale, t)
+			}
+		case domain.VariableTypeString, domain.VariableTypeURL:
+		}
+	}
+	return formatted
+}
+
+// normalizeLocales 规范化模板版本中各语言版本的语言标签，非法标签保持原样，由校验拒绝
+func normalizeLocales(v *domain.TemplateVersion) {
+	for i := range v.Locales {
+		if locale := i18n.Normalize(v.Locales[i].Locale); locale != "" {
+			v.Locales[i].Locale = locale
+		}
+	}
+}
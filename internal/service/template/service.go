@@ -0,0 +1,299 @@
+// Package template 消息模板管理
+// 模板保存在 MySQL 中，每个模板有多个不可修改的版本与一个生效版本指针；
+// 受理通知时按生效版本校验模板参数，按接收者的语言选择语言版本并格式化参数，记录使用的版本与语言版本，
+// 邮件模板同时渲染出邮件内容；短信模板的每个新版本创建后提交给供应商审核
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/i18n"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+)
+
+// DefaultPageSize 未指定分页大小时每页返回的条数
+const DefaultPageSize = 50
+
+// Service 模板管理服务接口，模板归属于 context 中已鉴权的租户
+type Service interface {
+	// Create 创建模板及其第一个版本
+	Create(ctx context.Context, t domain.MessageTemplate, v domain.TemplateVersion) (domain.MessageTemplate, domain.TemplateVersion, error)
+	// Get 查询模板及其生效版本
+	Get(ctx context.Context, id string) (domain.MessageTemplate, domain.TemplateVersion, error)
+	// List 按模板 ID 升序查询模板
+	List(ctx context.Context, filter domain.TemplateFilter) ([]domain.MessageTemplate, error)
+	// Update 更新模板名称与说明
+	Update(ctx context.Context, t domain.MessageTemplate) (domain.MessageTemplate, error)
+	// Delete 删除模板及其全部版本；已受理的通知不受影响
+	Delete(ctx context.Context, id string) error
+	// CreateVersion 为模板追加一个版本，activate 为 true 时同时设为生效版本
+	CreateVersion(ctx context.Context, v domain.TemplateVersion, activate bool) (domain.TemplateVersion, error)
+	// ListVersions 查询模板的全部版本
+	ListVersions(ctx context.Context, id string) ([]domain.TemplateVersion, error)
+	// Activate 切换模板的生效版本，对切换后受理的通知生效
+	Activate(ctx context.Context, id string, version int) (domain.MessageTemplate, error)
+	// Preview 按示例参数渲染模板版本，返回接收者将看到的内容；短信同时返回编码与条数，不调用任何供应商
+	// 参数缺失或类型不符时返回 domain.ErrInvalidNotification
+	Preview(ctx context.Context, req domain.TemplatePreviewRequest) (domain.TemplatePreview, error)
+	// Apply 按通知引用的模板校验参数，选择语言版本并记录使用的版本；邮件未指定内容时按模板渲染邮件内容
+	// 模板不存在、渠道不符或参数缺失时返回 domain.ErrInvalidNotification
+	Apply(ctx context.Context, n *domain.Notification) error
+}
+
+// ApprovalSubmitter 供应商模板审核，由模板审核同步器实现
+type ApprovalSubmitter interface {
+	// Submit 将模板版本提交给需要报备模板的供应商审核
+	Submit(ctx context.Context, t domain.MessageTemplate, v domain.TemplateVersion) error
+}
+
+// service 模板管理服务实现
+type service struct {
+	repo      repository.TemplateRepository
+	profiles  repository.RecipientProfileRepository
+	localizer *i18n.Localizer
+	submitter ApprovalSubmitter
+	logger    appLogger.Logger
+}
+
+// NewService 创建模板管理服务
+// profiles 为 nil 时不从接收者资料补全语言；submitter 为 nil 时不提交供应商审核
+func NewService(
+	repo repository.TemplateRepository,
+	profiles repository.RecipientProfileRepository,
+	localizer *i18n.Localizer,
+	submitter ApprovalSubmitter,
+	logger appLogger.Logger,
+) Service {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &service{
+		repo:      repo,
+		profiles:  profiles,
+		localizer: localizer,
+		submitter: submitter,
+		logger:    logger,
+	}
+}
+
+// Create 创建模板
+func (s *service) Create(
+	ctx context.Context,
+	t domain.MessageTemplate,
+	v domain.TemplateVersion,
+) (domain.MessageTemplate, domain.TemplateVersion, error) {
+	t.TenantID = tenantID(ctx)
+	if err := t.Validate(); err != nil {
+		return domain.MessageTemplate{}, domain.TemplateVersion{}, err
+	}
+	normalizeLocales(&v)
+	if err := v.Validate(t.Channel); err != nil {
+		return domain.MessageTemplate{}, domain.TemplateVersion{}, err
+	}
+	created, version, err := s.repo.Create(ctx, t, v)
+	if err != nil {
+		return domain.MessageTemplate{}, domain.TemplateVersion{}, err
+	}
+	s.logger.Info("创建模板",
+		zap.String("tenant_id", created.TenantID),
+		zap.String("template_id", created.ID),
+		zap.String("channel", string(created.Channel)),
+	)
+	s.submitApproval(ctx, created, version)
+	return created, version, nil
+}
+
+// Get 查询模板及其生效版本
+func (s *service) Get(ctx context.Context, id string) (domain.MessageTemplate, domain.TemplateVersion, error) {
+	t, err := s.repo.Find(ctx, tenantID(ctx), id)
+	if err != nil {
+		return domain.MessageTemplate{}, domain.TemplateVersion{}, err
+	}
+	v, err := s.repo.FindVersion(ctx, t.TenantID, t.ID, t.ActiveVersion)
+	if err != nil {
+		return domain.MessageTemplate{}, domain.TemplateVersion{}, err
+	}
+	return t, v, nil
+}
+
+// List 查询模板
+func (s *service) List(ctx context.Context, filter domain.TemplateFilter) ([]domain.MessageTemplate, error) {
+	filter.TenantID = tenantID(ctx)
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultPageSize
+	}
+	return s.repo.List(ctx, filter)
+}
+
+// Update 更新模板名称与说明
+func (s *service) Update(ctx context.Context, t domain.MessageTemplate) (domain.MessageTemplate, error) {
+	if t.Name == "" {
+		return domain.MessageTemplate{}, fmt.Errorf("%w: 模板名称不能为空", domain.ErrInvalidTemplate)
+	}
+	t.TenantID = tenantID(ctx)
+	return s.repo.Update(ctx, t)
+}
+
+// Delete 删除模板
+func (s *service) Delete(ctx context.Context, id string) error {
+	tid := tenantID(ctx)
+	if err := s.repo.Delete(ctx, tid, id); err != nil {
+		return err
+	}
+	s.logger.Info("删除模板", zap.String("tenant_id", tid), zap.String("template_id", id))
+	return nil
+}
+
+// CreateVersion 追加模板版本
+func (s *service) CreateVersion(ctx context.Context, v domain.TemplateVersion, activate bool) (domain.TemplateVersion, error) {
+	v.TenantID = tenantID(ctx)
+	t, err := s.repo.Find(ctx, v.TenantID, v.TemplateID)
+	if err != nil {
+		return domain.TemplateVersion{}, err
+	}
+	normalizeLocales(&v)
+	if err := v.Validate(t.Channel); err != nil {
+		return domain.TemplateVersion{}, err
+	}
+	created, err := s.repo.CreateVersion(ctx, v, activate)
+	if err != nil {
+		return domain.TemplateVersion{}, err
+	}
+	s.logger.Info("创建模板版本",
+		zap.String("tenant_id", created.TenantID),
+		zap.String("template_id", created.TemplateID),
+		zap.Int("version", created.Version),
+		zap.Bool("activated", activate),
+	)
+	s.submitApproval(ctx, t, created)
+	return created, nil
+}
+
+// submitApproval 提交供应商审核；失败只记录日志，可稍后通过重新提交补救，不影响模板版本的创建
+func (s *service) submitApproval(ctx context.Context, t domain.MessageTemplate, v domain.TemplateVersion) {
+	if s.submitter == nil {
+		return
+	}
+	if err := s.submitter.Submit(ctx, t, v); err != nil {
+		s.logger.Error("提交模板审核失败",
+			zap.String("tenant_id", t.TenantID),
+			zap.String("template_id", t.ID),
+			zap.Int("version", v.Version),
+			zap.Error(err),
+		)
+	}
+}
+
+// ListVersions 查询模板的全部版本
+func (s *service) ListVersions(ctx context.Context, id string) ([]domain.TemplateVersion, error) {
+	tid := tenantID(ctx)
+	if _, err := s.repo.Find(ctx, tid, id); err != nil {
+		return nil, err
+	}
+	return s.repo.ListVersions(ctx, tid, id)
+}
+
+// Activate 切换生效版本
+func (s *service) Activate(ctx context.Context, id string, version int) (domain.MessageTemplate, error) {
+	t, err := s.repo.Activate(ctx, tenantID(ctx), id, version)
+	if err != nil {
+		return domain.MessageTemplate{}, err
+	}
+	s.logger.Info("切换模板生效版本",
+		zap.String("tenant_id", t.TenantID),
+		zap.String("template_id", t.ID),
+		zap.Int("version", version),
+	)
+	return t, nil
+}
+
+// Preview 渲染模板版本，与受理通知时使用相同的语言选择与参数格式化规则
+func (s *service) Preview(ctx context.Context, req domain.TemplatePreviewRequest) (domain.TemplatePreview, error) {
+	t, err := s.repo.Find(ctx, tenantID(ctx), req.TemplateID)
+	if err != nil {
+		return domain.TemplatePreview{}, err
+	}
+	version := req.Version
+	if version == 0 {
+		version = t.ActiveVersion
+	}
+	v, err := s.repo.FindVersion(ctx, t.TenantID, t.ID, version)
+	if err != nil {
+		return domain.TemplatePreview{}, err
+	}
+	if err := v.CheckParams(req.Params); err != nil {
+		return domain.TemplatePreview{}, err
+	}
+
+	content, params := s.localizeContent(v, i18n.Normalize(req.Locale), req.Params)
+	preview := domain.TemplatePreview{
+		Version:  v.Version,
+		Locale:   content.Locale,
+		Rendered: content.Render(params),
+	}
+	if t.Channel == domain.ChannelSMS {
+		text := preview.Rendered.Body
+		if req.SignName != "" {
+			text = "【" + req.SignName + "】" + text
+		}
+		stats := domain.AnalyzeSMS(text)
+		preview.SMS = &stats
+	}
+	return preview, nil
+}
+
+// Apply 按生效版本校验通知的模板参数
+func (s *service) Apply(ctx context.Context, n *domain.Notification) error {
+	// 直接指定了邮件内容的通知不经过模板
+	if n.Template.ID == "" || n.Email != nil {
+		return nil
+	}
+	t, err := s.repo.Find(ctx, n.TenantID, n.Template.ID)
+	if errors.Is(err, domain.ErrTemplateNotFound) {
+		return fmt.Errorf("%w: 模板 %s 不存在", domain.ErrInvalidNotification, n.Template.ID)
+	}
+	if err != nil {
+		return err
+	}
+	if t.Channel != n.Channel {
+		return fmt.Errorf("%w: 模板 %s 仅适用于 %s 渠道", domain.ErrInvalidNotification, t.ID, t.Channel)
+	}
+	v, err := s.repo.FindVersion(ctx, t.TenantID, t.ID, t.ActiveVersion)
+	if err != nil {
+		return err
+	}
+	if err := v.CheckParams(n.Template.Params); err != nil {
+		return err
+	}
+	content, params, err := s.localize(ctx, n, v)
+	if err != nil {
+		return err
+	}
+
+	n.Template.Version = v.Version
+	n.Template.Locale = content.Locale
+	switch n.Channel {
+	case domain.ChannelSMS:
+		// 短信由供应商按模板渲染，参数按语言格式化后再交给供应商
+		n.Template.Params = params
+	case domain.ChannelEmail:
+		rendered := content.Render(params)
+		n.Email = &domain.EmailContent{
+			Subject:  rendered.Subject,
+			TextBody: rendered.Body,
+			HTMLBody: rendered.HTMLBody,
+		}
+	}
+	return nil
+}
+
+// tenantID 取出已鉴权的租户 ID；未启用租户鉴权时为空
+func tenantID(ctx context.Context) string {
+	t, _ := domain.TenantFromContext(ctx)
+	return t.ID
+}
@@ -0,0 +1,107 @@
+package tenant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// cache 租户与 API Key 的 Redis 缓存
+// 租户记录以 JSON 保存在 <prefix>id:<租户 ID>，API Key 哈希到租户 ID 的映射保存在 <prefix>apikey:<哈希>；
+// 读写失败只记录日志并回源 MySQL，client 为 nil 时不缓存
+type cache struct {
+	client    appRedis.Client
+	ttl       time.Duration
+	keyPrefix string
+}
+
+// newCache 创建租户缓存
+func newCache(client appRedis.Client, cfg *config.AuthConfig) *cache {
+	return &cache{
+		client:    client,
+		ttl:       time.Duration(cfg.CacheTTL) * time.Second,
+		keyPrefix: cfg.CacheKeyPrefix,
+	}
+}
+
+func (c *cache) tenantKey(id string) string {
+	return c.keyPrefix + "id:" + id
+}
+
+func (c *cache) apiKeyKey(hash string) string {
+	return c.keyPrefix + "apikey:" + hash
+}
+
+// getTenant 读取缓存的租户
+func (c *cache) getTenant(ctx context.Context, id string, logger appLogger.Logger) (domain.Tenant, bool) {
+	val, ok := c.get(ctx, c.tenantKey(id), logger)
+	if !ok {
+		return domain.Tenant{}, false
+	}
+	var t domain.Tenant
+	if err := json.Unmarshal([]byte(val), &t); err != nil {
+		logger.Warn("解析租户缓存失败", zap.String("tenant_id", id), zap.Error(err))
+		return domain.Tenant{}, false
+	}
+	return t, true
+}
+
+// setTenant 缓存租户；回调签名密钥不参与序列化，不写入 Redis
+func (c *cache) setTenant(ctx context.Context, t domain.Tenant, logger appLogger.Logger) {
+	val, err := json.Marshal(t)
+	if err != nil {
+		logger.Warn("序列化租户缓存失败", zap.String("tenant_id", t.ID), zap.Error(err))
+		return
+	}
+	c.set(ctx, c.tenantKey(t.ID), string(val), logger)
+}
+
+// getAPIKey 读取 API Key 哈希对应的租户 ID
+func (c *cache) getAPIKey(ctx context.Context, hash string, logger appLogger.Logger) (string, bool) {
+	return c.get(ctx, c.apiKeyKey(hash), logger)
+}
+
+// setAPIKey 缓存 API Key 哈希对应的租户 ID
+func (c *cache) setAPIKey(ctx context.Context, hash, tenantID string, logger appLogger.Logger) {
+	c.set(ctx, c.apiKeyKey(hash), tenantID, logger)
+}
+
+// del 删除缓存
+func (c *cache) del(ctx context.Context, key string) error {
+	if c.client == nil {
+		return nil
+	}
+	_, err := c.client.Del(ctx, key)
+	return err
+}
+
+func (c *cache) get(ctx context.Context, key string, logger appLogger.Logger) (string, bool) {
+	if c.client == nil {
+		return "", false
+	}
+	val, err := c.client.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			logger.Warn("读取租户缓存失败", zap.String("key", key), zap.Error(err))
+		}
+		return "", false
+	}
+	return val, true
+}
+
+func (c *cache) set(ctx context.Context, key, val string, logger appLogger.Logger) {
+	if c.client == nil {
+		return
+	}
+	if err := c.client.Set(ctx, key, val, c.ttl); err != nil {
+		logger.Warn("写入租户缓存失败", zap.String("key", key), zap.Error(err))
+	}
+}
@@ -0,0 +1,267 @@
+// Package tenant 租户（业务方）接入管理与鉴权
+// 管理员为业务方创建租户并签发 API Key，平台只保存 API Key 的 SHA-256 哈希；
+// 业务方调用时携带 API Key，由 Authenticate 解析出租户，租户与 API Key 缓存在 Redis 中
+package tenant
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	// apiKeyPrefix API Key 明文的固定前缀，便于在日志与代码仓库中识别泄露的密钥
+	apiKeyPrefix = "dd_"
+	// apiKeyRandomBytes API Key 随机部分的字节数
+	apiKeyRandomBytes = 32
+	// apiKeyDisplayLen 保存的明文前缀长度，仅用于辨认
+	apiKeyDisplayLen = len(apiKeyPrefix) + 8
+	// callbackSecretPrefix 回调签名密钥的固定前缀
+	callbackSecretPrefix = "whsec_"
+)
+
+// Service 租户管理与鉴权服务接口
+type Service interface {
+	// Create 创建租户
+	Create(ctx context.Context, t domain.Tenant) (domain.Tenant, error)
+	// Update 更新租户，并使缓存失效
+	Update(ctx context.Context, t domain.Tenant) (domain.Tenant, error)
+	// Get 查询租户，直接读取 MySQL，返回的租户包含回调签名密钥
+	Get(ctx context.Context, id string) (domain.Tenant, error)
+	// List 查询全部租户
+	List(ctx context.Context) ([]domain.Tenant, error)
+	// CreateAPIKey 为租户签发 API Key，明文只在此时返回一次
+	CreateAPIKey(ctx context.Context, tenantID, name string) (domain.APIKey, string, error)
+	// ListAPIKeys 查询租户的 API Key
+	ListAPIKeys(ctx context.Context, tenantID string) ([]domain.APIKey, error)
+	// RevokeAPIKey 吊销 API Key，并使缓存失效
+	RevokeAPIKey(ctx context.Context, tenantID string, id uint64) (domain.APIKey, error)
+	// RotateCallbackSecret 为租户生成新的回调签名密钥并立即替换旧密钥，明文只在此时返回一次
+	RotateCallbackSecret(ctx context.Context, tenantID string) (domain.Tenant, string, error)
+	// Authenticate 按 API Key 明文解析租户；凭证无效时返回 domain.ErrUnauthenticated，
+	// 租户已停用时返回 domain.ErrTenantDisabled；租户可能读自缓存，不包含回调签名密钥
+	Authenticate(ctx context.Context, apiKey string) (domain.Tenant, error)
+}
+
+// service 租户服务实现
+type service struct {
+	repo   repository.TenantRepository
+	cache  *cache
+	logger appLogger.Logger
+}
+
+// NewService 创建租户服务
+// client 为 nil 时（如未启用 Redis）不缓存，每次鉴权都查询 MySQL
+func NewService(
+	repo repository.TenantRepository,
+	client appRedis.Client,
+	cfg *config.AuthConfig,
+	logger appLogger.Logger,
+) Service {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &service{
+		repo:   repo,
+		cache:  newCache(client, cfg),
+		logger: logger,
+	}
+}
+
+// Create 创建租户
+func (s *service) Create(ctx context.Context, t domain.Tenant) (domain.Tenant, error) {
+	if err := t.Validate(); err != nil {
+		return domain.Tenant{}, err
+	}
+	created, err := s.repo.Create(ctx, t)
+	if err != nil {
+		return domain.Tenant{}, err
+	}
+	s.logger.Info("创建租户", zap.String("tenant_id", created.ID), zap.String("name", created.Name))
+	return created, nil
+}
+
+// Update 更新租户；更新后立即删除缓存，停用、渠道与配额的变更对后续请求即时生效
+func (s *service) Update(ctx context.Context, t domain.Tenant) (domain.Tenant, error) {
+	if err := t.Validate(); err != nil {
+		return domain.Tenant{}, err
+	}
+	updated, err := s.repo.Update(ctx, t)
+	if err != nil {
+		return domain.Tenant{}, err
+	}
+	s.invalidate(ctx, s.cache.tenantKey(updated.ID))
+	s.logger.Info("更新租户",
+		zap.String("tenant_id", updated.ID),
+		zap.Bool("enabled", updated.Enabled),
+		zap.Int64("daily_quota", updated.DailyQuota),
+		zap.Int64("monthly_quota", updated.MonthlyQuota),
+	)
+	return updated, nil
+}
+
+// Get 查询租户
+func (s *service) Get(ctx context.Context, id string) (domain.Tenant, error) {
+	return s.repo.FindByID(ctx, id)
+}
+
+// List 查询全部租户
+func (s *service) List(ctx context.Context) ([]domain.Tenant, error) {
+	return s.repo.List(ctx)
+}
+
+// CreateAPIKey 签发 API Key
+func (s *service) CreateAPIKey(ctx context.Context, tenantID, name string) (domain.APIKey, string, error) {
+	if _, err := s.repo.FindByID(ctx, tenantID); err != nil {
+		return domain.APIKey{}, "", err
+	}
+	plaintext, err := generateAPIKey()
+	if err != nil {
+		return domain.APIKey{}, "", err
+	}
+	key, err := s.repo.CreateAPIKey(ctx, domain.APIKey{
+		TenantID: tenantID,
+		Name:     name,
+		Prefix:   plaintext[:apiKeyDisplayLen],
+		Hash:     hashAPIKey(plaintext),
+	})
+	if err != nil {
+		return domain.APIKey{}, "", err
+	}
+	s.logger.Info("签发 API Key",
+		zap.String("tenant_id", tenantID),
+		zap.Uint64("api_key_id", key.ID),
+		zap.String("prefix", key.Prefix),
+	)
+	return key, plaintext, nil
+}
+
+// ListAPIKeys 查询租户的 API Key
+func (s *service) ListAPIKeys(ctx context.Context, tenantID string) ([]domain.APIKey, error) {
+	if _, err := s.repo.FindByID(ctx, tenantID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListAPIKeys(ctx, tenantID)
+}
+
+// RevokeAPIKey 吊销 API Key；吊销后立即删除缓存，该 API Key 对后续请求即时失效
+func (s *service) RevokeAPIKey(ctx context.Context, tenantID string, id uint64) (domain.APIKey, error) {
+	key, err := s.repo.RevokeAPIKey(ctx, tenantID, id)
+	if err != nil {
+		return domain.APIKey{}, err
+	}
+	s.invalidate(ctx, s.cache.apiKeyKey(key.Hash))
+	s.logger.Info("吊销 API Key",
+		zap.String("tenant_id", tenantID),
+		zap.Uint64("api_key_id", key.ID),
+		zap.String("prefix", key.Prefix),
+	)
+	return key, nil
+}
+
+// RotateCallbackSecret 生成新的回调签名密钥；替换后立即删除缓存，此后投递的回调使用新密钥签名
+func (s *service) RotateCallbackSecret(ctx context.Context, tenantID string) (domain.Tenant, string, error) {
+	secret, err := generateSecret(callbackSecretPrefix)
+	if err != nil {
+		return domain.Tenant{}, "", err
+	}
+	t, err := s.repo.SetCallbackSecret(ctx, tenantID, secret)
+	if err != nil {
+		return domain.Tenant{}, "", err
+	}
+	s.invalidate(ctx, s.cache.tenantKey(t.ID))
+	s.logger.Info("更换回调签名密钥", zap.String("tenant_id", t.ID))
+	return t, secret, nil
+}
+
+// Authenticate 按 API Key 解析租户，优先读取缓存
+func (s *service) Authenticate(ctx context.Context, apiKey string) (domain.Tenant, error) {
+	if !strings.HasPrefix(apiKey, apiKeyPrefix) {
+		return domain.Tenant{}, domain.ErrUnauthenticated
+	}
+	hash := hashAPIKey(apiKey)
+
+	tenantID, err := s.resolveAPIKey(ctx, hash)
+	if err != nil {
+		return domain.Tenant{}, err
+	}
+	t, err := s.loadTenant(ctx, tenantID)
+	if err != nil {
+		return domain.Tenant{}, err
+	}
+	if !t.Enabled {
+		return domain.Tenant{}, domain.ErrTenantDisabled
+	}
+	return t, nil
+}
+
+// resolveAPIKey 按 API Key 哈希查询所属租户 ID
+func (s *service) resolveAPIKey(ctx context.Context, hash string) (string, error) {
+	if tenantID, ok := s.cache.getAPIKey(ctx, hash, s.logger); ok {
+		return tenantID, nil
+	}
+	key, err := s.repo.FindAPIKeyByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			return "", domain.ErrUnauthenticated
+		}
+		return "", err
+	}
+	s.cache.setAPIKey(ctx, hash, key.TenantID, s.logger)
+	return key.TenantID, nil
+}
+
+// loadTenant 查询租户
+func (s *service) loadTenant(ctx context.Context, id string) (domain.Tenant, error) {
+	if t, ok := s.cache.getTenant(ctx, id, s.logger); ok {
+		return t, nil
+	}
+	t, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrTenantNotFound) {
+			return domain.Tenant{}, domain.ErrUnauthenticated
+		}
+		return domain.Tenant{}, err
+	}
+	s.cache.setTenant(ctx, t, s.logger)
+	return t, nil
+}
+
+// invalidate 删除缓存；删除失败时缓存最迟在过期后失效
+func (s *service) invalidate(ctx context.Context, key string) {
+	if err := s.cache.del(ctx, key); err != nil {
+		s.logger.Warn("删除租户缓存失败", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// generateAPIKey 生成 API Key 明文
+func generateAPIKey() (string, error) {
+	return generateSecret(apiKeyPrefix)
+}
+
+// generateSecret 生成带固定前缀的随机密钥
+func generateSecret(prefix string) (string, error) {
+	b := make([]byte, apiKeyRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashAPIKey 计算 API Key 的 SHA-256 哈希；API Key 本身是高熵随机串，无需加盐与慢哈希
+func hashAPIKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
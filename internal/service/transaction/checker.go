@@ -0,0 +1,242 @@
+package transaction
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	"github.com/dingdong-postman/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Resolver 按回查结果提交或取消两阶段通知，由通知服务实现
+type Resolver interface {
+	// Commit 提交两阶段通知
+	Commit(ctx context.Context, id uint64) (domain.Notification, error)
+	// CancelPrepared 取消两阶段通知
+	CancelPrepared(ctx context.Context, id uint64) (domain.Notification, error)
+}
+
+// Tenants 查询租户的回查地址，由租户服务实现
+type Tenants interface {
+	// Get 查询租户
+	Get(ctx context.Context, id string) (domain.Tenant, error)
+}
+
+// Checker 回查超时未结束的两阶段通知
+type Checker struct {
+	cfg      *config.TransactionConfig
+	repo     repository.PreparedRepository
+	tenants  Tenants
+	resolver Resolver
+	logger   appLogger.Logger
+
+	// conns 按回查地址复用的 gRPC 连接
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewChecker 创建两阶段通知回查器
+// tenants 为 nil 时（如未启用租户鉴权）统一使用默认回查地址，提交与取消不带租户身份
+func NewChecker(
+	cfg *config.TransactionConfig,
+	repo repository.PreparedRepository,
+	tenants Tenants,
+	resolver Resolver,
+	logger appLogger.Logger,
+) *Checker {
+	if logger == nil {
+		logger = appLogger.GetGlobal()
+	}
+	return &Checker{
+		cfg:      cfg,
+		repo:     repo,
+		tenants:  tenants,
+		resolver: resolver,
+		logger:   logger,
+		conns:    make(map[string]*grpc.ClientConn),
+	}
+}
+
+// Start 启动回查协程
+func (c *Checker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+
+	c.wg.Add(1)
+	go c.loop(ctx)
+
+	c.logger.Info("两阶段通知回查器启动",
+		zap.Int("check_timeout", c.cfg.CheckTimeout),
+		zap.Int("check_interval", c.cfg.CheckInterval),
+		zap.Int("max_checks", c.cfg.MaxChecks),
+		zap.String("default_endpoint", c.cfg.CheckEndpoint),
+	)
+}
+
+// Stop 停止回查器，等待正在进行的回查完成后关闭连接
+func (c *Checker) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for endpoint, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			c.logger.Warn("关闭回查连接失败", zap.String("endpoint", endpoint), zap.Error(err))
+		}
+	}
+	c.conns = make(map[string]*grpc.ClientConn)
+	c.logger.Info("两阶段通知回查器已停止")
+}
+
+// loop 按扫描间隔领取到期的回查计划
+func (c *Checker) loop(ctx context.Context) {
+	defer c.wg.Done()
+	ticker := time.NewTicker(time.Duration(c.cfg.ScanInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.checkOnce(ctx)
+		}
+	}
+}
+
+// checkOnce 领取到期的回查计划并逐个回查；领取时已将下次回查时间推迟 check_interval，
+// 回查失败或状态未知的通知到时再次回查
+func (c *Checker) checkOnce(ctx context.Context) {
+	interval := time.Duration(c.cfg.CheckInterval) * time.Second
+	checks, err := c.repo.ClaimDue(ctx, time.Now(), c.cfg.BatchSize, interval)
+	if err != nil {
+		c.logger.Error("领取两阶段通知回查计划失败", zap.Error(err))
+		return
+	}
+	for i := range checks {
+		if ctx.Err() != nil {
+			return
+		}
+		// 回查与提交不受回查器停止影响，避免回查结果已确定而未生效
+		c.check(context.WithoutCancel(ctx), checks[i])
+	}
+}
+
+// check 回查一条两阶段通知并按结果提交或取消
+func (c *Checker) check(ctx context.Context, pc domain.PreparedCheck) {
+	fields := []zap.Field{
+		zap.Uint64("notification_id", pc.NotificationID),
+		zap.String("tenant_id", pc.TenantID),
+		zap.String("biz_key", pc.BizKey),
+		zap.Int("checks", pc.Checks),
+	}
+
+	endpoint := c.cfg.CheckEndpoint
+	if pc.TenantID != "" && c.tenants != nil {
+		t, err := c.tenants.Get(ctx, pc.TenantID)
+		if err != nil {
+			c.logger.Error("查询两阶段通知所属租户失败，稍后再次回查", append(fields, zap.Error(err))...)
+			return
+		}
+		ctx = domain.WithTenant(ctx, t)
+		if t.CheckEndpoint != "" {
+			endpoint = t.CheckEndpoint
+		}
+	}
+
+	state := domain.TransactionUnknown
+	if endpoint == "" {
+		c.logger.Warn("未配置回查地址，无法回查两阶段通知", fields...)
+	} else {
+		var err error
+		if state, err = c.query(ctx, endpoint, pc); err != nil {
+			c.logger.Warn("回查两阶段通知失败", append(fields, zap.String("endpoint", endpoint), zap.Error(err))...)
+		}
+	}
+
+	var err error
+	switch {
+	case state == domain.TransactionCommitted:
+		_, err = c.resolver.Commit(ctx, pc.NotificationID)
+	case state == domain.TransactionRolledBack:
+		_, err = c.resolver.CancelPrepared(ctx, pc.NotificationID)
+	case pc.Checks >= c.cfg.MaxChecks:
+		c.logger.Warn("两阶段通知回查次数已用完，取消通知", fields...)
+		_, err = c.resolver.CancelPrepared(ctx, pc.NotificationID)
+	default:
+		c.logger.Info("两阶段通知的事务状态未知，稍后再次回查", fields...)
+		return
+	}
+	if err != nil {
+		// 回查期间调用方已自行提交或取消时返回 ErrNotPrepared，回查计划已随之删除
+		c.logger.Warn("按回查结果结束两阶段通知失败", append(fields, zap.Stringer("state", state), zap.Error(err))...)
+		return
+	}
+	c.logger.Info("已按回查结果结束两阶段通知", append(fields, zap.Stringer("state", state))...)
+}
+
+// query 调用回查地址上的 TransactionCheckService
+func (c *Checker) query(ctx context.Context, endpoint string, pc domain.PreparedCheck) (domain.TransactionState, error) {
+	conn, err := c.conn(endpoint)
+	if err != nil {
+		return domain.TransactionUnknown, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(c.cfg.CallTimeout)*time.Millisecond)
+	defer cancel()
+
+	client := notificationv1.NewTransactionCheckServiceClient(conn)
+	resp, err := client.CheckTransaction(ctx, &notificationv1.CheckTransactionRequest{
+		NotificationId: pc.NotificationID,
+		TenantId:       pc.TenantID,
+		BizKey:         pc.BizKey,
+		CheckCount:     int32(pc.Checks), //nolint:gosec // 回查次数不超过 max_checks
+		PreparedAt:     timestamppb.New(pc.PreparedAt),
+	})
+	if err != nil {
+		return domain.TransactionUnknown, fmt.Errorf("check transaction: %w", err)
+	}
+	switch resp.GetState() {
+	case notificationv1.TransactionState_TRANSACTION_STATE_COMMITTED:
+		return domain.TransactionCommitted, nil
+	case notificationv1.TransactionState_TRANSACTION_STATE_ROLLED_BACK:
+		return domain.TransactionRolledBack, nil
+	default:
+		return domain.TransactionUnknown, nil
+	}
+}
+
+// conn 返回回查地址的 gRPC 连接，首次使用时创建；连接按需建立，地址不可达时在调用时报错
+func (c *Checker) conn(endpoint string) (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if conn, ok := c.conns[endpoint]; ok {
+		return conn, nil
+	}
+	creds := insecure.NewCredentials()
+	if c.cfg.TLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", endpoint, err)
+	}
+	c.conns[endpoint] = conn
+	return conn, nil
+}
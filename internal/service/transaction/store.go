@@ -0,0 +1,44 @@
+// Package transaction 两阶段通知
+// 调用方在本地事务前预提交通知，通知与回查计划在同一事务中落库为 PREPARED 状态，提交前不会发送；
+// 调用方事务提交后提交通知、回滚后取消通知，提交与取消通过条件更新互斥。
+// 预提交超过 check_timeout 仍未结束的通知，由回查器通过 gRPC 回查调用方实现的 TransactionCheckService，
+// 按事务状态提交或取消；回查 max_checks 次仍无法确定时取消通知，避免通知长期悬挂
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	"github.com/dingdong-postman/internal/repository"
+)
+
+// Store 两阶段通知存储，预提交时按回查超时登记首次回查时间
+type Store struct {
+	cfg  *config.TransactionConfig
+	repo repository.PreparedRepository
+}
+
+// NewStore 创建两阶段通知存储
+func NewStore(cfg *config.TransactionConfig, repo repository.PreparedRepository) *Store {
+	return &Store{
+		cfg:  cfg,
+		repo: repo,
+	}
+}
+
+// Prepare 保存预提交的通知，check_timeout 后仍未结束时开始回查
+func (s *Store) Prepare(ctx context.Context, n domain.Notification) (domain.Notification, error) {
+	return s.repo.Prepare(ctx, n, time.Now().Add(time.Duration(s.cfg.CheckTimeout)*time.Second))
+}
+
+// Commit 将预提交的通知改为 to 状态（待发送或定时），返回是否成功；通知不处于预提交状态时返回 false
+func (s *Store) Commit(ctx context.Context, id uint64, to domain.SendStatus) (bool, error) {
+	return s.repo.Commit(ctx, id, to)
+}
+
+// Cancel 取消预提交的通知，返回是否成功；通知不处于预提交状态时返回 false
+func (s *Store) Cancel(ctx context.Context, id uint64) (bool, error) {
+	return s.repo.Cancel(ctx, id)
+}
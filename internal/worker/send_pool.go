@@ -0,0 +1,184 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dingdong-postman/internal/domain"
+	"github.com/dingdong-postman/internal/pkg/config"
+	appLogger "github.com/dingdong-postman/internal/pkg/logger"
+	appMySQL "github.com/dingdong-postman/internal/pkg/mysql"
+	"github.com/dingdong-postman/internal/repository"
+	"github.com/dingdong-postman/internal/service/retry"
+	"go.uber.org/zap"
+)
+
+// SendPool 异步发送工作池
+// 异步受理的通知先落库为待发送状态，再由工作池在后台投递：
+//   - Submit 将刚受理的通知放入内存队列，尽快投递
+//   - 后台轮询数据库中的待发送通知，兜底处理队列已满或进程重启遗留的通知
+//   - 轮询时一并回收发送中超过租约仍未回写结果的通知（如进程在发送途中退出），改回待发送或重试中重新投递
+//
+// 投递前通过条件更新抢占通知，保证同一条通知只会被一个 worker 发送
+type SendPool struct {
+	cfg    *config.WorkerConfig
+	repo   repository.NotificationRepository
+	engine *retry.Engine
+	logger appLogger.Logger
+
+	tasks chan domain.Notification
+	// queued 已在队列中等待投递的通知 ID，轮询时跳过，避免同一通知重复入队
+	queuedMu sync.Mutex
+	queued   map[uint64]struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewSendPool 创建异步发送工作池，数据库连接与日志分别取自全局 MySQL 与全局 Logger
+func NewSendPool(cfg *config.WorkerConfig, engine *retry.Engine) *SendPool {
+	return &SendPool{
+		cfg:    cfg,
+		repo:   repository.NewNotificationRepository(appMySQL.MustGetGlobal()),
+		engine: engine,
+		logger: appLogger.GetGlobal(),
+		tasks:  make(chan domain.Notification, cfg.QueueSize),
+		queued: make(map[uint64]struct{}),
+	}
+}
+
+// Start 启动 worker 与轮询协程
+func (p *SendPool) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	for i := 0; i < p.cfg.Concurrency; i++ {
+		p.wg.Add(1)
+		go p.work(ctx)
+	}
+	p.wg.Add(1)
+	go p.poll(ctx)
+
+	p.logger.Info("异步发送工作池启动",
+		zap.Int("concurrency", p.cfg.Concurrency),
+		zap.Int("queue_size", p.cfg.QueueSize),
+	)
+}
+
+// Stop 停止工作池并等待正在投递的通知完成；队列中尚未投递的通知仍为待发送状态，重启后由轮询继续处理
+func (p *SendPool) Stop() {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.wg.Wait()
+	p.logger.Info("异步发送工作池已停止")
+}
+
+// Submit 提交一条待发送的通知；队列已满时返回 false，通知会在下一次轮询时被投递
+func (p *SendPool) Submit(n domain.Notification) bool {
+	if !p.enqueue(n) {
+		p.logger.Warn("异步发送队列已满，等待轮询投递", zap.Uint64("notification_id", n.ID))
+		return false
+	}
+	return true
+}
+
+// enqueue 不阻塞地将通知放入队列；通知已在队列中时视为成功，队列已满时返回 false
+func (p *SendPool) enqueue(n domain.Notification) bool {
+	p.queuedMu.Lock()
+	defer p.queuedMu.Unlock()
+	if _, ok := p.queued[n.ID]; ok {
+		return true
+	}
+	select {
+	case p.tasks <- n:
+		p.queued[n.ID] = struct{}{}
+		return true
+	default:
+		return false
+	}
+}
+
+// dequeued 通知已从队列取出
+func (p *SendPool) dequeued(id uint64) {
+	p.queuedMu.Lock()
+	delete(p.queued, id)
+	p.queuedMu.Unlock()
+}
+
+// work 从队列中取出通知并投递
+func (p *SendPool) work(ctx context.Context) {
+	defer p.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-p.tasks:
+			p.dequeued(n.ID)
+			// 投递过程不受工作池停止影响，避免发送到一半被取消
+			p.deliver(context.WithoutCancel(ctx), n)
+		}
+	}
+}
+
+// poll 定时回收超时的发送中通知，并扫描数据库中的待发送通知放入队列
+func (p *SendPool) poll(ctx context.Context) {
+	defer p.wg.Done()
+	ticker := time.NewTicker(time.Duration(p.cfg.PollInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.reclaim(ctx)
+			p.pollOnce(ctx)
+		}
+	}
+}
+
+// reclaim 将发送中超过租约仍未回写结果的通知改回待发送或重试中
+func (p *SendPool) reclaim(ctx context.Context) {
+	before := time.Now().Add(-time.Duration(p.cfg.SendingLease) * time.Second)
+	n, err := p.repo.ReclaimSending(ctx, before, p.cfg.PollBatchSize)
+	if err != nil {
+		p.logger.Error("回收超时的发送中通知失败", zap.Error(err))
+		return
+	}
+	if n > 0 {
+		p.logger.Warn("发送中的通知超时未回写结果，已改回重新投递", zap.Int("count", n))
+	}
+}
+
+// pollOnce 将待发送的通知放入队列；已在队列中的跳过，队列已满时留待下一次轮询
+func (p *SendPool) pollOnce(ctx context.Context) {
+	ns, err := p.repo.FindPending(ctx, p.cfg.PollBatchSize)
+	if err != nil {
+		p.logger.Error("轮询待发送通知失败", zap.Error(err))
+		return
+	}
+	for i := range ns {
+		if !p.enqueue(ns[i]) {
+			return
+		}
+	}
+}
+
+// deliver 抢占并投递一条通知，发送与结果回写交给重试引擎
+func (p *SendPool) deliver(ctx context.Context, n domain.Notification) {
+	claimed, err := p.repo.ClaimPending(ctx, n.ID)
+	if err != nil {
+		p.logger.Error("抢占待发送通知失败", zap.Uint64("notification_id", n.ID), zap.Error(err))
+		return
+	}
+	if !claimed {
+		// 已被其他 worker 或实例处理
+		return
+	}
+
+	if _, err := p.engine.Deliver(ctx, n, 1); err != nil {
+		p.logger.Error("回写异步通知发送结果失败", zap.Uint64("notification_id", n.ID), zap.Error(err))
+	}
+}
@@ -1,16 +1,51 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"os"
-	"time"
+	"os/signal"
+	"syscall"
 
+	// 内嵌 IANA 时区数据，发送时段规则按接收者时区判断，不依赖运行环境安装 tzdata
+	_ "time/tzdata"
+
+	adminv1 "github.com/dingdong-postman/api/proto/gen/admin/v1"
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	recipientv1 "github.com/dingdong-postman/api/proto/gen/recipient/v1"
+	templatev1 "github.com/dingdong-postman/api/proto/gen/template/v1"
+	appGRPC "github.com/dingdong-postman/internal/api/grpc"
+	appKafka "github.com/dingdong-postman/internal/api/kafka"
+	"github.com/dingdong-postman/internal/api/webhook"
 	appConfig "github.com/dingdong-postman/internal/pkg/config"
+	"github.com/dingdong-postman/internal/pkg/grpcx"
+	"github.com/dingdong-postman/internal/pkg/i18n"
 	appLogger "github.com/dingdong-postman/internal/pkg/logger"
 	appMySQL "github.com/dingdong-postman/internal/pkg/mysql"
 	appRedis "github.com/dingdong-postman/internal/pkg/redis"
+	"github.com/dingdong-postman/internal/provider"
+	"github.com/dingdong-postman/internal/repository"
+	"github.com/dingdong-postman/internal/service/approval"
+	"github.com/dingdong-postman/internal/service/callback"
+	"github.com/dingdong-postman/internal/service/deadletter"
+	"github.com/dingdong-postman/internal/service/dlr"
+	"github.com/dingdong-postman/internal/service/health"
+	"github.com/dingdong-postman/internal/service/idempotent"
+	"github.com/dingdong-postman/internal/service/notification"
+	"github.com/dingdong-postman/internal/service/outbox"
+	"github.com/dingdong-postman/internal/service/quiethours"
+	"github.com/dingdong-postman/internal/service/ratelimit"
+	"github.com/dingdong-postman/internal/service/recipient"
+	"github.com/dingdong-postman/internal/service/retry"
+	"github.com/dingdong-postman/internal/service/router"
+	"github.com/dingdong-postman/internal/service/schedule"
+	"github.com/dingdong-postman/internal/service/sender"
+	"github.com/dingdong-postman/internal/service/shaper"
+	"github.com/dingdong-postman/internal/service/template"
+	"github.com/dingdong-postman/internal/service/tenant"
+	"github.com/dingdong-postman/internal/service/transaction"
+	"github.com/dingdong-postman/internal/worker"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -47,49 +82,216 @@ func main() {
 		}
 	}
 
-	// 4) 初始化全局 MySQL 数据库连接
-	if cfg.MySQL.Enabled {
-		if err := appMySQL.InitGlobal(&cfg.MySQL); err != nil {
-			log.Warn("初始化 MySQL 失败", zap.Error(err))
-		} else {
-			defer func() {
-				_ = appMySQL.Close()
-			}()
-			log.Info("MySQL 初始化成功", zap.String("host", cfg.MySQL.Host), zap.Int("port", cfg.MySQL.Port), zap.String("database", cfg.MySQL.Database))
-		}
+	// 4) 初始化全局 MySQL 数据库连接（通知记录依赖 MySQL，初始化失败直接退出）
+	if !cfg.MySQL.Enabled {
+		log.Fatal("通知服务依赖 MySQL，请启用 mysql 配置")
+	}
+	if err := appMySQL.InitGlobal(&cfg.MySQL); err != nil {
+		log.Fatal("初始化 MySQL 失败", zap.Error(err))
+	}
+	defer func() {
+		_ = appMySQL.Close()
+	}()
+	log.Info("MySQL 初始化成功", zap.String("host", cfg.MySQL.Host), zap.Int("port", cfg.MySQL.Port), zap.String("database", cfg.MySQL.Database))
+
+	db := appMySQL.GetGlobal()
+	if err := repository.InitTables(db); err != nil {
+		log.Fatal("初始化数据表失败", zap.Error(err))
 	}
 
 	fmt.Printf("App: %s | Env: %s | Version: %s\n", cfg.App.Name, cfg.App.Env, cfg.App.Version)
 
-	// 5) Redis 使用示例（如果已初始化）
-	const timeout = 60 * time.Second
-	if cfg.Redis.Enabled {
-		err = appRedis.GetGlobal().Set(context.Background(), "test_key", "test_value", timeout)
-		if err != nil {
-			log.Error("Redis Set 失败", zap.Error(err))
-		} else {
-			log.Info("Redis Set 成功", zap.String("key", "test_key"), zap.String("value", "test_value"))
-		}
+	// 5) 按 providers 配置创建渠道供应商
+	registry, err := provider.NewRegistryFromConfig(cfg.Providers, log)
+	if err != nil {
+		log.Fatal("初始化渠道供应商失败", zap.Error(err))
+	}
+	defer func() {
+		_ = registry.Close()
+	}()
 
-		// 获取 Redis 值
-		getValue, err := appRedis.GetGlobal().Get(context.Background(), "test_key")
-		if err != nil {
-			log.Error("Redis Get 失败", zap.Error(err))
-		} else {
-			log.Info("Redis Get 成功", zap.String("key", "test_key"), zap.String("value", getValue))
+	// 6) 组装通知服务并注册到 gRPC
+	notificationRepo := repository.NewNotificationRepository(db)
+
+	// 通知状态变更与事件在同一事务中写入发件箱，启用 Kafka 时按顺序发布到状态事件 topic，否则到期删除
+	var statusPublisher outbox.Publisher
+	if cfg.Kafka.Enabled && cfg.Kafka.StatusTopic != "" {
+		publisher := appKafka.NewStatusPublisher(&cfg.Kafka)
+		defer func() {
+			_ = publisher.Close()
+		}()
+		statusPublisher = publisher
+	}
+	statusRelay := outbox.NewRelay(&cfg.StatusEvent, repository.NewStatusEventRepository(db), statusPublisher, log)
+	statusRelay.Start()
+	defer statusRelay.Stop()
+
+	// Redis 可用时在各实例间共享供应商健康度，否则所有供应商视为健康、仅按优先级兜底
+	var tracker health.Tracker
+	if redisClient := appRedis.GetGlobal(); redisClient != nil {
+		tracker = health.NewRedisTracker(redisClient, &cfg.Failover)
+	}
+	providerRouter, err := router.New(&cfg.Routing, cfg.Providers, registry, log)
+	if err != nil {
+		log.Fatal("初始化供应商路由失败", zap.Error(err))
+	}
+	// Redis 可用且启用限流时按限流规则在各实例间共享计数，否则不限流
+	var limiter ratelimit.Limiter
+	if redisClient := appRedis.GetGlobal(); redisClient != nil && cfg.RateLimit.Enabled {
+		limiter = ratelimit.New(redisClient, &cfg.RateLimit, log)
+	}
+	// 按供应商的 QPS 配额限速，Redis 可用时各实例共享配额
+	providerShaper := shaper.New(&cfg.Shaping, cfg.Providers, appRedis.GetGlobal(), log)
+	providerShaper.Start()
+	defer providerShaper.Stop()
+
+	// 启用模板审核同步时，短信模板的每个版本提交给需要报备模板的供应商，审核通过前不路由到该供应商
+	templateRepo := repository.NewTemplateRepository(db)
+	var (
+		approvalSyncer *approval.Syncer
+		submitter      template.ApprovalSubmitter
+		templateGate   sender.TemplateGate
+	)
+	if cfg.TemplateApproval.Enabled {
+		approvalSyncer = approval.NewSyncer(
+			&cfg.TemplateApproval, repository.NewTemplateApprovalRepository(db), templateRepo, registry, log,
+		)
+		approvalSyncer.Start()
+		defer approvalSyncer.Stop()
+		submitter, templateGate = approvalSyncer, approvalSyncer
+	}
+
+	notificationSender := sender.NewProviderSender(
+		providerRouter, repository.NewSendAttemptRepository(db), tracker, limiter, providerShaper, templateGate,
+		&cfg.Failover, log,
+	)
+
+	// 所有发送路径经由重试引擎，可重试的失败按渠道策略退避后由后台扫描器重发
+	deadLetterRepo := repository.NewDeadLetterRepository(db)
+	retryEngine := retry.NewEngine(
+		&cfg.Retry, notificationRepo, repository.NewRetryRepository(db), deadLetterRepo, notificationSender, log,
+	)
+	retryEngine.Start()
+	defer retryEngine.Stop()
+
+	sendPool := worker.NewSendPool(&cfg.Worker, retryEngine)
+	sendPool.Start()
+	defer sendPool.Stop()
+
+	// 定时通知登记在 Redis 有序集合中，到期后交给异步发送工作池；未启用 Redis 时仅扫描数据库
+	scheduler := schedule.NewScheduler(&cfg.Schedule, notificationRepo, appRedis.GetGlobal(), sendPool, log)
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	// Redis 可用时启用业务键去重快速通道，否则仅依赖 MySQL 唯一索引
+	var checker idempotent.Checker
+	if redisClient := appRedis.GetGlobal(); redisClient != nil {
+		checker = idempotent.NewChecker(redisClient, &cfg.Idempotency)
+	}
+
+	// 启用租户鉴权时按 API Key 识别业务方，并限制其开通的渠道与日、月配额
+	tenantSvc := tenant.NewService(repository.NewTenantRepository(db), appRedis.GetGlobal(), &cfg.Auth, log)
+	var quota notification.Quota
+	var serverOpts []grpc.ServerOption
+	if cfg.Auth.Enabled {
+		quota = repository.NewTenantUsageRepository(db)
+		serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(
+			appGRPC.AuthUnaryInterceptor(tenantSvc, cfg.Auth.GetAdminToken()),
+		))
+	} else {
+		log.Warn("未启用租户鉴权，所有接口无需凭证即可调用")
+	}
+
+	// 通知引用的模板须在平台创建，受理时按模板生效版本校验参数，
+	// 并按请求或接收者资料中的语言选择模板的语言版本
+	recipientRepo := repository.NewRecipientProfileRepository(db)
+	templateSvc := template.NewService(templateRepo, recipientRepo, i18n.New(&cfg.I18n), submitter, log)
+
+	// 非事务类通知落在租户免打扰时间内时，按接收者所在时区推迟到下一个允许发送的时间
+	windowPlanner := quiethours.NewPlanner(recipientRepo, log)
+
+	// 两阶段通知预提交后等待调用方提交或取消，超时未结束的由回查器回查调用方的事务状态
+	preparedRepo := repository.NewPreparedRepository(db)
+	txStore := transaction.NewStore(&cfg.Transaction, preparedRepo)
+
+	notificationSvc := notification.NewService(
+		notificationRepo, retryEngine, sendPool, scheduler, windowPlanner, txStore,
+		checker, limiter, quota, templateSvc, log,
+	)
+
+	// 启用租户鉴权时按租户配置的回查地址回查，并以通知所属租户的身份提交或取消
+	var checkTenants transaction.Tenants
+	if cfg.Auth.Enabled {
+		checkTenants = tenantSvc
+	}
+	txChecker := transaction.NewChecker(&cfg.Transaction, preparedRepo, checkTenants, notificationSvc, log)
+	txChecker.Start()
+	defer txChecker.Stop()
+
+	// 通知进入终态时按请求或租户的回调地址投递签名的结果，失败按退避策略重试，每次投递都有记录可查
+	callbackRepo := repository.NewCallbackRepository(db)
+	var callbackTenants callback.Tenants
+	if cfg.Auth.Enabled {
+		callbackTenants = tenantSvc
+	}
+	callbackDispatcher := callback.NewDispatcher(&cfg.Callback, callbackRepo, callbackTenants, log)
+	callbackDispatcher.Start()
+	defer callbackDispatcher.Stop()
+
+	// 已成功提交的通知按供应商推送或拉取的投递回执变为已送达或发送失败，拉取器同时重新匹配先于发送结果到达的回执
+	deliveryReportRepo := repository.NewDeliveryReportRepository(db)
+	reportReceiver := dlr.NewReceiver(deliveryReportRepo, registry, log)
+	reportPoller := dlr.NewPoller(&cfg.DeliveryReport, deliveryReportRepo, registry, reportReceiver, log)
+	reportPoller.Start()
+	defer reportPoller.Stop()
+
+	server := grpcx.NewServer(&cfg.GRPC, log, serverOpts...)
+	notificationServer := appGRPC.NewNotificationServer(notificationSvc)
+	notificationv1.RegisterNotificationServiceServer(server, notificationServer)
+	notificationv1.RegisterCallbackServiceServer(
+		server, appGRPC.NewCallbackServer(callback.NewService(notificationSvc, callbackRepo)),
+	)
+	deadLetterSvc := deadletter.NewService(deadLetterRepo, notificationRepo, sendPool, templateSvc, log)
+	adminv1.RegisterDeadLetterServiceServer(server, appGRPC.NewDeadLetterServer(deadLetterSvc))
+	adminv1.RegisterTenantServiceServer(server, appGRPC.NewTenantServer(tenantSvc))
+	templatev1.RegisterTemplateServiceServer(server, appGRPC.NewTemplateServer(templateSvc, approvalSyncer))
+	recipientv1.RegisterRecipientServiceServer(server, appGRPC.NewRecipientServer(recipient.NewService(recipientRepo, log)))
+
+	// 高并发业务方可把通知写入 Kafka，按消费组消费后与 gRPC 异步发送走同一套受理逻辑，落库后才提交位点
+	if cfg.Kafka.Enabled && cfg.Kafka.IngestTopic != "" {
+		var ingestTenants tenant.Service
+		if cfg.Auth.Enabled {
+			ingestTenants = tenantSvc
 		}
+		consumer := appKafka.NewConsumer(&cfg.Kafka, notificationServer, ingestTenants, log)
+		consumer.Start()
+		defer consumer.Stop()
 	}
 
-	// 6) MySQL 使用示例（如果已初始化）
-	if cfg.MySQL.Enabled {
-		db := appMySQL.GetGlobal()
-		if db != nil {
-			// 测试数据库连接
-			if err := db.WithContext(context.Background()).Exec("SELECT 1").Error; err != nil {
-				log.Error("MySQL 查询失败", zap.Error(err))
-			} else {
-				log.Info("MySQL 连接测试成功")
+	// 启用回执推送时启动 HTTP 服务，接收供应商推送的短信状态报告与邮件退信、投诉事件
+	if cfg.DeliveryReport.Enabled {
+		webhookServer := webhook.NewServer(&cfg.DeliveryReport, reportReceiver, log)
+		go func() {
+			if err := webhookServer.Start(); err != nil {
+				log.Error("回执接收服务异常退出", zap.Error(err))
 			}
-		}
+		}()
+		defer webhookServer.Stop()
+	}
+
+	// 7) 启动 gRPC 服务，收到退出信号后优雅退出
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Start()
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case sig := <-quit:
+		log.Info("收到退出信号", zap.String("signal", sig.String()))
+		server.Stop()
+	case err := <-serveErr:
+		log.Error("gRPC 服务异常退出", zap.Error(err))
 	}
 }
@@ -0,0 +1,94 @@
+// Package callback 通知结果回调的请求格式与签名，供业务方接收回调时校验签名、解析结果
+//   - 通知进入终态（成功、送达、失败、取消）时，平台以 POST 将 JSON 编码的 Payload 发送到回调地址
+//   - 请求头 X-Dingdong-Timestamp 为发送时的 Unix 秒级时间戳，X-Dingdong-Signature 为
+//     "sha256=" 加上以签名密钥对 "<时间戳>.<请求体>" 计算的 HMAC-SHA256 十六进制值
+//   - 业务方返回 2xx 表示接收成功，其余响应或超时由平台按退避策略重试，同一回调可能收到多次，按 callback_id 去重
+package callback
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderSignature 携带签名的请求头
+	HeaderSignature = "X-Dingdong-Signature"
+	// HeaderTimestamp 携带签名时间戳的请求头
+	HeaderTimestamp = "X-Dingdong-Timestamp"
+	// HeaderCallbackID 携带回调 ID 的请求头
+	HeaderCallbackID = "X-Dingdong-Callback-Id"
+	// HeaderAttempt 携带投递序号的请求头，首次投递为 1
+	HeaderAttempt = "X-Dingdong-Attempt"
+	// signaturePrefix 签名值的前缀，标识签名算法
+	signaturePrefix = "sha256="
+)
+
+var (
+	// ErrMissingSignature 请求未携带签名或时间戳
+	ErrMissingSignature = errors.New("callback: missing signature")
+	// ErrInvalidSignature 签名不匹配
+	ErrInvalidSignature = errors.New("callback: invalid signature")
+	// ErrTimestampExpired 签名时间戳超出允许的偏差，可能是重放的请求
+	ErrTimestampExpired = errors.New("callback: timestamp expired")
+)
+
+// Payload 回调的请求体
+type Payload struct {
+	// CallbackID 回调 ID，重试时不变
+	CallbackID uint64 `json:"callback_id"`
+	// NotificationID 通知 ID
+	NotificationID uint64 `json:"notification_id"`
+	// TenantID 所属租户，未启用租户鉴权时为空
+	TenantID string `json:"tenant_id,omitempty"`
+	// BizKey 业务键
+	BizKey string `json:"biz_key"`
+	// Channel 渠道：SMS、EMAIL
+	Channel string `json:"channel"`
+	// Status 通知终态：SUCCEEDED、DELIVERED、FAILED、CANCELED；
+	// SUCCEEDED 之后收到供应商回执时会再次回调 DELIVERED 或 FAILED
+	Status string `json:"status"`
+	// ErrorMessage 失败原因
+	ErrorMessage string `json:"error_message,omitempty"`
+	// Provider 最终发送的供应商
+	Provider string `json:"provider,omitempty"`
+	// ProviderMessageID 供应商返回的消息 ID
+	ProviderMessageID string `json:"provider_message_id,omitempty"`
+	// OccurredAt 通知进入终态的时间
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Sign 计算请求体的签名，返回 X-Dingdong-Signature 的值
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 校验回调请求的签名；tolerance 大于 0 时拒绝时间戳与当前时间相差超过 tolerance 的请求
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	signature, ts := header.Get(HeaderSignature), header.Get(HeaderTimestamp)
+	if signature == "" || ts == "" || !strings.HasPrefix(signature, signaturePrefix) {
+		return ErrMissingSignature
+	}
+	timestamp, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return ErrMissingSignature
+	}
+	if tolerance > 0 {
+		if d := time.Since(time.Unix(timestamp, 0)); d > tolerance || d < -tolerance {
+			return ErrTimestampExpired
+		}
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
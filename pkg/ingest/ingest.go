@@ -0,0 +1,145 @@
+// Package ingest 通过 Kafka 提交通知请求的客户端
+// 高并发的业务方可以把通知写入 Kafka，由平台按消费组消费后以异步发送受理，效果等同于调用 SendNotificationAsync：
+//   - 消息体为 protobuf 编码的 notification.v1.Notification
+//   - 消息键为业务键，同一业务键的消息落在同一分区，重复投递由平台按业务键去重
+//   - 启用租户鉴权时 API Key 放在消息头 x-api-key 中
+package ingest
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// HeaderAPIKey 携带 API Key 的消息头
+	HeaderAPIKey = "x-api-key"
+	// HeaderContentType 标识消息体编码的消息头
+	HeaderContentType = "content-type"
+	// ContentTypeProtobuf 消息体为 protobuf 编码的 notification.v1.Notification
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// ErrUnsupportedContentType 消息体的编码不受支持
+var ErrUnsupportedContentType = errors.New("unsupported content type")
+
+// Config 生产者配置
+type Config struct {
+	// Brokers Kafka 集群地址列表
+	Brokers []string
+	// Topic 平台消费的通知请求 topic，与平台配置 kafka.ingest_topic 一致
+	Topic string
+	// APIKey 租户的 API Key，平台未启用租户鉴权时可留空
+	APIKey string
+	// Username SASL/PLAIN 用户名，留空则不使用 SASL
+	Username string
+	// Password SASL/PLAIN 密码
+	Password string
+	// TLS 为 nil 时不使用 TLS
+	TLS *tls.Config
+	// BatchTimeout 批量发送的最长等待时间，为 0 时使用 10 毫秒
+	BatchTimeout time.Duration
+}
+
+// Producer 通知请求生产者，可并发使用
+type Producer struct {
+	writer *kafka.Writer
+	apiKey string
+}
+
+// NewProducer 创建通知请求生产者
+func NewProducer(cfg Config) (*Producer, error) {
+	if len(cfg.Brokers) == 0 || cfg.Topic == "" {
+		return nil, errors.New("ingest: brokers 与 topic 不能为空")
+	}
+	batchTimeout := cfg.BatchTimeout
+	if batchTimeout <= 0 {
+		batchTimeout = 10 * time.Millisecond
+	}
+	transport := &kafka.Transport{TLS: cfg.TLS}
+	if cfg.Username != "" {
+		transport.SASL = plain.Mechanism{Username: cfg.Username, Password: cfg.Password}
+	}
+	return &Producer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(cfg.Brokers...),
+			Topic:        cfg.Topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: batchTimeout,
+			Transport:    transport,
+		},
+		apiKey: cfg.APIKey,
+	}, nil
+}
+
+// Send 校验并写入通知请求，全部写入成功（所有副本确认）后返回
+// 返回 nil 只表示请求已进入 Kafka，受理结果可按业务键通过 QueryNotification 查询
+func (p *Producer) Send(ctx context.Context, ns ...*notificationv1.Notification) error {
+	msgs := make([]kafka.Message, 0, len(ns))
+	for _, n := range ns {
+		msg, err := Encode(n, p.apiKey)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	if err := p.writer.WriteMessages(ctx, msgs...); err != nil {
+		return fmt.Errorf("ingest: write messages: %w", err)
+	}
+	return nil
+}
+
+// Close 发送缓冲中的消息并关闭生产者
+func (p *Producer) Close() error {
+	return p.writer.Close()
+}
+
+// Encode 将通知请求编码为 Kafka 消息；写入前按 proto 中声明的规则校验
+func Encode(n *notificationv1.Notification, apiKey string) (kafka.Message, error) {
+	if err := n.Validate(); err != nil {
+		return kafka.Message{}, fmt.Errorf("ingest: invalid notification: %w", err)
+	}
+	value, err := proto.Marshal(n)
+	if err != nil {
+		return kafka.Message{}, fmt.Errorf("ingest: marshal notification: %w", err)
+	}
+	headers := []kafka.Header{{Key: HeaderContentType, Value: []byte(ContentTypeProtobuf)}}
+	if apiKey != "" {
+		headers = append(headers, kafka.Header{Key: HeaderAPIKey, Value: []byte(apiKey)})
+	}
+	return kafka.Message{
+		Key:     []byte(n.GetBizKey()),
+		Value:   value,
+		Headers: headers,
+	}, nil
+}
+
+// Decode 解码 Kafka 消息，返回通知请求与消息头中的 API Key；未设置 content-type 时按 protobuf 解码
+func Decode(msg kafka.Message) (*notificationv1.Notification, string, error) {
+	if ct := header(msg, HeaderContentType); ct != "" && ct != ContentTypeProtobuf {
+		return nil, "", fmt.Errorf("%w: %s", ErrUnsupportedContentType, ct)
+	}
+	n := &notificationv1.Notification{}
+	if err := proto.Unmarshal(msg.Value, n); err != nil {
+		return nil, "", fmt.Errorf("ingest: unmarshal notification: %w", err)
+	}
+	return n, header(msg, HeaderAPIKey), nil
+}
+
+// header 取消息头的值，不存在时返回空字符串
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+// Package statusevent 通知状态变更事件的 Kafka 消息编解码，供下游系统订阅状态事件 topic 时使用
+//   - 消息体为 protobuf 编码的 notification.v1.StatusChangedEvent
+//   - 消息键为通知 ID，同一通知的事件按发生顺序落在同一分区
+//   - 消息头 event-type 标识事件类型，schema-version 标识事件结构的版本号
+//   - 事件至少投递一次，消费方可按 event_id 去重
+package statusevent
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	notificationv1 "github.com/dingdong-postman/api/proto/gen/notification/v1"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// HeaderEventType 标识事件类型的消息头
+	HeaderEventType = "event-type"
+	// HeaderSchemaVersion 标识事件结构版本号的消息头
+	HeaderSchemaVersion = "schema-version"
+	// HeaderContentType 标识消息体编码的消息头
+	HeaderContentType = "content-type"
+	// ContentTypeProtobuf 消息体为 protobuf 编码
+	ContentTypeProtobuf = "application/x-protobuf"
+	// EventTypeStatusChanged 通知状态变更事件
+	EventTypeStatusChanged = "notification.status_changed"
+	// SchemaVersion 当前的事件结构版本号
+	SchemaVersion = 1
+)
+
+var (
+	// ErrUnsupportedEventType 消息不是状态变更事件
+	ErrUnsupportedEventType = errors.New("unsupported event type")
+	// ErrUnsupportedSchemaVersion 事件结构的版本号高于当前支持的版本
+	ErrUnsupportedSchemaVersion = errors.New("unsupported schema version")
+)
+
+// Encode 将状态变更事件编码为 Kafka 消息
+func Encode(e *notificationv1.StatusChangedEvent) (kafka.Message, error) {
+	value, err := proto.Marshal(e)
+	if err != nil {
+		return kafka.Message{}, fmt.Errorf("statusevent: marshal event: %w", err)
+	}
+	return kafka.Message{
+		Key:   []byte(strconv.FormatUint(e.GetNotificationId(), 10)),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: HeaderEventType, Value: []byte(EventTypeStatusChanged)},
+			{Key: HeaderSchemaVersion, Value: []byte(strconv.Itoa(SchemaVersion))},
+			{Key: HeaderContentType, Value: []byte(ContentTypeProtobuf)},
+		},
+	}, nil
+}
+
+// Decode 解码状态变更事件；事件类型不符或版本号高于当前支持的版本时返回错误，
+// 未设置 schema-version 的消息按版本 1 解码
+func Decode(msg kafka.Message) (*notificationv1.StatusChangedEvent, error) {
+	if t := header(msg, HeaderEventType); t != EventTypeStatusChanged {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedEventType, t)
+	}
+	if v := header(msg, HeaderSchemaVersion); v != "" {
+		version, err := strconv.Atoi(v)
+		if err != nil || version > SchemaVersion {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedSchemaVersion, v)
+		}
+	}
+	e := &notificationv1.StatusChangedEvent{}
+	if err := proto.Unmarshal(msg.Value, e); err != nil {
+		return nil, fmt.Errorf("statusevent: unmarshal event: %w", err)
+	}
+	return e, nil
+}
+
+// header 取消息头的值，不存在时返回空字符串
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,9 @@
+package main
+
+// 渠道供应商插件，通过 init 将供应商类型注册到 provider 包；新增供应商时在此追加导入
+import (
+	_ "github.com/dingdong-postman/internal/provider/aliyunsms"
+	_ "github.com/dingdong-postman/internal/provider/console"
+	_ "github.com/dingdong-postman/internal/provider/smtp"
+	_ "github.com/dingdong-postman/internal/provider/tencentsms"
+)
@@ -5,4 +5,307 @@ CREATE DATABASE IF NOT EXISTS `notification`;
 CREATE USER 'notification'@'%' IDENTIFIED BY 'notification';
 GRANT CREATE, ALTER, INDEX, LOCK TABLES, REFERENCES, UPDATE, DELETE, DROP, SELECT, INSERT ON `notification`.* TO 'notification'@'%';
 
-FLUSH PRIVILEGES;
\ No newline at end of file
+FLUSH PRIVILEGES;
+
+-- create the tables
+-- The service also runs GORM AutoMigrate on startup (repository.InitTables), which adds any
+-- missing tables, columns and indexes. Keep this DDL in sync with the entities in
+-- internal/repository so a fresh database gets the same schema without relying on it.
+USE `notification`;
+
+-- notifications and their current delivery status
+CREATE TABLE IF NOT EXISTS `notification` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `tenant_id` varchar(64) NOT NULL DEFAULT '',
+  `biz_key` varchar(64) NOT NULL,
+  `channel` varchar(16) NOT NULL,
+  `recipient` varchar(256) NOT NULL,
+  `template_id` varchar(64) NOT NULL,
+  `template_params` text,
+  `template_version` bigint NOT NULL DEFAULT 0,
+  `template_locale` varchar(35) NOT NULL DEFAULT '',
+  `locale` varchar(35) NOT NULL DEFAULT '',
+  `email_content` mediumtext,
+  `sign_name` varchar(64),
+  `email_from` varchar(320),
+  `status` varchar(16) NOT NULL,
+  `error_message` varchar(512),
+  `provider` varchar(64),
+  `provider_message_id` varchar(128),
+  `scheduled_at` datetime(3) NULL,
+  `sending_since` datetime(3) NULL,
+  `transactional` boolean NOT NULL DEFAULT false,
+  `callback_url` varchar(512) NOT NULL DEFAULT '',
+  `report_polls` bigint NOT NULL DEFAULT 0,
+  `report_polled_at` datetime(3) NULL,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_tenant_biz_key` (`tenant_id`,`biz_key`),
+  INDEX `idx_status` (`status`),
+  INDEX `idx_status_scheduled_at` (`status`,`scheduled_at`),
+  INDEX `idx_status_updated_at` (`status`,`updated_at`),
+  INDEX `idx_status_sending_since` (`status`,`sending_since`),
+  INDEX `idx_provider_message` (`provider_message_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- every provider call made for a notification
+CREATE TABLE IF NOT EXISTS `notification_send_attempt` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `notification_id` bigint unsigned NOT NULL,
+  `seq` bigint NOT NULL,
+  `provider` varchar(64) NOT NULL,
+  `succeeded` boolean NOT NULL,
+  `message_id` varchar(128),
+  `error_category` varchar(16),
+  `error_code` varchar(128),
+  `error_message` varchar(512),
+  `duration_ms` bigint NOT NULL,
+  `created_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_notification` (`notification_id`),
+  INDEX `idx_provider_created` (`provider`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- pending retry plans, claimed by the retry scanner
+CREATE TABLE IF NOT EXISTS `notification_retry` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `notification_id` bigint unsigned NOT NULL,
+  `channel` varchar(16) NOT NULL,
+  `attempts` bigint NOT NULL,
+  `next_retry_at` datetime(3) NOT NULL,
+  `last_error` varchar(512),
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_notification` (`notification_id`),
+  INDEX `idx_next_retry_at` (`next_retry_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- notifications that failed permanently or ran out of retries
+CREATE TABLE IF NOT EXISTS `notification_dead_letter` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `notification_id` bigint unsigned NOT NULL,
+  `biz_key` varchar(64) NOT NULL,
+  `channel` varchar(16) NOT NULL,
+  `recipient` varchar(256) NOT NULL,
+  `attempts` bigint NOT NULL,
+  `error_category` varchar(16),
+  `last_error` varchar(512),
+  `provider` varchar(64),
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_notification` (`notification_id`),
+  INDEX `idx_channel_created` (`channel`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- tenants, their quotas and callback settings
+CREATE TABLE IF NOT EXISTS `tenant` (
+  `id` varchar(64),
+  `name` varchar(128) NOT NULL,
+  `enabled` boolean NOT NULL,
+  `allowed_channels` varchar(128) NOT NULL,
+  `daily_quota` bigint NOT NULL,
+  `monthly_quota` bigint NOT NULL,
+  `sign_name` varchar(64),
+  `email_from` varchar(320),
+  `delivery_windows` text,
+  `timezone` varchar(64) NOT NULL DEFAULT '',
+  `check_endpoint` varchar(256) NOT NULL DEFAULT '',
+  `callback_url` varchar(512) NOT NULL DEFAULT '',
+  `callback_secret` varchar(128) NOT NULL DEFAULT '',
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- hashed API keys used to authenticate tenants
+CREATE TABLE IF NOT EXISTS `tenant_api_key` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `tenant_id` varchar(64) NOT NULL,
+  `name` varchar(128),
+  `prefix` varchar(16) NOT NULL,
+  `key_hash` char(64) NOT NULL,
+  `revoked` boolean NOT NULL,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_tenant` (`tenant_id`),
+  UNIQUE INDEX `uk_key_hash` (`key_hash`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- per-tenant quota usage by day and month
+CREATE TABLE IF NOT EXISTS `tenant_usage` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `tenant_id` varchar(64) NOT NULL,
+  `period` varchar(16) NOT NULL,
+  `used` bigint NOT NULL,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_tenant_period` (`tenant_id`,`period`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- message templates
+CREATE TABLE IF NOT EXISTS `message_template` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `tenant_id` varchar(64) NOT NULL DEFAULT '',
+  `template_id` varchar(64) NOT NULL,
+  `name` varchar(128) NOT NULL,
+  `channel` varchar(16) NOT NULL,
+  `description` varchar(512),
+  `active_version` bigint NOT NULL,
+  `latest_version` bigint NOT NULL,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_tenant_template` (`tenant_id`,`template_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- immutable template versions
+CREATE TABLE IF NOT EXISTS `message_template_version` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `tenant_id` varchar(64) NOT NULL DEFAULT '',
+  `template_id` varchar(64) NOT NULL,
+  `version` bigint NOT NULL,
+  `subject` varchar(998),
+  `body` mediumtext,
+  `html_body` mediumtext,
+  `locales` mediumtext,
+  `variables` text,
+  `created_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_tenant_template_version` (`tenant_id`,`template_id`,`version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- template approval status at each provider
+CREATE TABLE IF NOT EXISTS `template_approval` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `tenant_id` varchar(64) NOT NULL DEFAULT '',
+  `template_id` varchar(64) NOT NULL,
+  `version` bigint NOT NULL,
+  `locale` varchar(35) NOT NULL DEFAULT '',
+  `provider` varchar(64) NOT NULL,
+  `vendor_code` varchar(64),
+  `param_names` varchar(1024),
+  `status` varchar(16) NOT NULL,
+  `reason` varchar(512),
+  `attempts` bigint NOT NULL,
+  `next_check_at` datetime(3) NOT NULL,
+  `submitted_at` datetime(3) NULL,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_template_version_locale_provider` (`tenant_id`,`template_id`,`version`,`locale`,`provider`),
+  INDEX `idx_status_next_check_at` (`status`,`next_check_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- recipient locale and timezone preferences
+CREATE TABLE IF NOT EXISTS `recipient_profile` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `tenant_id` varchar(64) NOT NULL DEFAULT '',
+  `recipient` varchar(256) NOT NULL,
+  `locale` varchar(35) NOT NULL DEFAULT '',
+  `timezone` varchar(64) NOT NULL DEFAULT '',
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_tenant_recipient` (`tenant_id`,`recipient`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- status change outbox for the event publisher
+CREATE TABLE IF NOT EXISTS `notification_status_event` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `notification_id` bigint unsigned NOT NULL,
+  `tenant_id` varchar(64) NOT NULL DEFAULT '',
+  `biz_key` varchar(64) NOT NULL,
+  `channel` varchar(16) NOT NULL,
+  `previous_status` varchar(16) NOT NULL DEFAULT '',
+  `status` varchar(16) NOT NULL,
+  `error_message` varchar(512),
+  `provider` varchar(64),
+  `provider_message_id` varchar(128),
+  `occurred_at` datetime(3) NOT NULL,
+  `published_at` datetime(3) NULL,
+  `claimed_until` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_occurred_at` (`occurred_at`),
+  INDEX `idx_published_at` (`published_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- two-phase notifications waiting for commit or cancel
+CREATE TABLE IF NOT EXISTS `notification_prepared` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `notification_id` bigint unsigned NOT NULL,
+  `tenant_id` varchar(64) NOT NULL DEFAULT '',
+  `biz_key` varchar(64) NOT NULL,
+  `checks` bigint NOT NULL DEFAULT 0,
+  `next_check_at` datetime(3) NOT NULL,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_notification` (`notification_id`),
+  INDEX `idx_next_check_at` (`next_check_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- result callbacks to tenant endpoints
+CREATE TABLE IF NOT EXISTS `notification_callback` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `notification_id` bigint unsigned NOT NULL,
+  `tenant_id` varchar(64) NOT NULL DEFAULT '',
+  `biz_key` varchar(64) NOT NULL,
+  `channel` varchar(16) NOT NULL,
+  `url` varchar(512) NOT NULL,
+  `notification_status` varchar(16) NOT NULL,
+  `error_message` varchar(512),
+  `provider` varchar(64),
+  `provider_message_id` varchar(128),
+  `occurred_at` datetime(3) NOT NULL,
+  `status` varchar(16) NOT NULL,
+  `attempts` bigint NOT NULL DEFAULT 0,
+  `next_attempt_at` datetime(3) NULL,
+  `last_error` varchar(512),
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_notification` (`notification_id`),
+  INDEX `idx_next_attempt_at` (`next_attempt_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- every delivery attempt of a result callback
+CREATE TABLE IF NOT EXISTS `notification_callback_attempt` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `callback_id` bigint unsigned NOT NULL,
+  `notification_id` bigint unsigned NOT NULL,
+  `attempt` bigint NOT NULL,
+  `url` varchar(512) NOT NULL,
+  `status_code` bigint NOT NULL DEFAULT 0,
+  `error` varchar(512),
+  `duration_ms` bigint NOT NULL DEFAULT 0,
+  `attempted_at` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_callback` (`callback_id`),
+  INDEX `idx_notification` (`notification_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- delivery receipts pushed or polled from providers
+CREATE TABLE IF NOT EXISTS `notification_delivery_report` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `notification_id` bigint unsigned NOT NULL DEFAULT 0,
+  `provider` varchar(64) NOT NULL,
+  `message_id` varchar(128) NOT NULL,
+  `recipient` varchar(256) NOT NULL DEFAULT '',
+  `status` varchar(16) NOT NULL,
+  `code` varchar(64),
+  `message` varchar(512),
+  `source` varchar(8) NOT NULL,
+  `outcome` varchar(16) NOT NULL,
+  `reported_at` datetime(3) NULL,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_notification` (`notification_id`),
+  INDEX `idx_provider_message` (`provider`,`message_id`),
+  INDEX `idx_outcome_created_at` (`outcome`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;